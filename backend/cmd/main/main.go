@@ -1,9 +1,15 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"io"
+	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
+	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/db"
@@ -40,8 +46,22 @@ func main() {
 
 	srv := server.NewServer(port, database, logger)
 
-	if err := srv.Start(); err != nil {
-		logger.WithError(err).Fatal("Failed to start server")
+	go func() {
+		if err := srv.Start(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.WithError(err).Fatal("Failed to start server")
+		}
+	}()
+
+	// Shut down gracefully on SIGINT/SIGTERM, giving requests and background
+	// jobs in progress 30 seconds to finish
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		logger.WithError(err).Error("Failed to shut down server gracefully")
 	}
 }
 
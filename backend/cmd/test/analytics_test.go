@@ -0,0 +1,137 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/analytics"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAnalytics covers recording views of public pages and the owner's report
+func TestAnalytics(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+	aggregator := analytics.NewAggregator(repo.NewAnalyticsRepository(testDB.DB))
+
+	visit := func(t *testing.T, path, ip, userAgent string) {
+		resp := MakeRequestWithHeaders(t, "GET", path, nil, "", map[string]string{
+			"X-Forwarded-For": ip,
+			"User-Agent":      userAgent,
+		})
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+	}
+
+	report := func(t *testing.T, portfolioID uint) map[string]interface{} {
+		require.NoError(t, aggregator.Rollup())
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/analytics", portfolioID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		return ParseJSONBody(t, resp)["data"].(map[string]interface{})
+	}
+
+	t.Run("CountsViewsAndVisitors", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		popular := CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "Popular")
+		other := CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "Other")
+
+		visit(t, fmt.Sprintf("/api/portfolios/public/%d?ref=https://www.google.com/search", portfolio.ID), "203.0.113.1", "Firefox")
+		visit(t, fmt.Sprintf("/api/portfolios/public/%d", portfolio.ID), "203.0.113.1", "Firefox")
+		visit(t, fmt.Sprintf("/api/portfolios/id/%d?ref=https://news.ycombinator.com/item", portfolio.ID), "203.0.113.2", "Safari")
+		visit(t, fmt.Sprintf("/api/categories/public/%d", category.ID), "203.0.113.2", "Safari")
+		visit(t, fmt.Sprintf("/api/projects/public/%d", popular.ID), "203.0.113.1", "Firefox")
+		visit(t, fmt.Sprintf("/api/projects/public/%d", popular.ID), "203.0.113.2", "Safari")
+		visit(t, fmt.Sprintf("/api/projects/public/%d", other.ID), "203.0.113.2", "Safari")
+
+		// Neither bots nor the owner's own reads count
+		visit(t, fmt.Sprintf("/api/portfolios/public/%d", portfolio.ID), "203.0.113.3", "Googlebot/2.1")
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d", portfolio.ID), nil, token)
+		require.Equal(t, 200, resp.Code)
+
+		// Missing pages don't count either
+		resp = MakeRequest(t, "GET", "/api/projects/public/999999", nil, "")
+		require.Equal(t, 404, resp.Code)
+
+		data := report(t, portfolio.ID)
+		assert.Equal(t, float64(7), data["views"])
+		assert.Equal(t, float64(2), data["visitors"])
+
+		daily := data["daily"].([]interface{})
+		require.Len(t, daily, 30)
+		today := daily[len(daily)-1].(map[string]interface{})
+		assert.Equal(t, data["to"], today["date"])
+		assert.Equal(t, float64(7), today["views"])
+
+		projects := data["top_projects"].([]interface{})
+		require.Len(t, projects, 2)
+		top := projects[0].(map[string]interface{})
+		assert.Equal(t, "Popular", top["title"])
+		assert.Equal(t, float64(2), top["views"])
+		assert.Equal(t, float64(2), top["visitors"])
+		assert.Len(t, data["top_categories"], 1)
+
+		referrers := data["referrers"].([]interface{})
+		require.Len(t, referrers, 2)
+		domains := []interface{}{referrers[0].(map[string]interface{})["domain"], referrers[1].(map[string]interface{})["domain"]}
+		assert.ElementsMatch(t, []interface{}{"google.com", "news.ycombinator.com"}, domains)
+		assert.Empty(t, data["countries"], "no GeoIP file configured")
+
+		// Rolling up again recounts the day instead of adding to it
+		data = report(t, portfolio.ID)
+		assert.Equal(t, float64(7), data["views"])
+
+		// No IP is stored
+		var stored int64
+		testDB.DB.Table("page_views").Where("visitor_hash LIKE ?", "%203.0.113%").Count(&stored)
+		assert.Equal(t, int64(0), stored)
+	})
+
+	t.Run("Period", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		path := fmt.Sprintf("/api/portfolios/own/%d/analytics", portfolio.ID)
+
+		resp := MakeRequest(t, "GET", path+"?from=2025-01-01&to=2025-01-07", nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "2025-01-01", data["from"])
+		assert.Len(t, data["daily"], 7)
+		assert.Equal(t, float64(0), data["views"])
+
+		for _, q := range []string{"?from=yesterday", "?from=2025-02-01&to=2025-01-01", "?from=2020-01-01&to=2025-01-01"} {
+			resp = MakeRequest(t, "GET", path+q, nil, token)
+			assert.Equal(t, 400, resp.Code, q)
+			assert.Equal(t, "invalid_query", parseProblem(t, resp).Code, q)
+		}
+	})
+
+	t.Run("OwnerOnly", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, "another-user")
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/analytics", portfolio.ID), nil, token)
+		assert.Equal(t, 403, resp.Code)
+
+		resp = MakeRequest(t, "GET", "/api/portfolios/own/999999/analytics", nil, token)
+		assert.Equal(t, 404, resp.Code)
+	})
+
+	t.Run("DeletedWithPortfolio", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		visit(t, fmt.Sprintf("/api/portfolios/public/%d", portfolio.ID), "203.0.113.1", "Firefox")
+		require.NoError(t, aggregator.Rollup())
+
+		resp := MakeRequest(t, "DELETE", fmt.Sprintf("/api/portfolios/own/%d", portfolio.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		var count int64
+		testDB.DB.Table("daily_views").Where("portfolio_id = ?", portfolio.ID).Count(&count)
+		assert.Equal(t, int64(0), count)
+		testDB.DB.Table("page_views").Where("portfolio_id = ?", portfolio.ID).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+}
@@ -0,0 +1,117 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBatch tests running several operations in one transaction
+func TestBatch(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	t.Run("CreatesPortfolioTreeWithReferences", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		payload := map[string]interface{}{
+			"operations": []map[string]interface{}{
+				{"method": "POST", "path": "/portfolios/own", "body": map[string]interface{}{"title": "Batch Portfolio"}},
+				{"method": "POST", "path": "/categories/own", "body": map[string]interface{}{"title": "Batch Category", "portfolio_id": "$ops[0].id"}},
+				{"method": "POST", "path": "/projects/own", "body": map[string]interface{}{
+					"title":       "Batch Project",
+					"description": "Created in a batch",
+					"category_id": "$ops[1].id",
+				}},
+				{"method": "GET", "path": "/portfolios/own/$ops[0].id"},
+			},
+		}
+		resp := MakeRequest(t, "POST", "/api/batch", payload, token)
+
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			results := data["results"].([]interface{})
+			assert.Len(t, results, 4)
+			assert.Equal(t, float64(201), results[0].(map[string]interface{})["status"])
+			assert.Equal(t, float64(200), results[3].(map[string]interface{})["status"])
+		})
+
+		var count int64
+		testDB.DB.Model(&models.Project{}).Where("owner_id = ? AND title = ?", userID, "Batch Project").Count(&count)
+		assert.Equal(t, int64(1), count)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("FailureRollsBackEverything", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		payload := map[string]interface{}{
+			"operations": []map[string]interface{}{
+				{"method": "POST", "path": "/portfolios/own", "body": map[string]interface{}{"title": "Rolled Back"}},
+				{"method": "POST", "path": "/categories/own", "body": map[string]interface{}{"title": "", "portfolio_id": "$ops[0].id"}},
+			},
+		}
+		resp := MakeRequest(t, "POST", "/api/batch", payload, token)
+
+		AssertJSONResponse(t, resp, 400, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Equal(t, float64(1), data["failed_operation"])
+			assert.Len(t, data["results"], 2)
+		})
+
+		var count int64
+		testDB.DB.Model(&models.Portfolio{}).Where("owner_id = ? AND title = ?", userID, "Rolled Back").Count(&count)
+		assert.Equal(t, int64(0), count)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("OtherUsersResourceForbidden", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		other := CreateTestPortfolio(testDB.DB, "other-user")
+
+		payload := map[string]interface{}{
+			"operations": []map[string]interface{}{
+				{"method": "POST", "path": "/categories/own", "body": map[string]interface{}{"title": "Sneaky", "portfolio_id": other.ID}},
+			},
+		}
+		resp := MakeRequest(t, "POST", "/api/batch", payload, token)
+		assert.Equal(t, 403, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("UnresolvedReference", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		payload := map[string]interface{}{
+			"operations": []map[string]interface{}{
+				{"method": "POST", "path": "/categories/own", "body": map[string]interface{}{"title": "Orphan", "portfolio_id": "$ops[3].id"}},
+			},
+		}
+		resp := MakeRequest(t, "POST", "/api/batch", payload, token)
+		assert.Equal(t, 400, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("NestedBatchRejected", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"operations": []map[string]interface{}{
+				{"method": "POST", "path": "/batch", "body": map[string]interface{}{"operations": []interface{}{}}},
+			},
+		}
+		resp := MakeRequest(t, "POST", "/api/batch", payload, token)
+		assert.Equal(t, 400, resp.Code)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"operations": []map[string]interface{}{{"method": "GET", "path": "/portfolios/own"}},
+		}
+		resp := MakeRequest(t, "POST", "/api/batch", payload, "")
+		assert.Equal(t, 401, resp.Code)
+	})
+}
@@ -0,0 +1,138 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestConcurrency_ProjectIfMatch tests optimistic concurrency control on project writes
+func TestConcurrency_ProjectIfMatch(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	t.Run("GetReturnsETag", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/projects/own/%d", project.ID), nil, token)
+		assert.Equal(t, 200, resp.Code)
+		assert.Equal(t, `"1"`, resp.Header().Get("ETag"))
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("UpdateWithMatchingVersion", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		payload := map[string]interface{}{
+			"title":       "Updated Project",
+			"description": "Updated description",
+			"category_id": category.ID,
+		}
+		resp := MakeRequestWithHeaders(t, "PUT", fmt.Sprintf("/api/projects/own/%d", project.ID), payload, token,
+			map[string]string{"If-Match": `"1"`})
+
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Equal(t, float64(2), data["version"])
+		})
+		assert.Equal(t, `"2"`, resp.Header().Get("ETag"))
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("UpdateWithStaleVersion", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+		path := fmt.Sprintf("/api/projects/own/%d", project.ID)
+
+		// First tab saves
+		first := map[string]interface{}{"title": "First Tab", "description": "From the first tab", "category_id": category.ID}
+		resp := MakeRequestWithHeaders(t, "PUT", path, first, token, map[string]string{"If-Match": `"1"`})
+		assert.Equal(t, 200, resp.Code)
+
+		// Second tab still holds version 1
+		second := map[string]interface{}{"title": "Second Tab", "description": "From the second tab", "category_id": category.ID}
+		resp = MakeRequestWithHeaders(t, "PUT", path, second, token, map[string]string{"If-Match": `"1"`})
+		assert.Equal(t, 412, resp.Code)
+
+		// The first write was kept
+		resp = MakeRequest(t, "GET", path, nil, token)
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Equal(t, "First Tab", data["title"])
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("DeleteWithStaleVersion", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		resp := MakeRequestWithHeaders(t, "DELETE", fmt.Sprintf("/api/projects/own/%d", project.ID), nil, token,
+			map[string]string{"If-Match": `"5"`})
+		assert.Equal(t, 412, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("InvalidIfMatch", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		resp := MakeRequestWithHeaders(t, "DELETE", fmt.Sprintf("/api/projects/own/%d", project.ID), nil, token,
+			map[string]string{"If-Match": "not-an-etag"})
+		assert.Equal(t, 412, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("WithoutIfMatch_NonStrictMode", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		resp := MakeRequest(t, "DELETE", fmt.Sprintf("/api/projects/own/%d", project.ID), nil, token)
+		assert.Equal(t, 200, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+}
+
+// TestConcurrency_SectionContentOrder tests that order changes bump the version
+func TestConcurrency_SectionContentOrder(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	cleanDatabase(testDB.DB)
+	portfolio := CreateTestPortfolio(testDB.DB, userID)
+	section := CreateTestSection(testDB.DB, portfolio.ID, userID)
+	content := CreateTestSectionContent(testDB.DB, section.ID, userID)
+	path := fmt.Sprintf("/api/section-contents/own/%d/order", content.ID)
+
+	resp := MakeRequestWithHeaders(t, "PATCH", path, map[string]interface{}{"order": 3}, token,
+		map[string]string{"If-Match": `"1"`})
+	assert.Equal(t, 200, resp.Code)
+	assert.Equal(t, `"2"`, resp.Header().Get("ETag"))
+
+	resp = MakeRequestWithHeaders(t, "PATCH", path, map[string]interface{}{"order": 4}, token,
+		map[string]string{"If-Match": `"1"`})
+	assert.Equal(t, 412, resp.Code)
+
+	cleanDatabase(testDB.DB)
+}
@@ -0,0 +1,268 @@
+package test
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/notify/smtptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// contactVisitors hands out an IP per visitor, so the contact rate limit of
+// one test doesn't spill into another
+var contactVisitors atomic.Int32
+
+// sendContact posts a message to the portfolio's contact form from ip
+func sendContact(t *testing.T, portfolioID uint, ip string, body map[string]interface{}) int {
+	resp := MakeRequestWithHeaders(t, "POST", fmt.Sprintf("/api/portfolios/public/%d/contact", portfolioID), body, "",
+		map[string]string{"X-Forwarded-For": ip})
+	return resp.Code
+}
+
+func newContactVisitor() string {
+	return fmt.Sprintf("198.51.100.%d", contactVisitors.Add(1))
+}
+
+// waitForMail waits until the SMTP stand-in received count messages
+func waitForMail(t *testing.T, count int) []smtptest.Message {
+	require.Eventually(t, func() bool { return len(mailServer.Messages()) >= count }, 5*time.Second, 20*time.Millisecond)
+	return mailServer.Messages()
+}
+
+// TestContact covers the contact form, its settings and the owner's inbox
+func TestContact(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+	message := map[string]interface{}{
+		"name":    "Ana Souza",
+		"email":   "ana@example.org",
+		"subject": "Freelance work",
+		"message": "Hi! Are you available in May?",
+	}
+
+	t.Run("SubmitToInbox", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		assert.Equal(t, http.StatusAccepted, sendContact(t, portfolio.ID, newContactVisitor(), message))
+
+		resp := MakeRequest(t, "GET", "/api/messages/own", nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		body := ParseJSONBody(t, resp)
+		assert.Equal(t, float64(1), body["total"])
+		messages := body["data"].([]interface{})
+		require.Len(t, messages, 1)
+		received := messages[0].(map[string]interface{})
+		assert.Equal(t, "Ana Souza", received["name"])
+		assert.Equal(t, "ana@example.org", received["email"])
+		assert.Equal(t, "Freelance work", received["subject"])
+		assert.Equal(t, "Hi! Are you available in May?", received["message"])
+		assert.Equal(t, models.ContactUnread, received["status"])
+		assert.Equal(t, float64(portfolio.ID), received["portfolio_id"])
+	})
+
+	t.Run("Notifications", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		mailServer.Reset()
+		portfolio := CreateTestPortfolioWithTitle(testDB.DB, userID, "Ana's Portfolio")
+
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/portfolios/own/%d/contact/settings", portfolio.ID), map[string]interface{}{
+			"notify_email":       "owner@example.com",
+			"auto_reply":         true,
+			"auto_reply_subject": "Thanks for writing to {portfolio}",
+			"auto_reply_body":    "Got your message, I'll answer soon.",
+		}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		assert.Equal(t, http.StatusAccepted, sendContact(t, portfolio.ID, newContactVisitor(), message))
+
+		mails := waitForMail(t, 2)
+		require.Len(t, mails, 2)
+		byRecipient := map[string]smtptest.Message{}
+		for _, mail := range mails {
+			require.Len(t, mail.To, 1)
+			byRecipient[mail.To[0]] = mail
+		}
+
+		owner, err := byRecipient["owner@example.com"].Parse()
+		require.NoError(t, err)
+		subject, _ := new(mime.WordDecoder).DecodeHeader(owner.Header.Get("Subject"))
+		assert.Equal(t, "New message on Ana's Portfolio: Freelance work", subject)
+		assert.Equal(t, `"Ana Souza" <ana@example.org>`, owner.Header.Get("Reply-To"))
+
+		reply, err := byRecipient["ana@example.org"].Parse()
+		require.NoError(t, err)
+		subject, _ = new(mime.WordDecoder).DecodeHeader(reply.Header.Get("Subject"))
+		assert.Equal(t, "Thanks for writing to Ana's Portfolio", subject)
+		assert.Equal(t, "<owner@example.com>", reply.Header.Get("Reply-To"))
+
+		// The same address isn't answered again, the owner still hears about it
+		mailServer.Reset()
+		assert.Equal(t, http.StatusAccepted, sendContact(t, portfolio.ID, newContactVisitor(), message))
+		require.Eventually(t, func() bool {
+			var queued int64
+			testDB.DB.Model(&models.Job{}).
+				Where("type LIKE ? AND status IN ?", "contact.%", []string{models.JobPending, models.JobRunning}).
+				Count(&queued)
+			return queued == 0
+		}, 5*time.Second, 20*time.Millisecond)
+		mails = mailServer.Messages()
+		require.Len(t, mails, 1)
+		assert.Equal(t, []string{"owner@example.com"}, mails[0].To)
+	})
+
+	t.Run("Honeypot", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		spam := map[string]interface{}{"name": "Bot", "email": "bot@example.com", "message": "Buy now", "website": "https://spam.example.com"}
+		assert.Equal(t, http.StatusAccepted, sendContact(t, portfolio.ID, newContactVisitor(), spam), "bots can't tell")
+
+		var count int64
+		testDB.DB.Model(&models.ContactMessage{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("RateLimit", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		ip := newContactVisitor()
+
+		for i := 0; i < 5; i++ {
+			require.Equal(t, http.StatusAccepted, sendContact(t, portfolio.ID, ip, message))
+		}
+		assert.Equal(t, http.StatusTooManyRequests, sendContact(t, portfolio.ID, ip, message))
+		assert.Equal(t, http.StatusAccepted, sendContact(t, portfolio.ID, newContactVisitor(), message), "other visitors aren't limited")
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		invalid := []map[string]interface{}{
+			{"name": "Ana", "message": "No email"},
+			{"name": "Ana", "email": "not-an-email", "message": "Hi"},
+			{"name": "   ", "email": "ana@example.org", "message": "Hi"},
+			{"name": "Ana", "email": "ana@example.org", "message": "  "},
+		}
+		for _, body := range invalid {
+			assert.Equal(t, http.StatusBadRequest, sendContact(t, portfolio.ID, newContactVisitor(), body), body)
+		}
+
+		assert.Equal(t, http.StatusNotFound, sendContact(t, 999999, newContactVisitor(), message))
+
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/portfolios/own/%d/contact/settings", portfolio.ID), map[string]interface{}{"enabled": false}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, http.StatusForbidden, sendContact(t, portfolio.ID, newContactVisitor(), message))
+	})
+
+	t.Run("InboxStates", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		require.Equal(t, http.StatusAccepted, sendContact(t, portfolio.ID, newContactVisitor(), message))
+
+		var stored models.ContactMessage
+		require.NoError(t, testDB.DB.First(&stored).Error)
+		path := fmt.Sprintf("/api/messages/own/%d", stored.ID)
+
+		resp := MakeRequestWithHeaders(t, "PUT", path, map[string]interface{}{"status": "read"}, token, map[string]string{"If-Match": `"1"`})
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, models.ContactRead, data["status"])
+		assert.NotEmpty(t, data["read_at"])
+		assert.Equal(t, `"2"`, resp.Header().Get("ETag"))
+
+		resp = MakeRequestWithHeaders(t, "PUT", path, map[string]interface{}{"status": "archived"}, token, map[string]string{"If-Match": `"1"`})
+		assert.Equal(t, 412, resp.Code, "stale version")
+
+		resp = MakeRequest(t, "PUT", path, map[string]interface{}{"status": "archived"}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		// Archived messages leave the inbox unless asked for
+		resp = MakeRequest(t, "GET", "/api/messages/own", nil, token)
+		assert.Equal(t, float64(0), ParseJSONBody(t, resp)["total"])
+		resp = MakeRequest(t, "GET", "/api/messages/own?status=archived", nil, token)
+		assert.Equal(t, float64(1), ParseJSONBody(t, resp)["total"])
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/messages/own?status=unread&portfolio_id=%d", portfolio.ID), nil, token)
+		assert.Equal(t, float64(0), ParseJSONBody(t, resp)["total"])
+
+		resp = MakeRequest(t, "PUT", path, map[string]interface{}{"status": "spam"}, token)
+		assert.Equal(t, 400, resp.Code)
+		resp = MakeRequest(t, "GET", "/api/messages/own?status=spam", nil, token)
+		assert.Equal(t, 400, resp.Code)
+		assert.Equal(t, "invalid_query", parseProblem(t, resp).Code)
+
+		resp = MakeRequest(t, "DELETE", path, nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		resp = MakeRequest(t, "GET", path, nil, token)
+		assert.Equal(t, 404, resp.Code)
+	})
+
+	t.Run("OwnerOnly", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, "another-user")
+		require.Equal(t, http.StatusAccepted, sendContact(t, portfolio.ID, newContactVisitor(), message))
+
+		var stored models.ContactMessage
+		require.NoError(t, testDB.DB.First(&stored).Error)
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/messages/own/%d", stored.ID), nil, token)
+		assert.Equal(t, 403, resp.Code)
+		resp = MakeRequest(t, "GET", "/api/messages/own", nil, token)
+		assert.Equal(t, float64(0), ParseJSONBody(t, resp)["total"])
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/contact/settings", portfolio.ID), nil, token)
+		assert.Equal(t, 403, resp.Code)
+	})
+
+	t.Run("Settings", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		path := fmt.Sprintf("/api/portfolios/own/%d/contact/settings", portfolio.ID)
+
+		// Defaults until the owner saves some
+		resp := MakeRequest(t, "GET", path, nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, true, data["enabled"])
+		assert.Equal(t, false, data["auto_reply"])
+		assert.Equal(t, `"1"`, resp.Header().Get("ETag"))
+
+		settings := map[string]interface{}{"notify_email": "owner@example.com"}
+		resp = MakeRequestWithHeaders(t, "PUT", path, settings, token, map[string]string{"If-Match": `"1"`})
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, `"2"`, resp.Header().Get("ETag"))
+
+		resp = MakeRequestWithHeaders(t, "PUT", path, settings, token, map[string]string{"If-Match": `"1"`})
+		assert.Equal(t, 412, resp.Code, "stale version")
+
+		resp = MakeRequest(t, "PUT", path, map[string]interface{}{"auto_reply": true, "auto_reply_body": "Thanks"}, token)
+		assert.Equal(t, 400, resp.Code, "auto-reply without subject")
+		resp = MakeRequest(t, "PUT", path, map[string]interface{}{"auto_reply": true, "auto_reply_subject": "Thanks, {name}", "auto_reply_body": "Thanks"}, token)
+		assert.Equal(t, 400, resp.Code, "auto-reply quoting the visitor")
+		resp = MakeRequest(t, "PUT", path, map[string]interface{}{"notify_email": "nope"}, token)
+		assert.Equal(t, 400, resp.Code)
+
+		resp = MakeRequest(t, "GET", path, nil, token)
+		data = ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "owner@example.com", data["notify_email"])
+		assert.Equal(t, float64(2), data["version"])
+	})
+
+	t.Run("DeletedWithPortfolio", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		require.Equal(t, http.StatusAccepted, sendContact(t, portfolio.ID, newContactVisitor(), message))
+
+		resp := MakeRequest(t, "DELETE", fmt.Sprintf("/api/portfolios/own/%d", portfolio.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		resp = MakeRequest(t, "GET", "/api/messages/own", nil, token)
+		assert.Equal(t, float64(0), ParseJSONBody(t, resp)["total"])
+	})
+}
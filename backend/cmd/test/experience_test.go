@@ -0,0 +1,251 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createEntry posts body to path and returns the ID of the created row
+func createEntry(t *testing.T, path string, body map[string]interface{}, token string) uint {
+	resp := MakeRequest(t, "POST", path, body, token)
+	require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+	return uint(ParseJSONBody(t, resp)["data"].(map[string]interface{})["id"].(float64))
+}
+
+// listField lists one field of each item at path
+func listField(t *testing.T, path string, field string) []interface{} {
+	resp := MakeRequest(t, "GET", path, nil, "")
+	require.Equal(t, 200, resp.Code, resp.Body.String())
+	values := []interface{}{}
+	for _, item := range ParseJSONBody(t, resp)["data"].([]interface{}) {
+		values = append(values, item.(map[string]interface{})[field])
+	}
+	return values
+}
+
+// TestExperience covers work history and education entries
+func TestExperience(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+	experience := func(portfolioID uint, company string) map[string]interface{} {
+		return map[string]interface{}{
+			"portfolio_id": portfolioID,
+			"company":      company,
+			"title":        "Backend Engineer",
+			"location":     "Lisbon",
+			"start_date":   "2021-03-01T00:00:00Z",
+			"end_date":     "2023-06-30T00:00:00Z",
+			"highlights":   []string{"Cut p99 latency in half", "Led the billing rewrite"},
+		}
+	}
+
+	t.Run("ExperienceCRUD", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		id := createEntry(t, "/api/experiences/own", experience(portfolio.ID, "Acme"), token)
+		path := fmt.Sprintf("/api/experiences/own/%d", id)
+
+		resp := MakeRequest(t, "GET", path, nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "Acme", data["company"])
+		assert.Equal(t, "Lisbon", data["location"])
+		assert.Equal(t, []interface{}{"Cut p99 latency in half", "Led the billing rewrite"}, data["highlights"])
+		assert.Equal(t, float64(1), data["position"])
+		assert.Equal(t, `"1"`, resp.Header().Get("ETag"))
+
+		update := map[string]interface{}{"company": "Acme Corp", "title": "Staff Engineer", "start_date": "2021-03-01T00:00:00Z", "current": true}
+		resp = MakeRequestWithHeaders(t, "PUT", path, update, token, map[string]string{"If-Match": `"1"`})
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data = ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "Staff Engineer", data["title"])
+		assert.Equal(t, true, data["current"])
+		assert.Nil(t, data["end_date"])
+		assert.Equal(t, []interface{}{}, data["highlights"])
+
+		resp = MakeRequestWithHeaders(t, "PUT", path, update, token, map[string]string{"If-Match": `"1"`})
+		assert.Equal(t, 412, resp.Code, "stale version")
+
+		assert.Equal(t, []interface{}{"Acme Corp"}, listField(t, fmt.Sprintf("/api/portfolios/public/%d/experiences", portfolio.ID), "company"))
+
+		resp = MakeRequest(t, "DELETE", path, nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		resp = MakeRequest(t, "GET", path, nil, token)
+		assert.Equal(t, 404, resp.Code)
+	})
+
+	t.Run("ExperienceValidation", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		invalid := []map[string]interface{}{
+			{"company": "Acme", "title": "Engineer"},
+			{"company": "  ", "title": "Engineer", "start_date": "2021-03-01T00:00:00Z"},
+			{"company": "Acme", "title": "Engineer", "start_date": "2021-03-01T00:00:00Z", "end_date": "2020-01-01T00:00:00Z"},
+			{"company": "Acme", "title": "Engineer", "start_date": "2021-03-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z", "current": true},
+			{"company": "Acme", "title": "Engineer", "start_date": "2021-03-01T00:00:00Z", "highlights": []string{""}},
+		}
+		for _, body := range invalid {
+			body["portfolio_id"] = portfolio.ID
+			resp := MakeRequest(t, "POST", "/api/experiences/own", body, token)
+			assert.Equal(t, 400, resp.Code, body)
+		}
+	})
+
+	t.Run("LinkedProjects", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		api := CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "API")
+		worker := CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "Worker")
+		other := CreateTestPortfolio(testDB.DB, userID)
+		elsewhere := CreateTestProject(testDB.DB, CreateTestCategory(testDB.DB, other.ID, userID).ID, userID)
+
+		body := experience(portfolio.ID, "Acme")
+		body["project_ids"] = []uint{worker.ID, api.ID, worker.ID}
+		id := createEntry(t, "/api/experiences/own", body, token)
+
+		public := fmt.Sprintf("/api/portfolios/public/%d/experiences", portfolio.ID)
+		assert.Equal(t, []interface{}{[]interface{}{float64(worker.ID), float64(api.ID)}}, listField(t, public, "project_ids"))
+
+		// Only projects shown in the portfolio
+		body["project_ids"] = []uint{elsewhere.ID}
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/experiences/own/%d", id), body, token)
+		assert.Equal(t, 400, resp.Code)
+
+		// Deleted projects drop out of the links
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/projects/own/%d", worker.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, []interface{}{[]interface{}{float64(api.ID)}}, listField(t, public, "project_ids"))
+	})
+
+	t.Run("Order", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		ids := map[string]uint{}
+		for _, company := range []string{"Acme", "Globex", "Initech"} {
+			ids[company] = createEntry(t, "/api/experiences/own", experience(portfolio.ID, company), token)
+		}
+		public := fmt.Sprintf("/api/portfolios/public/%d/experiences", portfolio.ID)
+
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/experiences/own/%d/position", ids["Initech"]),
+			map[string]interface{}{"before": ids["Acme"]}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, []interface{}{"Initech", "Acme", "Globex"}, listField(t, public, "company"))
+
+		resp = MakeRequest(t, "PUT", "/api/experiences/own/reorder", map[string]interface{}{"items": []map[string]interface{}{
+			{"id": ids["Globex"], "position": 1},
+		}}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, []interface{}{"Globex", "Initech", "Acme"}, listField(t, public, "company"))
+
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/experiences/own/%d", ids["Initech"]), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, []interface{}{float64(1), float64(2)}, listField(t, public, "position"))
+	})
+
+	t.Run("Education", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		public := fmt.Sprintf("/api/portfolios/public/%d/education", portfolio.ID)
+
+		bsc := createEntry(t, "/api/education/own", map[string]interface{}{
+			"portfolio_id": portfolio.ID,
+			"institution":  "University of Lisbon",
+			"degree":       "BSc Computer Science",
+			"start_date":   "2012-09-01T00:00:00Z",
+			"end_date":     "2015-07-01T00:00:00Z",
+		}, token)
+		msc := createEntry(t, "/api/education/own", map[string]interface{}{
+			"portfolio_id": portfolio.ID,
+			"institution":  "University of Porto",
+			"degree":       "MSc Software Engineering",
+		}, token)
+
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/education/own/%d/position", msc), map[string]interface{}{"position": 1}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, []interface{}{"MSc Software Engineering", "BSc Computer Science"}, listField(t, public, "degree"))
+
+		path := fmt.Sprintf("/api/education/own/%d", bsc)
+		resp = MakeRequestWithHeaders(t, "PUT", path, map[string]interface{}{"institution": "IST Lisbon", "degree": "BSc Computer Science"},
+			token, map[string]string{"If-Match": `"1"`})
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "IST Lisbon", data["institution"])
+		assert.Nil(t, data["start_date"])
+
+		resp = MakeRequest(t, "PUT", path, map[string]interface{}{
+			"institution": "IST Lisbon", "degree": "BSc", "start_date": "2015-09-01T00:00:00Z", "end_date": "2012-07-01T00:00:00Z",
+		}, token)
+		assert.Equal(t, 400, resp.Code, "end before start")
+
+		resp = MakeRequest(t, "DELETE", path, nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, []interface{}{"MSc Software Engineering"}, listField(t, public, "degree"))
+	})
+
+	t.Run("IncludedInPortfolio", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		body := experience(portfolio.ID, "Acme")
+		body["project_ids"] = []uint{project.ID}
+		createEntry(t, "/api/experiences/own", body, token)
+		createEntry(t, "/api/education/own", map[string]interface{}{
+			"portfolio_id": portfolio.ID, "institution": "University of Lisbon", "degree": "BSc Computer Science",
+		}, token)
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/public/%d?include=experiences,education", portfolio.ID), nil, "")
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		experiences := data["experiences"].([]interface{})
+		require.Len(t, experiences, 1)
+		assert.Equal(t, "Acme", experiences[0].(map[string]interface{})["company"])
+		assert.Equal(t, []interface{}{float64(project.ID)}, experiences[0].(map[string]interface{})["project_ids"])
+		education := data["education"].([]interface{})
+		require.Len(t, education, 1)
+		assert.Equal(t, "BSc Computer Science", education[0].(map[string]interface{})["degree"])
+	})
+
+	t.Run("OwnerOnly", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, "another-user")
+
+		resp := MakeRequest(t, "POST", "/api/experiences/own", experience(portfolio.ID, "Acme"), token)
+		assert.Equal(t, 403, resp.Code)
+		resp = MakeRequest(t, "POST", "/api/education/own",
+			map[string]interface{}{"portfolio_id": portfolio.ID, "institution": "University of Lisbon", "degree": "BSc"}, token)
+		assert.Equal(t, 403, resp.Code)
+
+		entry := models.Education{PortfolioID: portfolio.ID, OwnerID: "another-user", Institution: "University of Lisbon", Degree: "BSc", Position: 1}
+		require.NoError(t, testDB.DB.Create(&entry).Error)
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/education/own/%d", entry.ID), nil, token)
+		assert.Equal(t, 403, resp.Code)
+	})
+
+	t.Run("DeletedWithPortfolio", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		createEntry(t, "/api/experiences/own", experience(portfolio.ID, "Acme"), token)
+		createEntry(t, "/api/education/own", map[string]interface{}{
+			"portfolio_id": portfolio.ID, "institution": "University of Lisbon", "degree": "BSc",
+		}, token)
+
+		resp := MakeRequest(t, "DELETE", fmt.Sprintf("/api/portfolios/own/%d", portfolio.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		var count int64
+		testDB.DB.Model(&models.Experience{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+		testDB.DB.Model(&models.Education{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+}
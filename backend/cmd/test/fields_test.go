@@ -0,0 +1,132 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// keysOf returns the keys of a JSON object
+func keysOf(object interface{}) []string {
+	var keys []string
+	for key := range object.(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// TestFields_SparseFieldsets checks fields= and include= on detail and list endpoints
+func TestFields_SparseFieldsets(t *testing.T) {
+	userID := GetTestUserID()
+
+	t.Run("Success_SectionFields", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		section := CreateTestSection(testDB.DB, portfolio.ID, userID)
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/sections/public/%d?fields=title,type", section.ID), nil, "")
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.ElementsMatch(t, []string{"id", "title", "type"}, keysOf(data))
+			assert.Equal(t, "text", data["type"])
+			assert.EqualValues(t, section.ID, data["id"])
+		})
+		assert.NotEmpty(t, resp.Header().Get("ETag"), "the version is loaded for the ETag even when not selected")
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Success_SectionDefaultShapeIsComplete", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		section := CreateTestSection(testDB.DB, portfolio.ID, userID)
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/sections/public/%d", section.ID), nil, "")
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Equal(t, "text", data["type"])
+			assert.Equal(t, "Test section description", data["description"])
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Success_CategoryIncludeProjects", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "Second")
+		CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "First")
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/categories/public/%d?fields=title&include=projects", category.ID), nil, "")
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.ElementsMatch(t, []string{"id", "title", "projects"}, keysOf(data))
+			assert.Len(t, data["projects"], 2)
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Success_PortfolioWithoutRelations", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		CreateTestSection(testDB.DB, portfolio.ID, userID)
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/public/%d?fields=title", portfolio.ID), nil, "")
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			assert.ElementsMatch(t, []string{"id", "title"}, keysOf(body["data"]))
+		})
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/public/%d?fields=title&include=sections", portfolio.ID), nil, "")
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Len(t, data["sections"], 1)
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Success_ListFields", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		CreateTestProject(testDB.DB, category.ID, userID)
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/categories/public/%d/projects?fields=title,skills&sort=title&limit=10", category.ID), nil, "")
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].([]interface{})
+			require.Len(t, data, 1)
+			assert.ElementsMatch(t, []string{"id", "title", "skills"}, keysOf(data[0]))
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Error_FieldNotAllowed", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		for _, path := range []string{
+			fmt.Sprintf("/api/projects/public/%d?fields=deleted_at", project.ID),
+			fmt.Sprintf("/api/projects/public/%d?include=category", project.ID),
+			fmt.Sprintf("/api/categories/public/%d?include=sections", category.ID),
+			fmt.Sprintf("/api/portfolios/public/%d/sections?fields=skills", portfolio.ID),
+		} {
+			resp := MakeRequest(t, "GET", path, nil, "")
+			assert.Equal(t, 400, resp.Code, path)
+		}
+
+		cleanDatabase(testDB.DB)
+	})
+}
@@ -24,6 +24,11 @@ func getBaseURL() string {
 
 // MakeRequest creates and executes an HTTP request
 func MakeRequest(t *testing.T, method, path string, body interface{}, token string) *httptest.ResponseRecorder {
+	return MakeRequestWithHeaders(t, method, path, body, token, nil)
+}
+
+// MakeRequestWithHeaders creates and executes an HTTP request with extra headers (e.g. If-Match)
+func MakeRequestWithHeaders(t *testing.T, method, path string, body interface{}, token string, headers map[string]string) *httptest.ResponseRecorder {
 	var bodyReader io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
@@ -41,6 +46,9 @@ func MakeRequest(t *testing.T, method, path string, body interface{}, token stri
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
 
 	client := &http.Client{}
 	resp, err := client.Do(req)
@@ -0,0 +1,100 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestI18n_ErrorMessages checks error and validation messages follow Accept-Language
+func TestI18n_ErrorMessages(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	t.Run("BindingErrors_Portuguese", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		resp := MakeRequestWithHeaders(t, "POST", "/api/portfolios/own", map[string]interface{}{}, token, map[string]string{"Accept-Language": "pt-BR,pt;q=0.9,en;q=0.8"})
+		require.Equal(t, 400, resp.Code)
+		assert.Equal(t, "pt-BR", resp.Header().Get("Content-Language"))
+		assert.Contains(t, resp.Header().Values("Vary"), "Accept-Language")
+
+		problem := parseProblem(t, resp)
+		assert.Equal(t, "Requisição inválida", problem.Title)
+		assert.Equal(t, "Dados da requisição inválidos", problem.Detail)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "title", problem.Errors[0].Field, "fields and codes are not translated")
+		assert.Equal(t, "required", problem.Errors[0].Code)
+		assert.Equal(t, "O campo Título é obrigatório", problem.Errors[0].Message)
+	})
+
+	t.Run("ValidatorErrors_Spanish", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+
+		payload := map[string]interface{}{
+			"title":       "Bad link",
+			"description": "Project with an unsafe link",
+			"category_id": category.ID,
+			"link":        "javascript:alert(1)",
+		}
+		resp := MakeRequestWithHeaders(t, "POST", "/api/projects/own", payload, token, map[string]string{"Accept-Language": "es-MX"})
+		require.Equal(t, 400, resp.Code)
+		assert.Equal(t, "es", resp.Header().Get("Content-Language"))
+
+		problem := parseProblem(t, resp)
+		assert.Equal(t, "El campo Enlace debe usar el esquema http:// o https://", problem.Detail)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, problem.Detail, problem.Errors[0].Message)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("HandlerMessage_Spanish", func(t *testing.T) {
+		resp := MakeRequestWithHeaders(t, "GET", "/api/projects/public/999999", nil, "", map[string]string{"Accept-Language": "es"})
+		require.Equal(t, 404, resp.Code)
+
+		problem := parseProblem(t, resp)
+		assert.Equal(t, "Proyecto no encontrado", problem.Detail)
+		assert.Equal(t, "not_found", problem.Code)
+	})
+
+	t.Run("QueryError_Spanish", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+
+		resp := MakeRequestWithHeaders(t, "GET", fmt.Sprintf("/api/categories/public/%d/projects?sort=bogus", category.ID), nil, "", map[string]string{"Accept-Language": "es"})
+		require.Equal(t, 400, resp.Code)
+		assert.Equal(t, "es", resp.Header().Get("Content-Language"))
+
+		problem := parseProblem(t, resp)
+		assert.Equal(t, "sort debe ser uno de title, position, created_at, updated_at", problem.Detail)
+		assert.Equal(t, "invalid_query", problem.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("MiddlewareMessage_Portuguese", func(t *testing.T) {
+		resp := MakeRequestWithHeaders(t, "GET", "/api/portfolios/own", nil, "", map[string]string{"Accept-Language": "pt"})
+		require.Equal(t, 401, resp.Code)
+
+		problem := parseProblem(t, resp)
+		assert.Equal(t, "O cabeçalho Authorization é obrigatório", problem.Detail)
+	})
+
+	t.Run("UnsupportedLanguage_FallsBackToEnglish", func(t *testing.T) {
+		resp := MakeRequestWithHeaders(t, "GET", "/api/projects/public/999999", nil, "", map[string]string{"Accept-Language": "fr-FR, de;q=0.8"})
+		require.Equal(t, 404, resp.Code)
+		assert.Equal(t, "en", resp.Header().Get("Content-Language"))
+
+		problem := parseProblem(t, resp)
+		assert.Equal(t, "Not Found", problem.Title)
+		assert.Equal(t, "Project not found", problem.Detail)
+	})
+}
@@ -0,0 +1,88 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIdempotency_ProjectCreate tests Idempotency-Key handling on POST /api/projects/own
+func TestIdempotency_ProjectCreate(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	t.Run("RetryReplaysResponse", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+
+		payload := map[string]interface{}{
+			"title":       "Retried Project",
+			"description": "Created once, requested twice",
+			"category_id": category.ID,
+		}
+		headers := map[string]string{"Idempotency-Key": "create-project-1"}
+
+		first := MakeRequestWithHeaders(t, "POST", "/api/projects/own", payload, token, headers)
+		require.Equal(t, 201, first.Code)
+		assert.Empty(t, first.Header().Get("Idempotent-Replayed"))
+
+		second := MakeRequestWithHeaders(t, "POST", "/api/projects/own", payload, token, headers)
+		assert.Equal(t, 201, second.Code)
+		assert.Equal(t, "true", second.Header().Get("Idempotent-Replayed"))
+		assert.JSONEq(t, first.Body.String(), second.Body.String())
+
+		var count int64
+		testDB.DB.Model(&models.Project{}).Where("title = ?", "Retried Project").Count(&count)
+		assert.Equal(t, int64(1), count)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("DifferentBodyRejected", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		headers := map[string]string{"Idempotency-Key": "create-project-2"}
+
+		first := map[string]interface{}{"title": "First", "description": "First body", "category_id": category.ID}
+		resp := MakeRequestWithHeaders(t, "POST", "/api/projects/own", first, token, headers)
+		require.Equal(t, 201, resp.Code)
+
+		second := map[string]interface{}{"title": "Second", "description": "Second body", "category_id": category.ID}
+		resp = MakeRequestWithHeaders(t, "POST", "/api/projects/own", second, token, headers)
+		assert.Equal(t, 422, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("ValidationErrorIsReplayed", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		headers := map[string]string{"Idempotency-Key": "create-project-3"}
+		payload := map[string]interface{}{"description": "Missing title"}
+
+		first := MakeRequestWithHeaders(t, "POST", "/api/projects/own", payload, token, headers)
+		assert.Equal(t, 400, first.Code)
+
+		second := MakeRequestWithHeaders(t, "POST", "/api/projects/own", payload, token, headers)
+		assert.Equal(t, 400, second.Code)
+		assert.Equal(t, "true", second.Header().Get("Idempotent-Replayed"))
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("SameKeyOnAnotherEndpoint", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		headers := map[string]string{"Idempotency-Key": "shared-key"}
+
+		resp := MakeRequestWithHeaders(t, "POST", "/api/portfolios/own", map[string]interface{}{"title": "Keyed Portfolio"}, token, headers)
+		require.Equal(t, 201, resp.Code)
+
+		resp = MakeRequestWithHeaders(t, "POST", "/api/sections/own", map[string]interface{}{"title": "Keyed Section"}, token, headers)
+		assert.Equal(t, 422, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+}
@@ -0,0 +1,346 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/jobs"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestJobs covers the background job queue and its admin endpoints. The jobs
+// run here use test-only types, which the server's own runner never claims.
+func TestJobs(t *testing.T) {
+	token := GetTestAuthToken()
+	queue := repo.NewJobRepository(testDB.DB)
+
+	type greeting struct {
+		Name string `json:"name"`
+	}
+	work := func(t *testing.T, runner *jobs.Runner) int {
+		ran, err := runner.WorkDue(context.Background())
+		require.NoError(t, err)
+		return ran
+	}
+	load := func(t *testing.T, id uint) *models.Job {
+		job, err := queue.GetByID(id)
+		require.NoError(t, err)
+		return job
+	}
+	makeDue := func(t *testing.T, id uint) {
+		require.NoError(t, testDB.DB.Model(&models.Job{}).Where("id = ?", id).
+			Update("run_at", time.Now().Add(-time.Second)).Error)
+	}
+	dataOf := func(t *testing.T, method, path string, body interface{}, status int) map[string]interface{} {
+		resp := MakeRequest(t, method, path, body, token)
+		require.Equal(t, status, resp.Code, resp.Body.String())
+		data, _ := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		return data
+	}
+
+	t.Run("RunsAndRetries", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		var greeted []string
+		failures := 1
+		runner := jobs.NewRunner(queue, nil)
+		jobs.Register(runner, "test.greet", func(ctx context.Context, payload greeting) error {
+			if failures > 0 {
+				failures--
+				return errors.New("mail server unavailable")
+			}
+			greeted = append(greeted, payload.Name)
+			return nil
+		})
+
+		job, err := runner.Enqueue("test.greet", greeting{Name: "Ada"}, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, models.JobPending, job.Status)
+
+		assert.Equal(t, 1, work(t, runner))
+		failed := load(t, job.ID)
+		assert.Equal(t, models.JobPending, failed.Status, "queued again for a later attempt")
+		assert.Equal(t, 1, failed.Attempts)
+		assert.Equal(t, "mail server unavailable", failed.LastError)
+		assert.True(t, failed.RunAt.After(time.Now()), "retried after a backoff")
+		assert.Equal(t, 0, work(t, runner), "not due before the backoff")
+
+		makeDue(t, job.ID)
+		assert.Equal(t, 1, work(t, runner))
+		done := load(t, job.ID)
+		assert.Equal(t, models.JobSucceeded, done.Status)
+		assert.Equal(t, 2, done.Attempts)
+		assert.NotNil(t, done.FinishedAt)
+		assert.Empty(t, done.LockedBy)
+		assert.Equal(t, []string{"Ada"}, greeted)
+	})
+
+	t.Run("DeadLettersAfterMaxAttempts", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		runner := jobs.NewRunner(queue, nil)
+		jobs.Register(runner, "test.fail", func(ctx context.Context, _ struct{}) error {
+			return errors.New("always fails")
+		}, jobs.WithMaxAttempts(2))
+		jobs.Register(runner, "test.reject", func(ctx context.Context, _ struct{}) error {
+			return jobs.Permanent(errors.New("nothing to do"))
+		})
+
+		failing, err := runner.Enqueue("test.fail", nil, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, work(t, runner))
+		assert.Equal(t, models.JobPending, load(t, failing.ID).Status)
+		makeDue(t, failing.ID)
+		assert.Equal(t, 1, work(t, runner))
+		dead := load(t, failing.ID)
+		assert.Equal(t, models.JobDead, dead.Status)
+		assert.Equal(t, 2, dead.Attempts)
+
+		rejected, err := runner.Enqueue("test.reject", nil, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, work(t, runner))
+		assert.Equal(t, models.JobDead, load(t, rejected.ID).Status, "permanent errors aren't retried")
+		assert.Equal(t, 1, load(t, rejected.ID).Attempts)
+
+		// A payload that doesn't decode into the handler's type
+		malformed := &models.Job{Type: "test.reject", Payload: []byte(`{"unexpected":true}`), RunAt: time.Now(), MaxAttempts: 5, Timeout: 60}
+		require.NoError(t, queue.Create(malformed))
+		assert.Equal(t, 1, work(t, runner))
+		assert.Equal(t, models.JobDead, load(t, malformed.ID).Status)
+	})
+
+	t.Run("ConcurrentRunnersSkipLockedJobs", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		var runs atomic.Int32
+		var mu sync.Mutex
+		seen := make(map[int]int)
+		newRunner := func() *jobs.Runner {
+			runner := jobs.NewRunner(queue, nil)
+			jobs.Register(runner, "test.count", func(ctx context.Context, payload struct{ N int }) error {
+				runs.Add(1)
+				mu.Lock()
+				seen[payload.N]++
+				mu.Unlock()
+				time.Sleep(10 * time.Millisecond)
+				return nil
+			})
+			return runner
+		}
+
+		first, second := newRunner(), newRunner()
+		for n := 0; n < 20; n++ {
+			_, err := first.Enqueue("test.count", struct{ N int }{n}, time.Now())
+			require.NoError(t, err)
+		}
+
+		var wg sync.WaitGroup
+		counts := make([]int, 2)
+		for i, runner := range []*jobs.Runner{first, second} {
+			wg.Add(1)
+			go func(i int, runner *jobs.Runner) {
+				defer wg.Done()
+				ran, err := runner.WorkDue(context.Background())
+				assert.NoError(t, err)
+				counts[i] = ran
+			}(i, runner)
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(20), runs.Load(), "each job runs once")
+		assert.Equal(t, 20, counts[0]+counts[1])
+		assert.Len(t, seen, 20)
+		for n, times := range seen {
+			assert.Equal(t, 1, times, "job %d", n)
+		}
+	})
+
+	t.Run("ReclaimsExpiredLocks", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		var runs atomic.Int32
+		runner := jobs.NewRunner(queue, nil)
+		jobs.Register(runner, "test.crash", func(ctx context.Context, _ struct{}) error {
+			runs.Add(1)
+			return nil
+		}, jobs.WithMaxAttempts(2))
+
+		// Claimed by a worker that went away mid-attempt
+		abandon := func(t *testing.T, attempts int, lockedUntil time.Time) *models.Job {
+			job, err := runner.Enqueue("test.crash", nil, time.Now())
+			require.NoError(t, err)
+			require.NoError(t, testDB.DB.Model(job).Updates(map[string]interface{}{
+				"status":       models.JobRunning,
+				"attempts":     attempts,
+				"locked_by":    "gone-worker",
+				"locked_until": lockedUntil,
+			}).Error)
+			return job
+		}
+		held := abandon(t, 1, time.Now().Add(time.Hour))
+		expired := abandon(t, 1, time.Now().Add(-time.Second))
+		exhausted := abandon(t, 2, time.Now().Add(-time.Second))
+
+		assert.Equal(t, 1, work(t, runner), "only the expired lock with attempts left is reclaimed")
+		assert.Equal(t, int32(1), runs.Load())
+		assert.Equal(t, models.JobRunning, load(t, held.ID).Status)
+		reclaimed := load(t, expired.ID)
+		assert.Equal(t, models.JobSucceeded, reclaimed.Status)
+		assert.Equal(t, 2, reclaimed.Attempts)
+		dead := load(t, exhausted.ID)
+		assert.Equal(t, models.JobDead, dead.Status)
+		assert.Contains(t, dead.LastError, "visibility timeout")
+
+		// The worker that lost the lock can't store an outcome anymore
+		err := queue.Complete(expired.ID, "gone-worker", time.Now())
+		assert.ErrorIs(t, err, repo.ErrJobNotHeld)
+	})
+
+	t.Run("Schedules", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		var runs atomic.Int32
+		newRunner := func() *jobs.Runner {
+			runner := jobs.NewRunner(queue, nil)
+			jobs.Register(runner, "test.tick", func(ctx context.Context, _ struct{}) error {
+				runs.Add(1)
+				return nil
+			})
+			runner.Schedule("test.tick", jobs.Every(time.Hour), "test.tick")
+			return runner
+		}
+		enqueueDue := func(t *testing.T, runners ...*jobs.Runner) int {
+			total := 0
+			for _, runner := range runners {
+				enqueued, err := runner.EnqueueDue()
+				require.NoError(t, err)
+				total += enqueued
+			}
+			return total
+		}
+		comeDue := func(t *testing.T) {
+			require.NoError(t, testDB.DB.Model(&models.JobSchedule{}).Where("name = ?", "test.tick").
+				Update("next_run_at", time.Now().Add(-time.Second)).Error)
+		}
+		first, second := newRunner(), newRunner()
+
+		assert.Equal(t, 0, enqueueDue(t, first), "a new schedule first runs after its interval")
+		var schedule models.JobSchedule
+		require.NoError(t, testDB.DB.First(&schedule, "name = ?", "test.tick").Error)
+		assert.Equal(t, "@every 1h0m0s", schedule.Spec)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), schedule.NextRunAt, time.Minute)
+
+		comeDue(t)
+		assert.Equal(t, 1, enqueueDue(t, first, second), "enqueued once across runners")
+		require.NoError(t, testDB.DB.First(&schedule, "name = ?", "test.tick").Error)
+		assert.NotNil(t, schedule.LastRunAt)
+		assert.True(t, schedule.NextRunAt.After(time.Now()))
+
+		comeDue(t)
+		assert.Equal(t, 0, enqueueDue(t, first), "not while the previous run is unfinished")
+
+		assert.Equal(t, 1, work(t, first))
+		comeDue(t)
+		assert.Equal(t, 1, enqueueDue(t, second))
+		assert.Equal(t, 1, work(t, second))
+		assert.Equal(t, int32(2), runs.Load())
+
+		var scheduled []models.Job
+		require.NoError(t, testDB.DB.Where("schedule = ?", "test.tick").Find(&scheduled).Error)
+		assert.Len(t, scheduled, 2)
+	})
+
+	t.Run("AdminEndpoints", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		runner := jobs.NewRunner(queue, nil)
+		jobs.Register(runner, "test.fail", func(ctx context.Context, _ struct{}) error {
+			return jobs.Permanent(errors.New("broken"))
+		})
+		job, err := runner.Enqueue("test.fail", struct{}{}, time.Now())
+		require.NoError(t, err)
+		work(t, runner)
+
+		// List, filtered by status
+		resp := MakeRequest(t, "GET", "/api/admin/jobs?status=dead", nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		body := ParseJSONBody(t, resp)
+		list := body["data"].([]interface{})
+		require.Len(t, list, 1)
+		assert.Equal(t, float64(job.ID), list[0].(map[string]interface{})["id"])
+		assert.Equal(t, "broken", list[0].(map[string]interface{})["last_error"])
+		assert.Equal(t, float64(1), body["total"])
+
+		resp = MakeRequest(t, "GET", "/api/admin/jobs?status=pending&type=test.fail", nil, token)
+		require.Equal(t, 200, resp.Code)
+		assert.Empty(t, ParseJSONBody(t, resp)["data"])
+
+		resp = MakeRequest(t, "GET", "/api/admin/jobs?status=stuck", nil, token)
+		assert.Equal(t, 400, resp.Code)
+
+		// Single job
+		path := fmt.Sprintf("/api/admin/jobs/%d", job.ID)
+		data := dataOf(t, "GET", path, nil, 200)
+		assert.Equal(t, models.JobDead, data["status"])
+		assert.Equal(t, map[string]interface{}{}, data["payload"])
+		assert.Equal(t, 400, MakeRequest(t, "GET", "/api/admin/jobs/abc", nil, token).Code)
+		assert.Equal(t, 404, MakeRequest(t, "GET", "/api/admin/jobs/999999", nil, token).Code)
+
+		// Retry a dead job
+		data = dataOf(t, "POST", path+"/retry", nil, 200)
+		assert.Equal(t, models.JobPending, data["status"])
+		assert.Equal(t, float64(0), data["attempts"])
+		assert.Empty(t, data["last_error"])
+		dataOf(t, "POST", path+"/retry", nil, 409)
+
+		// Stats list the server's job types and schedules
+		assert.Eventually(t, func() bool {
+			data := dataOf(t, "GET", "/api/admin/jobs/stats", nil, 200)
+			return len(data["schedules"].([]interface{})) > 0
+		}, 5*time.Second, 100*time.Millisecond, "the server stores its schedules")
+		data = dataOf(t, "GET", "/api/admin/jobs/stats", nil, 200)
+		assert.Contains(t, data["types"], "analytics.rollup")
+		assert.Contains(t, data["types"], "links.check")
+		assert.NotContains(t, data["types"], "test.fail")
+		assert.Contains(t, data["counts"], map[string]interface{}{"type": "test.fail", "status": models.JobPending, "count": float64(1)})
+
+		// Delete, unless a worker holds the job
+		require.NoError(t, testDB.DB.Model(&models.Job{}).Where("id = ?", job.ID).Update("status", models.JobRunning).Error)
+		dataOf(t, "DELETE", path, nil, 409)
+		require.NoError(t, testDB.DB.Model(&models.Job{}).Where("id = ?", job.ID).Update("status", models.JobDead).Error)
+		dataOf(t, "DELETE", path, nil, 200)
+		assert.Equal(t, 404, MakeRequest(t, "GET", path, nil, token).Code)
+	})
+
+	t.Run("AdminEnqueue", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		later := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+		data := dataOf(t, "POST", "/api/admin/jobs", map[string]interface{}{
+			"type":   "analytics.rollup",
+			"run_at": later,
+		}, 201)
+		assert.Equal(t, "analytics.rollup", data["type"])
+		assert.Equal(t, models.JobPending, data["status"])
+		assert.Equal(t, map[string]interface{}{}, data["payload"])
+		runAt, err := time.Parse(time.RFC3339, data["run_at"].(string))
+		require.NoError(t, err)
+		assert.True(t, later.Equal(runAt), "run_at %v", runAt)
+
+		dataOf(t, "POST", "/api/admin/jobs", map[string]interface{}{"type": "test.unknown"}, 400)
+		dataOf(t, "POST", "/api/admin/jobs", map[string]interface{}{
+			"type":    "analytics.rollup",
+			"payload": map[string]interface{}{"portfolio": 1},
+		}, 400)
+		dataOf(t, "POST", "/api/admin/jobs", map[string]interface{}{}, 400)
+
+		// Due now: the server's runner picks it up
+		data = dataOf(t, "POST", "/api/admin/jobs", map[string]interface{}{"type": "analytics.rollup"}, 201)
+		id := uint(data["id"].(float64))
+		assert.Eventually(t, func() bool {
+			return load(t, id).Status == models.JobSucceeded
+		}, 10*time.Second, 100*time.Millisecond)
+	})
+}
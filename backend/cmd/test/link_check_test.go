@@ -0,0 +1,160 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/linkcheck"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLinkChecks covers checking stored URLs and the broken link report
+func TestLinkChecks(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	var gone atomic.Bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/flaky", func(w http.ResponseWriter, r *http.Request) {
+		if gone.Load() {
+			http.NotFound(w, r)
+		}
+	})
+	site := httptest.NewServer(mux)
+	defer site.Close()
+
+	checker := linkcheck.NewChecker(repo.NewLinkCheckRepository(testDB.DB), nil)
+	check := func(t *testing.T, before time.Time) int {
+		checked, err := checker.CheckDue(context.Background(), before)
+		require.NoError(t, err)
+		return checked
+	}
+	report := func(t *testing.T, portfolioID uint) []map[string]interface{} {
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/broken-links", portfolioID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		var links []map[string]interface{}
+		for _, link := range ParseJSONBody(t, resp)["data"].([]interface{}) {
+			links = append(links, link.(map[string]interface{}))
+		}
+		return links
+	}
+	createProject := func(categoryID uint, title, link string, links ...models.ProjectLink) *models.Project {
+		project := &models.Project{Title: title, CategoryID: categoryID, OwnerID: userID, Link: link, Links: links}
+		require.NoError(t, testDB.DB.Create(project).Error)
+		return project
+	}
+	createImage := func(sectionID uint, url string) *models.SectionContent {
+		content := &models.SectionContent{SectionID: sectionID, Type: "image", Content: url, OwnerID: userID}
+		require.NoError(t, testDB.DB.Create(content).Error)
+		return content
+	}
+
+	t.Run("ReportsBrokenLinks", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		section := CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, "Gallery")
+		project := createProject(category.ID, "Billing", site.URL+"/ok", models.ProjectLink{Type: models.LinkDemo, URL: site.URL + "/missing"})
+		image := createImage(section.ID, site.URL+"/missing")
+		createImage(section.ID, "/uploads/local.png")
+		CreateTestSectionContent(testDB.DB, section.ID, userID)
+
+		// Another portfolio storing the same URL
+		other := CreateTestPortfolio(testDB.DB, userID)
+		createProject(CreateTestCategory(testDB.DB, other.ID, userID).ID, "Other", site.URL+"/missing")
+
+		assert.Equal(t, 2, check(t, time.Now()), "each URL is checked once; relative ones aren't")
+
+		links := report(t, portfolio.ID)
+		require.Len(t, links, 2, "once for each place storing the URL")
+		assert.Equal(t, site.URL+"/missing", links[0]["url"])
+		assert.Equal(t, models.LinkSourceProject, links[0]["source_type"])
+		assert.EqualValues(t, project.ID, links[0]["source_id"])
+		assert.Equal(t, "Billing", links[0]["source_title"])
+		assert.EqualValues(t, 404, links[0]["status_code"])
+		assert.Equal(t, "responded with 404", links[0]["error"])
+		assert.EqualValues(t, 1, links[0]["failures"])
+		assert.NotNil(t, links[0]["broken_since"])
+		assert.Len(t, links[0]["history"], 1)
+		assert.Equal(t, models.LinkSourceSectionContent, links[1]["source_type"])
+		assert.EqualValues(t, image.ID, links[1]["source_id"])
+		assert.Equal(t, "Gallery", links[1]["source_title"])
+
+		assert.Equal(t, 0, check(t, time.Now().Add(-time.Minute)), "checked URLs wait for the next interval")
+	})
+
+	t.Run("TracksStatusAndHistory", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		gone.Store(false)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := createProject(category.ID, "Flaky", site.URL+"/flaky")
+
+		recheck := func(t *testing.T) {
+			require.NoError(t, testDB.DB.Model(&models.LinkCheck{}).Where("1 = 1").Update("checked_at", time.Now().Add(-time.Hour)).Error)
+			assert.Equal(t, 1, check(t, time.Now()))
+		}
+
+		assert.Equal(t, 1, check(t, time.Now()))
+		assert.Empty(t, report(t, portfolio.ID))
+
+		gone.Store(true)
+		recheck(t)
+		recheck(t)
+		links := report(t, portfolio.ID)
+		require.Len(t, links, 1)
+		assert.EqualValues(t, 2, links[0]["failures"])
+		history := links[0]["history"].([]interface{})
+		require.Len(t, history, 3)
+		assert.Equal(t, models.LinkBroken, history[0].(map[string]interface{})["status"], "latest first")
+		assert.Equal(t, models.LinkOK, history[2].(map[string]interface{})["status"])
+
+		gone.Store(false)
+		recheck(t)
+		assert.Empty(t, report(t, portfolio.ID))
+		var link models.LinkCheck
+		require.NoError(t, testDB.DB.First(&link, "url = ?", site.URL+"/flaky").Error)
+		assert.Equal(t, models.LinkOK, link.Status)
+		assert.Equal(t, 0, link.Failures)
+		assert.Nil(t, link.BrokenSince)
+
+		// Only the latest checks are kept
+		for i := 0; i < 12; i++ {
+			recheck(t)
+		}
+		var results int64
+		testDB.DB.Model(&models.LinkCheckResult{}).Where("link_check_id = ?", link.ID).Count(&results)
+		assert.Equal(t, int64(10), results)
+
+		// URLs no longer stored are forgotten with their history
+		resp := MakeRequest(t, "DELETE", fmt.Sprintf("/api/projects/own/%d", project.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, 0, check(t, time.Now()))
+		var count int64
+		testDB.DB.Model(&models.LinkCheck{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+		testDB.DB.Model(&models.LinkCheckResult{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("Access", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		foreign := CreateTestPortfolio(testDB.DB, "another-user")
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/broken-links", foreign.ID), nil, token)
+		assert.Equal(t, 403, resp.Code)
+		resp = MakeRequest(t, "GET", "/api/portfolios/own/999999/broken-links", nil, token)
+		assert.Equal(t, 404, resp.Code)
+		resp = MakeRequest(t, "GET", "/api/portfolios/own/abc/broken-links", nil, token)
+		assert.Equal(t, 400, resp.Code)
+	})
+}
@@ -0,0 +1,237 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOrdering covers placing categories, sections, projects and content
+// blocks before or after a sibling, bulk reorders, moves to another parent and
+// the gapless positions left behind
+func TestOrdering(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	// ordered returns the label and position of the live children of a
+	// parent, in order
+	ordered := func(t *testing.T, model interface{}, label, parentField, positionField string, parentID uint) ([]string, []uint) {
+		var rows []struct {
+			Label    string
+			Position uint
+		}
+		err := testDB.DB.Model(model).
+			Select(fmt.Sprintf("%s AS label, %q AS position", label, positionField)).
+			Where(parentField+" = ?", parentID).
+			Order(fmt.Sprintf("%q ASC, id ASC", positionField)).
+			Scan(&rows).Error
+		require.NoError(t, err)
+
+		labels := make([]string, len(rows))
+		positions := make([]uint, len(rows))
+		for i, row := range rows {
+			labels[i] = row.Label
+			positions[i] = row.Position
+		}
+		return labels, positions
+	}
+
+	categories := func(t *testing.T, portfolioID uint) ([]string, []uint) {
+		return ordered(t, &models.Category{}, "title", "portfolio_id", "position", portfolioID)
+	}
+	sections := func(t *testing.T, portfolioID uint) ([]string, []uint) {
+		return ordered(t, &models.Section{}, "title", "portfolio_id", "position", portfolioID)
+	}
+	contents := func(t *testing.T, sectionID uint) ([]string, []uint) {
+		return ordered(t, &models.SectionContent{}, "content", "section_id", "order", sectionID)
+	}
+
+	t.Run("BeforeAndAfter", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		backend := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Backend")
+		CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Frontend")
+		mobile := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Mobile")
+
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/categories/own/%d/position", mobile.ID),
+			map[string]interface{}{"before": backend.ID}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		titles, positions := categories(t, portfolio.ID)
+		assert.Equal(t, []string{"Mobile", "Backend", "Frontend"}, titles)
+		assert.Equal(t, []uint{1, 2, 3}, positions)
+
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/categories/own/%d/position", mobile.ID),
+			map[string]interface{}{"after": backend.ID}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		titles, _ = categories(t, portfolio.ID)
+		assert.Equal(t, []string{"Backend", "Mobile", "Frontend"}, titles)
+
+		// Positions past the end put it last
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/categories/own/%d/position", backend.ID),
+			map[string]interface{}{"position": 10}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		titles, positions = categories(t, portfolio.ID)
+		assert.Equal(t, []string{"Mobile", "Frontend", "Backend"}, titles)
+		assert.Equal(t, []uint{1, 2, 3}, positions)
+	})
+
+	t.Run("InvalidPlacement", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		backend := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Backend")
+		frontend := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Frontend")
+		otherPortfolio := CreateTestPortfolio(testDB.DB, userID)
+		elsewhere := CreateTestCategoryWithTitle(testDB.DB, otherPortfolio.ID, userID, "Elsewhere")
+
+		path := fmt.Sprintf("/api/categories/own/%d/position", backend.ID)
+		resp := MakeRequest(t, "PUT", path, map[string]interface{}{"position": 1, "after": frontend.ID}, token)
+		assert.Equal(t, 400, resp.Code)
+
+		// The anchor must be a sibling
+		resp = MakeRequest(t, "PUT", path, map[string]interface{}{"before": elsewhere.ID}, token)
+		assert.Equal(t, 400, resp.Code)
+
+		// A bulk reorder stays within one portfolio
+		resp = MakeRequest(t, "PUT", "/api/categories/own/reorder", map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"id": backend.ID, "position": 1},
+				{"id": elsewhere.ID, "position": 2},
+			},
+		}, token)
+		assert.Equal(t, 400, resp.Code)
+
+		titles, positions := categories(t, portfolio.ID)
+		assert.Equal(t, []string{"Backend", "Frontend"}, titles)
+		assert.Equal(t, []uint{1, 2}, positions)
+	})
+
+	t.Run("GaplessAfterDelete", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, "About")
+		skills := CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, "Skills")
+		CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, "Contact")
+
+		resp := MakeRequest(t, "DELETE", fmt.Sprintf("/api/sections/own/%d", skills.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		titles, positions := sections(t, portfolio.ID)
+		assert.Equal(t, []string{"About", "Contact"}, titles)
+		assert.Equal(t, []uint{1, 2}, positions)
+	})
+
+	t.Run("MoveSectionToAnotherPortfolio", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, "About")
+		skills := CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, "Skills")
+		CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, "Contact")
+		target := CreateTestPortfolio(testDB.DB, userID)
+		intro := CreateTestSectionWithTitle(testDB.DB, target.ID, userID, "Intro")
+		CreateTestSectionWithTitle(testDB.DB, target.ID, userID, "Work")
+
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/sections/own/%d/move", skills.ID),
+			map[string]interface{}{"portfolio_id": target.ID, "after": intro.ID}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, float64(target.ID), data["portfolio_id"])
+		assert.Equal(t, float64(2), data["position"])
+
+		titles, positions := sections(t, target.ID)
+		assert.Equal(t, []string{"Intro", "Skills", "Work"}, titles)
+		assert.Equal(t, []uint{1, 2, 3}, positions)
+		titles, positions = sections(t, portfolio.ID)
+		assert.Equal(t, []string{"About", "Contact"}, titles)
+		assert.Equal(t, []uint{1, 2}, positions)
+
+		// Only to portfolios of the same owner
+		foreign := CreateTestPortfolio(testDB.DB, "different-user-456")
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/sections/own/%d/move", skills.ID),
+			map[string]interface{}{"portfolio_id": foreign.ID}, token)
+		assert.Equal(t, 403, resp.Code)
+	})
+
+	t.Run("MoveProjectToAnotherCategory", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		backend := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Backend")
+		mobile := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Mobile")
+		CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "API")
+		worker := CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "Worker")
+		CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "CLI")
+		app := CreateTestProjectWithTitle(testDB.DB, mobile.ID, userID, "App")
+
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/projects/own/%d/move", worker.ID),
+			map[string]interface{}{"category_id": mobile.ID, "before": app.ID}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, float64(mobile.ID), data["category_id"])
+		assert.Equal(t, float64(1), data["position"])
+
+		titles, _ := listTitles(t, fmt.Sprintf("/api/projects/category/%d", mobile.ID))
+		assert.Equal(t, []string{"Worker", "App"}, titles)
+		titles, _ = listTitles(t, fmt.Sprintf("/api/projects/category/%d", backend.ID))
+		assert.Equal(t, []string{"API", "CLI"}, titles)
+
+		var positions []uint
+		testDB.DB.Model(&models.ProjectCategory{}).Where("category_id = ?", backend.ID).
+			Order("position ASC").Pluck("position", &positions)
+		assert.Equal(t, []uint{1, 2}, positions)
+
+		// Not into a category it is already in
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/projects/own/%d/move", app.ID),
+			map[string]interface{}{"category_id": mobile.ID}, token)
+		assert.Equal(t, 409, resp.Code)
+	})
+
+	t.Run("SectionContents", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		section := CreateTestSection(testDB.DB, portfolio.ID, userID)
+		first := CreateTestSectionContentWithOrder(testDB.DB, section.ID, userID, 1)
+		second := CreateTestSectionContentWithOrder(testDB.DB, section.ID, userID, 2)
+		third := CreateTestSectionContentWithOrder(testDB.DB, section.ID, userID, 3)
+
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/section-contents/own/%d/position", third.ID),
+			map[string]interface{}{"before": first.ID}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		labels, positions := contents(t, section.ID)
+		assert.Equal(t, []string{third.Content, first.Content, second.Content}, labels)
+		assert.Equal(t, []uint{1, 2, 3}, positions)
+
+		resp = MakeRequest(t, "PUT", "/api/section-contents/own/reorder", map[string]interface{}{
+			"section_id": section.ID,
+			"items": []map[string]interface{}{
+				{"id": first.ID, "position": 1},
+				{"id": second.ID, "position": 2},
+			},
+		}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		labels, _ = contents(t, section.ID)
+		assert.Equal(t, []string{first.Content, second.Content, third.Content}, labels)
+
+		// Moved to another section of the same owner
+		target := CreateTestSection(testDB.DB, portfolio.ID, userID)
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/section-contents/own/%d/move", first.ID),
+			map[string]interface{}{"section_id": target.ID}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		labels, positions = contents(t, section.ID)
+		assert.Equal(t, []string{second.Content, third.Content}, labels)
+		assert.Equal(t, []uint{1, 2}, positions)
+		labels, positions = contents(t, target.ID)
+		assert.Equal(t, []string{first.Content}, labels)
+		assert.Equal(t, []uint{1}, positions)
+
+		// Content blocks of another section can't be reordered in this one
+		resp = MakeRequest(t, "PUT", "/api/section-contents/own/reorder", map[string]interface{}{
+			"section_id": section.ID,
+			"items":      []map[string]interface{}{{"id": first.ID, "position": 1}},
+		}, token)
+		assert.Equal(t, 404, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+}
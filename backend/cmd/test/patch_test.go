@@ -0,0 +1,245 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	mergePatch = "application/merge-patch+json"
+	jsonPatch  = "application/json-patch+json"
+)
+
+// TestPatch_Project tests partial project updates with merge patch and JSON Patch
+func TestPatch_Project(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	t.Run("MergePatchUpdatesOnlyGivenFields", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		payload := map[string]interface{}{"title": "Patched Title"}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/projects/own/%d", project.ID), payload, token,
+			map[string]string{"Content-Type": mergePatch})
+
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Equal(t, "Patched Title", data["title"])
+			assert.Equal(t, project.Description, data["description"])
+			assert.Equal(t, float64(2), data["version"])
+		})
+		assert.Equal(t, `"2"`, resp.Header().Get("ETag"))
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("MergePatchNullClearsField", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		payload := map[string]interface{}{"client": nil, "skills": nil}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/projects/own/%d", project.ID), payload, token,
+			map[string]string{"Content-Type": mergePatch})
+		assert.Equal(t, 200, resp.Code)
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/projects/own/%d", project.ID), nil, token)
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Equal(t, "", data["client"])
+			assert.Empty(t, data["skills"])
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("JSONPatchSkillsArrayOperations", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		operations := []map[string]interface{}{
+			{"op": "add", "path": "/skills/-", "value": "Kubernetes"},
+			{"op": "remove", "path": "/skills/0"},
+		}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/projects/own/%d", project.ID), operations, token,
+			map[string]string{"Content-Type": jsonPatch})
+
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			skills := data["skills"].([]interface{})
+			assert.Equal(t, len(project.Skills), len(skills))
+			assert.Equal(t, "Kubernetes", skills[len(skills)-1])
+			assert.NotContains(t, skills, project.Skills[0])
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("JSONPatchFailedTest", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		operations := []map[string]interface{}{
+			{"op": "test", "path": "/title", "value": "Not The Title"},
+			{"op": "replace", "path": "/title", "value": "Should Not Apply"},
+		}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/projects/own/%d", project.ID), operations, token,
+			map[string]string{"Content-Type": jsonPatch})
+		assert.Equal(t, 409, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("RequiredFieldCannotBeRemoved", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		payload := map[string]interface{}{"title": nil}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/projects/own/%d", project.ID), payload, token,
+			map[string]string{"Content-Type": mergePatch})
+		assert.Equal(t, 400, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("ImmutableFieldRejected", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		payload := map[string]interface{}{"owner_id": "someone-else"}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/projects/own/%d", project.ID), payload, token,
+			map[string]string{"Content-Type": mergePatch})
+		assert.Equal(t, 400, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("UnsupportedMediaType", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		payload := map[string]interface{}{"title": "Patched Title"}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/projects/own/%d", project.ID), payload, token,
+			map[string]string{"Content-Type": "text/plain"})
+		assert.Equal(t, 415, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("StaleVersion", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+
+		payload := map[string]interface{}{"title": "Patched Title"}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/projects/own/%d", project.ID), payload, token,
+			map[string]string{"Content-Type": mergePatch, "If-Match": `"7"`})
+		assert.Equal(t, 412, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("OtherUsersProject", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, "other-user")
+		category := CreateTestCategory(testDB.DB, portfolio.ID, "other-user")
+		project := CreateTestProject(testDB.DB, category.ID, "other-user")
+
+		payload := map[string]interface{}{"title": "Patched Title"}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/projects/own/%d", project.ID), payload, token,
+			map[string]string{"Content-Type": mergePatch})
+		assert.Equal(t, 403, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+}
+
+// TestPatch_OptionalDescriptions tests that null clears optional pointer fields
+func TestPatch_OptionalDescriptions(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	t.Run("Portfolio", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		payload := map[string]interface{}{"description": nil}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/portfolios/own/%d", portfolio.ID), payload, token,
+			map[string]string{"Content-Type": mergePatch})
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Nil(t, data["description"])
+			assert.Equal(t, portfolio.Title, data["title"])
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Category", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+
+		operations := []map[string]interface{}{{"op": "replace", "path": "/description", "value": nil}}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/categories/own/%d", category.ID), operations, token,
+			map[string]string{"Content-Type": jsonPatch})
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Nil(t, data["description"])
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Section", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		section := CreateTestSection(testDB.DB, portfolio.ID, userID)
+
+		payload := map[string]interface{}{"description": nil, "type": "gallery"}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/sections/own/%d", section.ID), payload, token,
+			map[string]string{"Content-Type": mergePatch})
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Nil(t, data["description"])
+			assert.Equal(t, "gallery", data["type"])
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("SectionContent", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		section := CreateTestSection(testDB.DB, portfolio.ID, userID)
+		content := CreateTestSectionContent(testDB.DB, section.ID, userID)
+
+		payload := map[string]interface{}{"content": "Patched content", "metadata": nil}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/section-contents/own/%d", content.ID), payload, token,
+			map[string]string{"Content-Type": mergePatch})
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Equal(t, "Patched content", data["content"])
+			assert.Nil(t, data["metadata"])
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+}
@@ -0,0 +1,126 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parseProblem checks an error is served as application/problem+json and decodes it
+func parseProblem(t *testing.T, recorder *httptest.ResponseRecorder) response.Problem {
+	assert.Equal(t, response.ProblemContentType, recorder.Header().Get("Content-Type"))
+
+	var problem response.Problem
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+	return problem
+}
+
+// TestProblem_Responses checks errors follow RFC 7807 with codes, request IDs and field errors
+func TestProblem_Responses(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	t.Run("BindingErrors_ListFields", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		payload := map[string]interface{}{}
+		resp := MakeRequestWithHeaders(t, "POST", "/api/portfolios/own", payload, token, map[string]string{"X-Request-ID": "problem-test-1"})
+		require.Equal(t, 400, resp.Code)
+
+		problem := parseProblem(t, resp)
+		assert.Equal(t, response.CodeValidationFailed, problem.Code)
+		assert.Equal(t, 400, problem.Status)
+		assert.Equal(t, "problem-test-1", problem.RequestID)
+		assert.NotEmpty(t, problem.Error, "the former error member is kept")
+
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "title", problem.Errors[0].Field)
+		assert.Equal(t, "required", problem.Errors[0].Code)
+	})
+
+	t.Run("BindingErrors_JSONFieldNames", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		payload := map[string]interface{}{
+			"portfolio_id": portfolio.ID,
+			"company":      "Acme",
+			"title":        "Engineer",
+			"project_ids":  []int{0},
+		}
+		resp := MakeRequest(t, "POST", "/api/experiences/own", payload, token)
+		require.Equal(t, 400, resp.Code)
+
+		problem := parseProblem(t, resp)
+		assert.Equal(t, response.CodeValidationFailed, problem.Code)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "project_ids[0]", problem.Errors[0].Field)
+		assert.Equal(t, "min", problem.Errors[0].Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("ValidatorErrors_ListField", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+
+		payload := map[string]interface{}{
+			"title":       "Bad link",
+			"description": "Project with an unsafe link",
+			"category_id": category.ID,
+			"link":        "javascript:alert(1)",
+		}
+		resp := MakeRequest(t, "POST", "/api/projects/own", payload, token)
+		require.Equal(t, 400, resp.Code)
+
+		problem := parseProblem(t, resp)
+		assert.Equal(t, response.CodeValidationFailed, problem.Code)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "link", problem.Errors[0].Field)
+		assert.Equal(t, "url", problem.Errors[0].Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("NotFound_HasCode", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		resp := MakeRequest(t, "GET", "/api/projects/public/999999", nil, "")
+		require.Equal(t, 404, resp.Code)
+
+		problem := parseProblem(t, resp)
+		assert.Equal(t, response.CodeNotFound, problem.Code)
+		assert.Equal(t, "Project not found", problem.Detail)
+		assert.NotEmpty(t, problem.RequestID, "middleware.RequestID generates one when the client sends none")
+	})
+
+	t.Run("Middleware_Unauthorized", func(t *testing.T) {
+		resp := MakeRequest(t, "GET", "/api/portfolios/own", nil, "")
+		require.Equal(t, 401, resp.Code)
+
+		problem := parseProblem(t, resp)
+		assert.Equal(t, response.CodeUnauthorized, problem.Code)
+	})
+
+	t.Run("InvalidQuery_HasCode", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/public/%d/sections?sort=owner_id", portfolio.ID), nil, "")
+		require.Equal(t, 400, resp.Code)
+
+		problem := parseProblem(t, resp)
+		assert.Equal(t, response.CodeInvalidQuery, problem.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+}
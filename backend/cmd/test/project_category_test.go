@@ -0,0 +1,263 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProjectCategories covers projects that are in several categories: adding
+// and removing categories, per-category listings and per-category positions
+func TestProjectCategories(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	categoryTitles := func(t *testing.T, categoryID uint) []interface{} {
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/projects/category/%d", categoryID), nil, "")
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		var titles []interface{}
+		for _, item := range ParseJSONBody(t, resp)["data"].([]interface{}) {
+			titles = append(titles, item.(map[string]interface{})["title"])
+		}
+		return titles
+	}
+
+	t.Run("AddToAnotherCategory", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		backend := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Backend")
+		openSource := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Open Source")
+		CreateTestProjectWithTitle(testDB.DB, openSource.ID, userID, "Library")
+		project := CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "API")
+
+		resp := MakeRequest(t, "POST", fmt.Sprintf("/api/projects/own/%d/categories/%d", project.ID, openSource.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, float64(backend.ID), data["category_id"], "primary category is kept")
+		assert.Equal(t, []interface{}{float64(backend.ID), float64(openSource.ID)}, data["category_ids"])
+		assert.Equal(t, `"2"`, resp.Header().Get("ETag"))
+
+		assert.Equal(t, []interface{}{"API"}, categoryTitles(t, backend.ID))
+		assert.Equal(t, []interface{}{"Library", "API"}, categoryTitles(t, openSource.ID), "added at the end")
+
+		// The category detail lists it too
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/categories/own/%d", openSource.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		projects := ParseJSONBody(t, resp)["data"].(map[string]interface{})["projects"].([]interface{})
+		require.Len(t, projects, 2)
+		assert.Equal(t, "API", projects[1].(map[string]interface{})["title"])
+		assert.Equal(t, float64(2), projects[1].(map[string]interface{})["position"])
+
+		// Adding it again changes nothing
+		resp = MakeRequest(t, "POST", fmt.Sprintf("/api/projects/own/%d/categories/%d", project.ID, openSource.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Len(t, ParseJSONBody(t, resp)["data"].(map[string]interface{})["category_ids"], 2)
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/projects/own/%d", project.ID), nil, token)
+		require.Equal(t, 200, resp.Code)
+		assert.Len(t, ParseJSONBody(t, resp)["data"].(map[string]interface{})["category_ids"], 2)
+	})
+
+	t.Run("AddChecksCategoryAndTitle", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		backend := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Backend")
+		openSource := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Open Source")
+		project := CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "API")
+		CreateTestProjectWithTitle(testDB.DB, openSource.ID, userID, "API")
+
+		otherPortfolio := CreateTestPortfolio(testDB.DB, "other-user")
+		otherCategory := CreateTestCategory(testDB.DB, otherPortfolio.ID, "other-user")
+
+		resp := MakeRequest(t, "POST", fmt.Sprintf("/api/projects/own/%d/categories/%d", project.ID, openSource.ID), nil, token)
+		assert.Equal(t, 400, resp.Code, "a project with this title is already in the category")
+
+		resp = MakeRequest(t, "POST", fmt.Sprintf("/api/projects/own/%d/categories/%d", project.ID, otherCategory.ID), nil, token)
+		assert.Equal(t, 403, resp.Code)
+
+		resp = MakeRequest(t, "POST", fmt.Sprintf("/api/projects/own/%d/categories/99999", project.ID), nil, token)
+		assert.Equal(t, 404, resp.Code)
+
+		resp = MakeRequest(t, "POST", fmt.Sprintf("/api/projects/own/%d/categories/abc", project.ID), nil, token)
+		assert.Equal(t, 400, resp.Code)
+	})
+
+	t.Run("RemoveCategory", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		backend := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Backend")
+		openSource := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Open Source")
+		unrelated := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Design")
+		project := CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "API")
+
+		resp := MakeRequest(t, "POST", fmt.Sprintf("/api/projects/own/%d/categories/%d", project.ID, openSource.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/projects/own/%d/categories/%d", project.ID, unrelated.ID), nil, token)
+		assert.Equal(t, 404, resp.Code, "not in the category")
+
+		// Removing the primary category makes the other one primary
+		resp = MakeRequestWithHeaders(t, "DELETE", fmt.Sprintf("/api/projects/own/%d/categories/%d", project.ID, backend.ID), nil, token,
+			map[string]string{"If-Match": `"2"`})
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, float64(openSource.ID), data["category_id"])
+		assert.Equal(t, []interface{}{float64(openSource.ID)}, data["category_ids"])
+		assert.Empty(t, categoryTitles(t, backend.ID))
+		assert.Equal(t, []interface{}{"API"}, categoryTitles(t, openSource.ID))
+
+		// The last category stays
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/projects/own/%d/categories/%d", project.ID, openSource.ID), nil, token)
+		assert.Equal(t, 409, resp.Code)
+		assert.Equal(t, []interface{}{"API"}, categoryTitles(t, openSource.ID))
+	})
+
+	t.Run("PositionsPerCategory", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		backend := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Backend")
+		openSource := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Open Source")
+		api := CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "API")
+		CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "Worker")
+		library := CreateTestProjectWithTitle(testDB.DB, openSource.ID, userID, "Library")
+
+		resp := MakeRequest(t, "POST", fmt.Sprintf("/api/projects/own/%d/categories/%d", api.ID, openSource.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		// Moving it first in Open Source leaves Backend alone
+		resp = MakeRequest(t, "PUT", "/api/projects/own/reorder", map[string]interface{}{
+			"category_id": openSource.ID,
+			"items": []map[string]interface{}{
+				{"id": api.ID, "position": 1},
+				{"id": library.ID, "position": 2},
+			},
+		}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, []interface{}{"API", "Library"}, categoryTitles(t, openSource.ID))
+		assert.Equal(t, []interface{}{"API", "Worker"}, categoryTitles(t, backend.ID))
+
+		// Without category_id the primary category is reordered
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/projects/own/%d/position", api.ID), map[string]interface{}{"position": 3}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, []interface{}{"Worker", "API"}, categoryTitles(t, backend.ID))
+		assert.Equal(t, []interface{}{"API", "Library"}, categoryTitles(t, openSource.ID))
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/projects/own/%d", api.ID), nil, token)
+		require.Equal(t, 200, resp.Code)
+		assert.Equal(t, float64(2), ParseJSONBody(t, resp)["data"].(map[string]interface{})["position"])
+
+		// Projects outside the category can't be reordered in it
+		resp = MakeRequest(t, "PUT", "/api/projects/own/reorder", map[string]interface{}{
+			"category_id": backend.ID,
+			"items":       []map[string]interface{}{{"id": library.ID, "position": 1}},
+		}, token)
+		assert.Equal(t, 404, resp.Code)
+
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/projects/own/%d/position", library.ID),
+			map[string]interface{}{"position": 1, "category_id": backend.ID}, token)
+		assert.Equal(t, 404, resp.Code)
+	})
+
+	t.Run("DeletingACategoryKeepsSharedProjects", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		backend := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Backend")
+		openSource := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Open Source")
+		project := CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "API")
+
+		resp := MakeRequest(t, "POST", fmt.Sprintf("/api/projects/own/%d/categories/%d", project.ID, openSource.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/categories/own/%d", backend.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/projects/own/%d", project.ID), nil, token)
+		require.Equal(t, 200, resp.Code)
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, float64(openSource.ID), data["category_id"])
+		assert.Equal(t, []interface{}{float64(openSource.ID)}, data["category_ids"])
+	})
+
+	t.Run("MovingKeepsOtherCategories", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		backend := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Backend")
+		openSource := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Open Source")
+		tools := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Tools")
+		project := CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "API")
+
+		resp := MakeRequest(t, "POST", fmt.Sprintf("/api/projects/own/%d/categories/%d", project.ID, openSource.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		// Changing category_id moves the project out of its old primary category
+		resp = MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/projects/own/%d", project.ID),
+			map[string]interface{}{"category_id": tools.ID}, token, map[string]string{"Content-Type": mergePatch})
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, float64(tools.ID), data["category_id"])
+		assert.ElementsMatch(t, []interface{}{float64(tools.ID), float64(openSource.ID)}, data["category_ids"])
+
+		assert.Empty(t, categoryTitles(t, backend.ID))
+		assert.Equal(t, []interface{}{"API"}, categoryTitles(t, tools.ID))
+		assert.Equal(t, []interface{}{"API"}, categoryTitles(t, openSource.ID))
+	})
+
+	t.Run("EventsReachEveryPortfolio", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolioWithTitle(testDB.DB, userID, "Work")
+		otherPortfolio := CreateTestPortfolioWithTitle(testDB.DB, userID, "Hobby")
+		backend := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Backend")
+		openSource := CreateTestCategoryWithTitle(testDB.DB, otherPortfolio.ID, userID, "Open Source")
+		project := CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "API")
+		library := CreateTestProjectWithTitle(testDB.DB, openSource.ID, userID, "Library")
+
+		resp := MakeRequest(t, "POST", fmt.Sprintf("/api/projects/own/%d/categories/%d", project.ID, openSource.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		eventsOf := func(portfolioID uint) []models.PortfolioEvent {
+			var events []models.PortfolioEvent
+			require.NoError(t, testDB.DB.Where("portfolio_id = ?", portfolioID).Order("id ASC").Find(&events).Error)
+			return events
+		}
+		names := func(events []models.PortfolioEvent) []string {
+			var names []string
+			for _, event := range events {
+				names = append(names, event.Event)
+			}
+			return names
+		}
+
+		assert.Equal(t, []string{"project.updated"}, names(eventsOf(portfolio.ID)))
+		assert.Equal(t, []string{"project.updated"}, names(eventsOf(otherPortfolio.ID)))
+
+		// A reorder in the other portfolio's category is announced there only
+		resp = MakeRequest(t, "PUT", "/api/projects/own/reorder", map[string]interface{}{
+			"category_id": openSource.ID,
+			"items": []map[string]interface{}{
+				{"id": project.ID, "position": 1},
+				{"id": library.ID, "position": 2},
+			},
+		}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Len(t, eventsOf(portfolio.ID), 1)
+		reordered := eventsOf(otherPortfolio.ID)[1:]
+		require.NotEmpty(t, reordered)
+		for _, event := range reordered {
+			assert.Equal(t, "project.reordered", event.Event)
+			var data map[string]interface{}
+			require.NoError(t, json.Unmarshal(event.Data, &data))
+			assert.Equal(t, float64(openSource.ID), data["category_id"])
+		}
+
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/projects/own/%d", project.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		work := eventsOf(portfolio.ID)
+		hobby := eventsOf(otherPortfolio.ID)
+		assert.Equal(t, "project.deleted", work[len(work)-1].Event)
+		assert.Contains(t, names(hobby), "project.deleted")
+	})
+}
@@ -0,0 +1,242 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/gitimport"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/gitimport/gittest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// importRepositories posts an import and returns its results
+func importRepositories(t *testing.T, body map[string]interface{}, token string) []map[string]interface{} {
+	resp := MakeRequest(t, "POST", "/api/projects/own/import", body, token)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	var results []map[string]interface{}
+	for _, result := range ParseJSONBody(t, resp)["data"].([]interface{}) {
+		results = append(results, result.(map[string]interface{}))
+	}
+	return results
+}
+
+// importedProject reads the project an import result points at
+func importedProject(t *testing.T, result map[string]interface{}) *models.Project {
+	require.NotNil(t, result["project"], result)
+	var project models.Project
+	id := uint(result["project"].(map[string]interface{})["ID"].(float64))
+	require.NoError(t, testDB.DB.First(&project, id).Error)
+	return &project
+}
+
+// TestProjectImport covers importing projects from Git hosting providers
+func TestProjectImport(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+	billing := gittest.Repository{
+		ID:          101,
+		Owner:       "ana",
+		Name:        "billing",
+		Description: "Invoices and payments",
+		Topics:      []string{"payments", "api"},
+		Languages:   map[string]int64{"Go": 9000, "Shell": 100},
+	}
+	dashboard := gittest.Repository{ID: 102, Owner: "ana", Name: "dashboard", Languages: map[string]int64{"TypeScript": 5000}}
+	secret := gittest.Repository{ID: 103, Owner: "ana", Name: "secret", Private: true}
+	setup := func() *models.Category {
+		cleanDatabase(testDB.DB)
+		gitServer.Reset()
+		for _, repository := range []gittest.Repository{billing, dashboard, secret} {
+			gitServer.Put(repository)
+		}
+		return CreateTestCategory(testDB.DB, CreateTestPortfolio(testDB.DB, userID).ID, userID)
+	}
+
+	t.Run("ListRepositories", func(t *testing.T) {
+		category := setup()
+		results := importRepositories(t, map[string]interface{}{
+			"provider": "github", "category_id": category.ID, "repository_ids": []int64{101},
+		}, token)
+		projectID := results[0]["project"].(map[string]interface{})["ID"]
+
+		resp := MakeRequest(t, "GET", "/api/projects/own/import/github/repositories?account=ana", nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		repositories := ParseJSONBody(t, resp)["data"].([]interface{})
+		require.Len(t, repositories, 2, "private repositories are left out")
+		first := repositories[0].(map[string]interface{})
+		assert.Equal(t, "ana/billing", first["full_name"])
+		assert.Equal(t, "Go", first["language"])
+		assert.Equal(t, projectID, first["project_id"])
+		assert.Nil(t, repositories[1].(map[string]interface{})["project_id"])
+
+		resp = MakeRequest(t, "GET", "/api/projects/own/import/gitea/repositories?account=ana", nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Len(t, ParseJSONBody(t, resp)["data"].([]interface{}), 2)
+
+		resp = MakeRequest(t, "GET", "/api/projects/own/import/github/repositories?account=nobody", nil, token)
+		assert.Equal(t, 404, resp.Code)
+		resp = MakeRequest(t, "GET", "/api/projects/own/import/github/repositories", nil, token)
+		assert.Equal(t, 400, resp.Code)
+		resp = MakeRequest(t, "GET", "/api/projects/own/import/bitbucket/repositories?account=ana", nil, token)
+		assert.Equal(t, 400, resp.Code)
+	})
+
+	t.Run("CreatesProjects", func(t *testing.T) {
+		category := setup()
+		CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "dashboard")
+
+		results := importRepositories(t, map[string]interface{}{
+			"provider": "github", "category_id": category.ID, "repository_ids": []int64{101, 102, 103, 999, 101},
+		}, token)
+		require.Len(t, results, 4, "repeated IDs are imported once")
+		assert.Equal(t, "created", results[0]["status"])
+		assert.Equal(t, []interface{}{"skipped", "title_taken"}, []interface{}{results[1]["status"], results[1]["reason"]})
+		assert.Equal(t, []interface{}{"skipped", "not_found"}, []interface{}{results[2]["status"], results[2]["reason"]}, "private")
+		assert.Equal(t, []interface{}{"skipped", "not_found"}, []interface{}{results[3]["status"], results[3]["reason"]})
+
+		project := importedProject(t, results[0])
+		assert.Equal(t, "billing", project.Title)
+		assert.Equal(t, "Invoices and payments", project.Description)
+		assert.Equal(t, models.StringArray{"payments", "api", "Go", "Shell"}, project.Skills)
+		assert.Equal(t, "https://git.example.org/ana/billing", project.Link)
+		assert.Equal(t, category.ID, project.CategoryID)
+
+		// Without a description upstream, the full name stands in
+		gitServer.Put(gittest.Repository{ID: 104, Owner: "ana", Name: "notes", Archived: true})
+		results = importRepositories(t, map[string]interface{}{
+			"provider": "gitea", "category_id": category.ID, "repository_ids": []int64{104},
+		}, token)
+		project = importedProject(t, results[0])
+		assert.Equal(t, "ana/notes", project.Description)
+		assert.Equal(t, models.ProjectArchived, project.Status)
+	})
+
+	t.Run("ReimportUpdates", func(t *testing.T) {
+		category := setup()
+		other := CreateTestCategoryWithTitle(testDB.DB, category.PortfolioID, userID, "Other")
+		results := importRepositories(t, map[string]interface{}{
+			"provider": "github", "category_id": category.ID, "repository_ids": []int64{101},
+		}, token)
+		created := importedProject(t, results[0])
+
+		renamed := billing
+		renamed.Name, renamed.Description = "payments", "Payments platform"
+		gitServer.Put(renamed)
+		results = importRepositories(t, map[string]interface{}{
+			"provider": "github", "category_id": other.ID, "repository_ids": []int64{101},
+		}, token)
+		assert.Equal(t, "updated", results[0]["status"])
+		updated := importedProject(t, results[0])
+		assert.Equal(t, created.ID, updated.ID)
+		assert.Equal(t, "billing", updated.Title, "the title is the owner's")
+		assert.Equal(t, "Payments platform", updated.Description)
+		assert.Equal(t, category.ID, updated.CategoryID, "stays where it is")
+
+		// Once the project is deleted, the repository imports anew
+		resp := MakeRequest(t, "DELETE", fmt.Sprintf("/api/projects/own/%d", created.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		results = importRepositories(t, map[string]interface{}{
+			"provider": "github", "category_id": other.ID, "repository_ids": []int64{101},
+		}, token)
+		assert.Equal(t, "created", results[0]["status"])
+		assert.NotEqual(t, created.ID, importedProject(t, results[0]).ID)
+	})
+
+	t.Run("ProviderDown", func(t *testing.T) {
+		category := setup()
+		gitServer.Fail(true)
+
+		resp := MakeRequest(t, "POST", "/api/projects/own/import", map[string]interface{}{
+			"provider": "github", "category_id": category.ID, "repository_ids": []int64{101},
+		}, token)
+		assert.Equal(t, http.StatusBadGateway, resp.Code)
+		assert.Equal(t, response.CodeUpstreamFailed, parseProblem(t, resp).Code)
+
+		var count int64
+		testDB.DB.Model(&models.Project{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		category := setup()
+		foreign := CreateTestCategory(testDB.DB, CreateTestPortfolio(testDB.DB, "another-user").ID, "another-user")
+
+		invalid := []map[string]interface{}{
+			{"provider": "bitbucket", "category_id": category.ID, "repository_ids": []int64{101}},
+			{"provider": "github", "category_id": category.ID, "repository_ids": []int64{}},
+			{"provider": "github", "repository_ids": []int64{101}},
+		}
+		for _, body := range invalid {
+			resp := MakeRequest(t, "POST", "/api/projects/own/import", body, token)
+			assert.Equal(t, 400, resp.Code, body)
+		}
+
+		resp := MakeRequest(t, "POST", "/api/projects/own/import", map[string]interface{}{
+			"provider": "github", "category_id": foreign.ID, "repository_ids": []int64{101},
+		}, token)
+		assert.Equal(t, 403, resp.Code)
+	})
+
+	t.Run("Resync", func(t *testing.T) {
+		category := setup()
+		tracked := importedProject(t, importRepositories(t, map[string]interface{}{
+			"provider": "github", "category_id": category.ID, "repository_ids": []int64{101}, "sync": true,
+		}, token)[0])
+		untracked := importedProject(t, importRepositories(t, map[string]interface{}{
+			"provider": "github", "category_id": category.ID, "repository_ids": []int64{102},
+		}, token)[0])
+
+		importer := gitimport.NewImporter(gitimport.NewProviders(), repo.NewProjectRepository(testDB.DB), repo.NewProjectSourceRepository(testDB.DB))
+		sync := func() int {
+			attempted, err := importer.SyncDue(context.Background(), time.Now().Add(time.Second))
+			require.NoError(t, err)
+			return attempted
+		}
+
+		// Nothing changed upstream: the project isn't written
+		assert.Equal(t, 1, sync(), "only projects with sync on")
+		var project models.Project
+		require.NoError(t, testDB.DB.First(&project, tracked.ID).Error)
+		assert.Equal(t, tracked.Version, project.Version)
+
+		changed := billing
+		changed.Description = "Payments platform"
+		changed.Topics = []string{"payments"}
+		changed.Archived = true
+		gitServer.Put(changed)
+		gitServer.Put(gittest.Repository{ID: 102, Owner: "ana", Name: "dashboard", Description: "Charts"})
+		assert.Equal(t, 1, sync())
+
+		require.NoError(t, testDB.DB.First(&project, tracked.ID).Error)
+		assert.Equal(t, "Payments platform", project.Description)
+		assert.Equal(t, models.StringArray{"payments", "Go", "Shell"}, project.Skills)
+		assert.Equal(t, models.ProjectArchived, project.Status)
+		assert.Greater(t, project.Version, tracked.Version)
+		require.NoError(t, testDB.DB.First(&project, untracked.ID).Error)
+		assert.Equal(t, untracked.Description, project.Description, "sync off")
+
+		// Failures are recorded and retried after the next interval
+		gitServer.Fail(true)
+		assert.Equal(t, 1, sync())
+		var source models.ProjectSource
+		require.NoError(t, testDB.DB.First(&source, "project_id = ?", tracked.ID).Error)
+		assert.Contains(t, source.SyncError, "github responded with 503")
+		require.NotNil(t, source.FailedAt)
+		attempted, err := importer.SyncDue(context.Background(), source.FailedAt.Add(-time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, 0, attempted)
+
+		gitServer.Fail(false)
+		assert.Equal(t, 1, sync())
+		require.NoError(t, testDB.DB.First(&source, "project_id = ?", tracked.ID).Error)
+		assert.Empty(t, source.SyncError)
+		assert.Nil(t, source.FailedAt)
+	})
+}
@@ -0,0 +1,243 @@
+package test
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProjectDetails covers the dates, role, team size, status, featured flag
+// and typed links of projects, the filters on them and the portfolio timeline
+func TestProjectDetails(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	date := func(value string) *time.Time {
+		d, err := time.Parse("2006-01-02", value)
+		require.NoError(t, err)
+		return &d
+	}
+
+	titles := func(t *testing.T, path string) []string {
+		titles, _ := listTitles(t, path)
+		return titles
+	}
+
+	t.Run("CreateWithDetails", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+
+		payload := map[string]interface{}{
+			"title":       "Mobile app",
+			"description": "A shipped mobile app",
+			"category_id": category.ID,
+			"start_date":  "2023-03-01T00:00:00Z",
+			"end_date":    "2024-06-30T00:00:00Z",
+			"role":        "Lead developer",
+			"team_size":   4,
+			"status":      "archived",
+			"featured":    true,
+			"links": []map[string]interface{}{
+				{"type": "repo", "url": "https://github.com/example/app"},
+				{"type": "app_store", "url": "https://apps.apple.com/app/id1", "label": "iOS"},
+			},
+		}
+		resp := MakeRequest(t, "POST", "/api/projects/own", payload, token)
+		require.Equal(t, 201, resp.Code, resp.Body.String())
+
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "Lead developer", data["role"])
+		assert.Equal(t, float64(4), data["team_size"])
+		assert.Equal(t, "archived", data["status"])
+		assert.Equal(t, true, data["featured"])
+		assert.Equal(t, false, data["ongoing"])
+		assert.Equal(t, "2023-03-01T00:00:00Z", data["start_date"])
+		links := data["links"].([]interface{})
+		require.Len(t, links, 2)
+		assert.Equal(t, "app_store", links[1].(map[string]interface{})["type"])
+		assert.Equal(t, "iOS", links[1].(map[string]interface{})["label"])
+
+		// Without a status the project counts as shipped
+		payload = map[string]interface{}{
+			"title":       "Website",
+			"description": "A website",
+			"category_id": category.ID,
+		}
+		resp = MakeRequest(t, "POST", "/api/projects/own", payload, token)
+		require.Equal(t, 201, resp.Code, resp.Body.String())
+		data = ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "shipped", data["status"])
+		assert.Equal(t, []interface{}{}, data["links"])
+	})
+
+	t.Run("ValidatesDetails", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+
+		tests := []struct {
+			name  string
+			extra map[string]interface{}
+			field string
+			code  string
+		}{
+			{
+				name:  "Unsafe link",
+				extra: map[string]interface{}{"links": []map[string]interface{}{{"type": "demo", "url": "https://example.com"}, {"type": "demo", "url": "javascript:alert(1)"}}},
+				field: "links[1].url",
+				code:  "url",
+			},
+			{
+				name:  "Unknown link type",
+				extra: map[string]interface{}{"links": []map[string]interface{}{{"type": "blog", "url": "https://example.com"}}},
+				field: "links[0].type",
+				code:  "oneof",
+			},
+			{
+				name:  "End before start",
+				extra: map[string]interface{}{"start_date": "2024-01-01T00:00:00Z", "end_date": "2023-01-01T00:00:00Z"},
+				field: "end_date",
+				code:  "gtefield",
+			},
+			{
+				name:  "Ongoing with end date",
+				extra: map[string]interface{}{"ongoing": true, "end_date": "2023-01-01T00:00:00Z"},
+				field: "end_date",
+				code:  "excluded_with",
+			},
+			{
+				name:  "Unknown status",
+				extra: map[string]interface{}{"status": "done"},
+				field: "status",
+				code:  "oneof",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				payload := map[string]interface{}{
+					"title":       "Invalid",
+					"description": "Invalid details",
+					"category_id": category.ID,
+				}
+				for key, value := range tt.extra {
+					payload[key] = value
+				}
+				resp := MakeRequest(t, "POST", "/api/projects/own", payload, token)
+				require.Equal(t, 400, resp.Code, resp.Body.String())
+
+				problem := parseProblem(t, resp)
+				require.Len(t, problem.Errors, 1)
+				assert.Equal(t, tt.field, problem.Errors[0].Field)
+				assert.Equal(t, tt.code, problem.Errors[0].Code)
+			})
+		}
+	})
+
+	t.Run("PatchDetails", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+		testDB.DB.Model(project).Updates(map[string]interface{}{"end_date": date("2024-01-01"), "featured": true})
+
+		patch := map[string]interface{}{"end_date": nil, "ongoing": true, "featured": false, "status": "in_progress"}
+		resp := MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/projects/own/%d", project.ID), patch, token,
+			map[string]string{"Content-Type": mergePatch})
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Nil(t, data["end_date"])
+		assert.Equal(t, true, data["ongoing"])
+		assert.Equal(t, false, data["featured"])
+		assert.Equal(t, "in_progress", data["status"])
+
+		// Links support JSON Patch array operations
+		ops := []map[string]interface{}{
+			{"op": "add", "path": "/links/-", "value": map[string]interface{}{"type": "demo", "url": "https://demo.example.com"}},
+		}
+		resp = MakeRequestWithHeaders(t, "PATCH", fmt.Sprintf("/api/projects/own/%d", project.ID), ops, token,
+			map[string]string{"Content-Type": "application/json-patch+json"})
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		links := ParseJSONBody(t, resp)["data"].(map[string]interface{})["links"].([]interface{})
+		require.Len(t, links, 1)
+		assert.Equal(t, "https://demo.example.com", links[0].(map[string]interface{})["url"])
+	})
+
+	t.Run("FilterCategoryProjects", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+
+		shipped := CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "Shipped")
+		testDB.DB.Model(shipped).Updates(map[string]interface{}{"featured": true, "role": "Lead developer", "start_date": date("2022-05-01")})
+		current := CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "Current")
+		testDB.DB.Model(current).Updates(map[string]interface{}{"status": models.ProjectInProgress, "ongoing": true, "start_date": date("2024-02-01")})
+		archived := CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "Archived")
+		testDB.DB.Model(archived).Updates(map[string]interface{}{"status": models.ProjectArchived, "role": "Contributor"})
+
+		base := fmt.Sprintf("/api/projects/category/%d", category.ID)
+		assert.Equal(t, []string{"Shipped"}, titles(t, base+"?featured=true"))
+		assert.Equal(t, []string{"Current", "Archived"}, titles(t, base+"?status=in_progress,archived"))
+		assert.Equal(t, []string{"Shipped"}, titles(t, base+"?role=lead%20developer"))
+		assert.Equal(t, []string{"Current"}, titles(t, base+"?ongoing=true"))
+		assert.Equal(t, []string{"Current"}, titles(t, base+"?started_after=2023-01-01"))
+
+		resp := MakeRequest(t, "GET", base+"?featured=maybe", nil, "")
+		assert.Equal(t, 400, resp.Code)
+	})
+
+	t.Run("Timeline", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		backend := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Backend")
+		mobile := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Mobile")
+
+		api := CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "API")
+		testDB.DB.Model(api).Update("start_date", date("2021-09-01"))
+		app := CreateTestProjectWithTitle(testDB.DB, mobile.ID, userID, "App")
+		testDB.DB.Model(app).Updates(map[string]interface{}{"start_date": date("2019-01-15"), "featured": true})
+		// Without a start date, placed by when it was created
+		CreateTestProjectWithTitle(testDB.DB, backend.ID, userID, "Tooling")
+
+		// A project in both categories appears once
+		resp := MakeRequest(t, "POST", fmt.Sprintf("/api/projects/own/%d/categories/%d", api.ID, mobile.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		otherPortfolio := CreateTestPortfolio(testDB.DB, userID)
+		otherCategory := CreateTestCategory(testDB.DB, otherPortfolio.ID, userID)
+		CreateTestProjectWithTitle(testDB.DB, otherCategory.ID, userID, "Elsewhere")
+
+		timeline := fmt.Sprintf("/api/portfolios/public/%d/timeline", portfolio.ID)
+		assert.Equal(t, []string{"App", "API", "Tooling"}, titles(t, timeline))
+		assert.Equal(t, []string{"Tooling", "API", "App"}, titles(t, timeline+"?sort=-start_date"))
+		assert.Equal(t, []string{"App"}, titles(t, timeline+"?featured=true"))
+		assert.Equal(t, []string{"API"}, titles(t, timeline+"?started_after=2020-01-01&started_before=2022-01-01"))
+
+		resp = MakeRequest(t, "GET", timeline, nil, "")
+		require.Equal(t, 200, resp.Code)
+		second := ParseJSONBody(t, resp)["data"].([]interface{})[1].(map[string]interface{})
+		assert.Equal(t, []interface{}{float64(backend.ID), float64(mobile.ID)}, second["category_ids"])
+
+		// Paginated with a cursor
+		page, next := listTitles(t, timeline+"?limit=2")
+		assert.Equal(t, []string{"App", "API"}, page)
+		require.NotEmpty(t, next)
+		page, next = listTitles(t, timeline+"?limit=2&cursor="+url.QueryEscape(next))
+		assert.Equal(t, []string{"Tooling"}, page)
+		assert.Empty(t, next)
+
+		resp = MakeRequest(t, "GET", timeline+"?sort=title", nil, "")
+		assert.Equal(t, 400, resp.Code)
+		resp = MakeRequest(t, "GET", "/api/portfolios/public/abc/timeline", nil, "")
+		assert.Equal(t, 400, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+}
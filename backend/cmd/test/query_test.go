@@ -0,0 +1,179 @@
+package test
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listTitles reads the titles and next_cursor of a cursor-paginated list
+func listTitles(t *testing.T, path string) ([]string, string) {
+	resp := MakeRequest(t, "GET", path, nil, "")
+	require.Equal(t, 200, resp.Code, resp.Body.String())
+
+	body := ParseJSONBody(t, resp)
+	require.Contains(t, body, "next_cursor")
+
+	var titles []string
+	for _, item := range body["data"].([]interface{}) {
+		titles = append(titles, item.(map[string]interface{})["title"].(string))
+	}
+	next, _ := body["next_cursor"].(string)
+	return titles, next
+}
+
+// TestQuery_CursorPagination walks a list page by page with next_cursor
+func TestQuery_CursorPagination(t *testing.T) {
+	userID := GetTestUserID()
+
+	t.Run("Success_PagesFollowSort", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		for _, title := range []string{"Echo", "Alpha", "Delta", "Bravo", "Charlie"} {
+			CreateTestProjectWithTitle(testDB.DB, category.ID, userID, title)
+		}
+
+		base := fmt.Sprintf("/api/categories/public/%d/projects?sort=title&limit=2", category.ID)
+		var all []string
+		path := base
+		for pages := 0; pages < 5; pages++ {
+			titles, next := listTitles(t, path)
+			all = append(all, titles...)
+			if next == "" {
+				break
+			}
+			path = base + "&cursor=" + url.QueryEscape(next)
+		}
+
+		assert.Equal(t, []string{"Alpha", "Bravo", "Charlie", "Delta", "Echo"}, all)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Success_DescendingSort", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		for _, title := range []string{"First", "Second", "Third"} {
+			CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, title)
+		}
+
+		titles, next := listTitles(t, fmt.Sprintf("/api/portfolios/public/%d/categories?sort=-title&limit=2", portfolio.ID))
+		assert.Equal(t, []string{"Third", "Second"}, titles)
+		require.NotEmpty(t, next)
+
+		titles, next = listTitles(t, fmt.Sprintf("/api/portfolios/public/%d/categories?sort=-title&limit=2&cursor=%s", portfolio.ID, url.QueryEscape(next)))
+		assert.Equal(t, []string{"First"}, titles)
+		assert.Empty(t, next)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Success_NoLimitReturnsEverything", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		for i := 0; i < 3; i++ {
+			CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, fmt.Sprintf("Section %d", i))
+		}
+
+		titles, next := listTitles(t, fmt.Sprintf("/api/portfolios/public/%d/sections", portfolio.ID))
+		assert.Len(t, titles, 3)
+		assert.Empty(t, next)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Error_CursorFromAnotherSort", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "One")
+		CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "Two")
+
+		_, next := listTitles(t, fmt.Sprintf("/api/categories/public/%d/projects?sort=title&limit=1", category.ID))
+		require.NotEmpty(t, next)
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/categories/public/%d/projects?sort=created_at&cursor=%s", category.ID, url.QueryEscape(next)), nil, "")
+		assert.Equal(t, 400, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Error_InvalidQuery", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+
+		for _, query := range []string{"sort=owner_id", "limit=500", "cursor=garbage", "type=text", "created_after=soon"} {
+			resp := MakeRequest(t, "GET", fmt.Sprintf("/api/categories/public/%d/projects?%s", category.ID, query), nil, "")
+			assert.Equal(t, 400, resp.Code, query)
+		}
+
+		cleanDatabase(testDB.DB)
+	})
+}
+
+// TestQuery_Filters checks the filters of the list endpoints
+func TestQuery_Filters(t *testing.T) {
+	userID := GetTestUserID()
+
+	t.Run("Success_ProjectSkillsAndClient", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		testDB.DB.Create(&models.Project{Title: "Go API", Skills: []string{"Go", "Postgres"}, Client: "Acme", CategoryID: category.ID, OwnerID: userID})
+		testDB.DB.Create(&models.Project{Title: "Go CLI", Skills: []string{"Go"}, Client: "Globex", CategoryID: category.ID, OwnerID: userID})
+		testDB.DB.Create(&models.Project{Title: "Web App", Skills: []string{"React"}, Client: "Acme", CategoryID: category.ID, OwnerID: userID})
+
+		titles, _ := listTitles(t, fmt.Sprintf("/api/categories/public/%d/projects?skills=Go&sort=title", category.ID))
+		assert.Equal(t, []string{"Go API", "Go CLI"}, titles)
+
+		titles, _ = listTitles(t, fmt.Sprintf("/api/categories/public/%d/projects?skills=Go,Postgres", category.ID))
+		assert.Equal(t, []string{"Go API"}, titles)
+
+		titles, _ = listTitles(t, fmt.Sprintf("/api/categories/public/%d/projects?client=acme&sort=title", category.ID))
+		assert.Equal(t, []string{"Go API", "Web App"}, titles)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Success_SectionType", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, "About")
+		testDB.DB.Create(&models.Section{Title: "Gallery", Type: "gallery", PortfolioID: portfolio.ID, OwnerID: userID})
+
+		titles, _ := listTitles(t, fmt.Sprintf("/api/portfolios/public/%d/sections?type=gallery", portfolio.ID))
+		assert.Equal(t, []string{"Gallery"}, titles)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("Success_CreatedRange", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		old := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Old")
+		CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "New")
+		testDB.DB.Model(old).UpdateColumn("created_at", "2020-01-01")
+
+		titles, _ := listTitles(t, fmt.Sprintf("/api/portfolios/public/%d/categories?created_after=2021-01-01", portfolio.ID))
+		assert.Equal(t, []string{"New"}, titles)
+
+		titles, _ = listTitles(t, fmt.Sprintf("/api/portfolios/public/%d/categories?created_before=2021-01-01", portfolio.ID))
+		assert.Equal(t, []string{"Old"}, titles)
+
+		cleanDatabase(testDB.DB)
+	})
+}
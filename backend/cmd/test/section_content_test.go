@@ -33,7 +33,7 @@ func TestSectionContent_Create(t *testing.T) {
 			data := body["data"].(map[string]interface{})
 			assert.Equal(t, "text", data["type"])
 			assert.Equal(t, "Sample text content for testing", data["content"])
-			assert.Equal(t, float64(0), data["order"]) // Default order
+			assert.Equal(t, float64(1), data["order"]) // Appended to the section
 		})
 
 		cleanDatabase(testDB.DB)
@@ -80,7 +80,8 @@ func TestSectionContent_Create(t *testing.T) {
 
 		AssertJSONResponse(t, resp, 201, func(body map[string]interface{}) {
 			data := body["data"].(map[string]interface{})
-			assert.Equal(t, float64(5), data["order"])
+			// Orders past the end put the content block last
+			assert.Equal(t, float64(1), data["order"])
 		})
 
 		cleanDatabase(testDB.DB)
@@ -498,7 +499,8 @@ func TestSectionContent_UpdateOrder(t *testing.T) {
 
 		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
 			data := body["data"].(map[string]interface{})
-			assert.Equal(t, float64(5), data["order"])
+			// Orders past the end put the content block last
+			assert.Equal(t, float64(1), data["order"])
 		})
 
 		cleanDatabase(testDB.DB)
@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/db"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/gitimport/gittest"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/notify/smtptest"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/server"
 	"github.com/joho/godotenv"
 	"github.com/sirupsen/logrus"
@@ -21,6 +23,8 @@ var (
 	testDB     *db.Database
 	testServer *server.Server
 	testLogger *logrus.Logger
+	mailServer *smtptest.Server
+	gitServer  *gittest.Server
 	baseURL    string
 )
 
@@ -51,6 +55,8 @@ func TestMain(m *testing.M) {
 	// Cleanup - with proper ordering and timing
 	fmt.Println("Starting cleanup...")
 	teardownTestServer()
+	mailServer.Close()
+	gitServer.Close()
 	time.Sleep(500 * time.Millisecond) // Give server time to fully stop
 	teardownTestDatabase()
 	fmt.Println("Cleanup complete")
@@ -78,6 +84,33 @@ func setupTestEnvironment() {
 	os.Setenv("TESTING_MODE", "true")
 	fmt.Printf("TESTING_MODE set to: %s\n", os.Getenv("TESTING_MODE"))
 
+	// Webhook tests deliver to a stand-in receiver on localhost, and link
+	// checks request stand-in sites there too
+	os.Setenv("WEBHOOK_ALLOW_PRIVATE_TARGETS", "true")
+	os.Setenv("LINK_CHECK_ALLOW_PRIVATE_TARGETS", "true")
+
+	// The test user administers the background job queue
+	os.Setenv("ADMIN_USER_IDS", GetTestUserID())
+
+	// Contact notifications are sent to a local SMTP stand-in
+	server, err := smtptest.NewServer()
+	if err != nil {
+		fmt.Printf("FATAL: Failed to start SMTP stand-in: %v\n", err)
+		os.Exit(1)
+	}
+	mailServer = server
+	os.Setenv("SMTP_HOST", mailServer.Host())
+	os.Setenv("SMTP_PORT", mailServer.Port())
+	os.Setenv("SMTP_FROM", "Portfolio Manager <portfolio@example.com>")
+	os.Setenv("SMTP_USERNAME", "")
+
+	// Projects are imported from a local GitHub and Gitea stand-in
+	gitServer = gittest.NewServer()
+	os.Setenv("GITHUB_API_URL", gitServer.URL())
+	os.Setenv("GITHUB_TOKEN", "")
+	os.Setenv("GITEA_URL", gitServer.URL())
+	os.Setenv("GITEA_TOKEN", "")
+
 	// Set base URL from PORT
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -172,10 +205,37 @@ func cleanDatabaseWithError(db *gorm.DB) error {
 	// Note: "images" table has been removed via RemoveImageFeature migration
 	tables := []string{
 		"section_contents",
+		"project_categories",
 		"projects",
 		"categories",
 		"sections",
 		"portfolios",
+		"idempotency_keys",
+		"webhook_deliveries",
+		"webhooks",
+		"user_statuses",
+		"user_lifecycle_events",
+		"portfolio_events",
+		"translations",
+		"project_skills",
+		"skills",
+		"page_views",
+		"analytics_salts",
+		"daily_views",
+		"daily_resource_views",
+		"daily_source_views",
+		"contact_messages",
+		"contact_settings",
+		"testimonials",
+		"testimonial_invites",
+		"experiences",
+		"experience_projects",
+		"education",
+		"project_sources",
+		"link_check_results",
+		"link_checks",
+		"jobs",
+		"job_schedules",
 	}
 
 	for _, table := range tables {
@@ -196,6 +256,15 @@ func cleanDatabaseWithError(db *gorm.DB) error {
 		}
 	}
 
+	// Built-in templates are seeded at startup, so only remove those users saved
+	if err := db.Exec("DELETE FROM portfolio_templates WHERE owner_id <> ''").Error; err != nil {
+		retryErr := db.Exec("SET session_replication_role = 'origin'").Error
+		if retryErr != nil {
+			fmt.Printf("Warning: failed to re-enable foreign key checks: %v\n", retryErr)
+		}
+		return fmt.Errorf("failed to delete user templates: %w", err)
+	}
+
 	// Re-enable foreign key checks
 	if err := db.Exec("SET session_replication_role = 'origin'").Error; err != nil {
 		return fmt.Errorf("failed to re-enable foreign key checks: %w", err)
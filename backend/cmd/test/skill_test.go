@@ -0,0 +1,240 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSkills covers the skills taxonomy: linking projects, autocomplete,
+// renames, merges and usage statistics
+func TestSkills(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	createProject := func(t *testing.T, categoryID uint, title string, skills ...string) map[string]interface{} {
+		payload := map[string]interface{}{
+			"title":       title,
+			"description": "Project description",
+			"skills":      skills,
+			"category_id": categoryID,
+		}
+		resp := MakeRequest(t, "POST", "/api/projects/own", payload, token)
+		require.Equal(t, 201, resp.Code, resp.Body.String())
+		return ParseJSONBody(t, resp)["data"].(map[string]interface{})
+	}
+
+	ownSkills := func(t *testing.T) map[string]map[string]interface{} {
+		resp := MakeRequest(t, "GET", "/api/skills/own", nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		skills := make(map[string]map[string]interface{})
+		for _, item := range ParseJSONBody(t, resp)["data"].([]interface{}) {
+			skill := item.(map[string]interface{})
+			skills[skill["name"].(string)] = skill
+		}
+		return skills
+	}
+
+	t.Run("ProjectsLinkToCanonicalSkills", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+
+		project := createProject(t, category.ID, "API", "golang", "k8s", "GO", "Tailwind CSS")
+		assert.Equal(t, []interface{}{"Go", "Kubernetes", "Tailwind CSS"}, project["skills"])
+
+		skills := ownSkills(t)
+		require.Len(t, skills, 3)
+		assert.Equal(t, "language", skills["Go"]["kind"])
+		assert.Equal(t, float64(1), skills["Go"]["projects"])
+		assert.Contains(t, skills["Kubernetes"]["aliases"], "k8s")
+		assert.Nil(t, skills["Tailwind CSS"]["kind"])
+
+		// Any spelling finds the project
+		resp := MakeRequest(t, "GET", "/api/projects/search/skills?skills=Golang", nil, "")
+		require.Equal(t, 200, resp.Code)
+		assert.Len(t, ParseJSONBody(t, resp)["data"], 1)
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/projects/category/%d?skills=tailwind-css", category.ID), nil, "")
+		require.Equal(t, 200, resp.Code)
+		assert.Len(t, ParseJSONBody(t, resp)["data"], 1)
+	})
+
+	t.Run("CreateAndConflicts", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		payload := map[string]interface{}{"name": "Vue", "kind": "framework", "aliases": []string{"vuejs", "Vue 3"}}
+		resp := MakeRequest(t, "POST", "/api/skills/own", payload, token)
+		require.Equal(t, 201, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "vue", data["slug"])
+		assert.Equal(t, []interface{}{"vuejs", "vue3"}, data["aliases"])
+
+		resp = MakeRequest(t, "POST", "/api/skills/own", map[string]interface{}{"name": "Vue.js"}, token)
+		require.Equal(t, 409, resp.Code, "name is an alias of another skill")
+		assert.Contains(t, parseProblem(t, resp).Detail, "Vue.js")
+
+		resp = MakeRequest(t, "POST", "/api/skills/own", map[string]interface{}{"name": "Design", "kind": "design"}, token)
+		assert.Equal(t, 400, resp.Code)
+	})
+
+	t.Run("Autocomplete", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		createProject(t, category.ID, "One", "Postgres")
+		createProject(t, category.ID, "Two", "PostgreSQL", "Python")
+
+		resp := MakeRequest(t, "GET", "/api/skills/own/autocomplete?q=p", nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		suggestions := ParseJSONBody(t, resp)["data"].([]interface{})
+		require.GreaterOrEqual(t, len(suggestions), 3)
+
+		first := suggestions[0].(map[string]interface{})
+		assert.Equal(t, "PostgreSQL", first["name"], "most used first")
+		assert.Equal(t, float64(2), first["projects"])
+		assert.Equal(t, "Python", suggestions[1].(map[string]interface{})["name"])
+
+		// Built-in skills the user doesn't have come after, without an id
+		builtin := suggestions[2].(map[string]interface{})
+		assert.Nil(t, builtin["id"])
+		assert.NotEqual(t, "PostgreSQL", builtin["name"])
+		assert.NotEqual(t, "Python", builtin["name"])
+
+		resp = MakeRequest(t, "GET", "/api/skills/own/autocomplete?q=psq", nil, token)
+		require.Equal(t, 200, resp.Code)
+		suggestions = ParseJSONBody(t, resp)["data"].([]interface{})
+		require.Len(t, suggestions, 1, "aliases match too")
+		assert.Equal(t, "PostgreSQL", suggestions[0].(map[string]interface{})["name"])
+	})
+
+	t.Run("RenameUpdatesProjects", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := createProject(t, category.ID, "Site", "Tailwind")
+
+		skill := ownSkills(t)["Tailwind"]
+		payload := map[string]interface{}{"name": "Tailwind CSS", "kind": "framework", "aliases": []string{"tailwind"}}
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/skills/own/%.0f", skill["id"]), payload, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/projects/own/%.0f", project["id"]), nil, token)
+		require.Equal(t, 200, resp.Code)
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, []interface{}{"Tailwind CSS"}, data["skills"])
+		assert.Equal(t, float64(2), data["version"])
+
+		// The old name still resolves to the skill through the alias
+		created := createProject(t, category.ID, "Blog", "tailwind")
+		assert.Equal(t, []interface{}{"Tailwind CSS"}, created["skills"])
+	})
+
+	t.Run("Merge", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		createProject(t, category.ID, "One", "ReactJS Hooks", "Go")
+		two := createProject(t, category.ID, "Two", "React", "React Native Web")
+
+		skills := ownSkills(t)
+		target := skills["React"]
+		payload := map[string]interface{}{"source_ids": []interface{}{skills["ReactJS Hooks"]["id"], skills["React Native Web"]["id"]}}
+		resp := MakeRequest(t, "POST", fmt.Sprintf("/api/skills/own/%.0f/merge", target["id"]), payload, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		merged := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, float64(2), merged["projects"])
+		assert.Contains(t, merged["aliases"], "reactjshooks")
+		assert.Contains(t, merged["aliases"], "reactnativeweb")
+
+		skills = ownSkills(t)
+		assert.Len(t, skills, 2)
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/projects/own/%.0f", two["id"]), nil, token)
+		require.Equal(t, 200, resp.Code)
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, []interface{}{"React"}, data["skills"], "duplicates collapse")
+
+		resp = MakeRequest(t, "POST", fmt.Sprintf("/api/skills/own/%.0f/merge", target["id"]), map[string]interface{}{"source_ids": []interface{}{target["id"]}}, token)
+		assert.Equal(t, 400, resp.Code, "merge into itself")
+
+		resp = MakeRequest(t, "POST", fmt.Sprintf("/api/skills/own/%.0f/merge", target["id"]), map[string]interface{}{"source_ids": []int{999999}}, token)
+		assert.Equal(t, 404, resp.Code)
+	})
+
+	t.Run("DeleteOnlyWhenUnused", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		createProject(t, category.ID, "One", "Go")
+
+		resp := MakeRequest(t, "POST", "/api/skills/own", map[string]interface{}{"name": "Elixir"}, token)
+		require.Equal(t, 201, resp.Code)
+		unused := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/skills/own/%.0f", ownSkills(t)["Go"]["id"]), nil, token)
+		require.Equal(t, 409, resp.Code)
+		assert.Contains(t, parseProblem(t, resp).Detail, "1 projects")
+
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/skills/own/%.0f", unused["id"]), nil, token)
+		require.Equal(t, 200, resp.Code)
+		assert.NotContains(t, ownSkills(t), "Elixir")
+	})
+
+	t.Run("PortfolioStats", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		createProject(t, category.ID, "One", "Go", "Docker")
+		createProject(t, category.ID, "Two", "golang")
+		createProject(t, category.ID, "Three", "React")
+		createProject(t, category.ID, "Four", "Figma")
+
+		// Projects of other portfolios don't count
+		other := CreateTestPortfolioWithTitle(testDB.DB, userID, "Other Portfolio")
+		otherCategory := CreateTestCategory(testDB.DB, other.ID, userID)
+		createProject(t, otherCategory.ID, "Elsewhere", "Go")
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/public/%d/skills", portfolio.ID), nil, "")
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		stats := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, float64(4), stats["total_projects"])
+
+		skills := stats["skills"].([]interface{})
+		require.Len(t, skills, 4)
+		first := skills[0].(map[string]interface{})
+		assert.Equal(t, "Go", first["name"])
+		assert.Equal(t, float64(2), first["projects"])
+		assert.Equal(t, float64(50), first["percent"])
+
+		byKind := stats["by_kind"].(map[string]interface{})
+		assert.Equal(t, float64(2), byKind["language"])
+		assert.Equal(t, float64(1), byKind["tool"])
+		assert.Equal(t, float64(1), byKind["other"])
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/public/%d/skills?kind=framework", portfolio.ID), nil, "")
+		require.Equal(t, 200, resp.Code)
+		skills = ParseJSONBody(t, resp)["data"].(map[string]interface{})["skills"].([]interface{})
+		require.Len(t, skills, 1)
+		assert.Equal(t, "React", skills[0].(map[string]interface{})["name"])
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/public/%d/skills?kind=design", portfolio.ID), nil, "")
+		assert.Equal(t, 400, resp.Code)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		resp := MakeRequest(t, "POST", "/api/skills/own", map[string]interface{}{"name": "Go"}, token)
+		require.Equal(t, 201, resp.Code)
+		skill := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		testDB.DB.Exec("UPDATE skills SET owner_id = ? WHERE id = ?", "another-user", skill["id"])
+
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/skills/own/%.0f", skill["id"]), map[string]interface{}{"name": "Golang"}, token)
+		assert.Equal(t, 403, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+}
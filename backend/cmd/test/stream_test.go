@@ -0,0 +1,197 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sseMessage is one message read from an event stream
+type sseMessage struct {
+	id    string
+	event string
+	data  map[string]interface{}
+}
+
+// openEventStream connects to a portfolio's change stream; the stream closes with ctx
+func openEventStream(t *testing.T, ctx context.Context, portfolioID uint, lastEventID string) (*http.Response, *bufio.Reader) {
+	url := fmt.Sprintf("%s/api/portfolios/own/%d/events", getBaseURL(), portfolioID)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+GetTestAuthToken())
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/event-stream")
+	return resp, bufio.NewReader(resp.Body)
+}
+
+// readSSEMessage returns the next message, skipping comments and retry hints
+func readSSEMessage(t *testing.T, reader *bufio.Reader) sseMessage {
+	var msg sseMessage
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimRight(line, "\n")
+
+		switch {
+		case line == "":
+			if msg.event != "" {
+				return msg
+			}
+		case strings.HasPrefix(line, "id: "):
+			msg.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			msg.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &msg.data))
+		}
+	}
+}
+
+// TestPortfolioEventStream tests the Server-Sent Events change stream
+func TestPortfolioEventStream(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	t.Run("PushesLiveChanges", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		resp, reader := openEventStream(t, ctx, portfolio.ID, "")
+		defer resp.Body.Close()
+
+		create := MakeRequest(t, "POST", "/api/sections/own", map[string]interface{}{
+			"title":        "Live Section",
+			"type":         "text",
+			"portfolio_id": portfolio.ID,
+		}, token)
+		require.Equal(t, 201, create.Code)
+		sectionID := ParseJSONBody(t, create)["data"].(map[string]interface{})["id"]
+
+		msg := readSSEMessage(t, reader)
+		assert.Equal(t, "section.created", msg.event)
+		assert.NotEmpty(t, msg.id)
+		assert.Equal(t, float64(portfolio.ID), msg.data["portfolio_id"])
+		assert.Equal(t, "Live Section", msg.data["data"].(map[string]interface{})["title"])
+
+		position := MakeRequest(t, "PUT", fmt.Sprintf("/api/sections/own/%v/position", sectionID), map[string]interface{}{"position": 3}, token)
+		require.Equal(t, 200, position.Code)
+
+		msg = readSSEMessage(t, reader)
+		assert.Equal(t, "section.reordered", msg.event)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("ResumesWithLastEventID", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		for _, title := range []string{"First", "Second"} {
+			resp := MakeRequest(t, "POST", "/api/categories/own", map[string]interface{}{"title": title, "portfolio_id": portfolio.ID}, token)
+			require.Equal(t, 201, resp.Code)
+		}
+
+		var first models.PortfolioEvent
+		require.NoError(t, testDB.DB.Where("portfolio_id = ?", portfolio.ID).Order("id ASC").First(&first).Error)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		resp, reader := openEventStream(t, ctx, portfolio.ID, fmt.Sprintf("%d", first.ID))
+		defer resp.Body.Close()
+
+		msg := readSSEMessage(t, reader)
+		assert.Equal(t, "category.created", msg.event)
+		assert.Equal(t, "Second", msg.data["data"].(map[string]interface{})["title"])
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("KeepsEventsOfConcurrentWritersInCommitOrder", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		first := CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, "First")
+		second := CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, "Second")
+		require.NoError(t, testDB.DB.First(first, first.ID).Error)
+		require.NoError(t, testDB.DB.First(second, second.ID).Error)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		resp, reader := openEventStream(t, ctx, portfolio.ID, "")
+		defer resp.Body.Close()
+
+		// The first writer publishes its event but hasn't committed yet
+		txA := testDB.DB.Begin()
+		first.Title = "First edited"
+		require.NoError(t, repo.NewSectionRepository(txA).Patch(first))
+
+		// The second writer tries to commit ahead of it
+		committed := make(chan error, 1)
+		go func() {
+			second.Title = "Second edited"
+			committed <- repo.NewSectionRepository(testDB.DB).Patch(second)
+		}()
+
+		select {
+		case err := <-committed:
+			txA.Rollback()
+			t.Fatalf("second writer committed while the first held an earlier event: %v", err)
+		case <-time.After(300 * time.Millisecond):
+		}
+		require.NoError(t, txA.Commit().Error)
+		require.NoError(t, <-committed)
+
+		msgA := readSSEMessage(t, reader)
+		msgB := readSSEMessage(t, reader)
+		assert.Equal(t, "First edited", msgA.data["data"].(map[string]interface{})["title"])
+		assert.Equal(t, "Second edited", msgB.data["data"].(map[string]interface{})["title"])
+
+		idA, err := strconv.ParseUint(msgA.id, 10, 32)
+		require.NoError(t, err)
+		idB, err := strconv.ParseUint(msgB.id, 10, 32)
+		require.NoError(t, err)
+		assert.Less(t, idA, idB)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("RequiresEventStreamAccept", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/events", portfolio.ID), nil, token)
+		assert.Equal(t, http.StatusNotAcceptable, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("ForbiddenForOtherUsers", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, "other-user")
+
+		headers := map[string]string{"Accept": "text/event-stream"}
+		resp := MakeRequestWithHeaders(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/events", portfolio.ID), nil, token, headers)
+		assert.Equal(t, http.StatusForbidden, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+}
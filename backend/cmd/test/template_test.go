@@ -0,0 +1,225 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTemplates covers the built-in templates, saving portfolios as templates
+// and creating portfolios from them
+func TestTemplates(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	builtinID := func(t *testing.T, key string) float64 {
+		resp := MakeRequest(t, "GET", "/api/templates", nil, "")
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		for _, item := range ParseJSONBody(t, resp)["data"].([]interface{}) {
+			template := item.(map[string]interface{})
+			if template["key"] == key {
+				return template["id"].(float64)
+			}
+		}
+		t.Fatalf("built-in template %s not found", key)
+		return 0
+	}
+
+	saveTemplate := func(t *testing.T, portfolioID uint, name, visibility string) map[string]interface{} {
+		payload := map[string]interface{}{"portfolio_id": portfolioID, "name": name}
+		if visibility != "" {
+			payload["visibility"] = visibility
+		}
+		resp := MakeRequest(t, "POST", "/api/templates/own", payload, token)
+		require.Equal(t, 201, resp.Code, resp.Body.String())
+		return ParseJSONBody(t, resp)["data"].(map[string]interface{})
+	}
+
+	t.Run("ListBuiltin", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		resp := MakeRequest(t, "GET", "/api/templates", nil, "")
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].([]interface{})
+		require.Len(t, data, 3)
+
+		keys := []interface{}{}
+		for _, item := range data {
+			template := item.(map[string]interface{})
+			assert.Equal(t, "builtin", template["visibility"])
+			assert.Nil(t, template["bundle"], "listings leave out the bundle")
+			keys = append(keys, template["key"])
+		}
+		assert.ElementsMatch(t, []interface{}{"consultant", "designer", "developer"}, keys)
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/templates/public/%.0f", builtinID(t, "developer")), nil, "")
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		bundle := ParseJSONBody(t, resp)["data"].(map[string]interface{})["bundle"].(map[string]interface{})
+		assert.NotEmpty(t, bundle["sections"])
+		assert.NotEmpty(t, bundle["categories"])
+	})
+
+	t.Run("CreatePortfolioFromBuiltin", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		payload := map[string]interface{}{"title": "From Template", "template_id": builtinID(t, "developer")}
+		resp := MakeRequest(t, "POST", "/api/portfolios/own", payload, token)
+		require.Equal(t, 201, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "From Template", data["title"])
+
+		sections := data["sections"].([]interface{})
+		require.Len(t, sections, 3)
+		for i, item := range sections {
+			section := item.(map[string]interface{})
+			assert.Equal(t, float64(i+1), section["position"])
+			contents := section["contents"].([]interface{})
+			require.NotEmpty(t, contents)
+			assert.Equal(t, float64(1), contents[0].(map[string]interface{})["order"])
+		}
+		assert.Equal(t, "About me", sections[0].(map[string]interface{})["title"])
+
+		categories := data["categories"].([]interface{})
+		require.Len(t, categories, 2)
+		category := categories[0].(map[string]interface{})
+		assert.Equal(t, float64(1), category["position"])
+		projects := category["projects"].([]interface{})
+		require.Len(t, projects, 1)
+		project := projects[0].(map[string]interface{})
+		assert.Equal(t, []interface{}{"Go", "PostgreSQL"}, project["skills"])
+
+		// The placeholder projects are listed like any other
+		titles, _ := listTitles(t, fmt.Sprintf("/api/projects/category/%.0f", category["id"]))
+		assert.Equal(t, []string{project["title"].(string)}, titles)
+
+		// Their skills are in the taxonomy
+		resp = MakeRequest(t, "GET", "/api/skills/own", nil, token)
+		require.Equal(t, 200, resp.Code)
+		assert.NotEmpty(t, ParseJSONBody(t, resp)["data"])
+	})
+
+	t.Run("CreatePortfolioFromUnknownTemplate", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		payload := map[string]interface{}{"title": "Nothing", "template_id": 999999}
+		resp := MakeRequest(t, "POST", "/api/portfolios/own", payload, token)
+		assert.Equal(t, 404, resp.Code)
+
+		// Nothing was created
+		var count int64
+		testDB.DB.Table("portfolios").Where("owner_id = ?", userID).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("SaveAndReuse", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		second := CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, "Second")
+		first := CreateTestSectionWithTitle(testDB.DB, portfolio.ID, userID, "First")
+		testDB.DB.Exec("UPDATE sections SET position = 2 WHERE id = ?", second.ID)
+		testDB.DB.Exec("UPDATE sections SET position = 1 WHERE id = ?", first.ID)
+		CreateTestSectionContent(testDB.DB, first.ID, userID)
+		category := CreateTestCategoryWithTitle(testDB.DB, portfolio.ID, userID, "Work")
+		CreateTestProjectWithTitle(testDB.DB, category.ID, userID, "Placeholder")
+
+		template := saveTemplate(t, portfolio.ID, "My Layout", "")
+		assert.Equal(t, "private", template["visibility"])
+		bundle := template["bundle"].(map[string]interface{})
+		sections := bundle["sections"].([]interface{})
+		require.Len(t, sections, 2)
+		assert.Equal(t, "First", sections[0].(map[string]interface{})["title"])
+		assert.Len(t, sections[0].(map[string]interface{})["contents"], 1)
+
+		// Own templates are listed apart from the public ones
+		resp := MakeRequest(t, "GET", "/api/templates/own", nil, token)
+		require.Equal(t, 200, resp.Code)
+		assert.Len(t, ParseJSONBody(t, resp)["data"], 1)
+		resp = MakeRequest(t, "GET", "/api/templates", nil, "")
+		require.Equal(t, 200, resp.Code)
+		assert.Len(t, ParseJSONBody(t, resp)["data"], 3)
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/templates/public/%.0f", template["id"]), nil, "")
+		assert.Equal(t, 404, resp.Code)
+
+		payload := map[string]interface{}{"title": "Copy", "template_id": template["id"]}
+		resp = MakeRequest(t, "POST", "/api/portfolios/own", payload, token)
+		require.Equal(t, 201, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		require.Len(t, data["sections"], 2)
+		require.Len(t, data["categories"], 1)
+		projects := data["categories"].([]interface{})[0].(map[string]interface{})["projects"].([]interface{})
+		assert.Equal(t, "Placeholder", projects[0].(map[string]interface{})["title"])
+	})
+
+	t.Run("PrivateTemplatesOfOthers", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		template := saveTemplate(t, portfolio.ID, "Theirs", "private")
+		testDB.DB.Exec("UPDATE portfolio_templates SET owner_id = ? WHERE id = ?", "another-user", template["id"])
+
+		payload := map[string]interface{}{"title": "Copy", "template_id": template["id"]}
+		resp := MakeRequest(t, "POST", "/api/portfolios/own", payload, token)
+		assert.Equal(t, 404, resp.Code)
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/templates/own/%.0f", template["id"]), nil, token)
+		assert.Equal(t, 403, resp.Code)
+
+		// Once shared, anyone can use it
+		testDB.DB.Exec("UPDATE portfolio_templates SET visibility = 'shared' WHERE id = ?", template["id"])
+		resp = MakeRequest(t, "POST", "/api/portfolios/own", payload, token)
+		assert.Equal(t, 201, resp.Code, resp.Body.String())
+
+		resp = MakeRequest(t, "GET", "/api/templates", nil, "")
+		require.Equal(t, 200, resp.Code)
+		assert.Len(t, ParseJSONBody(t, resp)["data"], 4)
+	})
+
+	t.Run("UpdateAndDelete", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		template := saveTemplate(t, portfolio.ID, "Layout", "")
+		saveTemplate(t, portfolio.ID, "Other Layout", "")
+		path := fmt.Sprintf("/api/templates/own/%.0f", template["id"])
+
+		// Names are unique per owner, ignoring case
+		payload := map[string]interface{}{"portfolio_id": portfolio.ID, "name": "layout"}
+		resp := MakeRequest(t, "POST", "/api/templates/own", payload, token)
+		require.Equal(t, 409, resp.Code)
+		assert.Contains(t, parseProblem(t, resp).Detail, "layout")
+
+		resp = MakeRequest(t, "PUT", path, map[string]interface{}{"name": "Other Layout", "visibility": "private"}, token)
+		assert.Equal(t, 409, resp.Code)
+
+		resp = MakeRequest(t, "PUT", path, map[string]interface{}{"name": "Layout", "visibility": "builtin"}, token)
+		assert.Equal(t, 400, resp.Code, "only built-in templates are built-in")
+
+		resp = MakeRequestWithHeaders(t, "PUT", path, map[string]interface{}{"name": "Shared Layout", "visibility": "shared"}, token,
+			map[string]string{"If-Match": `"1"`})
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "shared", data["visibility"])
+		assert.Equal(t, `"2"`, resp.Header().Get("ETag"))
+
+		resp = MakeRequestWithHeaders(t, "DELETE", path, nil, token, map[string]string{"If-Match": `"1"`})
+		assert.Equal(t, 412, resp.Code)
+
+		resp = MakeRequest(t, "DELETE", path, nil, token)
+		require.Equal(t, 200, resp.Code)
+		resp = MakeRequest(t, "GET", path, nil, token)
+		assert.Equal(t, 404, resp.Code)
+
+		// Built-in templates belong to no one
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/templates/own/%.0f", builtinID(t, "designer")), nil, token)
+		assert.Equal(t, 403, resp.Code)
+	})
+
+	t.Run("SaveOthersPortfolio", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, "another-user")
+
+		payload := map[string]interface{}{"portfolio_id": portfolio.ID, "name": "Stolen"}
+		resp := MakeRequest(t, "POST", "/api/templates/own", payload, token)
+		assert.Equal(t, 403, resp.Code)
+	})
+}
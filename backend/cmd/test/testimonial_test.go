@@ -0,0 +1,275 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createInvite creates an invite link for the portfolio and returns its token
+func createInvite(t *testing.T, portfolioID uint, body map[string]interface{}, token string) string {
+	resp := MakeRequest(t, "POST", fmt.Sprintf("/api/portfolios/own/%d/testimonials/invites", portfolioID), body, token)
+	require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+	data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+	return data["token"].(string)
+}
+
+// testimonialAuthors lists the author names of the testimonials at path
+func testimonialAuthors(t *testing.T, path string, token string) []string {
+	resp := MakeRequest(t, "GET", path, nil, token)
+	require.Equal(t, 200, resp.Code, resp.Body.String())
+	authors := []string{}
+	for _, item := range ParseJSONBody(t, resp)["data"].([]interface{}) {
+		authors = append(authors, item.(map[string]interface{})["author_name"].(string))
+	}
+	return authors
+}
+
+// TestTestimonials covers testimonials, their approval and invite links
+func TestTestimonials(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+	quote := map[string]interface{}{
+		"author_name": "Ana Souza",
+		"author_role": "CTO",
+		"company":     "Acme",
+		"quote":       "Delivered ahead of schedule.",
+		"rating":      5,
+	}
+
+	t.Run("OwnerCreated", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		body := map[string]interface{}{"portfolio_id": portfolio.ID}
+		for key, value := range quote {
+			body[key] = value
+		}
+		resp := MakeRequest(t, "POST", "/api/testimonials/own", body, token)
+		require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, models.TestimonialApproved, data["status"])
+		assert.Equal(t, float64(1), data["position"])
+		assert.Equal(t, float64(5), data["rating"])
+
+		authors := testimonialAuthors(t, fmt.Sprintf("/api/portfolios/public/%d/testimonials", portfolio.ID), "")
+		assert.Equal(t, []string{"Ana Souza"}, authors)
+	})
+
+	t.Run("InviteNeedsApproval", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolioWithTitle(testDB.DB, userID, "Ana's Portfolio")
+		invite := createInvite(t, portfolio.ID, map[string]interface{}{"note": "Sent to Acme"}, token)
+		path := "/api/testimonials/invite/" + invite
+
+		resp := MakeRequest(t, "GET", path, nil, "")
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		info := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "Ana's Portfolio", info["portfolio_title"])
+
+		resp = MakeRequest(t, "POST", path, quote, "")
+		require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, models.TestimonialPending, data["status"])
+
+		// Pending testimonials are only visible to the owner
+		public := fmt.Sprintf("/api/portfolios/public/%d/testimonials", portfolio.ID)
+		assert.Empty(t, testimonialAuthors(t, public, ""))
+		own := fmt.Sprintf("/api/portfolios/own/%d/testimonials?status=pending", portfolio.ID)
+		assert.Equal(t, []string{"Ana Souza"}, testimonialAuthors(t, own, token))
+
+		// Each link works once
+		resp = MakeRequest(t, "POST", path, quote, "")
+		assert.Equal(t, http.StatusGone, resp.Code)
+		assert.Equal(t, "gone", parseProblem(t, resp).Code)
+		resp = MakeRequest(t, "GET", path, nil, "")
+		assert.Equal(t, http.StatusGone, resp.Code)
+
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/testimonials/own/%d/status", uint(data["id"].(float64))),
+			map[string]interface{}{"status": "approved"}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, []string{"Ana Souza"}, testimonialAuthors(t, public, ""))
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/testimonials/invites", portfolio.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		invites := ParseJSONBody(t, resp)["data"].([]interface{})
+		require.Len(t, invites, 1)
+		assert.NotEmpty(t, invites[0].(map[string]interface{})["used_at"])
+	})
+
+	t.Run("InviteExpiredOrRevoked", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		expired := createInvite(t, portfolio.ID, map[string]interface{}{}, token)
+		testDB.DB.Model(&models.TestimonialInvite{}).Where("token = ?", expired).
+			Update("expires_at", time.Now().Add(-time.Hour))
+		resp := MakeRequest(t, "POST", "/api/testimonials/invite/"+expired, quote, "")
+		assert.Equal(t, http.StatusGone, resp.Code)
+
+		revoked := createInvite(t, portfolio.ID, map[string]interface{}{}, token)
+		var invite models.TestimonialInvite
+		require.NoError(t, testDB.DB.Where("token = ?", revoked).First(&invite).Error)
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/testimonials/own/invites/%d", invite.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		resp = MakeRequest(t, "POST", "/api/testimonials/invite/"+revoked, quote, "")
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+
+		resp = MakeRequest(t, "GET", "/api/testimonials/invite/unknown", nil, "")
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+
+		var count int64
+		testDB.DB.Model(&models.Testimonial{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("Projects", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		project := CreateTestProject(testDB.DB, category.ID, userID)
+		other := CreateTestPortfolio(testDB.DB, userID)
+		elsewhere := CreateTestProject(testDB.DB, CreateTestCategory(testDB.DB, other.ID, userID).ID, userID)
+
+		invite := createInvite(t, portfolio.ID, map[string]interface{}{"project_id": project.ID}, token)
+		resp := MakeRequest(t, "POST", "/api/testimonials/invite/"+invite, quote, "")
+		require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, float64(project.ID), data["project_id"])
+
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/testimonials/own/%d/status", uint(data["id"].(float64))),
+			map[string]interface{}{"status": "approved"}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		assert.Equal(t, []string{"Ana Souza"}, testimonialAuthors(t, fmt.Sprintf("/api/projects/public/%d/testimonials", project.ID), ""))
+		assert.Equal(t, []string{"Ana Souza"}, testimonialAuthors(t,
+			fmt.Sprintf("/api/portfolios/public/%d/testimonials?project_id=%d", portfolio.ID, project.ID), ""))
+
+		// Only projects shown in the portfolio
+		resp = MakeRequest(t, "POST", fmt.Sprintf("/api/portfolios/own/%d/testimonials/invites", portfolio.ID),
+			map[string]interface{}{"project_id": elsewhere.ID}, token)
+		assert.Equal(t, 400, resp.Code)
+
+		// Testimonials of deleted projects leave the public listing
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/projects/own/%d", project.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Empty(t, testimonialAuthors(t, fmt.Sprintf("/api/portfolios/public/%d/testimonials", portfolio.ID), ""))
+	})
+
+	t.Run("Order", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		ids := map[string]uint{}
+		for _, author := range []string{"Ana", "Bruno", "Carla"} {
+			resp := MakeRequest(t, "POST", "/api/testimonials/own",
+				map[string]interface{}{"portfolio_id": portfolio.ID, "author_name": author, "quote": "Great work."}, token)
+			require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+			ids[author] = uint(ParseJSONBody(t, resp)["data"].(map[string]interface{})["id"].(float64))
+		}
+		public := fmt.Sprintf("/api/portfolios/public/%d/testimonials", portfolio.ID)
+
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/testimonials/own/%d/position", ids["Carla"]),
+			map[string]interface{}{"before": ids["Ana"]}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, []string{"Carla", "Ana", "Bruno"}, testimonialAuthors(t, public, ""))
+
+		resp = MakeRequest(t, "PUT", "/api/testimonials/own/reorder", map[string]interface{}{"items": []map[string]interface{}{
+			{"id": ids["Bruno"], "position": 1},
+			{"id": ids["Ana"], "position": 2},
+		}}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, []string{"Bruno", "Ana", "Carla"}, testimonialAuthors(t, public, ""))
+
+		// Rejected testimonials keep their place but aren't shown
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/testimonials/own/%d/status", ids["Ana"]),
+			map[string]interface{}{"status": "rejected"}, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Equal(t, []string{"Bruno", "Carla"}, testimonialAuthors(t, public, ""))
+
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/testimonials/own/%d", ids["Bruno"]), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		var positions []uint
+		testDB.DB.Model(&models.Testimonial{}).Where("portfolio_id = ?", portfolio.ID).
+			Order("position ASC").Pluck("position", &positions)
+		assert.Equal(t, []uint{1, 2}, positions)
+	})
+
+	t.Run("UpdateAndValidation", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		invalid := []map[string]interface{}{
+			{"author_name": "Ana"},
+			{"author_name": "   ", "quote": "Great work."},
+			{"author_name": "Ana", "quote": "Great work.", "rating": 6},
+			{"author_name": "Ana", "quote": "Great work.", "avatar_url": "not-a-url"},
+		}
+		for _, body := range invalid {
+			body["portfolio_id"] = portfolio.ID
+			resp := MakeRequest(t, "POST", "/api/testimonials/own", body, token)
+			assert.Equal(t, 400, resp.Code, body)
+		}
+
+		resp := MakeRequest(t, "POST", "/api/testimonials/own",
+			map[string]interface{}{"portfolio_id": portfolio.ID, "author_name": "Ana", "quote": "Great work."}, token)
+		require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+		path := fmt.Sprintf("/api/testimonials/own/%d", uint(ParseJSONBody(t, resp)["data"].(map[string]interface{})["id"].(float64)))
+
+		resp = MakeRequestWithHeaders(t, "PUT", path, map[string]interface{}{"author_name": "Ana Souza", "quote": "Great work, again."},
+			token, map[string]string{"If-Match": `"1"`})
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "Ana Souza", data["author_name"])
+		assert.Equal(t, models.TestimonialApproved, data["status"])
+		assert.Equal(t, `"2"`, resp.Header().Get("ETag"))
+
+		resp = MakeRequestWithHeaders(t, "PUT", path, map[string]interface{}{"author_name": "Ana", "quote": "Stale."},
+			token, map[string]string{"If-Match": `"1"`})
+		assert.Equal(t, 412, resp.Code, "stale version")
+
+		resp = MakeRequest(t, "PUT", path+"/status", map[string]interface{}{"status": "featured"}, token)
+		assert.Equal(t, 400, resp.Code)
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/testimonials?status=featured", portfolio.ID), nil, token)
+		assert.Equal(t, 400, resp.Code)
+		assert.Equal(t, "invalid_query", parseProblem(t, resp).Code)
+	})
+
+	t.Run("OwnerOnly", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, "another-user")
+		testimonial := models.Testimonial{PortfolioID: portfolio.ID, OwnerID: "another-user", AuthorName: "Ana", Quote: "Great work.", Status: models.TestimonialApproved, Position: 1}
+		require.NoError(t, testDB.DB.Create(&testimonial).Error)
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/testimonials/own/%d", testimonial.ID), nil, token)
+		assert.Equal(t, 403, resp.Code)
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/testimonials", portfolio.ID), nil, token)
+		assert.Equal(t, 403, resp.Code)
+		resp = MakeRequest(t, "POST", fmt.Sprintf("/api/portfolios/own/%d/testimonials/invites", portfolio.ID), map[string]interface{}{}, token)
+		assert.Equal(t, 403, resp.Code)
+		resp = MakeRequest(t, "POST", "/api/testimonials/own",
+			map[string]interface{}{"portfolio_id": portfolio.ID, "author_name": "Ana", "quote": "Great work."}, token)
+		assert.Equal(t, 403, resp.Code)
+	})
+
+	t.Run("DeletedWithPortfolio", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		invite := createInvite(t, portfolio.ID, map[string]interface{}{}, token)
+		resp := MakeRequest(t, "POST", "/api/testimonials/invite/"+invite, quote, "")
+		require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/portfolios/own/%d", portfolio.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		var count int64
+		testDB.DB.Model(&models.Testimonial{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+		testDB.DB.Model(&models.TestimonialInvite{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+	})
+}
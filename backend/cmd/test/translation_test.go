@@ -0,0 +1,225 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTranslations covers portfolio locales, the translation endpoints and
+// translated public content
+func TestTranslations(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	setLocales := func(t *testing.T, portfolioID uint) {
+		payload := map[string]interface{}{
+			"default_locale": "en",
+			"locales":        []string{"pt-br", "es"},
+		}
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/portfolios/own/%d/locales", portfolioID), payload, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+	}
+
+	t.Run("SetLocales", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		payload := map[string]interface{}{
+			"default_locale": "EN",
+			"locales":        []string{"pt-br", "es", "en"},
+		}
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/portfolios/own/%d/locales", portfolio.ID), payload, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "en", data["default_locale"])
+		assert.Equal(t, []interface{}{"en", "pt-BR", "es"}, data["locales"])
+
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/portfolios/own/%d/locales", portfolio.ID), map[string]interface{}{"default_locale": "english"}, token)
+		require.Equal(t, 400, resp.Code)
+		problem := parseProblem(t, resp)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "default_locale", problem.Errors[0].Field)
+		assert.Equal(t, "locale", problem.Errors[0].Code)
+	})
+
+	t.Run("SaveAndList", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		setLocales(t, portfolio.ID)
+
+		payload := map[string]interface{}{
+			"translations": []map[string]interface{}{
+				{"resource_type": "portfolio", "resource_id": portfolio.ID, "field": "title", "value": "Portfólio de teste"},
+				{"resource_type": "category", "resource_id": category.ID, "field": "title", "value": "Categoria de teste"},
+			},
+		}
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/portfolios/own/%d/translations/pt-BR", portfolio.ID), payload, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		assert.Len(t, ParseJSONBody(t, resp)["data"], 2)
+
+		// An empty value removes the translation
+		payload = map[string]interface{}{
+			"translations": []map[string]interface{}{
+				{"resource_type": "category", "resource_id": category.ID, "field": "title", "value": ""},
+			},
+		}
+		resp = MakeRequest(t, "PUT", fmt.Sprintf("/api/portfolios/own/%d/translations/pt-BR", portfolio.ID), payload, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/translations?locale=pt-br", portfolio.ID), nil, token)
+		require.Equal(t, 200, resp.Code)
+		translations := ParseJSONBody(t, resp)["data"].([]interface{})
+		require.Len(t, translations, 1)
+		assert.Equal(t, "Portfólio de teste", translations[0].(map[string]interface{})["value"])
+	})
+
+	t.Run("SaveRejected", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		other := CreateTestPortfolioWithTitle(testDB.DB, userID, "Other Portfolio")
+		otherCategory := CreateTestCategory(testDB.DB, other.ID, userID)
+		setLocales(t, portfolio.ID)
+
+		item := func(resourceType string, id uint, field string) map[string]interface{} {
+			return map[string]interface{}{
+				"translations": []map[string]interface{}{
+					{"resource_type": resourceType, "resource_id": id, "field": field, "value": "x"},
+				},
+			}
+		}
+		path := fmt.Sprintf("/api/portfolios/own/%d/translations/", portfolio.ID)
+
+		resp := MakeRequest(t, "PUT", path+"fr", item("portfolio", portfolio.ID, "title"), token)
+		assert.Equal(t, 400, resp.Code, "locale not enabled")
+
+		resp = MakeRequest(t, "PUT", path+"en", item("portfolio", portfolio.ID, "title"), token)
+		assert.Equal(t, 400, resp.Code, "default locale")
+
+		resp = MakeRequest(t, "PUT", path+"es", item("portfolio", portfolio.ID, "owner_id"), token)
+		assert.Equal(t, 400, resp.Code, "field not translatable")
+
+		resp = MakeRequest(t, "PUT", path+"es", item("category", otherCategory.ID, "title"), token)
+		require.Equal(t, 400, resp.Code, "category of another portfolio")
+		assert.Contains(t, parseProblem(t, resp).Detail, "is not part of this portfolio")
+	})
+
+	t.Run("PublicContent", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		section := CreateTestSection(testDB.DB, portfolio.ID, userID)
+		content := CreateTestSectionContent(testDB.DB, section.ID, userID)
+		setLocales(t, portfolio.ID)
+
+		payload := map[string]interface{}{
+			"translations": []map[string]interface{}{
+				{"resource_type": "portfolio", "resource_id": portfolio.ID, "field": "title", "value": "Portafolio de prueba"},
+				{"resource_type": "section_content", "resource_id": content.ID, "field": "content", "value": "Contenido de prueba"},
+			},
+		}
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/portfolios/own/%d/translations/es", portfolio.ID), payload, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		// ?lang= picks the locale
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/public/%d?lang=es", portfolio.ID), nil, "")
+		require.Equal(t, 200, resp.Code)
+		assert.Equal(t, "es", resp.Header().Get("Content-Language"))
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "Portafolio de prueba", data["title"])
+		assert.Equal(t, "Test description", data["description"], "untranslated fields fall back to the default locale")
+
+		// Accept-Language works too
+		resp = MakeRequestWithHeaders(t, "GET", fmt.Sprintf("/api/section-contents/%d", content.ID), nil, "", map[string]string{"Accept-Language": "es-AR, en;q=0.5"})
+		require.Equal(t, 200, resp.Code)
+		assert.Contains(t, resp.Header().Values("Vary"), "Accept-Language")
+		data = ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "Contenido de prueba", data["content"])
+
+		// Locales the portfolio isn't published in get the default
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/public/%d?lang=fr", portfolio.ID), nil, "")
+		require.Equal(t, 200, resp.Code)
+		assert.Equal(t, "en", resp.Header().Get("Content-Language"))
+		data = ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "Test Portfolio", data["title"])
+
+		// Owners edit the stored content unless they ask for a locale
+		resp = MakeRequestWithHeaders(t, "GET", fmt.Sprintf("/api/portfolios/own/%d", portfolio.ID), nil, token, map[string]string{"Accept-Language": "es"})
+		require.Equal(t, 200, resp.Code)
+		data = ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "Test Portfolio", data["title"])
+	})
+
+	t.Run("Completeness", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		setLocales(t, portfolio.ID)
+
+		// portfolio title and description, category title and description
+		payload := map[string]interface{}{
+			"translations": []map[string]interface{}{
+				{"resource_type": "portfolio", "resource_id": portfolio.ID, "field": "title", "value": "Portfólio"},
+				{"resource_type": "category", "resource_id": category.ID, "field": "title", "value": "Categoria"},
+				{"resource_type": "category", "resource_id": category.ID, "field": "description", "value": "Descrição"},
+			},
+		}
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/portfolios/own/%d/translations/pt-BR", portfolio.ID), payload, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/translations/completeness", portfolio.ID), nil, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+		report := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "en", report["default_locale"])
+
+		locales := report["locales"].([]interface{})
+		require.Len(t, locales, 2)
+		portuguese := locales[0].(map[string]interface{})
+		assert.Equal(t, "pt-BR", portuguese["locale"])
+		assert.Equal(t, float64(4), portuguese["total"])
+		assert.Equal(t, float64(3), portuguese["translated"])
+		assert.Equal(t, float64(75), portuguese["percent"])
+		missing := portuguese["missing"].([]interface{})
+		require.Len(t, missing, 1)
+		assert.Equal(t, "description", missing[0].(map[string]interface{})["field"])
+
+		spanish := locales[1].(map[string]interface{})
+		assert.Equal(t, float64(0), spanish["translated"])
+	})
+
+	t.Run("DeleteLocale", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		setLocales(t, portfolio.ID)
+
+		payload := map[string]interface{}{
+			"translations": []map[string]interface{}{
+				{"resource_type": "portfolio", "resource_id": portfolio.ID, "field": "title", "value": "Portafolio"},
+			},
+		}
+		resp := MakeRequest(t, "PUT", fmt.Sprintf("/api/portfolios/own/%d/translations/es", portfolio.ID), payload, token)
+		require.Equal(t, 200, resp.Code, resp.Body.String())
+
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/portfolios/own/%d/translations/es", portfolio.ID), nil, token)
+		require.Equal(t, 200, resp.Code)
+		assert.Equal(t, float64(1), ParseJSONBody(t, resp)["data"])
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/public/%d?lang=es", portfolio.ID), nil, "")
+		require.Equal(t, 200, resp.Code)
+		data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+		assert.Equal(t, "Test Portfolio", data["title"])
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, "another-user")
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/own/%d/translations", portfolio.ID), nil, token)
+		assert.Equal(t, 403, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+}
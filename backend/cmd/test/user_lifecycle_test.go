@@ -0,0 +1,167 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAuthentikSecret = "authentik-test-secret"
+
+// sendAuthentikEvent posts a lifecycle event signed with secret now
+func sendAuthentikEvent(t *testing.T, secret string, payload map[string]interface{}) *httptest.ResponseRecorder {
+	return sendAuthentikEventAt(t, secret, time.Now(), payload)
+}
+
+// sendAuthentikEventAt posts a lifecycle event signed with secret at signedAt
+func sendAuthentikEventAt(t *testing.T, secret string, signedAt time.Time, payload map[string]interface{}) *httptest.ResponseRecorder {
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	headers := map[string]string{"X-Authentik-Signature": webhook.Sign(secret, signedAt.Unix(), body)}
+
+	return MakeRequestWithHeaders(t, "POST", "/api/users/webhooks/authentik", payload, "", headers)
+}
+
+// TestAuthentikUserEvents tests the incoming Authentik user lifecycle webhook
+func TestAuthentikUserEvents(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+	t.Setenv("AUTHENTIK_WEBHOOK_SECRET", testAuthentikSecret)
+
+	t.Run("RejectsInvalidSignature", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		CreateTestPortfolio(testDB.DB, userID)
+
+		resp := sendAuthentikEvent(t, "wrong-secret", map[string]interface{}{"event": "user.deleted", "user_id": userID})
+		assert.Equal(t, 401, resp.Code)
+
+		var count int64
+		testDB.DB.Model(&models.Portfolio{}).Where("owner_id = ?", userID).Count(&count)
+		assert.Equal(t, int64(1), count)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("RejectsReplayedEvent", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		CreateTestPortfolio(testDB.DB, userID)
+
+		signedAt := time.Now().Add(-webhook.Tolerance - time.Minute)
+		resp := sendAuthentikEventAt(t, testAuthentikSecret, signedAt, map[string]interface{}{"event": "user.deleted", "user_id": userID})
+		assert.Equal(t, 401, resp.Code)
+
+		var count int64
+		testDB.DB.Model(&models.Portfolio{}).Where("owner_id = ?", userID).Count(&count)
+		assert.Equal(t, int64(1), count)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("RejectsUnknownEvent", func(t *testing.T) {
+		resp := sendAuthentikEvent(t, testAuthentikSecret, map[string]interface{}{"event": "user.promoted", "user_id": userID})
+		assert.Equal(t, 400, resp.Code)
+	})
+
+	t.Run("DeletedUserLosesAllData", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		CreateTestProject(testDB.DB, category.ID, userID)
+		section := CreateTestSection(testDB.DB, portfolio.ID, userID)
+		CreateTestSectionContent(testDB.DB, section.ID, userID)
+		other := CreateTestPortfolio(testDB.DB, "other-user")
+
+		resp := sendAuthentikEvent(t, testAuthentikSecret, map[string]interface{}{"event": "user.deleted", "user_id": userID})
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Equal(t, models.UserActionDataDeleted, data["action"])
+		})
+
+		var count int64
+		testDB.DB.Model(&models.Portfolio{}).Where("owner_id = ?", userID).Count(&count)
+		assert.Equal(t, int64(0), count)
+		testDB.DB.Model(&models.SectionContent{}).Where("owner_id = ?", userID).Count(&count)
+		assert.Equal(t, int64(0), count)
+		testDB.DB.Model(&models.Portfolio{}).Where("id = ?", other.ID).Count(&count)
+		assert.Equal(t, int64(1), count)
+
+		var events []models.UserLifecycleEvent
+		testDB.DB.Where("owner_id = ?", userID).Find(&events)
+		require.Len(t, events, 1)
+		assert.Equal(t, "user.deleted", events[0].Event)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("DeactivatedUserIsFrozenAndHidden", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		resp := sendAuthentikEvent(t, testAuthentikSecret, map[string]interface{}{"event": "user.deactivated", "user_id": userID})
+		require.Equal(t, 200, resp.Code)
+
+		// Public portfolio is hidden
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/public/%d", portfolio.ID), nil, "")
+		assert.Equal(t, 404, resp.Code)
+
+		// Writes are rejected, the data itself is kept
+		resp = MakeRequest(t, "POST", "/api/portfolios/own", map[string]interface{}{"title": "Frozen"}, token)
+		assert.Equal(t, 403, resp.Code)
+		resp = MakeRequest(t, "DELETE", fmt.Sprintf("/api/portfolios/own/%d", portfolio.ID), nil, token)
+		assert.Equal(t, 403, resp.Code)
+
+		var count int64
+		testDB.DB.Model(&models.Portfolio{}).Where("id = ?", portfolio.ID).Count(&count)
+		assert.Equal(t, int64(1), count)
+
+		// Reactivation makes the portfolio public and writable again
+		resp = sendAuthentikEvent(t, testAuthentikSecret, map[string]interface{}{"event": "user.reactivated", "user_id": userID})
+		require.Equal(t, 200, resp.Code)
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/portfolios/public/%d", portfolio.ID), nil, "")
+		assert.Equal(t, 200, resp.Code)
+		resp = MakeRequest(t, "POST", "/api/portfolios/own", map[string]interface{}{"title": "Thawed"}, token)
+		assert.Equal(t, 201, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("RenameIsRecorded", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+
+		resp := sendAuthentikEvent(t, testAuthentikSecret, map[string]interface{}{
+			"event":             "user.renamed",
+			"user_id":           userID,
+			"username":          "new-name",
+			"previous_username": "old-name",
+		})
+		require.Equal(t, 200, resp.Code)
+
+		var status models.UserStatus
+		require.NoError(t, testDB.DB.Where("owner_id = ?", userID).First(&status).Error)
+		assert.Equal(t, "new-name", status.Username)
+		assert.Equal(t, models.UserStatusActive, status.Status)
+
+		var event models.UserLifecycleEvent
+		require.NoError(t, testDB.DB.Where("owner_id = ?", userID).First(&event).Error)
+		assert.Equal(t, models.UserActionRenamed, event.Action)
+		assert.Equal(t, "old-name -> new-name", event.Details)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		t.Setenv("AUTHENTIK_WEBHOOK_SECRET", "")
+
+		resp := sendAuthentikEvent(t, "", map[string]interface{}{"event": "user.deleted", "user_id": userID})
+		assert.Equal(t, 503, resp.Code)
+	})
+}
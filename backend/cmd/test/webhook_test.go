@@ -0,0 +1,230 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// receivedWebhook is one request captured by the stand-in receiver
+type receivedWebhook struct {
+	event     string
+	signature string
+	body      []byte
+}
+
+// webhookReceiver is a local HTTP endpoint standing in for a subscriber
+type webhookReceiver struct {
+	server   *httptest.Server
+	mu       sync.Mutex
+	received []receivedWebhook
+	status   int
+}
+
+func newWebhookReceiver(status int) *webhookReceiver {
+	receiver := &webhookReceiver{status: status}
+	receiver.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receiver.mu.Lock()
+		receiver.received = append(receiver.received, receivedWebhook{
+			event:     r.Header.Get(webhook.EventHeader),
+			signature: r.Header.Get(webhook.SignatureHeader),
+			body:      body,
+		})
+		receiver.mu.Unlock()
+		w.WriteHeader(receiver.status)
+	}))
+	return receiver
+}
+
+func (r *webhookReceiver) requests() []receivedWebhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedWebhook(nil), r.received...)
+}
+
+// dispatchUntil runs the dispatcher until done reports true or the deadline passes
+func dispatchUntil(t *testing.T, done func() bool) {
+	dispatcher := webhook.NewDispatcher(repo.NewWebhookRepository(testDB.DB))
+	deadline := time.Now().Add(5 * time.Second)
+	for !done() && time.Now().Before(deadline) {
+		_, err := dispatcher.DispatchDue(context.Background())
+		require.NoError(t, err)
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// createTestWebhook registers a webhook through the API and returns its id and secret
+func createTestWebhook(t *testing.T, portfolioID uint, url string, events []string) (uint, string) {
+	payload := map[string]interface{}{
+		"portfolio_id": portfolioID,
+		"url":          url,
+		"events":       events,
+	}
+	resp := MakeRequest(t, "POST", "/api/webhooks/own", payload, GetTestAuthToken())
+	require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+
+	data := ParseJSONBody(t, resp)["data"].(map[string]interface{})
+	return uint(data["id"].(float64)), data["secret"].(string)
+}
+
+// TestWebhooks tests webhook registration and signed delivery of change events
+func TestWebhooks(t *testing.T) {
+	token := GetTestAuthToken()
+	userID := GetTestUserID()
+
+	t.Run("CreateReturnsSecretOnce", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		id, secret := createTestWebhook(t, portfolio.ID, "https://example.com/hook", []string{"project.*"})
+		assert.Len(t, secret, 64)
+
+		resp := MakeRequest(t, "GET", fmt.Sprintf("/api/webhooks/own/%d", id), nil, token)
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			data := body["data"].(map[string]interface{})
+			assert.Equal(t, "https://example.com/hook", data["url"])
+			assert.NotContains(t, data, "secret")
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("RejectsUnknownEvent", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+
+		payload := map[string]interface{}{
+			"portfolio_id": portfolio.ID,
+			"url":          "https://example.com/hook",
+			"events":       []string{"portfolio.published"},
+		}
+		resp := MakeRequest(t, "POST", "/api/webhooks/own", payload, token)
+		assert.Equal(t, 400, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("ForbiddenOnOtherUsersPortfolio", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, "other-user")
+
+		payload := map[string]interface{}{
+			"portfolio_id": portfolio.ID,
+			"url":          "https://example.com/hook",
+			"events":       []string{"*"},
+		}
+		resp := MakeRequest(t, "POST", "/api/webhooks/own", payload, token)
+		assert.Equal(t, 403, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("DeliversSignedEventOnProjectCreate", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		receiver := newWebhookReceiver(http.StatusOK)
+		defer receiver.server.Close()
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		category := CreateTestCategory(testDB.DB, portfolio.ID, userID)
+		webhookID, secret := createTestWebhook(t, portfolio.ID, receiver.server.URL, []string{"project.created"})
+
+		payload := map[string]interface{}{
+			"title":       "Webhook Project",
+			"description": "Triggers a webhook",
+			"category_id": category.ID,
+		}
+		resp := MakeRequest(t, "POST", "/api/projects/own", payload, token)
+		require.Equal(t, 201, resp.Code)
+
+		dispatchUntil(t, func() bool { return len(receiver.requests()) > 0 })
+
+		requests := receiver.requests()
+		require.Len(t, requests, 1)
+		assert.Equal(t, "project.created", requests[0].event)
+		assert.True(t, webhook.Verify(secret, requests[0].signature, requests[0].body))
+
+		var event map[string]interface{}
+		require.NoError(t, json.Unmarshal(requests[0].body, &event))
+		assert.Equal(t, "project.created", event["event"])
+		assert.Equal(t, float64(portfolio.ID), event["portfolio_id"])
+		assert.Equal(t, "Webhook Project", event["data"].(map[string]interface{})["title"])
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/webhooks/own/%d/deliveries", webhookID), nil, token)
+		AssertJSONResponse(t, resp, 200, func(body map[string]interface{}) {
+			deliveries := body["data"].([]interface{})
+			require.Len(t, deliveries, 1)
+			delivery := deliveries[0].(map[string]interface{})
+			assert.Equal(t, models.WebhookDeliveryDelivered, delivery["status"])
+			assert.Equal(t, float64(200), delivery["last_status_code"])
+		})
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("IgnoresUnsubscribedEvents", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		createTestWebhook(t, portfolio.ID, "https://example.com/hook", []string{"section.*"})
+
+		payload := map[string]interface{}{"title": "Not Watched", "portfolio_id": portfolio.ID}
+		resp := MakeRequest(t, "POST", "/api/categories/own", payload, token)
+		require.Equal(t, 201, resp.Code)
+
+		var count int64
+		testDB.DB.Model(&models.WebhookDelivery{}).Count(&count)
+		assert.Equal(t, int64(0), count)
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("FailedDeliveryIsRetried", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		receiver := newWebhookReceiver(http.StatusInternalServerError)
+		defer receiver.server.Close()
+
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		webhookID, _ := createTestWebhook(t, portfolio.ID, receiver.server.URL, []string{"category.*"})
+
+		payload := map[string]interface{}{"title": "Watched", "portfolio_id": portfolio.ID}
+		resp := MakeRequest(t, "POST", "/api/categories/own", payload, token)
+		require.Equal(t, 201, resp.Code)
+
+		dispatchUntil(t, func() bool { return len(receiver.requests()) > 0 })
+
+		var delivery models.WebhookDelivery
+		require.NoError(t, testDB.DB.Where("webhook_id = ?", webhookID).First(&delivery).Error)
+		assert.Equal(t, models.WebhookDeliveryPending, delivery.Status)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, 500, delivery.LastStatusCode)
+		assert.True(t, delivery.NextAttemptAt.After(time.Now()))
+
+		cleanDatabase(testDB.DB)
+	})
+
+	t.Run("DeleteWebhook", func(t *testing.T) {
+		cleanDatabase(testDB.DB)
+		portfolio := CreateTestPortfolio(testDB.DB, userID)
+		id, _ := createTestWebhook(t, portfolio.ID, "https://example.com/hook", []string{"*"})
+
+		resp := MakeRequest(t, "DELETE", fmt.Sprintf("/api/webhooks/own/%d", id), nil, token)
+		assert.Equal(t, 200, resp.Code)
+
+		resp = MakeRequest(t, "GET", fmt.Sprintf("/api/webhooks/own/%d", id), nil, token)
+		assert.Equal(t, 404, resp.Code)
+
+		cleanDatabase(testDB.DB)
+	})
+}
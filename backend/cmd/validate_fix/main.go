@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -20,23 +21,13 @@ func main() {
 
 	// Initialize metrics and start background collection
 	metricsCollector := metrics.NewCollector()
-	metricsCollector.StartMetricsCollection(database.DB)
+	metricsCollector.StartMetricsCollection(context.Background(), database.DB)
 
 	// Initialize repositories
-	portfolioRepo := repo.NewPortfolioRepository(database.DB)
-	categoryRepo := repo.NewCategoryRepository(database.DB)
-	sectionRepo := repo.NewSectionRepository(database.DB)
-	projectRepo := repo.NewProjectRepository(database.DB)
-	sectionContentRepo := repo.NewSectionContentRepository(database.DB)
+	repos := repo.NewRepositories(database.DB)
 
 	// Initialize handler - this will fail to compile if signature is wrong
-	userHandler := handler.NewUserHandler(
-		portfolioRepo,
-		categoryRepo,
-		sectionRepo,
-		projectRepo,
-		sectionContentRepo,
-	)
+	userHandler := handler.NewUserHandler(repos)
 
 	if userHandler == nil {
 		log.Fatal("Failed to create user handler")
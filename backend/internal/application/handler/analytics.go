@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultAnalyticsDays = 30  // Period of the report without from=
+	maxAnalyticsDays     = 366 // Longest period of a report
+	analyticsTopLimit    = 10  // Entries in each top list
+)
+
+type AnalyticsHandler struct {
+	repos *repo.Repositories
+}
+
+func NewAnalyticsHandler(repos *repo.Repositories) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		repos: repos,
+	}
+}
+
+// GetByPortfolio reports the views of the portfolio's public pages from
+// ?from= to ?to=, by default the last 30 days. Counts come from the daily
+// rollups, so the latest views show up once the next rollup ran.
+func (h *AnalyticsHandler) GetByPortfolio(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	portfolioID := c.Param("id")
+
+	id, err := strconv.Atoi(portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_ANALYTICS_INVALID_ID",
+			"where":       "backend/internal/application/handler/analytics.go",
+			"function":    "GetByPortfolio",
+			"userID":      userID,
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid portfolio ID")
+		response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+		return
+	}
+
+	period, err := query.ParsePeriod(c.Request.URL.Query(), time.Now(), defaultAnalyticsDays, maxAnalyticsDays)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_ANALYTICS_INVALID_QUERY",
+			"where":       "backend/internal/application/handler/analytics.go",
+			"function":    "GetByPortfolio",
+			"userID":      userID,
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Invalid analytics period")
+		msg := i18n.MessageOf(err)
+		response.ErrorWithParams(c, http.StatusBadRequest, response.CodeInvalidQuery, msg.Key, msg.Params)
+		return
+	}
+
+	portfolio, err := repos.Portfolio.GetByIDBasic(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_ANALYTICS_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/analytics.go",
+			"function":    "GetByPortfolio",
+			"userID":      userID,
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	if portfolio.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_ANALYTICS_FORBIDDEN",
+			"where":       "backend/internal/application/handler/analytics.go",
+			"function":    "GetByPortfolio",
+			"userID":      userID,
+			"portfolioID": id,
+			"ownerID":     portfolio.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "portfolio",
+			"resource_id":   portfolio.ID,
+			"owner_id":      portfolio.OwnerID,
+			"action":        "analytics",
+		})
+		return
+	}
+
+	days, err := repos.Analytics.GetDaily(portfolio.ID, period.From, period.To)
+	var projects, categories []models.ResourceViews
+	if err == nil {
+		projects, err = repos.Analytics.GetTopResources(portfolio.ID, models.ViewProject, period.From, period.To, analyticsTopLimit)
+	}
+	if err == nil {
+		categories, err = repos.Analytics.GetTopResources(portfolio.ID, models.ViewCategory, period.From, period.To, analyticsTopLimit)
+	}
+	var referrers, countries []models.SourceViews
+	if err == nil {
+		referrers, err = repos.Analytics.GetTopSources(portfolio.ID, models.SourceReferrer, period.From, period.To, analyticsTopLimit)
+	}
+	if err == nil {
+		countries, err = repos.Analytics.GetTopSources(portfolio.ID, models.SourceCountry, period.From, period.To, analyticsTopLimit)
+	}
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_ANALYTICS_DB_ERROR",
+			"where":       "backend/internal/application/handler/analytics.go",
+			"function":    "GetByPortfolio",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to retrieve analytics")
+		response.InternalError(c, i18n.MsgAnalyticsReportFailed)
+		return
+	}
+
+	report := dtoresponse.ToAnalyticsResponse(portfolio.ID, period.From, period.To, days, projects, categories, referrers, countries)
+	response.OK(c, "analytics", report, "Success")
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/batch"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// errBatchFailed rolls back the batch transaction after an operation failed
+var errBatchFailed = errors.New("batch operation failed")
+
+// forwardedBatchHeaders are the operation headers passed on to the handlers
+var forwardedBatchHeaders = []string{"If-Match", "Content-Type"}
+
+type BatchHandler struct {
+	db       *gorm.DB
+	engine   http.Handler
+	basePath string
+}
+
+// NewBatchHandler creates the handler. engine serves the API under basePath,
+// with handlers that take their repositories from the request context (see
+// repo.WithRepositories): every operation of a batch goes through the regular
+// routes, middleware and handlers while sharing one transaction.
+func NewBatchHandler(db *gorm.DB, basePath string, engine http.Handler) *BatchHandler {
+	return &BatchHandler{
+		db:       db,
+		engine:   engine,
+		basePath: basePath,
+	}
+}
+
+// Execute runs the operations in order inside one transaction. The first
+// operation answering with a 4xx/5xx status rolls everything back and its
+// status is returned along with the results so far.
+func (h *BatchHandler) Execute(c *gin.Context) {
+	userID := c.GetString("userID") // From auth middleware
+
+	var req request.BatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "BATCH_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/batch.go",
+			"function":  "Execute",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	results := make([]dtoresponse.BatchResult, 0, len(req.Operations))
+	payloads := make([]interface{}, 0, len(req.Operations))
+	failed := -1
+
+	err := h.db.WithContext(c.Request.Context()).Transaction(func(tx *gorm.DB) error {
+		ctx := repo.WithRepositories(c.Request.Context(), repo.NewRepositories(tx))
+
+		for i, op := range req.Operations {
+			result := h.run(c, ctx, op, payloads)
+			results = append(results, result)
+			if result.Status >= http.StatusBadRequest {
+				failed = i
+				return errBatchFailed
+			}
+			payloads = append(payloads, dataPayload(result.Body))
+		}
+		return nil
+	})
+
+	if errors.Is(err, errBatchFailed) {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":       "BATCH_OPERATION_FAILED",
+			"where":           "backend/internal/application/handler/batch.go",
+			"function":        "Execute",
+			"userID":          userID,
+			"failedOperation": failed,
+			"method":          req.Operations[failed].Method,
+			"path":            req.Operations[failed].Path,
+			"status":          results[failed].Status,
+		}).Warn("Batch rolled back")
+		problem := response.NewProblemWithParams(c, results[failed].Status, response.CodeBatchFailed, i18n.MsgBatchOperationFailed, i18n.Params{"index": failed})
+		problem.Data = dtoresponse.BatchResponse{Results: results, FailedOperation: &failed}
+		response.WriteProblem(c, problem)
+		return
+	}
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "BATCH_COMMIT_ERROR",
+			"where":     "backend/internal/application/handler/batch.go",
+			"function":  "Execute",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Error("Failed to commit batch")
+		response.InternalError(c, i18n.MsgBatchCommitFailed)
+		return
+	}
+
+	response.OK(c, "batch", dtoresponse.BatchResponse{Results: results}, "Batch completed successfully")
+}
+
+// run resolves references in a single operation and serves it through the
+// engine with ctx, which carries the repositories of the batch transaction
+func (h *BatchHandler) run(c *gin.Context, ctx context.Context, op request.BatchOperation, payloads []interface{}) dtoresponse.BatchResult {
+	target, err := batch.ResolvePath(op.Path, payloads)
+	if err != nil {
+		return errorResult(c, http.StatusBadRequest, i18n.MessageOf(err))
+	}
+	if !strings.HasPrefix(target, "/") {
+		return errorResult(c, http.StatusBadRequest, i18n.Message{Key: i18n.MsgBatchPathInvalid})
+	}
+	if cleaned := path.Clean(strings.SplitN(target, "?", 2)[0]); cleaned == "/batch" || strings.HasPrefix(cleaned, "/batch/") {
+		return errorResult(c, http.StatusBadRequest, i18n.Message{Key: i18n.MsgBatchNested})
+	}
+
+	body, err := batch.ResolveBody(op.Body, payloads)
+	if err != nil {
+		return errorResult(c, http.StatusBadRequest, i18n.MessageOf(err))
+	}
+
+	opRequest, err := http.NewRequestWithContext(ctx, op.Method, h.basePath+target, bytes.NewReader(body))
+	if err != nil {
+		return errorResult(c, http.StatusBadRequest, i18n.Message{Key: i18n.MsgBatchOperationInvalid})
+	}
+	opRequest.RemoteAddr = c.Request.RemoteAddr
+	opRequest.Header.Set("Authorization", c.GetHeader("Authorization"))
+	opRequest.Header.Set("Accept-Language", c.GetHeader("Accept-Language"))
+	if len(body) > 0 {
+		opRequest.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range op.Headers {
+		for _, allowed := range forwardedBatchHeaders {
+			if http.CanonicalHeaderKey(key) == allowed {
+				opRequest.Header.Set(allowed, value)
+			}
+		}
+	}
+
+	recorder := httptest.NewRecorder()
+	h.engine.ServeHTTP(recorder, opRequest)
+
+	result := dtoresponse.BatchResult{Status: recorder.Code}
+	if recorder.Body.Len() > 0 && json.Valid(recorder.Body.Bytes()) {
+		result.Body = recorder.Body.Bytes()
+	}
+	return result
+}
+
+// errorResult builds the result of an operation rejected before it ran
+func errorResult(c *gin.Context, status int, message i18n.Message) dtoresponse.BatchResult {
+	body, _ := json.Marshal(response.NewProblemWithParams(c, status, "", message.Key, message.Params))
+	return dtoresponse.BatchResult{Status: status, Body: body}
+}
+
+// dataPayload returns the "data" member of a response body, which is what
+// "$ops[N].field" references point into
+func dataPayload(body []byte) interface{} {
+	var envelope map[string]interface{}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+	if data, ok := envelope["data"]; ok {
+		return data
+	}
+	return envelope
+}
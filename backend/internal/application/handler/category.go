@@ -2,7 +2,6 @@ package handler
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 
@@ -10,6 +9,10 @@ import (
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/metrics"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
 	"github.com/gin-gonic/gin"
@@ -17,27 +20,31 @@ import (
 )
 
 type CategoryHandler struct {
-	repo          repo.CategoryRepository
-	portfolioRepo repo.PortfolioRepository
-	metrics       *metrics.Collector
+	repos   *repo.Repositories
+	metrics *metrics.Collector
+}
+
+// categoryListQuery is what GetByPortfolio accepts in its query string
+var categoryListQuery = query.Options{
+	SortFields:  []string{query.SortTitle, query.SortPosition, query.SortCreatedAt, query.SortUpdatedAt},
+	DefaultSort: query.SortPosition,
+	Filters:     []string{query.FilterCreated},
+	Selection:   categorySelection,
 }
 
 type BulkReorderRequest struct {
-	Items []struct {
-		ID       uint `json:"id" binding:"required"`
-		Position uint `json:"position" binding:"required,min=1"`
-	} `json:"items" binding:"required,min=1"`
+	Items []request.ReorderItem `json:"items" binding:"required,min=1"`
 }
 
-func NewCategoryHandler(repo repo.CategoryRepository, portfolioRepo repo.PortfolioRepository, metrics *metrics.Collector) *CategoryHandler {
+func NewCategoryHandler(repos *repo.Repositories, metrics *metrics.Collector) *CategoryHandler {
 	return &CategoryHandler{
-		repo:          repo,
-		portfolioRepo: portfolioRepo,
-		metrics:       metrics,
+		repos:   repos,
+		metrics: metrics,
 	}
 }
 
 func (h *CategoryHandler) GetByUser(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 
 	// Parse pagination parameters
@@ -57,7 +64,7 @@ func (h *CategoryHandler) GetByUser(c *gin.Context) {
 
 	offset := (page - 1) * limit
 
-	categories, total, err := h.repo.GetByOwnerIDBasic(userID, limit, offset)
+	categories, total, err := repos.Category.GetByOwnerIDBasic(userID, limit, offset)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "GET_CATEGORIES_BY_USER_DB_ERROR",
@@ -66,13 +73,14 @@ func (h *CategoryHandler) GetByUser(c *gin.Context) {
 			"userID":    userID,
 			"error":     err.Error(),
 		}).Error("Failed to retrieve categories")
-		response.InternalError(c, "Failed to retrieve categories")
+		response.InternalError(c, i18n.MsgCategoryListFailed)
 		return
 	}
 
 	response.SuccessWithPagination(c, http.StatusOK, "categories", categories, page, limit, total)
 }
 func (h *CategoryHandler) Update(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 	categoryID := c.Param("id")
 
@@ -87,7 +95,7 @@ func (h *CategoryHandler) Update(c *gin.Context) {
 			"categoryID": categoryID,
 			"error":      err.Error(),
 		}).Warn("Invalid category ID")
-		response.BadRequest(c, "Invalid category ID")
+		response.BadRequest(c, i18n.MsgCategoryInvalidID)
 		return
 	}
 
@@ -102,7 +110,7 @@ func (h *CategoryHandler) Update(c *gin.Context) {
 			"categoryID": id,
 			"error":      err.Error(),
 		}).Warn("Invalid request data")
-		response.BadRequest(c, "Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
 		return
 	}
 
@@ -121,12 +129,12 @@ func (h *CategoryHandler) Update(c *gin.Context) {
 			"portfolioID": updateData.PortfolioID,
 			"error":       err.Error(),
 		}).Warn("Category validation failed")
-		response.BadRequest(c, err.Error())
+		response.Invalid(c, err)
 		return
 	}
 
 	// Check if category exists and belongs to user
-	existing, err := h.repo.GetByIDBasic(uint(id))
+	existing, err := repos.Category.GetByIDBasic(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":  "UPDATE_CATEGORY_NOT_FOUND",
@@ -136,7 +144,7 @@ func (h *CategoryHandler) Update(c *gin.Context) {
 			"categoryID": id,
 			"error":      err.Error(),
 		}).Warn("Category not found")
-		response.NotFound(c, "Category not found")
+		response.NotFound(c, i18n.MsgCategoryNotFound)
 		return
 	}
 
@@ -149,7 +157,7 @@ func (h *CategoryHandler) Update(c *gin.Context) {
 			"categoryID": id,
 			"ownerID":    existing.OwnerID,
 		}).Warn("Access denied")
-		response.ForbiddenWithDetails(c, "Access denied", map[string]interface{}{
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
 			"resource_type": "category",
 			"resource_id":   existing.ID,
 			"owner_id":      existing.OwnerID,
@@ -158,8 +166,18 @@ func (h *CategoryHandler) Update(c *gin.Context) {
 		return
 	}
 
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Category", uint(id), existing.Version)
+	if !ok {
+		return
+	}
+	updateData.Version = version
+
 	// Update category
-	if err := h.repo.Update(&updateData); err != nil {
+	if err := repos.Category.Update(&updateData); err != nil {
+		if versionConflict(c, "Category", uint(id), err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "UPDATE_CATEGORY_DB_ERROR",
 			"where":       "backend/internal/application/handler/category.go",
@@ -169,14 +187,125 @@ func (h *CategoryHandler) Update(c *gin.Context) {
 			"portfolioID": updateData.PortfolioID,
 			"error":       err.Error(),
 		}).Error("Failed to update category")
-		response.InternalError(c, "Failed to update category")
+		response.InternalError(c, i18n.MsgCategoryUpdateFailed)
 		return
 	}
 
+	setETag(c, updateData.Version)
 	response.OK(c, "category", &updateData, "Category updated successfully")
 }
 
+// Patch applies a JSON Merge Patch or JSON Patch to the category
+func (h *CategoryHandler) Patch(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	categoryID := c.Param("id")
+
+	// Parse category ID
+	id, err := strconv.Atoi(categoryID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "PATCH_CATEGORY_INVALID_ID",
+			"where":      "backend/internal/application/handler/category.go",
+			"function":   "Patch",
+			"userID":     userID,
+			"categoryID": categoryID,
+			"error":      err.Error(),
+		}).Warn("Invalid category ID")
+		response.BadRequest(c, i18n.MsgCategoryInvalidID)
+		return
+	}
+
+	// Check if category exists and belongs to user
+	existing, err := repos.Category.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "PATCH_CATEGORY_NOT_FOUND",
+			"where":      "backend/internal/application/handler/category.go",
+			"function":   "Patch",
+			"userID":     userID,
+			"categoryID": id,
+			"error":      err.Error(),
+		}).Warn("Category not found")
+		response.NotFound(c, i18n.MsgCategoryNotFound)
+		return
+	}
+
+	if existing.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "PATCH_CATEGORY_FORBIDDEN",
+			"where":      "backend/internal/application/handler/category.go",
+			"function":   "Patch",
+			"userID":     userID,
+			"categoryID": id,
+			"ownerID":    existing.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "category",
+			"resource_id":   existing.ID,
+			"owner_id":      existing.OwnerID,
+			"action":        "update",
+		})
+		return
+	}
+
+	// Reject the patch if the client edited an outdated copy
+	version, ok := checkVersion(c, "Category", uint(id), existing.Version)
+	if !ok {
+		return
+	}
+
+	// Apply the patch to the editable fields
+	doc := request.PatchCategoryRequest{
+		Title:       existing.Title,
+		Description: existing.Description,
+	}
+	if !applyPatch(c, "Category", uint(id), &doc) {
+		return
+	}
+	existing.Title = doc.Title
+	existing.Description = doc.Description
+	existing.Version = version
+
+	// Validate category data
+	if err := validator.ValidateCategory(existing); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_CATEGORY_VALIDATION_ERROR",
+			"where":       "backend/internal/application/handler/category.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"categoryID":  id,
+			"portfolioID": existing.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Category validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	// Write every editable field so removed ones are cleared
+	if err := repos.Category.Patch(existing); err != nil {
+		if versionConflict(c, "Category", uint(id), err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_CATEGORY_DB_ERROR",
+			"where":       "backend/internal/application/handler/category.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"categoryID":  id,
+			"portfolioID": existing.PortfolioID,
+			"error":       err.Error(),
+		}).Error("Failed to patch category")
+		response.InternalError(c, i18n.MsgCategoryUpdateFailed)
+		return
+	}
+
+	setETag(c, existing.Version)
+	response.OK(c, "category", existing, "Category updated successfully")
+}
+
 func (h *CategoryHandler) Create(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 
 	// Log initial request
@@ -195,7 +324,7 @@ func (h *CategoryHandler) Create(c *gin.Context) {
 			"userID":    userID,
 			"error":     err.Error(),
 		}).Warn("Failed to parse category creation request")
-		response.BadRequest(c, "Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
 		return
 	}
 
@@ -219,12 +348,12 @@ func (h *CategoryHandler) Create(c *gin.Context) {
 			"portfolioID": newCategory.PortfolioID,
 			"error":       err.Error(),
 		}).Warn("Category validation failed")
-		response.BadRequest(c, err.Error())
+		response.Invalid(c, err)
 		return
 	}
 
 	// Validate that the portfolio exists and belongs to the user
-	portfolio, err := h.portfolioRepo.GetByIDBasic(newCategory.PortfolioID)
+	portfolio, err := repos.Portfolio.GetByIDBasic(newCategory.PortfolioID)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "CREATE_CATEGORY_PORTFOLIO_NOT_FOUND",
@@ -234,7 +363,7 @@ func (h *CategoryHandler) Create(c *gin.Context) {
 			"portfolioID": newCategory.PortfolioID,
 			"error":       err.Error(),
 		}).Warn("Portfolio not found")
-		response.NotFound(c, "Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
 		return
 	}
 
@@ -247,7 +376,7 @@ func (h *CategoryHandler) Create(c *gin.Context) {
 			"portfolioID": newCategory.PortfolioID,
 			"ownerID":     portfolio.OwnerID,
 		}).Warn("Access denied to portfolio")
-		response.ForbiddenWithDetails(c, "Access denied", map[string]interface{}{
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
 			"resource_type": "portfolio",
 			"resource_id":   portfolio.ID,
 			"owner_id":      portfolio.OwnerID,
@@ -263,7 +392,7 @@ func (h *CategoryHandler) Create(c *gin.Context) {
 	}).Info("Creating category - position will be set by database trigger")
 
 	// Create category (position is automatically set by database trigger)
-	if err := h.repo.Create(&newCategory); err != nil {
+	if err := repos.Category.Create(&newCategory); err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "CREATE_CATEGORY_DB_ERROR",
 			"where":       "backend/internal/application/handler/category.go",
@@ -272,7 +401,7 @@ func (h *CategoryHandler) Create(c *gin.Context) {
 			"portfolioID": newCategory.PortfolioID,
 			"error":       err.Error(),
 		}).Error("Failed to create category")
-		response.InternalError(c, "Failed to create category")
+		response.InternalError(c, i18n.MsgCategoryCreateFailed)
 		return
 	}
 
@@ -288,6 +417,7 @@ func (h *CategoryHandler) Create(c *gin.Context) {
 }
 
 func (h *CategoryHandler) Delete(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID")
 	categoryID := c.Param("id")
 
@@ -301,12 +431,12 @@ func (h *CategoryHandler) Delete(c *gin.Context) {
 			"categoryID": categoryID,
 			"error":      err.Error(),
 		}).Warn("Invalid category ID")
-		response.BadRequest(c, "Invalid category ID")
+		response.BadRequest(c, i18n.MsgCategoryInvalidID)
 		return
 	}
 
 	// Fetch category to check ownership and get portfolio_id
-	category, err := h.repo.GetByID(uint(id))
+	category, err := repos.Category.GetByID(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":  "DELETE_CATEGORY_NOT_FOUND",
@@ -316,7 +446,7 @@ func (h *CategoryHandler) Delete(c *gin.Context) {
 			"categoryID": id,
 			"error":      err.Error(),
 		}).Warn("Category not found")
-		response.NotFound(c, "Category not found")
+		response.NotFound(c, i18n.MsgCategoryNotFound)
 		return
 	}
 
@@ -329,7 +459,7 @@ func (h *CategoryHandler) Delete(c *gin.Context) {
 			"categoryID": id,
 			"ownerID":    category.OwnerID,
 		}).Warn("Access denied")
-		response.ForbiddenWithDetails(c, "Access denied", map[string]interface{}{
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
 			"resource_type": "category",
 			"resource_id":   category.ID,
 			"owner_id":      category.OwnerID,
@@ -338,8 +468,17 @@ func (h *CategoryHandler) Delete(c *gin.Context) {
 		return
 	}
 
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Category", uint(id), category.Version)
+	if !ok {
+		return
+	}
+
 	// Delete category (CASCADE: all related projects will be deleted)
-	if err := h.repo.Delete(uint(id)); err != nil {
+	if err := repos.Category.Delete(uint(id), version); err != nil {
+		if versionConflict(c, "Category", uint(id), err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "DELETE_CATEGORY_DB_ERROR",
 			"where":       "backend/internal/application/handler/category.go",
@@ -350,7 +489,7 @@ func (h *CategoryHandler) Delete(c *gin.Context) {
 			"error":       err.Error(),
 		}).Error("Failed to delete category")
 
-		response.InternalError(c, "Failed to delete category")
+		response.InternalError(c, i18n.MsgCategoryDeleteFailed)
 		return
 	}
 
@@ -367,6 +506,7 @@ func (h *CategoryHandler) Delete(c *gin.Context) {
 }
 
 func (h *CategoryHandler) GetByIDPublic(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	categoryID := c.Param("id")
 
 	// Parse category ID
@@ -379,12 +519,22 @@ func (h *CategoryHandler) GetByIDPublic(c *gin.Context) {
 			"categoryID": categoryID,
 			"error":      err.Error(),
 		}).Warn("Invalid category ID")
-		response.BadRequest(c, "Invalid category ID")
+		response.BadRequest(c, i18n.MsgCategoryInvalidID)
+		return
+	}
+
+	sel, ok := parseSelection(c, categorySelection, "GetByIDPublic")
+	if !ok {
 		return
 	}
 
-	// Get complete category with relationships
-	category, err := h.repo.GetByIDWithRelations(uint(id))
+	// Get complete category with relationships, or only what fields= and include= ask for
+	var category *models.Category
+	if sel.Empty() {
+		category, err = repos.Category.GetByIDWithRelations(uint(id))
+	} else {
+		category, err = repos.Category.GetByIDSelected(uint(id), sel)
+	}
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":  "GET_CATEGORY_BY_ID_PUBLIC_NOT_FOUND",
@@ -393,18 +543,45 @@ func (h *CategoryHandler) GetByIDPublic(c *gin.Context) {
 			"categoryID": id,
 			"error":      err.Error(),
 		}).Warn("Category not found")
-		response.NotFound(c, "Category not found")
+		response.NotFound(c, i18n.MsgCategoryNotFound)
+		return
+	}
+
+	if ownerHidden(repos.UserStatus, category.OwnerID, "GetByIDPublic") {
+		response.NotFound(c, i18n.MsgCategoryNotFound)
 		return
 	}
 
+	localize(c, repos.Translation, models.TranslationCategory, category.ID, "GetByIDPublic").ApplyCategory(category)
+
+	setETag(c, category.Version)
+	if !sel.Empty() {
+		response.OK(c, "category", dtoresponse.ToCategorySparse(category, sel), "Success")
+		return
+	}
 	response.OK(c, "category", category, "Success")
 }
 
 func (h *CategoryHandler) GetByPortfolio(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	portfolioID := c.Param("id")
 
+	spec, err := query.Parse(c.Request.URL.Query(), categoryListQuery)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_CATEGORIES_BY_PORTFOLIO_INVALID_QUERY",
+			"where":       "backend/internal/application/handler/category.go",
+			"function":    "GetByPortfolio",
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid list query")
+		msg := i18n.MessageOf(err)
+		response.ErrorWithParams(c, http.StatusBadRequest, response.CodeInvalidQuery, msg.Key, msg.Params)
+		return
+	}
+
 	// Get categories for this portfolio
-	categories, err := h.repo.GetByPortfolioID(portfolioID)
+	categories, nextCursor, err := repos.Category.ListByPortfolioID(portfolioID, spec)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "GET_CATEGORIES_BY_PORTFOLIO_DB_ERROR",
@@ -413,15 +590,32 @@ func (h *CategoryHandler) GetByPortfolio(c *gin.Context) {
 			"portfolioID": portfolioID,
 			"error":       err.Error(),
 		}).Error("Failed to retrieve categories")
-		response.InternalError(c, "Failed to retrieve categories")
+		response.InternalError(c, i18n.MsgCategoryListFailed)
+		return
+	}
+
+	if len(categories) > 0 && ownerHidden(repos.UserStatus, categories[0].OwnerID, "GetByPortfolio") {
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
 		return
 	}
 
-	response.OK(c, "categories", categories, "Success")
+	if len(categories) > 0 {
+		translations := localize(c, repos.Translation, models.TranslationCategory, categories[0].ID, "GetByPortfolio")
+		for i := range categories {
+			translations.ApplyCategory(&categories[i])
+		}
+	}
+
+	if !spec.Selection.Empty() {
+		response.SuccessWithCursor(c, http.StatusOK, "categories", dtoresponse.ToCategoryListSparse(categories, spec.Selection), nextCursor)
+		return
+	}
+	response.SuccessWithCursor(c, http.StatusOK, "categories", categories, nextCursor)
 }
 
 // UpdatePosition updates the position field of a category
 func (h *CategoryHandler) UpdatePosition(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 	categoryID := c.Param("id")
 
@@ -436,14 +630,12 @@ func (h *CategoryHandler) UpdatePosition(c *gin.Context) {
 			"categoryID": categoryID,
 			"error":      err.Error(),
 		}).Warn("Invalid category ID")
-		response.BadRequest(c, "Invalid category ID")
+		response.BadRequest(c, i18n.MsgCategoryInvalidID)
 		return
 	}
 
 	// Parse request body
-	var req struct {
-		Position uint `json:"position" binding:"required"`
-	}
+	var req request.PositionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":  "UPDATE_CATEGORY_POSITION_BAD_REQUEST",
@@ -453,12 +645,16 @@ func (h *CategoryHandler) UpdatePosition(c *gin.Context) {
 			"categoryID": id,
 			"error":      err.Error(),
 		}).Warn("Invalid request data")
-		response.BadRequest(c, "Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+	at, ok := placement(c, req)
+	if !ok {
 		return
 	}
 
 	// Check if category exists and belongs to user
-	existing, err := h.repo.GetByIDBasic(uint(id))
+	existing, err := repos.Category.GetByIDBasic(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":  "UPDATE_CATEGORY_POSITION_NOT_FOUND",
@@ -468,7 +664,7 @@ func (h *CategoryHandler) UpdatePosition(c *gin.Context) {
 			"categoryID": id,
 			"error":      err.Error(),
 		}).Warn("Category not found")
-		response.NotFound(c, "Category not found")
+		response.NotFound(c, i18n.MsgCategoryNotFound)
 		return
 	}
 
@@ -481,7 +677,7 @@ func (h *CategoryHandler) UpdatePosition(c *gin.Context) {
 			"categoryID": id,
 			"ownerID":    existing.OwnerID,
 		}).Warn("Access denied")
-		response.ForbiddenWithDetails(c, "Access denied", map[string]interface{}{
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
 			"resource_type": "category",
 			"resource_id":   existing.ID,
 			"owner_id":      existing.OwnerID,
@@ -493,8 +689,18 @@ func (h *CategoryHandler) UpdatePosition(c *gin.Context) {
 	// Store old position before update
 	oldPosition := existing.Position
 
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Category", uint(id), existing.Version)
+	if !ok {
+		return
+	}
+
 	// Update position
-	if err := h.repo.UpdatePosition(uint(id), req.Position); err != nil {
+	position, err := repos.Category.UpdatePosition(uint(id), at, version)
+	if err != nil {
+		if orderingFailed(c, "Category", uint(id), err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":  "UPDATE_CATEGORY_POSITION_DB_ERROR",
 			"where":      "backend/internal/application/handler/category.go",
@@ -504,7 +710,7 @@ func (h *CategoryHandler) UpdatePosition(c *gin.Context) {
 			"position":   req.Position,
 			"error":      err.Error(),
 		}).Error("Failed to update category position")
-		response.InternalError(c, "Failed to update category position")
+		response.InternalError(c, i18n.MsgCategoryPositionFailed)
 		return
 	}
 
@@ -513,33 +719,31 @@ func (h *CategoryHandler) UpdatePosition(c *gin.Context) {
 		"operation":   "UPDATE_CATEGORY_POSITION",
 		"categoryID":  id,
 		"oldPosition": oldPosition,
-		"newPosition": req.Position,
+		"newPosition": position,
 		"userID":      userID,
 	}).Info("Category position updated successfully")
 
+	setETag(c, version+1)
 	response.OK(c, "message", "Category position updated successfully", "Success")
 }
 
 // BulkReorder handles reordering multiple categories atomically
 func (h *CategoryHandler) BulkReorder(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	// Get user ID
 	userID := c.GetString("userID") // From auth middleware
 
 	// Parse request
 	var req BulkReorderRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request: "+err.Error())
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
 		return
 	}
 
 	// Validate no duplicate positions
-	positionMap := make(map[uint]bool)
-	for _, item := range req.Items {
-		if positionMap[item.Position] {
-			response.BadRequest(c, fmt.Sprintf("Duplicate position: %d", item.Position))
-			return
-		}
-		positionMap[item.Position] = true
+	items, ok := reorderItems(c, req.Items)
+	if !ok {
+		return
 	}
 
 	// Verify all categories belong to user's portfolios
@@ -548,27 +752,27 @@ func (h *CategoryHandler) BulkReorder(c *gin.Context) {
 		categoryIDs[i] = item.ID
 	}
 
-	categories, err := h.repo.GetByIDs(categoryIDs)
+	categories, err := repos.Category.GetByIDs(categoryIDs)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "BULK_REORDER_CATEGORIES",
 			"userID":    userID,
 			"error":     err.Error(),
 		}).Error("Failed to fetch categories for bulk reorder")
-		response.InternalError(c, "Failed to fetch categories")
+		response.InternalError(c, i18n.MsgCategoryListFailed)
 		return
 	}
 
 	if len(categories) != len(req.Items) {
-		response.NotFound(c, "Some categories not found")
+		response.NotFound(c, i18n.MsgCategorySomeNotFound)
 		return
 	}
 
 	// Verify ownership
 	for _, cat := range categories {
-		portfolio, err := h.portfolioRepo.GetByID(cat.PortfolioID)
+		portfolio, err := repos.Portfolio.GetByID(cat.PortfolioID)
 		if err != nil || portfolio.OwnerID != userID {
-			response.ForbiddenWithDetails(c, "Access denied", map[string]interface{}{
+			response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
 				"resource_type": "category",
 				"resource_id":   cat.ID,
 			})
@@ -576,15 +780,27 @@ func (h *CategoryHandler) BulkReorder(c *gin.Context) {
 		}
 	}
 
+	// Reorder within one portfolio
+	portfolioID := categories[0].PortfolioID
+	for _, cat := range categories {
+		if cat.PortfolioID != portfolioID {
+			response.BadRequest(c, i18n.MsgReorderNotSiblings)
+			return
+		}
+	}
+
 	// Update positions in transaction
-	if err := h.repo.BulkUpdatePositions(req.Items); err != nil {
+	if err := repos.Category.BulkUpdatePositions(portfolioID, items); err != nil {
+		if orderingFailed(c, "Category", 0, err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "BULK_REORDER_CATEGORIES",
 			"userID":    userID,
 			"itemCount": len(req.Items),
 			"error":     err.Error(),
 		}).Error("Failed to bulk update category positions")
-		response.InternalError(c, "Failed to update positions")
+		response.InternalError(c, i18n.MsgUpdatePositionsFailed)
 		return
 	}
 
@@ -0,0 +1,561 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/contactmail"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/jobs"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ContactHandler struct {
+	repos  *repo.Repositories
+	runner *jobs.Runner // Sends the mails, see contactmail
+}
+
+// NewContactHandler creates the handler. Submit queues the mails on runner,
+// where the contactmail job types must be registered.
+func NewContactHandler(repos *repo.Repositories, runner *jobs.Runner) *ContactHandler {
+	return &ContactHandler{
+		repos:  repos,
+		runner: runner,
+	}
+}
+
+// Submit stores a message sent through the contact form of a portfolio and
+// notifies the owner. Submissions that filled in the honeypot are answered
+// like any other but dropped.
+func (h *ContactHandler) Submit(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	portfolioID := c.Param("id")
+
+	id, err := strconv.Atoi(portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SUBMIT_CONTACT_INVALID_ID",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    "Submit",
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid portfolio ID")
+		response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+		return
+	}
+
+	var req request.ContactRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SUBMIT_CONTACT_BAD_REQUEST",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    "Submit",
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	if req.Website != "" {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SUBMIT_CONTACT_HONEYPOT",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    "Submit",
+			"portfolioID": id,
+			"ip":          c.ClientIP(),
+		}).Warn("Contact message dropped as spam")
+		response.SuccessWithKey(c, http.StatusAccepted, "message", nil, "Message sent")
+		return
+	}
+
+	message := models.ContactMessage{
+		PortfolioID: uint(id),
+		Name:        strings.TrimSpace(req.Name),
+		Email:       req.Email,
+		Subject:     strings.TrimSpace(req.Subject),
+		Body:        strings.TrimSpace(req.Message),
+		Status:      models.ContactUnread,
+	}
+
+	if err := validator.ValidateContactMessage(&message); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SUBMIT_CONTACT_VALIDATION_ERROR",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    "Submit",
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Contact message validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	portfolio, err := repos.Portfolio.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SUBMIT_CONTACT_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    "Submit",
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	// Portfolios of suspended owners are hidden as if they didn't exist
+	if ownerHidden(repos.UserStatus, portfolio.OwnerID, "Submit") {
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	settings, ok := h.settings(c, portfolio, "Submit")
+	if !ok {
+		return
+	}
+	if !settings.Enabled {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SUBMIT_CONTACT_DISABLED",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    "Submit",
+			"portfolioID": portfolio.ID,
+		}).Warn("Contact form disabled")
+		response.Forbidden(c, i18n.MsgContactDisabled)
+		return
+	}
+
+	// Mail servers can be slow, visitors don't wait for them: the mails are
+	// queued with the message and retried until they go out
+	message.OwnerID = portfolio.OwnerID
+	err = repos.Contact.Create(&message, func(message *models.ContactMessage) ([]*models.Job, error) {
+		return contactmail.Jobs(h.runner, message, settings)
+	})
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SUBMIT_CONTACT_DB_ERROR",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    "Submit",
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to store contact message")
+		response.InternalError(c, i18n.MsgContactSendFailed)
+		return
+	}
+
+	audit.GetCreateLogger().WithFields(logrus.Fields{
+		"operation":   "SUBMIT_CONTACT",
+		"messageID":   message.ID,
+		"portfolioID": portfolio.ID,
+		"ownerID":     portfolio.OwnerID,
+	}).Info("Contact message received")
+
+	response.SuccessWithKey(c, http.StatusAccepted, "message", nil, "Message sent")
+}
+
+// GetByUser lists the user's messages, newest first, optionally filtered by
+// ?portfolio_id= and ?status=. Archived messages are only listed when asked for.
+func (h *ContactHandler) GetByUser(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	page := 1
+	if pageStr := c.Query("page"); pageStr != "" {
+		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
+			page = p
+		}
+	}
+
+	limit := 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+
+	var portfolioID uint
+	if portfolioParam := c.Query("portfolio_id"); portfolioParam != "" {
+		id, err := strconv.Atoi(portfolioParam)
+		if err != nil || id < 1 {
+			response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+			return
+		}
+		portfolioID = uint(id)
+	}
+
+	status := c.Query("status")
+	switch status {
+	case "", models.ContactUnread, models.ContactRead, models.ContactArchived:
+	default:
+		response.ErrorWithCode(c, http.StatusBadRequest, response.CodeInvalidQuery, i18n.MsgContactInvalidStatus)
+		return
+	}
+
+	messages, total, err := repos.Contact.GetByOwnerID(userID, portfolioID, status, limit, (page-1)*limit)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "GET_CONTACT_MESSAGES_BY_USER_DB_ERROR",
+			"where":     "backend/internal/application/handler/contact.go",
+			"function":  "GetByUser",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Error("Failed to retrieve contact messages")
+		response.InternalError(c, i18n.MsgContactListFailed)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "messages", dtoresponse.ToContactMessageListResponse(messages), page, limit, total)
+}
+
+func (h *ContactHandler) GetByID(c *gin.Context) {
+	message, ok := h.ownedMessage(c, "GetByID")
+	if !ok {
+		return
+	}
+
+	setETag(c, message.Version)
+	response.OK(c, "message", dtoresponse.ToContactMessageResponse(message), "Success")
+}
+
+// Update moves a message to another inbox state: unread, read or archived
+func (h *ContactHandler) Update(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedMessage(c, "Update")
+	if !ok {
+		return
+	}
+
+	var req request.UpdateContactMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "UPDATE_CONTACT_MESSAGE_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/contact.go",
+			"function":  "Update",
+			"userID":    userID,
+			"messageID": existing.ID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "ContactMessage", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	existing.Status = req.Status
+	existing.Version = version
+
+	if err := repos.Contact.UpdateStatus(existing); err != nil {
+		if versionConflict(c, "ContactMessage", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "UPDATE_CONTACT_MESSAGE_DB_ERROR",
+			"where":     "backend/internal/application/handler/contact.go",
+			"function":  "Update",
+			"userID":    userID,
+			"messageID": existing.ID,
+			"error":     err.Error(),
+		}).Error("Failed to update contact message")
+		response.InternalError(c, i18n.MsgContactUpdateFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation": "UPDATE_CONTACT_MESSAGE",
+		"messageID": existing.ID,
+		"status":    existing.Status,
+		"userID":    userID,
+	}).Info("Contact message updated successfully")
+
+	setETag(c, existing.Version)
+	response.OK(c, "message", dtoresponse.ToContactMessageResponse(existing), "Message updated successfully")
+}
+
+func (h *ContactHandler) Delete(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedMessage(c, "Delete")
+	if !ok {
+		return
+	}
+
+	// Reject the delete if the client saw an outdated copy
+	version, ok := checkVersion(c, "ContactMessage", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	if err := repos.Contact.Delete(existing.ID, version); err != nil {
+		if versionConflict(c, "ContactMessage", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "DELETE_CONTACT_MESSAGE_DB_ERROR",
+			"where":     "backend/internal/application/handler/contact.go",
+			"function":  "Delete",
+			"userID":    userID,
+			"messageID": existing.ID,
+			"error":     err.Error(),
+		}).Error("Failed to delete contact message")
+		response.InternalError(c, i18n.MsgContactDeleteFailed)
+		return
+	}
+
+	audit.GetDeleteLogger().WithFields(logrus.Fields{
+		"operation":   "DELETE_CONTACT_MESSAGE",
+		"messageID":   existing.ID,
+		"portfolioID": existing.PortfolioID,
+		"userID":      userID,
+	}).Info("Contact message deleted successfully")
+
+	response.OK(c, "message", nil, "Message deleted successfully")
+}
+
+// GetSettings returns the contact form settings of the portfolio
+func (h *ContactHandler) GetSettings(c *gin.Context) {
+	portfolio, ok := h.ownedPortfolio(c, "GetSettings")
+	if !ok {
+		return
+	}
+
+	settings, ok := h.settings(c, portfolio, "GetSettings")
+	if !ok {
+		return
+	}
+
+	setETag(c, settings.Version)
+	response.OK(c, "settings", dtoresponse.ToContactSettingsResponse(settings), "Success")
+}
+
+// SaveSettings replaces the contact form settings of the portfolio
+func (h *ContactHandler) SaveSettings(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	portfolio, ok := h.ownedPortfolio(c, "SaveSettings")
+	if !ok {
+		return
+	}
+
+	var req request.ContactSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SAVE_CONTACT_SETTINGS_BAD_REQUEST",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    "SaveSettings",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	existing, ok := h.settings(c, portfolio, "SaveSettings")
+	if !ok {
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "ContactSettings", portfolio.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	existing.Enabled = req.Enabled == nil || *req.Enabled
+	existing.NotifyEmail = req.NotifyEmail
+	existing.AutoReply = req.AutoReply
+	existing.AutoReplySubject = req.AutoReplySubject
+	existing.AutoReplyBody = req.AutoReplyBody
+	existing.Version = version
+
+	if err := validator.ValidateContactSettings(existing); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SAVE_CONTACT_SETTINGS_VALIDATION_ERROR",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    "SaveSettings",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Warn("Contact settings validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	if err := repos.Contact.SaveSettings(existing); err != nil {
+		if versionConflict(c, "ContactSettings", portfolio.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SAVE_CONTACT_SETTINGS_DB_ERROR",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    "SaveSettings",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to save contact settings")
+		response.InternalError(c, i18n.MsgContactSettingsSaveFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":   "SAVE_CONTACT_SETTINGS",
+		"portfolioID": portfolio.ID,
+		"enabled":     existing.Enabled,
+		"autoReply":   existing.AutoReply,
+		"userID":      userID,
+	}).Info("Contact settings saved successfully")
+
+	setETag(c, existing.Version)
+	response.OK(c, "settings", dtoresponse.ToContactSettingsResponse(existing), "Contact settings saved successfully")
+}
+
+// settings loads the contact settings of the portfolio, the defaults when it
+// has none stored, writing the error response on failure
+func (h *ContactHandler) settings(c *gin.Context, portfolio *models.Portfolio, function string) (*models.ContactSettings, bool) {
+	repos := h.repos.For(c.Request.Context())
+	settings, err := repos.Contact.GetSettings(portfolio.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.DefaultContactSettings(portfolio), true
+	}
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_CONTACT_SETTINGS_DB_ERROR",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    function,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to retrieve contact settings")
+		response.InternalError(c, i18n.MsgContactSettingsFailed)
+		return nil, false
+	}
+	return settings, true
+}
+
+// ownedMessage loads the message named by :id and checks it belongs to the
+// user, writing the error response otherwise
+func (h *ContactHandler) ownedMessage(c *gin.Context, function string) (*models.ContactMessage, bool) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	messageID := c.Param("id")
+
+	id, err := strconv.Atoi(messageID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "CONTACT_MESSAGE_INVALID_ID",
+			"where":     "backend/internal/application/handler/contact.go",
+			"function":  function,
+			"userID":    userID,
+			"messageID": messageID,
+			"error":     err.Error(),
+		}).Warn("Invalid message ID")
+		response.BadRequest(c, i18n.MsgContactInvalidID)
+		return nil, false
+	}
+
+	message, err := repos.Contact.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "CONTACT_MESSAGE_NOT_FOUND",
+			"where":     "backend/internal/application/handler/contact.go",
+			"function":  function,
+			"userID":    userID,
+			"messageID": id,
+			"error":     err.Error(),
+		}).Warn("Message not found")
+		response.NotFound(c, i18n.MsgContactNotFound)
+		return nil, false
+	}
+
+	if message.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "CONTACT_MESSAGE_FORBIDDEN",
+			"where":     "backend/internal/application/handler/contact.go",
+			"function":  function,
+			"userID":    userID,
+			"messageID": id,
+			"ownerID":   message.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "contact_message",
+			"resource_id":   message.ID,
+			"owner_id":      message.OwnerID,
+			"action":        function,
+		})
+		return nil, false
+	}
+
+	return message, true
+}
+
+// ownedPortfolio loads the portfolio named by :id and checks it belongs to the
+// user, writing the error response otherwise
+func (h *ContactHandler) ownedPortfolio(c *gin.Context, function string) (*models.Portfolio, bool) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	portfolioID := c.Param("id")
+
+	id, err := strconv.Atoi(portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CONTACT_INVALID_PORTFOLIO_ID",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    function,
+			"userID":      userID,
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid portfolio ID")
+		response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+		return nil, false
+	}
+
+	portfolio, err := repos.Portfolio.GetByIDBasic(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CONTACT_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    function,
+			"userID":      userID,
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return nil, false
+	}
+
+	if portfolio.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CONTACT_FORBIDDEN",
+			"where":       "backend/internal/application/handler/contact.go",
+			"function":    function,
+			"userID":      userID,
+			"portfolioID": id,
+			"ownerID":     portfolio.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "portfolio",
+			"resource_id":   portfolio.ID,
+			"owner_id":      portfolio.OwnerID,
+			"action":        function,
+		})
+		return nil, false
+	}
+
+	return portfolio, true
+}
@@ -0,0 +1,498 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type EducationHandler struct {
+	repos *repo.Repositories
+}
+
+func NewEducationHandler(repos *repo.Repositories) *EducationHandler {
+	return &EducationHandler{
+		repos: repos,
+	}
+}
+
+// GetByPortfolio lists the education of a portfolio in the owner's order
+func (h *EducationHandler) GetByPortfolio(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	portfolioID := c.Param("id")
+
+	id, err := strconv.Atoi(portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_EDUCATION_INVALID_ID",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "GetByPortfolio",
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid portfolio ID")
+		response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+		return
+	}
+
+	portfolio, err := repos.Portfolio.GetByIDBasic(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_EDUCATION_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "GetByPortfolio",
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	// Portfolios of suspended owners are hidden as if they didn't exist
+	if ownerHidden(repos.UserStatus, portfolio.OwnerID, "GetByPortfolio") {
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	education, err := repos.Education.GetByPortfolioID(portfolio.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_EDUCATION_DB_ERROR",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "GetByPortfolio",
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to retrieve education")
+		response.InternalError(c, i18n.MsgEducationListFailed)
+		return
+	}
+
+	response.OK(c, "education", dtoresponse.ToEducationListResponse(education), "Success")
+}
+
+func (h *EducationHandler) GetByID(c *gin.Context) {
+	education, ok := h.ownedEducation(c, "GetByID")
+	if !ok {
+		return
+	}
+
+	setETag(c, education.Version)
+	response.OK(c, "education", dtoresponse.ToEducationResponse(education), "Success")
+}
+
+// Create adds an education entry to the end of the portfolio's education
+func (h *EducationHandler) Create(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	var req request.CreateEducationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "CREATE_EDUCATION_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/education.go",
+			"function":  "Create",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	education := models.Education{
+		PortfolioID: req.PortfolioID,
+		OwnerID:     userID,
+	}
+	applyEducation(&education, req.EducationRequest)
+
+	if err := validator.ValidateEducation(&education); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_EDUCATION_VALIDATION_ERROR",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Education validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	// Validate portfolio exists and belongs to user
+	portfolio, err := repos.Portfolio.GetByIDBasic(req.PortfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_EDUCATION_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	if portfolio.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_EDUCATION_FORBIDDEN",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"ownerID":     portfolio.OwnerID,
+		}).Warn("Access denied to portfolio")
+		response.ForbiddenWithDetails(c, i18n.MsgPortfolioAccessDenied, map[string]interface{}{
+			"resource_type": "portfolio",
+			"resource_id":   portfolio.ID,
+			"owner_id":      portfolio.OwnerID,
+			"action":        "create_education",
+		})
+		return
+	}
+
+	if err := repos.Education.Create(&education); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_EDUCATION_DB_ERROR",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to create education entry")
+		response.InternalError(c, i18n.MsgEducationCreateFailed)
+		return
+	}
+
+	audit.GetCreateLogger().WithFields(logrus.Fields{
+		"operation":   "CREATE_EDUCATION",
+		"userID":      userID,
+		"educationID": education.ID,
+		"portfolioID": portfolio.ID,
+		"position":    education.Position,
+	}).Info("Education entry created successfully")
+
+	setETag(c, education.Version)
+	response.Created(c, "education", dtoresponse.ToEducationResponse(&education), "Education entry created successfully")
+}
+
+// Update replaces the content of an education entry
+func (h *EducationHandler) Update(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedEducation(c, "Update")
+	if !ok {
+		return
+	}
+
+	var req request.EducationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "UPDATE_EDUCATION_BAD_REQUEST",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "Update",
+			"userID":      userID,
+			"educationID": existing.ID,
+			"error":       err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Education", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	applyEducation(existing, req)
+	existing.Version = version
+
+	if err := validator.ValidateEducation(existing); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "UPDATE_EDUCATION_VALIDATION_ERROR",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "Update",
+			"userID":      userID,
+			"educationID": existing.ID,
+			"error":       err.Error(),
+		}).Warn("Education validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	if err := repos.Education.Update(existing); err != nil {
+		if versionConflict(c, "Education", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "UPDATE_EDUCATION_DB_ERROR",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "Update",
+			"userID":      userID,
+			"educationID": existing.ID,
+			"error":       err.Error(),
+		}).Error("Failed to update education entry")
+		response.InternalError(c, i18n.MsgEducationUpdateFailed)
+		return
+	}
+
+	setETag(c, existing.Version)
+	response.OK(c, "education", dtoresponse.ToEducationResponse(existing), "Education entry updated successfully")
+}
+
+// UpdatePosition moves an education entry within its portfolio
+func (h *EducationHandler) UpdatePosition(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedEducation(c, "UpdatePosition")
+	if !ok {
+		return
+	}
+
+	var req request.PositionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "UPDATE_EDUCATION_POSITION_BAD_REQUEST",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "UpdatePosition",
+			"userID":      userID,
+			"educationID": existing.ID,
+			"error":       err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+	at, ok := placement(c, req)
+	if !ok {
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Education", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	position, err := repos.Education.UpdatePosition(existing.ID, at, version)
+	if err != nil {
+		if orderingFailed(c, "Education", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "UPDATE_EDUCATION_POSITION_DB_ERROR",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "UpdatePosition",
+			"userID":      userID,
+			"educationID": existing.ID,
+			"error":       err.Error(),
+		}).Error("Failed to update education position")
+		response.InternalError(c, i18n.MsgEducationPositionFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":   "UPDATE_EDUCATION_POSITION",
+		"educationID": existing.ID,
+		"oldPosition": existing.Position,
+		"newPosition": position,
+		"userID":      userID,
+	}).Info("Education position updated successfully")
+
+	setETag(c, version+1)
+	response.OK(c, "message", "Education position updated successfully", "Success")
+}
+
+// BulkReorder puts several education entries of one portfolio at the given
+// positions at once
+func (h *EducationHandler) BulkReorder(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	var req request.ReorderEducationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	// Validate no duplicate positions
+	items, ok := reorderItems(c, req.Items)
+	if !ok {
+		return
+	}
+
+	ids := make([]uint, len(req.Items))
+	for i, item := range req.Items {
+		ids[i] = item.ID
+	}
+
+	entries, err := repos.Education.GetByIDs(ids)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "BULK_REORDER_EDUCATION",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Error("Failed to fetch education for bulk reorder")
+		response.InternalError(c, i18n.MsgEducationListFailed)
+		return
+	}
+
+	if len(entries) != len(req.Items) {
+		response.NotFound(c, i18n.MsgEducationSomeNotFound)
+		return
+	}
+
+	// Reorder the user's education entries within one portfolio
+	portfolioID := entries[0].PortfolioID
+	for _, education := range entries {
+		if education.OwnerID != userID {
+			response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+				"resource_type": "education",
+				"resource_id":   education.ID,
+			})
+			return
+		}
+		if education.PortfolioID != portfolioID {
+			response.BadRequest(c, i18n.MsgReorderNotSiblings)
+			return
+		}
+	}
+
+	if err := repos.Education.BulkUpdatePositions(portfolioID, items); err != nil {
+		if orderingFailed(c, "Education", 0, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "BULK_REORDER_EDUCATION",
+			"userID":    userID,
+			"itemCount": len(req.Items),
+			"error":     err.Error(),
+		}).Error("Failed to bulk update education positions")
+		response.InternalError(c, i18n.MsgUpdatePositionsFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":   "BULK_REORDER_EDUCATION",
+		"userID":      userID,
+		"portfolioID": portfolioID,
+		"itemCount":   len(req.Items),
+	}).Info("Education reordered successfully")
+
+	response.OK(c, "message", "Education reordered successfully", "Success")
+}
+
+func (h *EducationHandler) Delete(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedEducation(c, "Delete")
+	if !ok {
+		return
+	}
+
+	// Reject the delete if the client saw an outdated copy
+	version, ok := checkVersion(c, "Education", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	if err := repos.Education.Delete(existing.ID, version); err != nil {
+		if versionConflict(c, "Education", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "DELETE_EDUCATION_DB_ERROR",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    "Delete",
+			"userID":      userID,
+			"educationID": existing.ID,
+			"error":       err.Error(),
+		}).Error("Failed to delete education entry")
+		response.InternalError(c, i18n.MsgEducationDeleteFailed)
+		return
+	}
+
+	audit.GetDeleteLogger().WithFields(logrus.Fields{
+		"operation":   "DELETE_EDUCATION",
+		"educationID": existing.ID,
+		"portfolioID": existing.PortfolioID,
+		"userID":      userID,
+	}).Info("Education entry deleted successfully")
+
+	response.OK(c, "message", "Education entry deleted successfully", "Success")
+}
+
+// applyEducation copies the content of a request onto the education entry
+func applyEducation(education *models.Education, req request.EducationRequest) {
+	education.Institution = strings.TrimSpace(req.Institution)
+	education.Degree = strings.TrimSpace(req.Degree)
+	education.StartDate = req.StartDate
+	education.EndDate = req.EndDate
+}
+
+// ownedEducation loads the education entry named by :id and checks it belongs to
+// the user, writing the error response otherwise
+func (h *EducationHandler) ownedEducation(c *gin.Context, function string) (*models.Education, bool) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	educationID := c.Param("id")
+
+	id, err := strconv.Atoi(educationID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "EDUCATION_INVALID_ID",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    function,
+			"userID":      userID,
+			"educationID": educationID,
+			"error":       err.Error(),
+		}).Warn("Invalid education ID")
+		response.BadRequest(c, i18n.MsgEducationInvalidID)
+		return nil, false
+	}
+
+	education, err := repos.Education.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "EDUCATION_NOT_FOUND",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    function,
+			"userID":      userID,
+			"educationID": id,
+			"error":       err.Error(),
+		}).Warn("Education entry not found")
+		response.NotFound(c, i18n.MsgEducationNotFound)
+		return nil, false
+	}
+
+	if education.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "EDUCATION_FORBIDDEN",
+			"where":       "backend/internal/application/handler/education.go",
+			"function":    function,
+			"userID":      userID,
+			"educationID": id,
+			"ownerID":     education.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "education",
+			"resource_id":   education.ID,
+			"owner_id":      education.OwnerID,
+			"action":        function,
+		})
+		return nil, false
+	}
+
+	return education, true
+}
@@ -0,0 +1,546 @@
+package handler
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type ExperienceHandler struct {
+	repos *repo.Repositories
+}
+
+func NewExperienceHandler(repos *repo.Repositories) *ExperienceHandler {
+	return &ExperienceHandler{
+		repos: repos,
+	}
+}
+
+// GetByPortfolio lists the work history of a portfolio in the owner's order
+func (h *ExperienceHandler) GetByPortfolio(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	portfolioID := c.Param("id")
+
+	id, err := strconv.Atoi(portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_EXPERIENCES_INVALID_ID",
+			"where":       "backend/internal/application/handler/experience.go",
+			"function":    "GetByPortfolio",
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid portfolio ID")
+		response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+		return
+	}
+
+	portfolio, err := repos.Portfolio.GetByIDBasic(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_EXPERIENCES_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/experience.go",
+			"function":    "GetByPortfolio",
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	// Portfolios of suspended owners are hidden as if they didn't exist
+	if ownerHidden(repos.UserStatus, portfolio.OwnerID, "GetByPortfolio") {
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	experiences, err := repos.Experience.GetByPortfolioID(portfolio.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_EXPERIENCES_DB_ERROR",
+			"where":       "backend/internal/application/handler/experience.go",
+			"function":    "GetByPortfolio",
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to retrieve experiences")
+		response.InternalError(c, i18n.MsgExperienceListFailed)
+		return
+	}
+
+	response.OK(c, "experiences", dtoresponse.ToExperienceListResponse(experiences), "Success")
+}
+
+func (h *ExperienceHandler) GetByID(c *gin.Context) {
+	experience, ok := h.ownedExperience(c, "GetByID")
+	if !ok {
+		return
+	}
+
+	setETag(c, experience.Version)
+	response.OK(c, "experience", dtoresponse.ToExperienceResponse(experience), "Success")
+}
+
+// Create adds an experience to the end of the portfolio's work history
+func (h *ExperienceHandler) Create(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	var req request.CreateExperienceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "CREATE_EXPERIENCE_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/experience.go",
+			"function":  "Create",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	experience := models.Experience{
+		PortfolioID: req.PortfolioID,
+		OwnerID:     userID,
+	}
+	applyExperience(&experience, req.ExperienceRequest)
+
+	if err := validator.ValidateExperience(&experience); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_EXPERIENCE_VALIDATION_ERROR",
+			"where":       "backend/internal/application/handler/experience.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Experience validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	// Validate portfolio exists and belongs to user
+	portfolio, err := repos.Portfolio.GetByIDBasic(req.PortfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_EXPERIENCE_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/experience.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	if portfolio.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_EXPERIENCE_FORBIDDEN",
+			"where":       "backend/internal/application/handler/experience.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"ownerID":     portfolio.OwnerID,
+		}).Warn("Access denied to portfolio")
+		response.ForbiddenWithDetails(c, i18n.MsgPortfolioAccessDenied, map[string]interface{}{
+			"resource_type": "portfolio",
+			"resource_id":   portfolio.ID,
+			"owner_id":      portfolio.OwnerID,
+			"action":        "create_experience",
+		})
+		return
+	}
+
+	if !h.projectsInPortfolio(c, experience.ProjectIDs, portfolio.ID, "Create") {
+		return
+	}
+
+	if err := repos.Experience.Create(&experience); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_EXPERIENCE_DB_ERROR",
+			"where":       "backend/internal/application/handler/experience.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to create experience")
+		response.InternalError(c, i18n.MsgExperienceCreateFailed)
+		return
+	}
+
+	audit.GetCreateLogger().WithFields(logrus.Fields{
+		"operation":    "CREATE_EXPERIENCE",
+		"userID":       userID,
+		"experienceID": experience.ID,
+		"portfolioID":  portfolio.ID,
+		"position":     experience.Position,
+	}).Info("Experience created successfully")
+
+	setETag(c, experience.Version)
+	response.Created(c, "experience", dtoresponse.ToExperienceResponse(&experience), "Experience created successfully")
+}
+
+// Update replaces the content and linked projects of an experience
+func (h *ExperienceHandler) Update(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedExperience(c, "Update")
+	if !ok {
+		return
+	}
+
+	var req request.ExperienceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":    "UPDATE_EXPERIENCE_BAD_REQUEST",
+			"where":        "backend/internal/application/handler/experience.go",
+			"function":     "Update",
+			"userID":       userID,
+			"experienceID": existing.ID,
+			"error":        err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Experience", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	applyExperience(existing, req)
+	existing.Version = version
+
+	if err := validator.ValidateExperience(existing); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":    "UPDATE_EXPERIENCE_VALIDATION_ERROR",
+			"where":        "backend/internal/application/handler/experience.go",
+			"function":     "Update",
+			"userID":       userID,
+			"experienceID": existing.ID,
+			"error":        err.Error(),
+		}).Warn("Experience validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	if !h.projectsInPortfolio(c, existing.ProjectIDs, existing.PortfolioID, "Update") {
+		return
+	}
+
+	if err := repos.Experience.Update(existing); err != nil {
+		if versionConflict(c, "Experience", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":    "UPDATE_EXPERIENCE_DB_ERROR",
+			"where":        "backend/internal/application/handler/experience.go",
+			"function":     "Update",
+			"userID":       userID,
+			"experienceID": existing.ID,
+			"error":        err.Error(),
+		}).Error("Failed to update experience")
+		response.InternalError(c, i18n.MsgExperienceUpdateFailed)
+		return
+	}
+
+	setETag(c, existing.Version)
+	response.OK(c, "experience", dtoresponse.ToExperienceResponse(existing), "Experience updated successfully")
+}
+
+// UpdatePosition moves an experience within its portfolio
+func (h *ExperienceHandler) UpdatePosition(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedExperience(c, "UpdatePosition")
+	if !ok {
+		return
+	}
+
+	var req request.PositionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":    "UPDATE_EXPERIENCE_POSITION_BAD_REQUEST",
+			"where":        "backend/internal/application/handler/experience.go",
+			"function":     "UpdatePosition",
+			"userID":       userID,
+			"experienceID": existing.ID,
+			"error":        err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+	at, ok := placement(c, req)
+	if !ok {
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Experience", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	position, err := repos.Experience.UpdatePosition(existing.ID, at, version)
+	if err != nil {
+		if orderingFailed(c, "Experience", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":    "UPDATE_EXPERIENCE_POSITION_DB_ERROR",
+			"where":        "backend/internal/application/handler/experience.go",
+			"function":     "UpdatePosition",
+			"userID":       userID,
+			"experienceID": existing.ID,
+			"error":        err.Error(),
+		}).Error("Failed to update experience position")
+		response.InternalError(c, i18n.MsgExperiencePositionFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":    "UPDATE_EXPERIENCE_POSITION",
+		"experienceID": existing.ID,
+		"oldPosition":  existing.Position,
+		"newPosition":  position,
+		"userID":       userID,
+	}).Info("Experience position updated successfully")
+
+	setETag(c, version+1)
+	response.OK(c, "message", "Experience position updated successfully", "Success")
+}
+
+// BulkReorder puts several experiences of one portfolio at the given
+// positions at once
+func (h *ExperienceHandler) BulkReorder(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	var req request.ReorderExperiencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	// Validate no duplicate positions
+	items, ok := reorderItems(c, req.Items)
+	if !ok {
+		return
+	}
+
+	ids := make([]uint, len(req.Items))
+	for i, item := range req.Items {
+		ids[i] = item.ID
+	}
+
+	experiences, err := repos.Experience.GetByIDs(ids)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "BULK_REORDER_EXPERIENCES",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Error("Failed to fetch experiences for bulk reorder")
+		response.InternalError(c, i18n.MsgExperienceListFailed)
+		return
+	}
+
+	if len(experiences) != len(req.Items) {
+		response.NotFound(c, i18n.MsgExperienceSomeNotFound)
+		return
+	}
+
+	// Reorder the user's experiences within one portfolio
+	portfolioID := experiences[0].PortfolioID
+	for _, experience := range experiences {
+		if experience.OwnerID != userID {
+			response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+				"resource_type": "experience",
+				"resource_id":   experience.ID,
+			})
+			return
+		}
+		if experience.PortfolioID != portfolioID {
+			response.BadRequest(c, i18n.MsgReorderNotSiblings)
+			return
+		}
+	}
+
+	if err := repos.Experience.BulkUpdatePositions(portfolioID, items); err != nil {
+		if orderingFailed(c, "Experience", 0, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "BULK_REORDER_EXPERIENCES",
+			"userID":    userID,
+			"itemCount": len(req.Items),
+			"error":     err.Error(),
+		}).Error("Failed to bulk update experience positions")
+		response.InternalError(c, i18n.MsgUpdatePositionsFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":   "BULK_REORDER_EXPERIENCES",
+		"userID":      userID,
+		"portfolioID": portfolioID,
+		"itemCount":   len(req.Items),
+	}).Info("Experiences reordered successfully")
+
+	response.OK(c, "message", "Experiences reordered successfully", "Success")
+}
+
+func (h *ExperienceHandler) Delete(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedExperience(c, "Delete")
+	if !ok {
+		return
+	}
+
+	// Reject the delete if the client saw an outdated copy
+	version, ok := checkVersion(c, "Experience", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	if err := repos.Experience.Delete(existing.ID, version); err != nil {
+		if versionConflict(c, "Experience", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":    "DELETE_EXPERIENCE_DB_ERROR",
+			"where":        "backend/internal/application/handler/experience.go",
+			"function":     "Delete",
+			"userID":       userID,
+			"experienceID": existing.ID,
+			"error":        err.Error(),
+		}).Error("Failed to delete experience")
+		response.InternalError(c, i18n.MsgExperienceDeleteFailed)
+		return
+	}
+
+	audit.GetDeleteLogger().WithFields(logrus.Fields{
+		"operation":    "DELETE_EXPERIENCE",
+		"experienceID": existing.ID,
+		"portfolioID":  existing.PortfolioID,
+		"userID":       userID,
+	}).Info("Experience deleted successfully")
+
+	response.OK(c, "message", "Experience deleted successfully", "Success")
+}
+
+// applyExperience copies the content of a request onto the experience. Linked
+// projects keep their order; repeats are dropped.
+func applyExperience(experience *models.Experience, req request.ExperienceRequest) {
+	experience.Company = strings.TrimSpace(req.Company)
+	experience.Title = strings.TrimSpace(req.Title)
+	experience.Location = strings.TrimSpace(req.Location)
+	experience.StartDate = req.StartDate
+	experience.EndDate = req.EndDate
+	experience.Current = req.Current
+
+	experience.Highlights = make(models.StringArray, len(req.Highlights))
+	for i, highlight := range req.Highlights {
+		experience.Highlights[i] = strings.TrimSpace(highlight)
+	}
+
+	experience.ProjectIDs = nil
+	for _, projectID := range req.ProjectIDs {
+		if !slices.Contains(experience.ProjectIDs, projectID) {
+			experience.ProjectIDs = append(experience.ProjectIDs, projectID)
+		}
+	}
+}
+
+// projectsInPortfolio checks that the linked projects are shown in the
+// portfolio, writing a 400 otherwise
+func (h *ExperienceHandler) projectsInPortfolio(c *gin.Context, projectIDs []uint, portfolioID uint, function string) bool {
+	repos := h.repos.For(c.Request.Context())
+	found, err := repos.Experience.ProjectsInPortfolio(projectIDs, portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "EXPERIENCE_PROJECT_CHECK_ERROR",
+			"where":       "backend/internal/application/handler/experience.go",
+			"function":    function,
+			"projectIDs":  projectIDs,
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Error("Failed to check the linked projects")
+		response.InternalError(c, i18n.MsgExperienceUpdateFailed)
+		return false
+	}
+	if !found {
+		response.BadRequest(c, i18n.MsgExperienceProjectsNotInPortfolio)
+		return false
+	}
+	return true
+}
+
+// ownedExperience loads the experience named by :id and checks it belongs to
+// the user, writing the error response otherwise
+func (h *ExperienceHandler) ownedExperience(c *gin.Context, function string) (*models.Experience, bool) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	experienceID := c.Param("id")
+
+	id, err := strconv.Atoi(experienceID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":    "EXPERIENCE_INVALID_ID",
+			"where":        "backend/internal/application/handler/experience.go",
+			"function":     function,
+			"userID":       userID,
+			"experienceID": experienceID,
+			"error":        err.Error(),
+		}).Warn("Invalid experience ID")
+		response.BadRequest(c, i18n.MsgExperienceInvalidID)
+		return nil, false
+	}
+
+	experience, err := repos.Experience.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":    "EXPERIENCE_NOT_FOUND",
+			"where":        "backend/internal/application/handler/experience.go",
+			"function":     function,
+			"userID":       userID,
+			"experienceID": id,
+			"error":        err.Error(),
+		}).Warn("Experience not found")
+		response.NotFound(c, i18n.MsgExperienceNotFound)
+		return nil, false
+	}
+
+	if experience.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":    "EXPERIENCE_FORBIDDEN",
+			"where":        "backend/internal/application/handler/experience.go",
+			"function":     function,
+			"userID":       userID,
+			"experienceID": id,
+			"ownerID":      experience.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "experience",
+			"resource_id":   experience.ID,
+			"owner_id":      experience.OwnerID,
+			"action":        function,
+		})
+		return nil, false
+	}
+
+	return experience, true
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// Fields and relations each resource accepts in fields= and include=
+var (
+	portfolioSelection = query.SelectionOptions{
+		Fields:  []string{"title", "description", "owner_id", "version", "default_locale", "locales", "created_at", "updated_at"},
+		Include: []string{"sections", "categories", "experiences", "education"},
+	}
+	categorySelection = query.SelectionOptions{
+		Fields:  []string{"title", "description", "position", "owner_id", "portfolio_id", "version", "created_at", "updated_at"},
+		Include: []string{"projects"},
+	}
+	projectSelection = query.SelectionOptions{
+		Fields: []string{"title", "description", "skills", "client", "link", "links", "start_date", "end_date", "ongoing", "role", "team_size", "status", "featured", "position", "owner_id", "category_id", "version", "created_at", "updated_at"},
+	}
+	sectionSelection = query.SelectionOptions{
+		Fields:  []string{"title", "description", "type", "position", "portfolio_id", "owner_id", "version", "created_at", "updated_at"},
+		Include: []string{"contents"},
+	}
+)
+
+// parseSelection reads fields= and include= of a detail request, answering
+// 400 when they name something the resource doesn't have
+func parseSelection(c *gin.Context, opts query.SelectionOptions, function string) (query.Selection, bool) {
+	sel, err := query.ParseSelection(c.Request.URL.Query(), opts)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "INVALID_FIELD_SELECTION",
+			"where":     "backend/internal/application/handler/fields.go",
+			"function":  function,
+			"query":     c.Request.URL.RawQuery,
+			"error":     err.Error(),
+		}).Warn("Invalid field selection")
+		msg := i18n.MessageOf(err)
+		response.ErrorWithParams(c, http.StatusBadRequest, response.CodeInvalidQuery, msg.Key, msg.Params)
+		return sel, false
+	}
+	return sel, true
+}
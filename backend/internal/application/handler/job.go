@@ -0,0 +1,308 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/jobs"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// JobHandler lets administrators inspect and manage the background job queue
+type JobHandler struct {
+	repos  *repo.Repositories
+	runner *jobs.Runner
+}
+
+func NewJobHandler(repos *repo.Repositories, runner *jobs.Runner) *JobHandler {
+	return &JobHandler{
+		repos:  repos,
+		runner: runner,
+	}
+}
+
+// List returns the jobs, latest first, optionally filtered by ?status= and ?type=
+func (h *JobHandler) List(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	page := 1
+	if pageStr := c.Query("page"); pageStr != "" {
+		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
+			page = p
+		}
+	}
+
+	limit := 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+
+	status := c.Query("status")
+	if status != "" && !slices.Contains(models.JobStatuses, status) {
+		response.BadRequest(c, i18n.MsgJobInvalidStatus)
+		return
+	}
+
+	list, total, err := repos.Job.List(status, c.Query("type"), limit, (page-1)*limit)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "LIST_JOBS_DB_ERROR",
+			"where":     "backend/internal/application/handler/job.go",
+			"function":  "List",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Error("Failed to retrieve jobs")
+		response.InternalError(c, i18n.MsgJobListFailed)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "jobs", dtoresponse.ToJobListResponse(list), page, limit, total)
+}
+
+// GetStats returns the registered job types, the queue counts by type and
+// status, and the schedules with their last and next runs
+func (h *JobHandler) GetStats(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	counts, err := repos.Job.Counts()
+	if err != nil {
+		h.statsFailed(c, userID, err)
+		return
+	}
+	schedules, err := repos.Job.GetSchedules()
+	if err != nil {
+		h.statsFailed(c, userID, err)
+		return
+	}
+
+	response.OK(c, "stats", dtoresponse.JobStatsResponse{
+		Types:     h.runner.Types(),
+		Counts:    counts,
+		Schedules: schedules,
+	}, "Success")
+}
+
+func (h *JobHandler) statsFailed(c *gin.Context, userID string, err error) {
+	audit.GetErrorLogger().WithFields(logrus.Fields{
+		"operation": "GET_JOB_STATS_DB_ERROR",
+		"where":     "backend/internal/application/handler/job.go",
+		"function":  "GetStats",
+		"userID":    userID,
+		"error":     err.Error(),
+	}).Error("Failed to retrieve job statistics")
+	response.InternalError(c, i18n.MsgJobStatsFailed)
+}
+
+// GetByID returns a job with its payload and last error
+func (h *JobHandler) GetByID(c *gin.Context) {
+	job, ok := h.findJob(c, "GetByID")
+	if !ok {
+		return
+	}
+
+	response.OK(c, "job", dtoresponse.ToJobResponse(job), "Success")
+}
+
+// Enqueue queues a job of a registered type, e.g. to run a scheduled job now
+func (h *JobHandler) Enqueue(c *gin.Context) {
+	userID := c.GetString("userID") // From auth middleware
+
+	var req request.EnqueueJobRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "ENQUEUE_JOB_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/job.go",
+			"function":  "Enqueue",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	runAt := time.Now()
+	if req.RunAt != nil {
+		runAt = *req.RunAt
+	}
+	payload := req.Payload
+	if len(payload) == 0 {
+		payload = []byte("{}")
+	}
+
+	job, err := h.runner.Enqueue(req.Type, payload, runAt)
+	if err != nil {
+		fields := logrus.Fields{
+			"operation": "ENQUEUE_JOB_ERROR",
+			"where":     "backend/internal/application/handler/job.go",
+			"function":  "Enqueue",
+			"userID":    userID,
+			"type":      req.Type,
+			"error":     err.Error(),
+		}
+		switch {
+		case errors.Is(err, jobs.ErrUnknownType):
+			audit.GetErrorLogger().WithFields(fields).Warn("Unknown job type")
+			response.BadRequest(c, i18n.MsgJobUnknownType)
+		case errors.Is(err, jobs.ErrInvalidPayload):
+			audit.GetErrorLogger().WithFields(fields).Warn("Invalid job payload")
+			response.BadRequest(c, i18n.MsgJobInvalidPayload)
+		default:
+			audit.GetErrorLogger().WithFields(fields).Error("Failed to enqueue job")
+			response.InternalError(c, i18n.MsgJobCreateFailed)
+		}
+		return
+	}
+
+	audit.GetCreateLogger().WithFields(logrus.Fields{
+		"operation": "ENQUEUE_JOB",
+		"jobID":     job.ID,
+		"type":      job.Type,
+		"userID":    userID,
+	}).Info("Job enqueued successfully")
+
+	response.Created(c, "job", dtoresponse.ToJobResponse(job), "Job enqueued successfully")
+}
+
+// Retry queues a dead job again with a fresh set of attempts
+func (h *JobHandler) Retry(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	job, ok := h.findJob(c, "Retry")
+	if !ok {
+		return
+	}
+
+	if job.Status != models.JobDead {
+		response.Conflict(c, i18n.MsgJobNotDead)
+		return
+	}
+
+	err := repos.Job.Retry(job.ID, time.Now())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Retried by someone else since it was loaded
+		response.Conflict(c, i18n.MsgJobNotDead)
+		return
+	}
+	if err == nil {
+		job, err = repos.Job.GetByID(job.ID)
+	}
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "RETRY_JOB_DB_ERROR",
+			"where":     "backend/internal/application/handler/job.go",
+			"function":  "Retry",
+			"userID":    userID,
+			"jobID":     job.ID,
+			"error":     err.Error(),
+		}).Error("Failed to retry job")
+		response.InternalError(c, i18n.MsgJobRetryFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation": "RETRY_JOB",
+		"jobID":     job.ID,
+		"type":      job.Type,
+		"userID":    userID,
+	}).Info("Job queued for retry")
+
+	response.OK(c, "job", dtoresponse.ToJobResponse(job), "Job queued for retry")
+}
+
+// Delete removes a job that isn't running
+func (h *JobHandler) Delete(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	job, ok := h.findJob(c, "Delete")
+	if !ok {
+		return
+	}
+
+	if job.Status == models.JobRunning {
+		response.Conflict(c, i18n.MsgJobRunning)
+		return
+	}
+
+	err := repos.Job.Delete(job.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Claimed by a worker since it was loaded
+		response.Conflict(c, i18n.MsgJobRunning)
+		return
+	}
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "DELETE_JOB_DB_ERROR",
+			"where":     "backend/internal/application/handler/job.go",
+			"function":  "Delete",
+			"userID":    userID,
+			"jobID":     job.ID,
+			"error":     err.Error(),
+		}).Error("Failed to delete job")
+		response.InternalError(c, i18n.MsgJobDeleteFailed)
+		return
+	}
+
+	audit.GetDeleteLogger().WithFields(logrus.Fields{
+		"operation": "DELETE_JOB",
+		"jobID":     job.ID,
+		"type":      job.Type,
+		"status":    job.Status,
+		"userID":    userID,
+	}).Info("Job deleted successfully")
+
+	response.OK(c, "job", nil, "Job deleted successfully")
+}
+
+// findJob loads the job named by :id, writing the error response otherwise
+func (h *JobHandler) findJob(c *gin.Context, function string) (*models.Job, bool) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	jobID := c.Param("id")
+
+	id, err := strconv.Atoi(jobID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "JOB_INVALID_ID",
+			"where":     "backend/internal/application/handler/job.go",
+			"function":  function,
+			"userID":    userID,
+			"jobID":     jobID,
+			"error":     err.Error(),
+		}).Warn("Invalid job ID")
+		response.BadRequest(c, i18n.MsgJobInvalidID)
+		return nil, false
+	}
+
+	job, err := repos.Job.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "JOB_NOT_FOUND",
+			"where":     "backend/internal/application/handler/job.go",
+			"function":  function,
+			"userID":    userID,
+			"jobID":     id,
+			"error":     err.Error(),
+		}).Warn("Job not found")
+		response.NotFound(c, i18n.MsgJobNotFound)
+		return nil, false
+	}
+
+	return job, true
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type LinkCheckHandler struct {
+	repos *repo.Repositories
+}
+
+func NewLinkCheckHandler(repos *repo.Repositories) *LinkCheckHandler {
+	return &LinkCheckHandler{
+		repos: repos,
+	}
+}
+
+// GetBrokenByPortfolio reports the broken URLs stored in the portfolio's
+// projects and image contents, with where each is stored and its latest
+// checks. URLs are checked in the background, so new ones show up once the
+// next check ran.
+func (h *LinkCheckHandler) GetBrokenByPortfolio(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	portfolioID := c.Param("id")
+
+	id, err := strconv.Atoi(portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_BROKEN_LINKS_INVALID_ID",
+			"where":       "backend/internal/application/handler/link_check.go",
+			"function":    "GetBrokenByPortfolio",
+			"userID":      userID,
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid portfolio ID")
+		response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+		return
+	}
+
+	portfolio, err := repos.Portfolio.GetByIDBasic(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_BROKEN_LINKS_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/link_check.go",
+			"function":    "GetBrokenByPortfolio",
+			"userID":      userID,
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	if portfolio.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_BROKEN_LINKS_FORBIDDEN",
+			"where":       "backend/internal/application/handler/link_check.go",
+			"function":    "GetBrokenByPortfolio",
+			"userID":      userID,
+			"portfolioID": id,
+			"ownerID":     portfolio.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "portfolio",
+			"resource_id":   portfolio.ID,
+			"owner_id":      portfolio.OwnerID,
+			"action":        "broken_links",
+		})
+		return
+	}
+
+	broken, err := repos.LinkCheck.GetBrokenByPortfolio(portfolio.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_BROKEN_LINKS_DB_ERROR",
+			"where":       "backend/internal/application/handler/link_check.go",
+			"function":    "GetBrokenByPortfolio",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to retrieve broken links")
+		response.InternalError(c, i18n.MsgLinkReportFailed)
+		return
+	}
+
+	response.OK(c, "links", broken, "Success")
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/ordering"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+)
+
+// placement returns where the request puts the item, writing a 400 when it
+// gives more than one of position, before and after
+func placement(c *gin.Context, r request.PositionRequest) (ordering.Placement, bool) {
+	given := 0
+	for _, value := range []uint{r.Position, r.Before, r.After} {
+		if value != 0 {
+			given++
+		}
+	}
+	if given > 1 {
+		response.BadRequest(c, i18n.MsgPositionAmbiguous)
+		return ordering.Placement{}, false
+	}
+	return ordering.Placement{Position: r.Position, Before: r.Before, After: r.After}, true
+}
+
+// reorderItems validates the items of a bulk reorder, writing a 400 when two
+// of them ask for the same position, and converts them for the repository
+func reorderItems(c *gin.Context, items []request.ReorderItem) ([]ordering.Item, bool) {
+	positions := make(map[uint]bool, len(items))
+	converted := make([]ordering.Item, len(items))
+	for i, item := range items {
+		if positions[item.Position] {
+			response.ErrorWithParams(c, http.StatusBadRequest, "", i18n.MsgDuplicatePosition, i18n.Params{"position": item.Position})
+			return nil, false
+		}
+		positions[item.Position] = true
+		converted[i] = ordering.Item{ID: item.ID, Position: item.Position}
+	}
+	return converted, true
+}
+
+// orderingFailed writes a 412 or 400 and returns true when err is a version
+// conflict or a placement the siblings can't satisfy
+func orderingFailed(c *gin.Context, resource string, id uint, err error) bool {
+	switch {
+	case versionConflict(c, resource, id, err):
+	case errors.Is(err, ordering.ErrAnchor):
+		response.BadRequest(c, i18n.MsgPositionAnchorInvalid)
+	case errors.Is(err, ordering.ErrNotSibling):
+		response.BadRequest(c, i18n.MsgReorderNotSiblings)
+	case errors.Is(err, ordering.ErrDuplicate):
+		response.BadRequest(c, i18n.MsgReorderDuplicateItem)
+	default:
+		return false
+	}
+	return true
+}
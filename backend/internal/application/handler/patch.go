@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/patch"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/sirupsen/logrus"
+)
+
+// applyPatch applies the request body to doc, a pointer to the resource's patch
+// document, and checks the document's binding rules. The body is a JSON Merge
+// Patch or a JSON Patch depending on Content-Type. On failure the error response
+// is written and false returned.
+func applyPatch(c *gin.Context, resource string, id uint, doc interface{}) bool {
+	fields := logrus.Fields{
+		"where":      "backend/internal/application/handler/patch.go",
+		"function":   "applyPatch",
+		"userID":     c.GetString("userID"),
+		"resource":   resource,
+		"resourceID": id,
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		fields["operation"] = "PATCH_READ_BODY_ERROR"
+		fields["error"] = err.Error()
+		audit.GetErrorLogger().WithFields(fields).Warn("Failed to read patch body")
+		response.BadRequest(c, i18n.MsgReadBodyFailed)
+		return false
+	}
+
+	if err := patch.Apply(c.ContentType(), doc, body); err != nil {
+		fields["error"] = err.Error()
+		switch {
+		case errors.Is(err, patch.ErrUnsupportedMediaType):
+			fields["operation"] = "PATCH_UNSUPPORTED_MEDIA_TYPE"
+			fields["contentType"] = c.ContentType()
+			audit.GetErrorLogger().WithFields(fields).Warn("Unsupported patch media type")
+			response.ErrorWithParams(c, http.StatusUnsupportedMediaType, "", i18n.MsgPatchContentType, i18n.Params{
+				"merge": patch.MergePatchContentType,
+				"json":  patch.JSONPatchContentType,
+			})
+		case errors.Is(err, patch.ErrTestFailed):
+			fields["operation"] = "PATCH_TEST_FAILED"
+			audit.GetErrorLogger().WithFields(fields).Warn("Patch test operation failed")
+			response.Conflict(c, i18n.MsgPatchTestFailed)
+		default:
+			fields["operation"] = "PATCH_INVALID"
+			audit.GetErrorLogger().WithFields(fields).Warn("Invalid patch")
+			msg := i18n.MessageOf(err)
+			response.ErrorWithParams(c, http.StatusBadRequest, "", msg.Key, msg.Params)
+		}
+		return false
+	}
+
+	if err := binding.Validator.ValidateStruct(doc); err != nil {
+		fields["operation"] = "PATCH_VALIDATION_ERROR"
+		fields["error"] = err.Error()
+		audit.GetErrorLogger().WithFields(fields).Warn("Patched " + resource + " failed validation")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return false
+	}
+
+	return true
+}
@@ -12,24 +12,27 @@ import (
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
 	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 )
 
 type PortfolioHandler struct {
-	repo    repo.PortfolioRepository
+	repos   *repo.Repositories
 	metrics *metrics.Collector
 }
 
-func NewPortfolioHandler(repo repo.PortfolioRepository, metrics *metrics.Collector) *PortfolioHandler {
+func NewPortfolioHandler(repos *repo.Repositories, metrics *metrics.Collector) *PortfolioHandler {
 	return &PortfolioHandler{
-		repo:    repo,
+		repos:   repos,
 		metrics: metrics,
 	}
 }
 
 func (h *PortfolioHandler) GetByUser(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 
 	// Parse pagination parameters
@@ -41,7 +44,7 @@ func (h *PortfolioHandler) GetByUser(c *gin.Context) {
 	page, limit := pagination.GetPageAndLimit()
 	offset := pagination.GetOffset()
 
-	portfolios, total, err := h.repo.GetByOwnerIDBasic(userID, limit, offset)
+	portfolios, total, err := repos.Portfolio.GetByOwnerIDBasic(userID, limit, offset)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "GET_PORTFOLIOS_BY_USER_DB_ERROR",
@@ -50,9 +53,7 @@ func (h *PortfolioHandler) GetByUser(c *gin.Context) {
 			"userID":    userID,
 			"error":     err.Error(),
 		}).Error("Failed to retrieve portfolios")
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to retrieve portfolios",
-		})
+		response.Error(c, http.StatusInternalServerError, i18n.MsgPortfolioListFailed)
 		return
 	}
 
@@ -65,6 +66,7 @@ func (h *PortfolioHandler) GetByUser(c *gin.Context) {
 	})
 }
 func (h *PortfolioHandler) Update(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 	portfolioID := c.Param("id")
 
@@ -79,9 +81,7 @@ func (h *PortfolioHandler) Update(c *gin.Context) {
 			"portfolioID": portfolioID,
 			"error":       err.Error(),
 		}).Warn("Invalid portfolio ID")
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid portfolio ID",
-		})
+		response.Error(c, http.StatusBadRequest, i18n.MsgPortfolioInvalidID)
 		return
 	}
 
@@ -96,9 +96,7 @@ func (h *PortfolioHandler) Update(c *gin.Context) {
 			"portfolioID": id,
 			"error":       err.Error(),
 		}).Warn("Invalid request data")
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid request data",
-		})
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
 		return
 	}
 
@@ -120,14 +118,12 @@ func (h *PortfolioHandler) Update(c *gin.Context) {
 			"portfolioID": id,
 			"error":       err.Error(),
 		}).Warn("Portfolio validation failed")
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		response.Invalid(c, err)
 		return
 	}
 
 	// Check if portfolio exists and belongs to user
-	existing, err := h.repo.GetByIDBasic(uint(id))
+	existing, err := repos.Portfolio.GetByIDBasic(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "UPDATE_PORTFOLIO_NOT_FOUND",
@@ -137,9 +133,7 @@ func (h *PortfolioHandler) Update(c *gin.Context) {
 			"portfolioID": id,
 			"error":       err.Error(),
 		}).Warn("Portfolio not found")
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error: "Portfolio not found",
-		})
+		response.Error(c, http.StatusNotFound, i18n.MsgPortfolioNotFound)
 		return
 	}
 	if existing.OwnerID != userID {
@@ -151,14 +145,12 @@ func (h *PortfolioHandler) Update(c *gin.Context) {
 			"portfolioID": id,
 			"ownerID":     existing.OwnerID,
 		}).Warn("Access denied")
-		c.JSON(http.StatusForbidden, dto.ErrorResponse{
-			Error: "Access denied",
-		})
+		response.Error(c, http.StatusForbidden, i18n.MsgAccessDenied)
 		return
 	}
 
 	// Check for duplicate title
-	isDuplicate, err := h.repo.CheckDuplicate(updateData.Title, updateData.OwnerID, updateData.ID)
+	isDuplicate, err := repos.Portfolio.CheckDuplicate(updateData.Title, updateData.OwnerID, updateData.ID)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "UPDATE_PORTFOLIO_DUPLICATE_CHECK_ERROR",
@@ -169,9 +161,7 @@ func (h *PortfolioHandler) Update(c *gin.Context) {
 			"title":       updateData.Title,
 			"error":       err.Error(),
 		}).Error("Failed to check for duplicate portfolio")
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to check for duplicate portfolio",
-		})
+		response.Error(c, http.StatusInternalServerError, i18n.MsgPortfolioDuplicateCheckFailed)
 		return
 	}
 	if isDuplicate {
@@ -183,14 +173,22 @@ func (h *PortfolioHandler) Update(c *gin.Context) {
 			"portfolioID": id,
 			"title":       updateData.Title,
 		}).Warn("Portfolio with this title already exists")
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Portfolio with this title already exists",
-		})
+		response.Error(c, http.StatusBadRequest, i18n.MsgPortfolioTitleTaken)
 		return
 	}
 
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Portfolio", uint(id), existing.Version)
+	if !ok {
+		return
+	}
+	updateData.Version = version
+
 	// Update portfolio
-	if err := h.repo.Update(&updateData); err != nil {
+	if err := repos.Portfolio.Update(&updateData); err != nil {
+		if versionConflict(c, "Portfolio", uint(id), err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "UPDATE_PORTFOLIO_DB_ERROR",
 			"where":       "backend/internal/application/handler/portfolio.go",
@@ -199,9 +197,7 @@ func (h *PortfolioHandler) Update(c *gin.Context) {
 			"portfolioID": id,
 			"error":       err.Error(),
 		}).Error("Failed to update portfolio")
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to update portfolio",
-		})
+		response.Error(c, http.StatusInternalServerError, i18n.MsgPortfolioUpdateFailed)
 		return
 	}
 
@@ -213,13 +209,155 @@ func (h *PortfolioHandler) Update(c *gin.Context) {
 		"userID":      userID,
 	}).Info("Portfolio updated successfully")
 
+	setETag(c, updateData.Version)
 	c.JSON(http.StatusOK, dto.SuccessResponse{
 		Message: "Portfolio updated successfully",
 		Data:    dtoresponse.ToPortfolioResponse(&updateData),
 	})
 }
 
+// Patch applies a JSON Merge Patch or JSON Patch to the portfolio
+func (h *PortfolioHandler) Patch(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	portfolioID := c.Param("id")
+
+	// Parse portfolio ID
+	id, err := strconv.Atoi(portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_PORTFOLIO_INVALID_ID",
+			"where":       "backend/internal/application/handler/portfolio.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid portfolio ID")
+		response.Error(c, http.StatusBadRequest, i18n.MsgPortfolioInvalidID)
+		return
+	}
+
+	// Check if portfolio exists and belongs to user
+	existing, err := repos.Portfolio.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/portfolio.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.Error(c, http.StatusNotFound, i18n.MsgPortfolioNotFound)
+		return
+	}
+	if existing.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_PORTFOLIO_FORBIDDEN",
+			"where":       "backend/internal/application/handler/portfolio.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"portfolioID": id,
+			"ownerID":     existing.OwnerID,
+		}).Warn("Access denied")
+		response.Error(c, http.StatusForbidden, i18n.MsgAccessDenied)
+		return
+	}
+
+	// Reject the patch if the client edited an outdated copy
+	version, ok := checkVersion(c, "Portfolio", uint(id), existing.Version)
+	if !ok {
+		return
+	}
+
+	// Apply the patch to the editable fields
+	doc := request.PatchPortfolioRequest{
+		Title:       existing.Title,
+		Description: existing.Description,
+	}
+	if !applyPatch(c, "Portfolio", uint(id), &doc) {
+		return
+	}
+	existing.Title = doc.Title
+	existing.Description = doc.Description
+	existing.Version = version
+
+	// Validate portfolio data
+	if err := validator.ValidatePortfolio(existing); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_PORTFOLIO_VALIDATION_ERROR",
+			"where":       "backend/internal/application/handler/portfolio.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Portfolio validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	// Check for duplicate title
+	isDuplicate, err := repos.Portfolio.CheckDuplicate(existing.Title, userID, existing.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_PORTFOLIO_DUPLICATE_CHECK_ERROR",
+			"where":       "backend/internal/application/handler/portfolio.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"portfolioID": id,
+			"title":       existing.Title,
+			"error":       err.Error(),
+		}).Error("Failed to check for duplicate portfolio")
+		response.Error(c, http.StatusInternalServerError, i18n.MsgPortfolioDuplicateCheckFailed)
+		return
+	}
+	if isDuplicate {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_PORTFOLIO_DUPLICATE_TITLE",
+			"where":       "backend/internal/application/handler/portfolio.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"portfolioID": id,
+			"title":       existing.Title,
+		}).Warn("Portfolio with this title already exists")
+		response.Error(c, http.StatusBadRequest, i18n.MsgPortfolioTitleTaken)
+		return
+	}
+
+	// Write every editable field so removed ones are cleared
+	if err := repos.Portfolio.Patch(existing); err != nil {
+		if versionConflict(c, "Portfolio", uint(id), err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_PORTFOLIO_DB_ERROR",
+			"where":       "backend/internal/application/handler/portfolio.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Error("Failed to patch portfolio")
+		response.Error(c, http.StatusInternalServerError, i18n.MsgPortfolioUpdateFailed)
+		return
+	}
+
+	// Audit log for update operation
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":   "PATCH_PORTFOLIO",
+		"portfolioID": existing.ID,
+		"title":       existing.Title,
+		"userID":      userID,
+	}).Info("Portfolio patched successfully")
+
+	setETag(c, existing.Version)
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Portfolio updated successfully",
+		Data:    dtoresponse.ToPortfolioResponse(existing),
+	})
+}
+
 func (h *PortfolioHandler) Create(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 
 	logrus.WithFields(logrus.Fields{
@@ -241,9 +379,7 @@ func (h *PortfolioHandler) Create(c *gin.Context) {
 			"userID":    userID,
 		}).Warn("Failed to parse portfolio creation request")
 
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid request data: " + err.Error(),
-		})
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
 		return
 	}
 
@@ -278,16 +414,14 @@ func (h *PortfolioHandler) Create(c *gin.Context) {
 			"title":     newPortfolio.Title,
 		}).Warn("Portfolio validation failed")
 
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: err.Error(),
-		})
+		response.Invalid(c, err)
 		return
 	}
 
 	logrus.Info("Portfolio validation passed, checking for duplicates...")
 
 	// Check for duplicate title
-	isDuplicate, err := h.repo.CheckDuplicate(newPortfolio.Title, newPortfolio.OwnerID, 0)
+	isDuplicate, err := repos.Portfolio.CheckDuplicate(newPortfolio.Title, newPortfolio.OwnerID, 0)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "CREATE_PORTFOLIO_DUPLICATE_CHECK_ERROR",
@@ -298,9 +432,7 @@ func (h *PortfolioHandler) Create(c *gin.Context) {
 			"title":     newPortfolio.Title,
 		}).Error("Failed to check for duplicate portfolio")
 
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to check for duplicate portfolio",
-		})
+		response.Error(c, http.StatusInternalServerError, i18n.MsgPortfolioDuplicateCheckFailed)
 		return
 	}
 	if isDuplicate {
@@ -312,16 +444,19 @@ func (h *PortfolioHandler) Create(c *gin.Context) {
 			"title":     newPortfolio.Title,
 		}).Warn("Duplicate portfolio title detected")
 
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Portfolio with this title already exists",
-		})
+		response.Error(c, http.StatusBadRequest, i18n.MsgPortfolioTitleTaken)
 		return
 	}
 
 	logrus.Info("No duplicates found, creating portfolio...")
 
+	if req.TemplateID != 0 {
+		h.createFromTemplate(c, &newPortfolio, req.TemplateID)
+		return
+	}
+
 	// Create portfolio
-	if err := h.repo.Create(&newPortfolio); err != nil {
+	if err := repos.Portfolio.Create(&newPortfolio); err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "CREATE_PORTFOLIO_DB_ERROR",
 			"where":     "backend/internal/application/handler/portfolio.go",
@@ -331,9 +466,7 @@ func (h *PortfolioHandler) Create(c *gin.Context) {
 			"title":     newPortfolio.Title,
 		}).Error("Failed to create portfolio in database")
 
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to create portfolio",
-		})
+		response.Error(c, http.StatusInternalServerError, i18n.MsgPortfolioCreateFailed)
 		return
 	}
 
@@ -351,7 +484,64 @@ func (h *PortfolioHandler) Create(c *gin.Context) {
 	})
 }
 
+// createFromTemplate creates the portfolio with what the template adds, in a
+// single transaction, and responds with all of it. Private templates can only
+// be used by their owner.
+func (h *PortfolioHandler) createFromTemplate(c *gin.Context, newPortfolio *models.Portfolio, templateID uint) {
+	repos := h.repos.For(c.Request.Context())
+	userID := newPortfolio.OwnerID
+
+	template, err := repos.Template.GetByID(templateID)
+	if err != nil || !template.UsableBy(userID) {
+		fields := logrus.Fields{
+			"operation":  "CREATE_PORTFOLIO_TEMPLATE_NOT_FOUND",
+			"where":      "backend/internal/application/handler/portfolio.go",
+			"function":   "createFromTemplate",
+			"userID":     userID,
+			"templateID": templateID,
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		}
+		audit.GetErrorLogger().WithFields(fields).Warn("Template not found")
+
+		response.NotFound(c, i18n.MsgTemplateNotFound)
+		return
+	}
+
+	if err := repos.Portfolio.CreateFromTemplate(newPortfolio, template.Bundle); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "CREATE_PORTFOLIO_FROM_TEMPLATE_DB_ERROR",
+			"where":      "backend/internal/application/handler/portfolio.go",
+			"function":   "createFromTemplate",
+			"error":      err.Error(),
+			"userID":     userID,
+			"templateID": templateID,
+			"title":      newPortfolio.Title,
+		}).Error("Failed to create portfolio from template")
+
+		response.Error(c, http.StatusInternalServerError, i18n.MsgPortfolioCreateFailed)
+		return
+	}
+
+	audit.GetCreateLogger().WithFields(logrus.Fields{
+		"operation":   "CREATE_PORTFOLIO",
+		"portfolioID": newPortfolio.ID,
+		"title":       newPortfolio.Title,
+		"templateID":  templateID,
+		"sections":    len(newPortfolio.Sections),
+		"categories":  len(newPortfolio.Categories),
+		"userID":      userID,
+	}).Info("Portfolio created from template successfully")
+
+	c.JSON(http.StatusCreated, dto.SuccessResponse{
+		Message: "Portfolio created successfully",
+		Data:    dtoresponse.ToPortfolioDetailResponse(newPortfolio),
+	})
+}
+
 func (h *PortfolioHandler) Delete(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID")
 	portfolioID := c.Param("id")
 
@@ -365,14 +555,12 @@ func (h *PortfolioHandler) Delete(c *gin.Context) {
 			"portfolioID": portfolioID,
 			"error":       err.Error(),
 		}).Warn("Invalid portfolio ID")
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid portfolio ID",
-		})
+		response.Error(c, http.StatusBadRequest, i18n.MsgPortfolioInvalidID)
 		return
 	}
 
 	// Use basic method - only fetch id and owner_id for authorization
-	portfolio, err := h.repo.GetByIDBasic(uint(id))
+	portfolio, err := repos.Portfolio.GetByIDBasic(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "DELETE_PORTFOLIO_NOT_FOUND",
@@ -382,9 +570,7 @@ func (h *PortfolioHandler) Delete(c *gin.Context) {
 			"portfolioID": id,
 			"error":       err.Error(),
 		}).Warn("Portfolio not found")
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error: "Portfolio not found",
-		})
+		response.Error(c, http.StatusNotFound, i18n.MsgPortfolioNotFound)
 		return
 	}
 
@@ -397,13 +583,20 @@ func (h *PortfolioHandler) Delete(c *gin.Context) {
 			"portfolioID": id,
 			"ownerID":     portfolio.OwnerID,
 		}).Warn("Access denied")
-		c.JSON(http.StatusForbidden, dto.ErrorResponse{
-			Error: "Access denied",
-		})
+		response.Error(c, http.StatusForbidden, i18n.MsgAccessDenied)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Portfolio", uint(id), portfolio.Version)
+	if !ok {
 		return
 	}
 
-	if err := h.repo.Delete(uint(id)); err != nil {
+	if err := repos.Portfolio.Delete(uint(id), version); err != nil {
+		if versionConflict(c, "Portfolio", uint(id), err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "DELETE_PORTFOLIO_DB_ERROR",
 			"where":       "backend/internal/application/handler/portfolio.go",
@@ -413,9 +606,7 @@ func (h *PortfolioHandler) Delete(c *gin.Context) {
 			"error":       err.Error(),
 		}).Error("Failed to delete portfolio")
 
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to delete portfolio",
-		})
+		response.Error(c, http.StatusInternalServerError, i18n.MsgPortfolioDeleteFailed)
 		return
 	}
 
@@ -434,6 +625,7 @@ func (h *PortfolioHandler) Delete(c *gin.Context) {
 }
 
 func (h *PortfolioHandler) GetByIDPublic(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	portfolioID := c.Param("id")
 
 	// Parse portfolio ID
@@ -446,14 +638,22 @@ func (h *PortfolioHandler) GetByIDPublic(c *gin.Context) {
 			"portfolioID": portfolioID,
 			"error":       err.Error(),
 		}).Warn("Invalid portfolio ID")
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid portfolio ID",
-		})
+		response.Error(c, http.StatusBadRequest, i18n.MsgPortfolioInvalidID)
+		return
+	}
+
+	sel, ok := parseSelection(c, portfolioSelection, "GetByIDPublic")
+	if !ok {
 		return
 	}
 
-	// Get complete portfolio with relationships
-	portfolio, err := h.repo.GetByIDWithRelations(uint(id))
+	// Get complete portfolio with relationships, or only what fields= and include= ask for
+	var portfolio *models.Portfolio
+	if sel.Empty() {
+		portfolio, err = repos.Portfolio.GetByIDWithRelations(uint(id))
+	} else {
+		portfolio, err = repos.Portfolio.GetByIDSelected(uint(id), sel)
+	}
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "GET_PORTFOLIO_BY_ID_PUBLIC_NOT_FOUND",
@@ -462,14 +662,26 @@ func (h *PortfolioHandler) GetByIDPublic(c *gin.Context) {
 			"portfolioID": id,
 			"error":       err.Error(),
 		}).Warn("Portfolio not found")
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error: "Portfolio not found",
-		})
+		response.Error(c, http.StatusNotFound, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	// Portfolios of suspended owners are hidden as if they didn't exist
+	if ownerHidden(repos.UserStatus, portfolio.OwnerID, "GetByIDPublic") {
+		response.Error(c, http.StatusNotFound, i18n.MsgPortfolioNotFound)
 		return
 	}
 
+	localize(c, repos.Translation, models.TranslationPortfolio, portfolio.ID, "GetByIDPublic").ApplyPortfolio(portfolio)
+
+	var data interface{} = dtoresponse.ToPortfolioDetailResponse(portfolio)
+	if !sel.Empty() {
+		data = dtoresponse.ToPortfolioSparse(portfolio, sel)
+	}
+
+	setETag(c, portfolio.Version)
 	c.JSON(http.StatusOK, dto.SuccessResponse{
 		Message: "Success",
-		Data:    dtoresponse.ToPortfolioDetailResponse(portfolio),
+		Data:    data,
 	})
 }
@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"errors"
+	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -8,6 +11,10 @@ import (
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/metrics"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
 	"github.com/gin-gonic/gin"
@@ -15,22 +22,60 @@ import (
 )
 
 type ProjectHandler struct {
-	repo          repo.ProjectRepository
-	categoryRepo  repo.CategoryRepository
-	portfolioRepo repo.PortfolioRepository
-	metrics       *metrics.Collector
+	repos   *repo.Repositories
+	metrics *metrics.Collector
 }
 
-func NewProjectHandler(repo repo.ProjectRepository, categoryRepo repo.CategoryRepository, portfolioRepo repo.PortfolioRepository, metrics *metrics.Collector) *ProjectHandler {
+// ProjectPositionRequest moves a project within one of its categories
+type ProjectPositionRequest struct {
+	request.PositionRequest
+	CategoryID uint `json:"category_id"` // Defaults to the primary category
+}
+
+// ProjectMoveRequest moves a project from one of its categories to another
+type ProjectMoveRequest struct {
+	request.PositionRequest
+	CategoryID     uint `json:"category_id" binding:"required"`
+	FromCategoryID uint `json:"from_category_id"` // Defaults to the primary category
+}
+
+// ProjectBulkReorderRequest reorders several projects of one category
+type ProjectBulkReorderRequest struct {
+	CategoryID uint                  `json:"category_id" binding:"required"`
+	Items      []request.ReorderItem `json:"items" binding:"required,min=1"`
+}
+
+// projectListQuery is what GetByCategory accepts in its query string
+var projectListQuery = query.Options{
+	SortFields:  []string{query.SortTitle, query.SortPosition, query.SortCreatedAt, query.SortUpdatedAt},
+	DefaultSort: query.SortPosition,
+	Filters:     projectFilters,
+	Selection:   projectSelection,
+}
+
+// projectTimelineQuery is what GetTimeline accepts in its query string
+var projectTimelineQuery = query.Options{
+	SortFields:  []string{query.SortStartDate},
+	DefaultSort: query.SortStartDate,
+	Filters:     projectFilters,
+	Selection:   projectSelection,
+}
+
+// projectFilters are the filters every public project list accepts
+var projectFilters = []string{
+	query.FilterSkills, query.FilterClient, query.FilterCreated,
+	query.FilterStatus, query.FilterFeatured, query.FilterRole, query.FilterOngoing, query.FilterStarted,
+}
+
+func NewProjectHandler(repos *repo.Repositories, metrics *metrics.Collector) *ProjectHandler {
 	return &ProjectHandler{
-		repo:          repo,
-		categoryRepo:  categoryRepo,
-		portfolioRepo: portfolioRepo,
-		metrics:       metrics,
+		repos:   repos,
+		metrics: metrics,
 	}
 }
 
 func (h *ProjectHandler) GetByUser(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 
 	// Parse pagination parameters - using default values if not provided
@@ -49,7 +94,7 @@ func (h *ProjectHandler) GetByUser(c *gin.Context) {
 
 	offset := (page - 1) * limit
 
-	projects, total, err := h.repo.GetByOwnerIDBasic(userID, limit, offset)
+	projects, total, err := repos.Project.GetByOwnerIDBasic(userID, limit, offset)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "GET_PROJECTS_BY_USER_DB_ERROR",
@@ -58,7 +103,7 @@ func (h *ProjectHandler) GetByUser(c *gin.Context) {
 			"userID":    userID,
 			"error":     err.Error(),
 		}).Error("Failed to retrieve projects")
-		response.InternalError(c, "Failed to retrieve projects")
+		response.InternalError(c, i18n.MsgProjectListFailed)
 		return
 	}
 
@@ -66,13 +111,28 @@ func (h *ProjectHandler) GetByUser(c *gin.Context) {
 }
 
 func (h *ProjectHandler) GetByCategory(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	// Try both parameter names for flexibility
 	categoryID := c.Param("categoryId")
 	if categoryID == "" {
 		categoryID = c.Param("id")
 	}
 
-	projects, err := h.repo.GetByCategoryID(categoryID)
+	spec, err := query.Parse(c.Request.URL.Query(), projectListQuery)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "GET_PROJECTS_BY_CATEGORY_INVALID_QUERY",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "GetByCategory",
+			"categoryID": categoryID,
+			"error":      err.Error(),
+		}).Warn("Invalid list query")
+		msg := i18n.MessageOf(err)
+		response.ErrorWithParams(c, http.StatusBadRequest, response.CodeInvalidQuery, msg.Key, msg.Params)
+		return
+	}
+
+	projects, nextCursor, err := repos.Project.ListByCategoryID(categoryID, spec)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":  "GET_PROJECTS_BY_CATEGORY_DB_ERROR",
@@ -81,14 +141,97 @@ func (h *ProjectHandler) GetByCategory(c *gin.Context) {
 			"categoryID": categoryID,
 			"error":      err.Error(),
 		}).Error("Failed to retrieve projects")
-		response.InternalError(c, "Failed to retrieve projects")
+		response.InternalError(c, i18n.MsgProjectListFailed)
 		return
 	}
 
-	response.OK(c, "projects", projects, "Success")
+	if len(projects) > 0 && ownerHidden(repos.UserStatus, projects[0].OwnerID, "GetByCategory") {
+		response.NotFound(c, i18n.MsgCategoryNotFound)
+		return
+	}
+
+	if len(projects) > 0 {
+		translations := localize(c, repos.Translation, models.TranslationProject, projects[0].ID, "GetByCategory")
+		for i := range projects {
+			translations.ApplyProject(&projects[i])
+		}
+	}
+
+	if !spec.Selection.Empty() {
+		response.SuccessWithCursor(c, http.StatusOK, "projects", dtoresponse.ToProjectListSparse(projects, spec.Selection), nextCursor)
+		return
+	}
+	response.SuccessWithCursor(c, http.StatusOK, "projects", projects, nextCursor)
+}
+
+// GetTimeline lists the projects of every category of a portfolio, each once,
+// in chronological order of their start date
+func (h *ProjectHandler) GetTimeline(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	portfolioID := c.Param("id")
+
+	// Parse portfolio ID
+	id, err := strconv.Atoi(portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_PROJECT_TIMELINE_INVALID_ID",
+			"where":       "backend/internal/application/handler/project.go",
+			"function":    "GetTimeline",
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid portfolio ID")
+		response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+		return
+	}
+
+	spec, err := query.Parse(c.Request.URL.Query(), projectTimelineQuery)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_PROJECT_TIMELINE_INVALID_QUERY",
+			"where":       "backend/internal/application/handler/project.go",
+			"function":    "GetTimeline",
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Invalid list query")
+		msg := i18n.MessageOf(err)
+		response.ErrorWithParams(c, http.StatusBadRequest, response.CodeInvalidQuery, msg.Key, msg.Params)
+		return
+	}
+
+	projects, nextCursor, err := repos.Project.ListTimeline(uint(id), spec)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_PROJECT_TIMELINE_DB_ERROR",
+			"where":       "backend/internal/application/handler/project.go",
+			"function":    "GetTimeline",
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Error("Failed to retrieve projects")
+		response.InternalError(c, i18n.MsgProjectListFailed)
+		return
+	}
+
+	if len(projects) > 0 && ownerHidden(repos.UserStatus, projects[0].OwnerID, "GetTimeline") {
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	if len(projects) > 0 {
+		translations := localize(c, repos.Translation, models.TranslationProject, projects[0].ID, "GetTimeline")
+		for i := range projects {
+			translations.ApplyProject(&projects[i])
+		}
+	}
+
+	if !spec.Selection.Empty() {
+		response.SuccessWithCursor(c, http.StatusOK, "projects", dtoresponse.ToProjectListSparse(projects, spec.Selection), nextCursor)
+		return
+	}
+	response.SuccessWithCursor(c, http.StatusOK, "projects", projects, nextCursor)
 }
 
 func (h *ProjectHandler) GetByID(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	projectID := c.Param("id")
 
 	// Parse project ID
@@ -101,11 +244,21 @@ func (h *ProjectHandler) GetByID(c *gin.Context) {
 			"projectID": projectID,
 			"error":     err.Error(),
 		}).Warn("Invalid project ID")
-		response.BadRequest(c, "Invalid project ID")
+		response.BadRequest(c, i18n.MsgProjectInvalidID)
+		return
+	}
+
+	sel, ok := parseSelection(c, projectSelection, "GetByID")
+	if !ok {
 		return
 	}
 
-	project, err := h.repo.GetByID(uint(id))
+	var project *models.Project
+	if sel.Empty() {
+		project, err = repos.Project.GetByID(uint(id))
+	} else {
+		project, err = repos.Project.GetByIDSelected(uint(id), sel)
+	}
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "GET_PROJECT_BY_ID_NOT_FOUND",
@@ -114,14 +267,20 @@ func (h *ProjectHandler) GetByID(c *gin.Context) {
 			"projectID": id,
 			"error":     err.Error(),
 		}).Warn("Project not found")
-		response.NotFound(c, "Project not found")
+		response.NotFound(c, i18n.MsgProjectNotFound)
 		return
 	}
 
+	setETag(c, project.Version)
+	if !sel.Empty() {
+		response.OK(c, "project", dtoresponse.ToProjectSparse(project, sel), "Success")
+		return
+	}
 	response.OK(c, "project", project, "Success")
 }
 
 func (h *ProjectHandler) Create(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 
 	// Parse request body
@@ -134,7 +293,7 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 			"userID":    userID,
 			"error":     err.Error(),
 		}).Warn("Invalid request data")
-		response.BadRequest(c, "Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
 		return
 	}
 
@@ -151,12 +310,12 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 			"categoryID": newProject.CategoryID,
 			"error":      err.Error(),
 		}).Warn("Project validation failed")
-		response.BadRequest(c, err.Error())
+		response.Invalid(c, err)
 		return
 	}
 
 	// Validate category exists and belongs to user's portfolio
-	category, err := h.categoryRepo.GetByID(newProject.CategoryID)
+	category, err := repos.Category.GetByID(newProject.CategoryID)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":  "CREATE_PROJECT_CATEGORY_NOT_FOUND",
@@ -166,11 +325,11 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 			"categoryID": newProject.CategoryID,
 			"error":      err.Error(),
 		}).Warn("Category not found")
-		response.NotFound(c, "Category not found")
+		response.NotFound(c, i18n.MsgCategoryNotFound)
 		return
 	}
 
-	portfolio, err := h.portfolioRepo.GetByIDBasic(category.PortfolioID)
+	portfolio, err := repos.Portfolio.GetByIDBasic(category.PortfolioID)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "CREATE_PROJECT_PORTFOLIO_NOT_FOUND",
@@ -181,7 +340,7 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 			"portfolioID": category.PortfolioID,
 			"error":       err.Error(),
 		}).Warn("Portfolio not found")
-		response.NotFound(c, "Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
 		return
 	}
 
@@ -195,7 +354,7 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 			"portfolioID": category.PortfolioID,
 			"ownerID":     portfolio.OwnerID,
 		}).Warn("Access denied: category belongs to another user's portfolio")
-		response.ForbiddenWithDetails(c, "Access denied: category belongs to another user's portfolio", map[string]interface{}{
+		response.ForbiddenWithDetails(c, i18n.MsgCategoryAccessDenied, map[string]interface{}{
 			"resource_type": "category",
 			"resource_id":   category.ID,
 			"owner_id":      portfolio.OwnerID,
@@ -205,7 +364,7 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 	}
 
 	// Check for duplicate title
-	isDuplicate, err := h.repo.CheckDuplicate(newProject.Title, newProject.CategoryID, 0)
+	isDuplicate, err := repos.Project.CheckDuplicate(newProject.Title, newProject.CategoryID, 0)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":  "CREATE_PROJECT_DUPLICATE_CHECK_ERROR",
@@ -216,7 +375,7 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 			"title":      newProject.Title,
 			"error":      err.Error(),
 		}).Error("Failed to check for duplicate project")
-		response.InternalError(c, "Failed to check for duplicate project")
+		response.InternalError(c, i18n.MsgProjectDuplicateCheckFailed)
 		return
 	}
 	if isDuplicate {
@@ -228,12 +387,12 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 			"categoryID": newProject.CategoryID,
 			"title":      newProject.Title,
 		}).Warn("Project with this title already exists in this category")
-		response.BadRequest(c, "Project with this title already exists in this category")
+		response.BadRequest(c, i18n.MsgProjectTitleTaken)
 		return
 	}
 
 	// Create a project
-	if err := h.repo.Create(&newProject); err != nil {
+	if err := repos.Project.Create(&newProject); err != nil {
 		// Check if error is due to foreign key constraint (invalid category_id)
 		errMsg := err.Error()
 		if strings.Contains(errMsg, "fk_categories_projects") || strings.Contains(errMsg, "23503") {
@@ -245,7 +404,7 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 				"categoryID": newProject.CategoryID,
 				"error":      err.Error(),
 			}).Warn("Category not found during project creation")
-			response.NotFound(c, "Category not found")
+			response.NotFound(c, i18n.MsgCategoryNotFound)
 			return
 		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
@@ -256,7 +415,7 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 			"categoryID": newProject.CategoryID,
 			"error":      err.Error(),
 		}).Error("Failed to create project")
-		response.InternalError(c, "Failed to create project")
+		response.InternalError(c, i18n.MsgProjectCreateFailed)
 		return
 	}
 
@@ -264,6 +423,7 @@ func (h *ProjectHandler) Create(c *gin.Context) {
 }
 
 func (h *ProjectHandler) Update(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 	projectID := c.Param("id")
 
@@ -278,7 +438,7 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 			"projectID": projectID,
 			"error":     err.Error(),
 		}).Warn("Invalid project ID")
-		response.BadRequest(c, "Invalid project ID")
+		response.BadRequest(c, i18n.MsgProjectInvalidID)
 		return
 	}
 
@@ -293,7 +453,7 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 			"projectID": id,
 			"error":     err.Error(),
 		}).Warn("Invalid request data")
-		response.BadRequest(c, "Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
 		return
 	}
 
@@ -312,12 +472,12 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 			"categoryID": updateData.CategoryID,
 			"error":      err.Error(),
 		}).Warn("Project validation failed")
-		response.BadRequest(c, err.Error())
+		response.Invalid(c, err)
 		return
 	}
 
 	// Check if project exists and belongs to user
-	existing, err := h.repo.GetByID(uint(id))
+	existing, err := repos.Project.GetByID(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_PROJECT_NOT_FOUND",
@@ -327,7 +487,7 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 			"projectID": id,
 			"error":     err.Error(),
 		}).Warn("Project not found")
-		response.NotFound(c, "Project not found")
+		response.NotFound(c, i18n.MsgProjectNotFound)
 		return
 	}
 	if existing.OwnerID != userID {
@@ -339,7 +499,7 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 			"projectID": id,
 			"ownerID":   existing.OwnerID,
 		}).Warn("Access denied")
-		response.ForbiddenWithDetails(c, "Access denied", map[string]interface{}{
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
 			"resource_type": "project",
 			"resource_id":   existing.ID,
 			"owner_id":      existing.OwnerID,
@@ -349,7 +509,7 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 	}
 
 	// Check for duplicate title
-	isDuplicate, err := h.repo.CheckDuplicate(updateData.Title, updateData.CategoryID, updateData.ID)
+	isDuplicate, err := repos.Project.CheckDuplicate(updateData.Title, updateData.CategoryID, updateData.ID)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":  "UPDATE_PROJECT_DUPLICATE_CHECK_ERROR",
@@ -361,7 +521,7 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 			"title":      updateData.Title,
 			"error":      err.Error(),
 		}).Error("Failed to check for duplicate project")
-		response.InternalError(c, "Failed to check for duplicate project")
+		response.InternalError(c, i18n.MsgProjectDuplicateCheckFailed)
 		return
 	}
 	if isDuplicate {
@@ -374,12 +534,22 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 			"categoryID": updateData.CategoryID,
 			"title":      updateData.Title,
 		}).Warn("Project with this title already exists in this category")
-		response.BadRequest(c, "Project with this title already exists in this category")
+		response.BadRequest(c, i18n.MsgProjectTitleTaken)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Project", uint(id), existing.Version)
+	if !ok {
 		return
 	}
+	updateData.Version = version
 
 	// Update project
-	if err := h.repo.Update(&updateData); err != nil {
+	if err := repos.Project.Update(&updateData); err != nil {
+		if versionConflict(c, "Project", uint(id), err) {
+			return
+		}
 		// Check if error is due to foreign key constraint (invalid category_id)
 		errMsg := err.Error()
 		if strings.Contains(errMsg, "fk_categories_projects") || strings.Contains(errMsg, "23503") {
@@ -392,7 +562,7 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 				"categoryID": updateData.CategoryID,
 				"error":      err.Error(),
 			}).Warn("Category not found")
-			response.NotFound(c, "Category not found")
+			response.NotFound(c, i18n.MsgCategoryNotFound)
 			return
 		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
@@ -404,14 +574,215 @@ func (h *ProjectHandler) Update(c *gin.Context) {
 			"categoryID": updateData.CategoryID,
 			"error":      err.Error(),
 		}).Error("Failed to update project")
-		response.InternalError(c, "Failed to update project")
+		response.InternalError(c, i18n.MsgProjectUpdateFailed)
 		return
 	}
 
+	setETag(c, updateData.Version)
 	response.OK(c, "project", &updateData, "Project updated successfully")
 }
 
+// Patch applies a JSON Merge Patch or JSON Patch to the project
+func (h *ProjectHandler) Patch(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	projectID := c.Param("id")
+
+	// Parse project ID
+	id, err := strconv.Atoi(projectID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PATCH_PROJECT_INVALID_ID",
+			"where":     "backend/internal/application/handler/project.go",
+			"function":  "Patch",
+			"userID":    userID,
+			"projectID": projectID,
+			"error":     err.Error(),
+		}).Warn("Invalid project ID")
+		response.BadRequest(c, i18n.MsgProjectInvalidID)
+		return
+	}
+
+	// Check if project exists and belongs to user
+	existing, err := repos.Project.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PATCH_PROJECT_NOT_FOUND",
+			"where":     "backend/internal/application/handler/project.go",
+			"function":  "Patch",
+			"userID":    userID,
+			"projectID": id,
+			"error":     err.Error(),
+		}).Warn("Project not found")
+		response.NotFound(c, i18n.MsgProjectNotFound)
+		return
+	}
+	if existing.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PATCH_PROJECT_FORBIDDEN",
+			"where":     "backend/internal/application/handler/project.go",
+			"function":  "Patch",
+			"userID":    userID,
+			"projectID": id,
+			"ownerID":   existing.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "project",
+			"resource_id":   existing.ID,
+			"owner_id":      existing.OwnerID,
+			"action":        "update",
+		})
+		return
+	}
+
+	// Reject the patch if the client edited an outdated copy
+	version, ok := checkVersion(c, "Project", uint(id), existing.Version)
+	if !ok {
+		return
+	}
+
+	// Apply the patch to the editable fields
+	doc := request.PatchProjectRequest{
+		Title:       existing.Title,
+		Description: existing.Description,
+		Skills:      existing.Skills,
+		Client:      existing.Client,
+		Link:        existing.Link,
+		Links:       toLinkDocs(existing.Links),
+		StartDate:   existing.StartDate,
+		EndDate:     existing.EndDate,
+		Ongoing:     existing.Ongoing,
+		Role:        existing.Role,
+		TeamSize:    existing.TeamSize,
+		Status:      existing.Status,
+		Featured:    existing.Featured,
+		CategoryID:  existing.CategoryID,
+	}
+	if !applyPatch(c, "Project", uint(id), &doc) {
+		return
+	}
+
+	// Moving the project requires owning the target category
+	if doc.CategoryID != existing.CategoryID {
+		category, err := repos.Category.GetByIDBasic(doc.CategoryID)
+		if err != nil {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation":  "PATCH_PROJECT_CATEGORY_NOT_FOUND",
+				"where":      "backend/internal/application/handler/project.go",
+				"function":   "Patch",
+				"userID":     userID,
+				"projectID":  id,
+				"categoryID": doc.CategoryID,
+				"error":      err.Error(),
+			}).Warn("Category not found")
+			response.NotFound(c, i18n.MsgCategoryNotFound)
+			return
+		}
+		if category.OwnerID != userID {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation":  "PATCH_PROJECT_CATEGORY_FORBIDDEN",
+				"where":      "backend/internal/application/handler/project.go",
+				"function":   "Patch",
+				"userID":     userID,
+				"projectID":  id,
+				"categoryID": doc.CategoryID,
+				"ownerID":    category.OwnerID,
+			}).Warn("Access denied to target category")
+			response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+				"resource_type": "category",
+				"resource_id":   category.ID,
+				"owner_id":      category.OwnerID,
+				"action":        "move_project",
+			})
+			return
+		}
+	}
+
+	existing.Title = doc.Title
+	existing.Description = doc.Description
+	existing.Skills = doc.Skills
+	existing.Client = doc.Client
+	existing.Link = doc.Link
+	existing.Links = fromLinkDocs(doc.Links)
+	existing.StartDate = doc.StartDate
+	existing.EndDate = doc.EndDate
+	existing.Ongoing = doc.Ongoing
+	existing.Role = doc.Role
+	existing.TeamSize = doc.TeamSize
+	existing.Status = doc.Status
+	existing.Featured = doc.Featured
+	existing.CategoryID = doc.CategoryID
+	existing.Version = version
+
+	// Validate project data
+	if err := validator.ValidateProject(existing); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "PATCH_PROJECT_VALIDATION_ERROR",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "Patch",
+			"userID":     userID,
+			"projectID":  id,
+			"categoryID": existing.CategoryID,
+			"error":      err.Error(),
+		}).Warn("Project validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	// Check for duplicate title
+	isDuplicate, err := repos.Project.CheckDuplicate(existing.Title, existing.CategoryID, existing.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "PATCH_PROJECT_DUPLICATE_CHECK_ERROR",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "Patch",
+			"userID":     userID,
+			"projectID":  id,
+			"categoryID": existing.CategoryID,
+			"title":      existing.Title,
+			"error":      err.Error(),
+		}).Error("Failed to check for duplicate project")
+		response.InternalError(c, i18n.MsgProjectDuplicateCheckFailed)
+		return
+	}
+	if isDuplicate {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "PATCH_PROJECT_DUPLICATE_TITLE",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "Patch",
+			"userID":     userID,
+			"projectID":  id,
+			"categoryID": existing.CategoryID,
+			"title":      existing.Title,
+		}).Warn("Project with this title already exists in this category")
+		response.BadRequest(c, i18n.MsgProjectTitleTaken)
+		return
+	}
+
+	// Write every editable field so removed ones are cleared
+	if err := repos.Project.Patch(existing); err != nil {
+		if versionConflict(c, "Project", uint(id), err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "PATCH_PROJECT_DB_ERROR",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "Patch",
+			"userID":     userID,
+			"projectID":  id,
+			"categoryID": existing.CategoryID,
+			"error":      err.Error(),
+		}).Error("Failed to patch project")
+		response.InternalError(c, i18n.MsgProjectUpdateFailed)
+		return
+	}
+
+	setETag(c, existing.Version)
+	response.OK(c, "project", existing, "Project updated successfully")
+}
+
 func (h *ProjectHandler) Delete(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID")
 	projectID := c.Param("id")
 
@@ -425,12 +796,12 @@ func (h *ProjectHandler) Delete(c *gin.Context) {
 			"projectID": projectID,
 			"error":     err.Error(),
 		}).Warn("Invalid project ID")
-		response.BadRequest(c, "Invalid project ID")
+		response.BadRequest(c, i18n.MsgProjectInvalidID)
 		return
 	}
 
 	// Get a project to check ownership
-	project, err := h.repo.GetByID(uint(id))
+	project, err := repos.Project.GetByID(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "DELETE_PROJECT_NOT_FOUND",
@@ -440,7 +811,7 @@ func (h *ProjectHandler) Delete(c *gin.Context) {
 			"projectID": id,
 			"error":     err.Error(),
 		}).Warn("Project not found")
-		response.NotFound(c, "Project not found")
+		response.NotFound(c, i18n.MsgProjectNotFound)
 		return
 	}
 
@@ -453,7 +824,7 @@ func (h *ProjectHandler) Delete(c *gin.Context) {
 			"projectID": id,
 			"ownerID":   project.OwnerID,
 		}).Warn("Access denied")
-		response.ForbiddenWithDetails(c, "Access denied", map[string]interface{}{
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
 			"resource_type": "project",
 			"resource_id":   project.ID,
 			"owner_id":      project.OwnerID,
@@ -462,7 +833,16 @@ func (h *ProjectHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	if err := h.repo.Delete(uint(id)); err != nil {
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Project", uint(id), project.Version)
+	if !ok {
+		return
+	}
+
+	if err := repos.Project.Delete(uint(id), version); err != nil {
+		if versionConflict(c, "Project", uint(id), err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":  "DELETE_PROJECT_DB_ERROR",
 			"where":      "backend/internal/application/handler/project.go",
@@ -473,7 +853,7 @@ func (h *ProjectHandler) Delete(c *gin.Context) {
 			"error":      err.Error(),
 		}).Error("Failed to delete project")
 
-		response.InternalError(c, "Failed to delete project")
+		response.InternalError(c, i18n.MsgProjectDeleteFailed)
 		return
 	}
 
@@ -489,6 +869,7 @@ func (h *ProjectHandler) Delete(c *gin.Context) {
 }
 
 func (h *ProjectHandler) GetBySkills(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	skills := c.QueryArray("skills")
 	if len(skills) == 0 {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
@@ -496,11 +877,11 @@ func (h *ProjectHandler) GetBySkills(c *gin.Context) {
 			"where":     "backend/internal/application/handler/project.go",
 			"function":  "GetBySkills",
 		}).Warn("At least one skill is required")
-		response.BadRequest(c, "At least one skill is required")
+		response.BadRequest(c, i18n.MsgProjectSkillsRequired)
 		return
 	}
 
-	projects, err := h.repo.GetBySkills(skills)
+	projects, err := repos.Project.GetBySkills(skills)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "GET_PROJECTS_BY_SKILLS_DB_ERROR",
@@ -509,7 +890,7 @@ func (h *ProjectHandler) GetBySkills(c *gin.Context) {
 			"skills":    skills,
 			"error":     err.Error(),
 		}).Error("Failed to retrieve projects")
-		response.InternalError(c, "Failed to retrieve projects")
+		response.InternalError(c, i18n.MsgProjectListFailed)
 		return
 	}
 
@@ -517,6 +898,7 @@ func (h *ProjectHandler) GetBySkills(c *gin.Context) {
 }
 
 func (h *ProjectHandler) GetByClient(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	client := c.Query("client")
 	if client == "" {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
@@ -524,11 +906,11 @@ func (h *ProjectHandler) GetByClient(c *gin.Context) {
 			"where":     "backend/internal/application/handler/project.go",
 			"function":  "GetByClient",
 		}).Warn("Client name is required")
-		response.BadRequest(c, "Client name is required")
+		response.BadRequest(c, i18n.MsgProjectClientRequired)
 		return
 	}
 
-	projects, err := h.repo.GetByClient(client)
+	projects, err := repos.Project.GetByClient(client)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "GET_PROJECTS_BY_CLIENT_DB_ERROR",
@@ -537,7 +919,7 @@ func (h *ProjectHandler) GetByClient(c *gin.Context) {
 			"client":    client,
 			"error":     err.Error(),
 		}).Error("Failed to retrieve projects")
-		response.InternalError(c, "Failed to retrieve projects")
+		response.InternalError(c, i18n.MsgProjectListFailed)
 		return
 	}
 
@@ -545,6 +927,7 @@ func (h *ProjectHandler) GetByClient(c *gin.Context) {
 }
 
 func (h *ProjectHandler) GetByIDPublic(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	projectID := c.Param("id")
 
 	// Parse project ID
@@ -557,11 +940,21 @@ func (h *ProjectHandler) GetByIDPublic(c *gin.Context) {
 			"projectID": projectID,
 			"error":     err.Error(),
 		}).Warn("Invalid project ID")
-		response.BadRequest(c, "Invalid project ID")
+		response.BadRequest(c, i18n.MsgProjectInvalidID)
 		return
 	}
 
-	project, err := h.repo.GetByID(uint(id))
+	sel, ok := parseSelection(c, projectSelection, "GetByIDPublic")
+	if !ok {
+		return
+	}
+
+	var project *models.Project
+	if sel.Empty() {
+		project, err = repos.Project.GetByID(uint(id))
+	} else {
+		project, err = repos.Project.GetByIDSelected(uint(id), sel)
+	}
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "GET_PROJECT_BY_ID_PUBLIC_NOT_FOUND",
@@ -570,15 +963,28 @@ func (h *ProjectHandler) GetByIDPublic(c *gin.Context) {
 			"projectID": id,
 			"error":     err.Error(),
 		}).Warn("Project not found")
-		response.NotFound(c, "Project not found")
+		response.NotFound(c, i18n.MsgProjectNotFound)
 		return
 	}
 
+	if ownerHidden(repos.UserStatus, project.OwnerID, "GetByIDPublic") {
+		response.NotFound(c, i18n.MsgProjectNotFound)
+		return
+	}
+
+	localize(c, repos.Translation, models.TranslationProject, project.ID, "GetByIDPublic").ApplyProject(project)
+
+	if !sel.Empty() {
+		response.OK(c, "project", dtoresponse.ToProjectSparse(project, sel), "Success")
+		return
+	}
 	response.OK(c, "project", project, "Success")
 }
 
-// UpdatePosition updates the position field of a project
+// UpdatePosition moves a project within one of its categories, the primary one
+// unless category_id says otherwise
 func (h *ProjectHandler) UpdatePosition(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 	projectID := c.Param("id")
 
@@ -593,14 +999,12 @@ func (h *ProjectHandler) UpdatePosition(c *gin.Context) {
 			"projectID": projectID,
 			"error":     err.Error(),
 		}).Warn("Invalid project ID")
-		response.BadRequest(c, "Invalid project ID")
+		response.BadRequest(c, i18n.MsgProjectInvalidID)
 		return
 	}
 
 	// Parse request body
-	var req struct {
-		Position uint `json:"position" binding:"required"`
-	}
+	var req ProjectPositionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_PROJECT_POSITION_BAD_REQUEST",
@@ -610,12 +1014,16 @@ func (h *ProjectHandler) UpdatePosition(c *gin.Context) {
 			"projectID": id,
 			"error":     err.Error(),
 		}).Warn("Invalid request data")
-		response.BadRequest(c, "Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+	at, ok := placement(c, req.PositionRequest)
+	if !ok {
 		return
 	}
 
 	// Check if project exists and belongs to user
-	existing, err := h.repo.GetByID(uint(id))
+	existing, err := repos.Project.GetByID(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_PROJECT_POSITION_NOT_FOUND",
@@ -625,7 +1033,7 @@ func (h *ProjectHandler) UpdatePosition(c *gin.Context) {
 			"projectID": id,
 			"error":     err.Error(),
 		}).Warn("Project not found")
-		response.NotFound(c, "Project not found")
+		response.NotFound(c, i18n.MsgProjectNotFound)
 		return
 	}
 
@@ -638,7 +1046,7 @@ func (h *ProjectHandler) UpdatePosition(c *gin.Context) {
 			"projectID": id,
 			"ownerID":   existing.OwnerID,
 		}).Warn("Access denied")
-		response.ForbiddenWithDetails(c, "Access denied", map[string]interface{}{
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
 			"resource_type": "project",
 			"resource_id":   existing.ID,
 			"owner_id":      existing.OwnerID,
@@ -647,20 +1055,579 @@ func (h *ProjectHandler) UpdatePosition(c *gin.Context) {
 		return
 	}
 
+	categoryID := req.CategoryID
+	if categoryID == 0 {
+		categoryID = existing.CategoryID
+	}
+	if !slices.Contains(existing.CategoryIDs, categoryID) {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "UPDATE_PROJECT_POSITION_NOT_IN_CATEGORY",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "UpdatePosition",
+			"userID":     userID,
+			"projectID":  id,
+			"categoryID": categoryID,
+		}).Warn("Project is not in the category")
+		response.NotFound(c, i18n.MsgProjectNotInCategory)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Project", uint(id), existing.Version)
+	if !ok {
+		return
+	}
+
 	// Update position
-	if err := h.repo.UpdatePosition(uint(id), req.Position); err != nil {
+	if _, err := repos.Project.UpdatePosition(uint(id), categoryID, at, version); err != nil {
+		if orderingFailed(c, "Project", uint(id), err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "UPDATE_PROJECT_POSITION_DB_ERROR",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "UpdatePosition",
+			"userID":     userID,
+			"projectID":  id,
+			"categoryID": categoryID,
+			"position":   req.Position,
+			"error":      err.Error(),
+		}).Error("Failed to update project position")
+		response.InternalError(c, i18n.MsgProjectPositionFailed)
+		return
+	}
+
+	setETag(c, version+1)
+	response.OK(c, "message", "Project position updated successfully", "Success")
+}
+
+// BulkReorder sets the positions of several projects within one category
+func (h *ProjectHandler) BulkReorder(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	// Parse request
+	var req ProjectBulkReorderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	// Validate no duplicate positions
+	items, ok := reorderItems(c, req.Items)
+	if !ok {
+		return
+	}
+
+	if !h.ownCategory(c, userID, req.CategoryID, "BulkReorder", "reorder_projects") {
+		return
+	}
+
+	// Verify all projects are in the category
+	projects, err := repos.Project.GetByCategoryID(strconv.FormatUint(uint64(req.CategoryID), 10))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "BULK_REORDER_PROJECTS",
+			"userID":     userID,
+			"categoryID": req.CategoryID,
+			"error":      err.Error(),
+		}).Error("Failed to fetch projects for bulk reorder")
+		response.InternalError(c, i18n.MsgProjectListFailed)
+		return
+	}
+	inCategory := make(map[uint]bool, len(projects))
+	for _, project := range projects {
+		inCategory[project.ID] = true
+	}
+	for _, item := range req.Items {
+		if !inCategory[item.ID] {
+			response.NotFound(c, i18n.MsgProjectSomeNotInCategory)
+			return
+		}
+	}
+
+	// Update positions in transaction
+	if err := repos.Project.BulkUpdatePositions(req.CategoryID, items); err != nil {
+		if orderingFailed(c, "Project", 0, err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
-			"operation": "UPDATE_PROJECT_POSITION_DB_ERROR",
+			"operation":  "BULK_REORDER_PROJECTS",
+			"userID":     userID,
+			"categoryID": req.CategoryID,
+			"itemCount":  len(req.Items),
+			"error":      err.Error(),
+		}).Error("Failed to bulk update project positions")
+		response.InternalError(c, i18n.MsgUpdatePositionsFailed)
+		return
+	}
+
+	// Audit log successful reorder
+	itemDetails := make([]map[string]uint, len(req.Items))
+	for i, item := range req.Items {
+		itemDetails[i] = map[string]uint{
+			"id":       item.ID,
+			"position": item.Position,
+		}
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":  "BULK_REORDER_PROJECTS",
+		"userID":     userID,
+		"categoryID": req.CategoryID,
+		"itemCount":  len(req.Items),
+		"items":      itemDetails,
+	}).Info("Projects reordered successfully")
+
+	response.OK(c, "message", "Projects reordered successfully", "Success")
+}
+
+// Move moves a project from one of its categories, the primary one unless
+// from_category_id says otherwise, to another category of the user. Moving it
+// out of the primary category makes the new one primary.
+func (h *ProjectHandler) Move(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	projectID := c.Param("id")
+
+	// Parse project ID
+	id, err := strconv.Atoi(projectID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "MOVE_PROJECT_INVALID_ID",
 			"where":     "backend/internal/application/handler/project.go",
-			"function":  "UpdatePosition",
+			"function":  "Move",
+			"userID":    userID,
+			"projectID": projectID,
+			"error":     err.Error(),
+		}).Warn("Invalid project ID")
+		response.BadRequest(c, i18n.MsgProjectInvalidID)
+		return
+	}
+
+	// Parse request body
+	var req ProjectMoveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "MOVE_PROJECT_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/project.go",
+			"function":  "Move",
 			"userID":    userID,
 			"projectID": id,
-			"position":  req.Position,
 			"error":     err.Error(),
-		}).Error("Failed to update project position")
-		response.InternalError(c, "Failed to update project position")
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+	at, ok := placement(c, req.PositionRequest)
+	if !ok {
 		return
 	}
 
-	response.OK(c, "message", "Project position updated successfully", "Success")
+	// Check if project exists and belongs to user
+	project, err := repos.Project.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "MOVE_PROJECT_NOT_FOUND",
+			"where":     "backend/internal/application/handler/project.go",
+			"function":  "Move",
+			"userID":    userID,
+			"projectID": id,
+			"error":     err.Error(),
+		}).Warn("Project not found")
+		response.NotFound(c, i18n.MsgProjectNotFound)
+		return
+	}
+
+	if project.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "MOVE_PROJECT_FORBIDDEN",
+			"where":     "backend/internal/application/handler/project.go",
+			"function":  "Move",
+			"userID":    userID,
+			"projectID": id,
+			"ownerID":   project.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "project",
+			"resource_id":   project.ID,
+			"owner_id":      project.OwnerID,
+			"action":        "move",
+		})
+		return
+	}
+
+	from := req.FromCategoryID
+	if from == 0 {
+		from = project.CategoryID
+	}
+	if !slices.Contains(project.CategoryIDs, from) {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "MOVE_PROJECT_NOT_IN_CATEGORY",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "Move",
+			"userID":     userID,
+			"projectID":  id,
+			"categoryID": from,
+		}).Warn("Project is not in the category")
+		response.NotFound(c, i18n.MsgProjectNotInCategory)
+		return
+	}
+	if slices.Contains(project.CategoryIDs, req.CategoryID) {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "MOVE_PROJECT_ALREADY_IN_CATEGORY",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "Move",
+			"userID":     userID,
+			"projectID":  id,
+			"categoryID": req.CategoryID,
+		}).Warn("Project is already in the category")
+		response.Conflict(c, i18n.MsgProjectAlreadyInCategory)
+		return
+	}
+
+	if !h.ownCategory(c, userID, req.CategoryID, "Move", "move_project") {
+		return
+	}
+
+	// Check for duplicate title in the category
+	isDuplicate, err := repos.Project.CheckDuplicate(project.Title, req.CategoryID, project.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "MOVE_PROJECT_DUPLICATE_CHECK_ERROR",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "Move",
+			"userID":     userID,
+			"projectID":  id,
+			"categoryID": req.CategoryID,
+			"error":      err.Error(),
+		}).Error("Failed to check for duplicate project")
+		response.InternalError(c, i18n.MsgProjectDuplicateCheckFailed)
+		return
+	}
+	if isDuplicate {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "MOVE_PROJECT_DUPLICATE_TITLE",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "Move",
+			"userID":     userID,
+			"projectID":  id,
+			"categoryID": req.CategoryID,
+			"title":      project.Title,
+		}).Warn("Project with this title already exists in this category")
+		response.BadRequest(c, i18n.MsgProjectTitleTaken)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Project", project.ID, project.Version)
+	if !ok {
+		return
+	}
+	project.Version = version
+
+	if err := repos.Project.MoveToCategory(project, from, req.CategoryID, at); err != nil {
+		if orderingFailed(c, "Project", project.ID, err) {
+			return
+		}
+		if errors.Is(err, repo.ErrAlreadyInCategory) {
+			response.Conflict(c, i18n.MsgProjectAlreadyInCategory)
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "MOVE_PROJECT_DB_ERROR",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "Move",
+			"userID":     userID,
+			"projectID":  id,
+			"fromID":     from,
+			"categoryID": req.CategoryID,
+			"error":      err.Error(),
+		}).Error("Failed to move project")
+		response.InternalError(c, i18n.MsgProjectMoveFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":   "MOVE_PROJECT",
+		"userID":      userID,
+		"projectID":   project.ID,
+		"fromID":      from,
+		"categoryID":  req.CategoryID,
+		"categoryIDs": project.CategoryIDs,
+	}).Info("Project moved to category")
+
+	setETag(c, project.Version)
+	response.OK(c, "project", project, "Project moved successfully")
+}
+
+// AddCategory puts a project in one more category, at the end of it
+func (h *ProjectHandler) AddCategory(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	project, categoryID, ok := h.projectAndCategory(c, userID, "AddCategory")
+	if !ok {
+		return
+	}
+
+	if !h.ownCategory(c, userID, categoryID, "AddCategory", "add_project") {
+		return
+	}
+
+	// Check for duplicate title in the category
+	if !slices.Contains(project.CategoryIDs, categoryID) {
+		isDuplicate, err := repos.Project.CheckDuplicate(project.Title, categoryID, project.ID)
+		if err != nil {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation":  "ADD_PROJECT_CATEGORY_DUPLICATE_CHECK_ERROR",
+				"where":      "backend/internal/application/handler/project.go",
+				"function":   "AddCategory",
+				"userID":     userID,
+				"projectID":  project.ID,
+				"categoryID": categoryID,
+				"error":      err.Error(),
+			}).Error("Failed to check for duplicate project")
+			response.InternalError(c, i18n.MsgProjectDuplicateCheckFailed)
+			return
+		}
+		if isDuplicate {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation":  "ADD_PROJECT_CATEGORY_DUPLICATE_TITLE",
+				"where":      "backend/internal/application/handler/project.go",
+				"function":   "AddCategory",
+				"userID":     userID,
+				"projectID":  project.ID,
+				"categoryID": categoryID,
+				"title":      project.Title,
+			}).Warn("Project with this title already exists in this category")
+			response.BadRequest(c, i18n.MsgProjectTitleTaken)
+			return
+		}
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Project", project.ID, project.Version)
+	if !ok {
+		return
+	}
+	project.Version = version
+
+	if err := repos.Project.AddCategory(project, categoryID); err != nil {
+		if versionConflict(c, "Project", project.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "ADD_PROJECT_CATEGORY_DB_ERROR",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "AddCategory",
+			"userID":     userID,
+			"projectID":  project.ID,
+			"categoryID": categoryID,
+			"error":      err.Error(),
+		}).Error("Failed to add project to category")
+		response.InternalError(c, i18n.MsgProjectCategoriesFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":   "ADD_PROJECT_CATEGORY",
+		"userID":      userID,
+		"projectID":   project.ID,
+		"categoryID":  categoryID,
+		"categoryIDs": project.CategoryIDs,
+	}).Info("Project added to category")
+
+	setETag(c, project.Version)
+	response.OK(c, "project", project, "Project added to category successfully")
+}
+
+// RemoveCategory takes a project out of one of its categories. Removing the
+// primary category makes the next one primary; the last one can't be removed.
+func (h *ProjectHandler) RemoveCategory(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	project, categoryID, ok := h.projectAndCategory(c, userID, "RemoveCategory")
+	if !ok {
+		return
+	}
+
+	if !slices.Contains(project.CategoryIDs, categoryID) {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "REMOVE_PROJECT_CATEGORY_NOT_IN_CATEGORY",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "RemoveCategory",
+			"userID":     userID,
+			"projectID":  project.ID,
+			"categoryID": categoryID,
+		}).Warn("Project is not in the category")
+		response.NotFound(c, i18n.MsgProjectNotInCategory)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Project", project.ID, project.Version)
+	if !ok {
+		return
+	}
+	project.Version = version
+
+	if err := repos.Project.RemoveCategory(project, categoryID); err != nil {
+		if versionConflict(c, "Project", project.ID, err) {
+			return
+		}
+		if errors.Is(err, repo.ErrLastCategory) {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation":  "REMOVE_PROJECT_CATEGORY_LAST",
+				"where":      "backend/internal/application/handler/project.go",
+				"function":   "RemoveCategory",
+				"userID":     userID,
+				"projectID":  project.ID,
+				"categoryID": categoryID,
+			}).Warn("Project is only in this category")
+			response.Conflict(c, i18n.MsgProjectLastCategory)
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "REMOVE_PROJECT_CATEGORY_DB_ERROR",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   "RemoveCategory",
+			"userID":     userID,
+			"projectID":  project.ID,
+			"categoryID": categoryID,
+			"error":      err.Error(),
+		}).Error("Failed to remove project from category")
+		response.InternalError(c, i18n.MsgProjectCategoriesFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":   "REMOVE_PROJECT_CATEGORY",
+		"userID":      userID,
+		"projectID":   project.ID,
+		"categoryID":  categoryID,
+		"categoryIDs": project.CategoryIDs,
+	}).Info("Project removed from category")
+
+	setETag(c, project.Version)
+	response.OK(c, "project", project, "Project removed from category successfully")
+}
+
+// projectAndCategory parses :id and :categoryId and loads the project, which
+// the user must own
+func (h *ProjectHandler) projectAndCategory(c *gin.Context, userID string, function string) (*models.Project, uint, bool) {
+	repos := h.repos.For(c.Request.Context())
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PROJECT_CATEGORY_INVALID_ID",
+			"where":     "backend/internal/application/handler/project.go",
+			"function":  function,
+			"userID":    userID,
+			"projectID": c.Param("id"),
+			"error":     err.Error(),
+		}).Warn("Invalid project ID")
+		response.BadRequest(c, i18n.MsgProjectInvalidID)
+		return nil, 0, false
+	}
+	categoryID, err := strconv.Atoi(c.Param("categoryId"))
+	if err != nil || categoryID <= 0 {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "PROJECT_CATEGORY_INVALID_CATEGORY_ID",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   function,
+			"userID":     userID,
+			"projectID":  id,
+			"categoryID": c.Param("categoryId"),
+		}).Warn("Invalid category ID")
+		response.BadRequest(c, i18n.MsgCategoryInvalidID)
+		return nil, 0, false
+	}
+
+	project, err := repos.Project.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PROJECT_CATEGORY_NOT_FOUND",
+			"where":     "backend/internal/application/handler/project.go",
+			"function":  function,
+			"userID":    userID,
+			"projectID": id,
+			"error":     err.Error(),
+		}).Warn("Project not found")
+		response.NotFound(c, i18n.MsgProjectNotFound)
+		return nil, 0, false
+	}
+	if project.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PROJECT_CATEGORY_FORBIDDEN",
+			"where":     "backend/internal/application/handler/project.go",
+			"function":  function,
+			"userID":    userID,
+			"projectID": id,
+			"ownerID":   project.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "project",
+			"resource_id":   project.ID,
+			"owner_id":      project.OwnerID,
+			"action":        "update_categories",
+		})
+		return nil, 0, false
+	}
+	return project, uint(categoryID), true
+}
+
+// ownCategory checks that the category exists and belongs to the user
+func (h *ProjectHandler) ownCategory(c *gin.Context, userID string, categoryID uint, function string, action string) bool {
+	repos := h.repos.For(c.Request.Context())
+	category, err := repos.Category.GetByIDBasic(categoryID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "PROJECT_CATEGORY_CATEGORY_NOT_FOUND",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   function,
+			"userID":     userID,
+			"categoryID": categoryID,
+			"error":      err.Error(),
+		}).Warn("Category not found")
+		response.NotFound(c, i18n.MsgCategoryNotFound)
+		return false
+	}
+	if category.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "PROJECT_CATEGORY_CATEGORY_FORBIDDEN",
+			"where":      "backend/internal/application/handler/project.go",
+			"function":   function,
+			"userID":     userID,
+			"categoryID": categoryID,
+			"ownerID":    category.OwnerID,
+		}).Warn("Access denied to category")
+		response.ForbiddenWithDetails(c, i18n.MsgCategoryAccessDenied, map[string]interface{}{
+			"resource_type": "category",
+			"resource_id":   category.ID,
+			"owner_id":      category.OwnerID,
+			"action":        action,
+		})
+		return false
+	}
+	return true
+}
+
+// toLinkDocs copies stored project links into a patch document
+func toLinkDocs(links models.ProjectLinks) []request.ProjectLink {
+	docs := make([]request.ProjectLink, len(links))
+	for i, link := range links {
+		docs[i] = request.ProjectLink(link)
+	}
+	return docs
+}
+
+// fromLinkDocs copies the links of a patch document back for storing
+func fromLinkDocs(docs []request.ProjectLink) models.ProjectLinks {
+	links := make(models.ProjectLinks, len(docs))
+	for i, doc := range docs {
+		links[i] = models.ProjectLink(doc)
+	}
+	return links
 }
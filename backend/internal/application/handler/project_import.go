@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/gitimport"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type ProjectImportHandler struct {
+	repos     *repo.Repositories
+	providers map[string]gitimport.Provider
+}
+
+// RepositoryResponse is a repository of a Git hosting provider, with the
+// owner's project imported from it, if any
+type RepositoryResponse struct {
+	gitimport.Repository
+	ProjectID *uint      `json:"project_id,omitempty"`
+	Sync      bool       `json:"sync"`
+	SyncedAt  *time.Time `json:"synced_at,omitempty"`
+	SyncError string     `json:"sync_error,omitempty"`
+}
+
+func NewProjectImportHandler(repos *repo.Repositories, providers map[string]gitimport.Provider) *ProjectImportHandler {
+	return &ProjectImportHandler{
+		repos:     repos,
+		providers: providers,
+	}
+}
+
+// importer reads from the providers and stores through the repositories of the request
+func (h *ProjectImportHandler) importer(repos *repo.Repositories) *gitimport.Importer {
+	return gitimport.NewImporter(h.providers, repos.Project, repos.ProjectSource)
+}
+
+// GetRepositories lists the public repositories of an account on a provider,
+// telling which ones the user already imported
+func (h *ProjectImportHandler) GetRepositories(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	provider := c.Param("provider")
+	account := strings.TrimSpace(c.Query("account"))
+
+	if account == "" {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "LIST_REPOSITORIES_ACCOUNT_REQUIRED",
+			"where":     "backend/internal/application/handler/project_import.go",
+			"function":  "GetRepositories",
+			"userID":    userID,
+			"provider":  provider,
+		}).Warn("Account name is required")
+		response.BadRequest(c, i18n.MsgImportAccountRequired)
+		return
+	}
+
+	repositories, imported, err := h.importer(repos).Repositories(c.Request.Context(), userID, provider, account)
+	if err != nil {
+		h.providerFailed(c, err, "GetRepositories", i18n.MsgImportListFailed, logrus.Fields{
+			"userID":   userID,
+			"provider": provider,
+			"account":  account,
+		})
+		return
+	}
+
+	list := make([]RepositoryResponse, len(repositories))
+	for i, repository := range repositories {
+		list[i] = RepositoryResponse{Repository: repository}
+		if source, ok := imported[repository.ID]; ok {
+			list[i].ProjectID = &source.ProjectID
+			list[i].Sync = source.Sync
+			list[i].SyncedAt = &source.SyncedAt
+			list[i].SyncError = source.SyncError
+		}
+	}
+	response.OK(c, "repositories", list, "Repositories retrieved successfully")
+}
+
+// Import creates or updates projects of a category from repositories of a
+// provider. Each repository gets a result: created, updated or skipped with
+// a reason.
+func (h *ProjectImportHandler) Import(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	var req request.ImportProjectsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "IMPORT_PROJECTS_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/project_import.go",
+			"function":  "Import",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	category, err := repos.Category.GetByIDBasic(req.CategoryID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "IMPORT_PROJECTS_CATEGORY_NOT_FOUND",
+			"where":      "backend/internal/application/handler/project_import.go",
+			"function":   "Import",
+			"userID":     userID,
+			"categoryID": req.CategoryID,
+			"error":      err.Error(),
+		}).Warn("Category not found")
+		response.NotFound(c, i18n.MsgCategoryNotFound)
+		return
+	}
+	if category.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "IMPORT_PROJECTS_FORBIDDEN",
+			"where":      "backend/internal/application/handler/project_import.go",
+			"function":   "Import",
+			"userID":     userID,
+			"categoryID": req.CategoryID,
+			"ownerID":    category.OwnerID,
+		}).Warn("Access denied: category belongs to another user")
+		response.ForbiddenWithDetails(c, i18n.MsgCategoryAccessDenied, map[string]interface{}{
+			"resource_type": "category",
+			"resource_id":   category.ID,
+			"owner_id":      category.OwnerID,
+			"action":        "import_projects",
+		})
+		return
+	}
+
+	results, err := h.importer(repos).Import(c.Request.Context(), userID, req.Provider, req.CategoryID, uniqueIDs(req.RepositoryIDs), req.Sync)
+	if err != nil {
+		h.providerFailed(c, err, "Import", i18n.MsgImportFailed, logrus.Fields{
+			"userID":     userID,
+			"provider":   req.Provider,
+			"categoryID": req.CategoryID,
+		})
+		return
+	}
+
+	audit.GetCreateLogger().WithFields(logrus.Fields{
+		"operation":  "IMPORT_PROJECTS",
+		"userID":     userID,
+		"provider":   req.Provider,
+		"categoryID": req.CategoryID,
+		"count":      len(results),
+	}).Info("Projects imported")
+
+	response.OK(c, "results", results, "Repositories imported successfully")
+}
+
+// providerFailed answers an importer error: unknown providers and accounts
+// are the client's, unreachable providers a 502, and anything else ours
+func (h *ProjectImportHandler) providerFailed(c *gin.Context, err error, function string, message string, fields logrus.Fields) {
+	fields["where"] = "backend/internal/application/handler/project_import.go"
+	fields["function"] = function
+	fields["error"] = err.Error()
+
+	switch {
+	case errors.Is(err, gitimport.ErrUnknownProvider):
+		fields["operation"] = "GIT_IMPORT_UNKNOWN_PROVIDER"
+		audit.GetErrorLogger().WithFields(fields).Warn("Git provider not configured")
+		response.BadRequest(c, i18n.MsgImportUnknownProvider)
+	case errors.Is(err, gitimport.ErrNotFound):
+		fields["operation"] = "GIT_IMPORT_ACCOUNT_NOT_FOUND"
+		audit.GetErrorLogger().WithFields(fields).Warn("Account not found on the Git provider")
+		response.NotFound(c, i18n.MsgImportAccountNotFound)
+	case errors.As(err, new(*gitimport.ProviderError)):
+		fields["operation"] = "GIT_IMPORT_PROVIDER_ERROR"
+		audit.GetErrorLogger().WithFields(fields).Error("Git provider request failed")
+		response.ErrorWithCode(c, http.StatusBadGateway, response.CodeUpstreamFailed, i18n.MsgImportProviderFailed)
+	default:
+		fields["operation"] = "GIT_IMPORT_DB_ERROR"
+		audit.GetErrorLogger().WithFields(fields).Error("Failed to import from the Git provider")
+		response.InternalError(c, message)
+	}
+}
+
+// uniqueIDs drops repeated IDs, keeping the first of each
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]bool, len(ids))
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -10,6 +11,10 @@ import (
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/metrics"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
 	"github.com/gin-gonic/gin"
@@ -17,27 +22,37 @@ import (
 )
 
 type SectionHandler struct {
-	repo          repo.SectionRepository
-	portfolioRepo repo.PortfolioRepository
-	metrics       *metrics.Collector
+	repos   *repo.Repositories
+	metrics *metrics.Collector
+}
+
+// sectionListQuery is what GetByPortfolio accepts in its query string
+var sectionListQuery = query.Options{
+	SortFields:  []string{query.SortTitle, query.SortPosition, query.SortCreatedAt, query.SortUpdatedAt},
+	DefaultSort: query.SortPosition,
+	Filters:     []string{query.FilterType, query.FilterCreated},
+	Selection:   sectionSelection,
 }
 
 type SectionBulkReorderRequest struct {
-	Items []struct {
-		ID       uint `json:"id" binding:"required"`
-		Position uint `json:"position" binding:"required,min=1"`
-	} `json:"items" binding:"required,min=1"`
+	Items []request.ReorderItem `json:"items" binding:"required,min=1"`
 }
 
-func NewSectionHandler(repo repo.SectionRepository, portfolioRepo repo.PortfolioRepository, metrics *metrics.Collector) *SectionHandler {
+// SectionMoveRequest moves a section to another portfolio of the same owner
+type SectionMoveRequest struct {
+	request.PositionRequest
+	PortfolioID uint `json:"portfolio_id" binding:"required"`
+}
+
+func NewSectionHandler(repos *repo.Repositories, metrics *metrics.Collector) *SectionHandler {
 	return &SectionHandler{
-		repo:          repo,
-		portfolioRepo: portfolioRepo,
-		metrics:       metrics,
+		repos:   repos,
+		metrics: metrics,
 	}
 }
 
 func (h *SectionHandler) GetByUser(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 
 	// Parse pagination parameters - using default values if not provided
@@ -56,7 +71,7 @@ func (h *SectionHandler) GetByUser(c *gin.Context) {
 
 	offset := (page - 1) * limit
 
-	sections, total, err := h.repo.GetByOwnerID(userID, limit, offset)
+	sections, total, err := repos.Section.GetByOwnerID(userID, limit, offset)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "GET_SECTIONS_BY_USER_DB_ERROR",
@@ -65,7 +80,7 @@ func (h *SectionHandler) GetByUser(c *gin.Context) {
 			"userID":    userID,
 			"error":     err.Error(),
 		}).Error("Failed to retrieve sections")
-		response.InternalError(c, "Failed to retrieve sections")
+		response.InternalError(c, i18n.MsgSectionListFailed)
 		return
 	}
 
@@ -73,6 +88,7 @@ func (h *SectionHandler) GetByUser(c *gin.Context) {
 }
 
 func (h *SectionHandler) GetByPortfolio(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	// Extract portfolio ID from URL parameter
 	// This handler is used by two routes:
 	// 1. /api/portfolios/public/:id/sections
@@ -89,7 +105,7 @@ func (h *SectionHandler) GetByPortfolio(c *gin.Context) {
 			"path":      c.Request.URL.Path,
 			"allParams": c.Params,
 		}).Warn("Portfolio ID parameter is missing or empty")
-		response.BadRequest(c, "Portfolio ID is required")
+		response.BadRequest(c, i18n.MsgPortfolioIDRequired)
 		return
 	}
 
@@ -99,7 +115,21 @@ func (h *SectionHandler) GetByPortfolio(c *gin.Context) {
 		"path":        c.Request.URL.Path,
 	}).Info("GetByPortfolio handler called")
 
-	sections, err := h.repo.GetByPortfolioID(portfolioID)
+	spec, err := query.Parse(c.Request.URL.Query(), sectionListQuery)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_SECTIONS_BY_PORTFOLIO_INVALID_QUERY",
+			"where":       "backend/internal/application/handler/section.go",
+			"function":    "GetByPortfolio",
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid list query")
+		msg := i18n.MessageOf(err)
+		response.ErrorWithParams(c, http.StatusBadRequest, response.CodeInvalidQuery, msg.Key, msg.Params)
+		return
+	}
+
+	sections, nextCursor, err := repos.Section.ListByPortfolioID(portfolioID, spec)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "GET_SECTIONS_BY_PORTFOLIO_DB_ERROR",
@@ -109,19 +139,36 @@ func (h *SectionHandler) GetByPortfolio(c *gin.Context) {
 			"error":       err.Error(),
 			"errorType":   fmt.Sprintf("%T", err),
 		}).Error("Failed to get sections from repository")
-		response.InternalErrorWithDetails(c, "Failed to retrieve sections", err)
+		response.InternalErrorWithDetails(c, i18n.MsgSectionListFailed, err)
 		return
 	}
 
+	if len(sections) > 0 && ownerHidden(repos.UserStatus, sections[0].OwnerID, "GetByPortfolio") {
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	if len(sections) > 0 {
+		translations := localize(c, repos.Translation, models.TranslationSection, sections[0].ID, "GetByPortfolio")
+		for i := range sections {
+			translations.ApplySection(&sections[i])
+		}
+	}
+
 	logrus.WithFields(logrus.Fields{
 		"portfolioID":   portfolioID,
 		"sectionsCount": len(sections),
 	}).Info("Successfully retrieved sections")
 
-	response.OK(c, "sections", sections, "Success")
+	if !spec.Selection.Empty() {
+		response.SuccessWithCursor(c, http.StatusOK, "sections", dtoresponse.ToSectionListSparse(sections, spec.Selection), nextCursor)
+		return
+	}
+	response.SuccessWithCursor(c, http.StatusOK, "sections", sections, nextCursor)
 }
 
 func (h *SectionHandler) GetByID(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	sectionID := c.Param("id")
 
 	// Parse section ID
@@ -134,11 +181,21 @@ func (h *SectionHandler) GetByID(c *gin.Context) {
 			"sectionID": sectionID,
 			"error":     err.Error(),
 		}).Warn("Invalid section ID")
-		response.BadRequest(c, "Invalid section ID")
+		response.BadRequest(c, i18n.MsgSectionInvalidID)
+		return
+	}
+
+	sel, ok := parseSelection(c, sectionSelection, "GetByID")
+	if !ok {
 		return
 	}
 
-	section, err := h.repo.GetByID(uint(id))
+	var section *models.Section
+	if sel.Empty() {
+		section, err = repos.Section.GetByID(uint(id))
+	} else {
+		section, err = repos.Section.GetByIDSelected(uint(id), sel)
+	}
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "GET_SECTION_BY_ID_NOT_FOUND",
@@ -147,14 +204,27 @@ func (h *SectionHandler) GetByID(c *gin.Context) {
 			"sectionID": id,
 			"error":     err.Error(),
 		}).Warn("Section not found")
-		response.NotFound(c, "Section not found")
+		response.NotFound(c, i18n.MsgSectionNotFound)
+		return
+	}
+
+	if ownerHidden(repos.UserStatus, section.OwnerID, "GetByID") {
+		response.NotFound(c, i18n.MsgSectionNotFound)
 		return
 	}
 
+	localize(c, repos.Translation, models.TranslationSection, section.ID, "GetByID").ApplySection(section)
+
+	setETag(c, section.Version)
+	if !sel.Empty() {
+		response.OK(c, "section", dtoresponse.ToSectionSparse(section, sel), "Success")
+		return
+	}
 	response.OK(c, "section", section, "Success")
 }
 
 func (h *SectionHandler) GetByType(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	sectionType := c.Query("type")
 	if sectionType == "" {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
@@ -162,11 +232,11 @@ func (h *SectionHandler) GetByType(c *gin.Context) {
 			"where":     "backend/internal/application/handler/section.go",
 			"function":  "GetByType",
 		}).Warn("Section type is required")
-		response.BadRequest(c, "Section type is required")
+		response.BadRequest(c, i18n.MsgSectionTypeRequired)
 		return
 	}
 
-	sections, err := h.repo.GetByType(sectionType)
+	sections, err := repos.Section.GetByType(sectionType)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "GET_SECTIONS_BY_TYPE_DB_ERROR",
@@ -175,7 +245,7 @@ func (h *SectionHandler) GetByType(c *gin.Context) {
 			"sectionType": sectionType,
 			"error":       err.Error(),
 		}).Error("Failed to retrieve sections")
-		response.InternalError(c, "Failed to retrieve sections")
+		response.InternalError(c, i18n.MsgSectionListFailed)
 		return
 	}
 
@@ -183,6 +253,7 @@ func (h *SectionHandler) GetByType(c *gin.Context) {
 }
 
 func (h *SectionHandler) Create(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 
 	// Parse request body
@@ -195,7 +266,7 @@ func (h *SectionHandler) Create(c *gin.Context) {
 			"userID":    userID,
 			"error":     err.Error(),
 		}).Warn("Invalid request data")
-		response.BadRequest(c, "Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
 		return
 	}
 
@@ -218,12 +289,12 @@ func (h *SectionHandler) Create(c *gin.Context) {
 			"portfolioID": newSection.PortfolioID,
 			"error":       err.Error(),
 		}).Warn("Section validation failed")
-		response.BadRequest(c, err.Error())
+		response.Invalid(c, err)
 		return
 	}
 
 	// Validate portfolio exists and belongs to user
-	portfolio, err := h.portfolioRepo.GetByIDBasic(newSection.PortfolioID)
+	portfolio, err := repos.Portfolio.GetByIDBasic(newSection.PortfolioID)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "CREATE_SECTION_PORTFOLIO_NOT_FOUND",
@@ -233,7 +304,7 @@ func (h *SectionHandler) Create(c *gin.Context) {
 			"portfolioID": newSection.PortfolioID,
 			"error":       err.Error(),
 		}).Warn("Portfolio not found")
-		response.NotFound(c, "Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
 		return
 	}
 
@@ -246,7 +317,7 @@ func (h *SectionHandler) Create(c *gin.Context) {
 			"portfolioID": newSection.PortfolioID,
 			"ownerID":     portfolio.OwnerID,
 		}).Warn("Access denied to portfolio")
-		response.ForbiddenWithDetails(c, "Access denied: portfolio belongs to another user", map[string]interface{}{
+		response.ForbiddenWithDetails(c, i18n.MsgPortfolioAccessDenied, map[string]interface{}{
 			"resource_type": "portfolio",
 			"resource_id":   portfolio.ID,
 			"owner_id":      portfolio.OwnerID,
@@ -256,7 +327,7 @@ func (h *SectionHandler) Create(c *gin.Context) {
 	}
 
 	// Check for duplicate title
-	isDuplicate, err := h.repo.CheckDuplicate(newSection.Title, newSection.PortfolioID, 0)
+	isDuplicate, err := repos.Section.CheckDuplicate(newSection.Title, newSection.PortfolioID, 0)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "CREATE_SECTION_DUPLICATE_CHECK_ERROR",
@@ -267,7 +338,7 @@ func (h *SectionHandler) Create(c *gin.Context) {
 			"title":       newSection.Title,
 			"error":       err.Error(),
 		}).Error("Failed to check for duplicate section")
-		response.InternalError(c, "Failed to check for duplicate section")
+		response.InternalError(c, i18n.MsgSectionDuplicateCheckFailed)
 		return
 	}
 	if isDuplicate {
@@ -279,7 +350,7 @@ func (h *SectionHandler) Create(c *gin.Context) {
 			"portfolioID": newSection.PortfolioID,
 			"title":       newSection.Title,
 		}).Warn("Section with this title already exists in this portfolio")
-		response.BadRequest(c, "Section with this title already exists in this portfolio")
+		response.BadRequest(c, i18n.MsgSectionTitleTaken)
 		return
 	}
 
@@ -290,7 +361,7 @@ func (h *SectionHandler) Create(c *gin.Context) {
 	}).Info("Creating section - position will be set by database trigger")
 
 	// Create a section
-	if err := h.repo.Create(&newSection); err != nil {
+	if err := repos.Section.Create(&newSection); err != nil {
 		// Check if error is due to foreign key constraint (invalid portfolio_id)
 		errMsg := err.Error()
 		if strings.Contains(errMsg, "fk_portfolios_sections") || strings.Contains(errMsg, "23503") {
@@ -302,7 +373,7 @@ func (h *SectionHandler) Create(c *gin.Context) {
 				"portfolioID": newSection.PortfolioID,
 				"error":       err.Error(),
 			}).Warn("Portfolio not found during section creation")
-			response.NotFound(c, "Portfolio not found")
+			response.NotFound(c, i18n.MsgPortfolioNotFound)
 			return
 		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
@@ -313,7 +384,7 @@ func (h *SectionHandler) Create(c *gin.Context) {
 			"portfolioID": newSection.PortfolioID,
 			"error":       err.Error(),
 		}).Error("Failed to create section")
-		response.InternalError(c, "Failed to create section")
+		response.InternalError(c, i18n.MsgSectionCreateFailed)
 		return
 	}
 
@@ -330,6 +401,7 @@ func (h *SectionHandler) Create(c *gin.Context) {
 }
 
 func (h *SectionHandler) Update(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 	sectionID := c.Param("id")
 
@@ -344,7 +416,7 @@ func (h *SectionHandler) Update(c *gin.Context) {
 			"sectionID": sectionID,
 			"error":     err.Error(),
 		}).Warn("Invalid section ID")
-		response.BadRequest(c, "Invalid section ID")
+		response.BadRequest(c, i18n.MsgSectionInvalidID)
 		return
 	}
 
@@ -359,7 +431,7 @@ func (h *SectionHandler) Update(c *gin.Context) {
 			"sectionID": id,
 			"error":     err.Error(),
 		}).Warn("Invalid request data")
-		response.BadRequest(c, "Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
 		return
 	}
 
@@ -378,12 +450,12 @@ func (h *SectionHandler) Update(c *gin.Context) {
 			"portfolioID": updateData.PortfolioID,
 			"error":       err.Error(),
 		}).Warn("Section validation failed")
-		response.BadRequest(c, err.Error())
+		response.Invalid(c, err)
 		return
 	}
 
 	// Check if section exists and belongs to user
-	existing, err := h.repo.GetByID(uint(id))
+	existing, err := repos.Section.GetByID(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_SECTION_NOT_FOUND",
@@ -393,7 +465,7 @@ func (h *SectionHandler) Update(c *gin.Context) {
 			"sectionID": id,
 			"error":     err.Error(),
 		}).Warn("Section not found")
-		response.NotFound(c, "Section not found")
+		response.NotFound(c, i18n.MsgSectionNotFound)
 		return
 	}
 	if existing.OwnerID != userID {
@@ -405,7 +477,7 @@ func (h *SectionHandler) Update(c *gin.Context) {
 			"sectionID": id,
 			"ownerID":   existing.OwnerID,
 		}).Warn("Access denied")
-		response.ForbiddenWithDetails(c, "Access denied", map[string]interface{}{
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
 			"resource_type": "section",
 			"resource_id":   existing.ID,
 			"owner_id":      existing.OwnerID,
@@ -415,7 +487,7 @@ func (h *SectionHandler) Update(c *gin.Context) {
 	}
 
 	// Check for duplicate title
-	isDuplicate, err := h.repo.CheckDuplicate(updateData.Title, updateData.PortfolioID, updateData.ID)
+	isDuplicate, err := repos.Section.CheckDuplicate(updateData.Title, updateData.PortfolioID, updateData.ID)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "UPDATE_SECTION_DUPLICATE_CHECK_ERROR",
@@ -427,7 +499,7 @@ func (h *SectionHandler) Update(c *gin.Context) {
 			"title":       updateData.Title,
 			"error":       err.Error(),
 		}).Error("Failed to check for duplicate section")
-		response.InternalError(c, "Failed to check for duplicate section")
+		response.InternalError(c, i18n.MsgSectionDuplicateCheckFailed)
 		return
 	}
 	if isDuplicate {
@@ -440,12 +512,22 @@ func (h *SectionHandler) Update(c *gin.Context) {
 			"portfolioID": updateData.PortfolioID,
 			"title":       updateData.Title,
 		}).Warn("Section with this title already exists in this portfolio")
-		response.BadRequest(c, "Section with this title already exists in this portfolio")
+		response.BadRequest(c, i18n.MsgSectionTitleTaken)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Section", uint(id), existing.Version)
+	if !ok {
 		return
 	}
+	updateData.Version = version
 
 	// Update section
-	if err := h.repo.Update(&updateData); err != nil {
+	if err := repos.Section.Update(&updateData); err != nil {
+		if versionConflict(c, "Section", uint(id), err) {
+			return
+		}
 		// Check if error is due to foreign key constraint (invalid portfolio_id)
 		errMsg := err.Error()
 		if strings.Contains(errMsg, "fk_portfolios_sections") || strings.Contains(errMsg, "23503") {
@@ -458,7 +540,7 @@ func (h *SectionHandler) Update(c *gin.Context) {
 				"portfolioID": updateData.PortfolioID,
 				"error":       err.Error(),
 			}).Warn("Portfolio not found")
-			response.NotFound(c, "Portfolio not found")
+			response.NotFound(c, i18n.MsgPortfolioNotFound)
 			return
 		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
@@ -470,14 +552,156 @@ func (h *SectionHandler) Update(c *gin.Context) {
 			"portfolioID": updateData.PortfolioID,
 			"error":       err.Error(),
 		}).Error("Failed to update section")
-		response.InternalError(c, "Failed to update section")
+		response.InternalError(c, i18n.MsgSectionUpdateFailed)
 		return
 	}
 
+	setETag(c, updateData.Version)
 	response.OK(c, "section", &updateData, "Section updated successfully")
 }
 
+// Patch applies a JSON Merge Patch or JSON Patch to the section
+func (h *SectionHandler) Patch(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	sectionID := c.Param("id")
+
+	// Parse section ID
+	id, err := strconv.Atoi(sectionID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PATCH_SECTION_INVALID_ID",
+			"where":     "backend/internal/application/handler/section.go",
+			"function":  "Patch",
+			"userID":    userID,
+			"sectionID": sectionID,
+			"error":     err.Error(),
+		}).Warn("Invalid section ID")
+		response.BadRequest(c, i18n.MsgSectionInvalidID)
+		return
+	}
+
+	// Check if section exists and belongs to user
+	existing, err := repos.Section.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PATCH_SECTION_NOT_FOUND",
+			"where":     "backend/internal/application/handler/section.go",
+			"function":  "Patch",
+			"userID":    userID,
+			"sectionID": id,
+			"error":     err.Error(),
+		}).Warn("Section not found")
+		response.NotFound(c, i18n.MsgSectionNotFound)
+		return
+	}
+	if existing.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PATCH_SECTION_FORBIDDEN",
+			"where":     "backend/internal/application/handler/section.go",
+			"function":  "Patch",
+			"userID":    userID,
+			"sectionID": id,
+			"ownerID":   existing.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "section",
+			"resource_id":   existing.ID,
+			"owner_id":      existing.OwnerID,
+			"action":        "update",
+		})
+		return
+	}
+
+	// Reject the patch if the client edited an outdated copy
+	version, ok := checkVersion(c, "Section", uint(id), existing.Version)
+	if !ok {
+		return
+	}
+
+	// Apply the patch to the editable fields
+	doc := request.PatchSectionRequest{
+		Title:       existing.Title,
+		Description: existing.Description,
+		Type:        existing.Type,
+	}
+	if !applyPatch(c, "Section", uint(id), &doc) {
+		return
+	}
+	existing.Title = doc.Title
+	existing.Description = doc.Description
+	existing.Type = doc.Type
+	existing.Version = version
+
+	// Validate section data
+	if err := validator.ValidateSection(existing); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_SECTION_VALIDATION_ERROR",
+			"where":       "backend/internal/application/handler/section.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"sectionID":   id,
+			"portfolioID": existing.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Section validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	// Check for duplicate title
+	isDuplicate, err := repos.Section.CheckDuplicate(existing.Title, existing.PortfolioID, existing.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_SECTION_DUPLICATE_CHECK_ERROR",
+			"where":       "backend/internal/application/handler/section.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"sectionID":   id,
+			"portfolioID": existing.PortfolioID,
+			"title":       existing.Title,
+			"error":       err.Error(),
+		}).Error("Failed to check for duplicate section")
+		response.InternalError(c, i18n.MsgSectionDuplicateCheckFailed)
+		return
+	}
+	if isDuplicate {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_SECTION_DUPLICATE_TITLE",
+			"where":       "backend/internal/application/handler/section.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"sectionID":   id,
+			"portfolioID": existing.PortfolioID,
+			"title":       existing.Title,
+		}).Warn("Section with this title already exists in this portfolio")
+		response.BadRequest(c, i18n.MsgSectionTitleTaken)
+		return
+	}
+
+	// Write every editable field so removed ones are cleared
+	if err := repos.Section.Patch(existing); err != nil {
+		if versionConflict(c, "Section", uint(id), err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PATCH_SECTION_DB_ERROR",
+			"where":       "backend/internal/application/handler/section.go",
+			"function":    "Patch",
+			"userID":      userID,
+			"sectionID":   id,
+			"portfolioID": existing.PortfolioID,
+			"error":       err.Error(),
+		}).Error("Failed to patch section")
+		response.InternalError(c, i18n.MsgSectionUpdateFailed)
+		return
+	}
+
+	setETag(c, existing.Version)
+	response.OK(c, "section", existing, "Section updated successfully")
+}
+
 func (h *SectionHandler) Delete(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID")
 	sectionID := c.Param("id")
 
@@ -491,12 +715,12 @@ func (h *SectionHandler) Delete(c *gin.Context) {
 			"sectionID": sectionID,
 			"error":     err.Error(),
 		}).Warn("Invalid section ID")
-		response.BadRequest(c, "Invalid section ID")
+		response.BadRequest(c, i18n.MsgSectionInvalidID)
 		return
 	}
 
 	// Get a section to check ownership
-	section, err := h.repo.GetByID(uint(id))
+	section, err := repos.Section.GetByID(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "DELETE_SECTION_NOT_FOUND",
@@ -506,7 +730,7 @@ func (h *SectionHandler) Delete(c *gin.Context) {
 			"sectionID": id,
 			"error":     err.Error(),
 		}).Warn("Section not found")
-		response.NotFound(c, "Section not found")
+		response.NotFound(c, i18n.MsgSectionNotFound)
 		return
 	}
 
@@ -519,7 +743,7 @@ func (h *SectionHandler) Delete(c *gin.Context) {
 			"sectionID": id,
 			"ownerID":   section.OwnerID,
 		}).Warn("Access denied")
-		response.ForbiddenWithDetails(c, "Access denied", map[string]interface{}{
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
 			"resource_type": "section",
 			"resource_id":   section.ID,
 			"owner_id":      section.OwnerID,
@@ -528,8 +752,17 @@ func (h *SectionHandler) Delete(c *gin.Context) {
 		return
 	}
 
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Section", uint(id), section.Version)
+	if !ok {
+		return
+	}
+
 	// Delete section (CASCADE: all related section_contents will be deleted)
-	if err := h.repo.Delete(uint(id)); err != nil {
+	if err := repos.Section.Delete(uint(id), version); err != nil {
+		if versionConflict(c, "Section", uint(id), err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "DELETE_SECTION_DB_ERROR",
 			"where":       "backend/internal/application/handler/section.go",
@@ -540,7 +773,7 @@ func (h *SectionHandler) Delete(c *gin.Context) {
 			"error":       err.Error(),
 		}).Error("Failed to delete section")
 
-		response.InternalError(c, "Failed to delete section")
+		response.InternalError(c, i18n.MsgSectionDeleteFailed)
 		return
 	}
 
@@ -558,6 +791,7 @@ func (h *SectionHandler) Delete(c *gin.Context) {
 
 // UpdatePosition updates the position field of a section
 func (h *SectionHandler) UpdatePosition(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 	sectionID := c.Param("id")
 
@@ -572,14 +806,12 @@ func (h *SectionHandler) UpdatePosition(c *gin.Context) {
 			"sectionID": sectionID,
 			"error":     err.Error(),
 		}).Warn("Invalid section ID")
-		response.BadRequest(c, "Invalid section ID")
+		response.BadRequest(c, i18n.MsgSectionInvalidID)
 		return
 	}
 
 	// Parse request body
-	var req struct {
-		Position uint `json:"position" binding:"required"`
-	}
+	var req request.PositionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_SECTION_POSITION_BAD_REQUEST",
@@ -589,12 +821,16 @@ func (h *SectionHandler) UpdatePosition(c *gin.Context) {
 			"sectionID": id,
 			"error":     err.Error(),
 		}).Warn("Invalid request data")
-		response.BadRequest(c, "Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+	at, ok := placement(c, req)
+	if !ok {
 		return
 	}
 
 	// Check if the section exists and belongs to a user
-	existing, err := h.repo.GetByID(uint(id))
+	existing, err := repos.Section.GetByID(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_SECTION_POSITION_NOT_FOUND",
@@ -604,7 +840,7 @@ func (h *SectionHandler) UpdatePosition(c *gin.Context) {
 			"sectionID": id,
 			"error":     err.Error(),
 		}).Warn("Section not found")
-		response.NotFound(c, "Section not found")
+		response.NotFound(c, i18n.MsgSectionNotFound)
 		return
 	}
 
@@ -617,7 +853,7 @@ func (h *SectionHandler) UpdatePosition(c *gin.Context) {
 			"sectionID": id,
 			"ownerID":   existing.OwnerID,
 		}).Warn("Access denied")
-		response.ForbiddenWithDetails(c, "Access denied", map[string]interface{}{
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
 			"resource_type": "section",
 			"resource_id":   existing.ID,
 			"owner_id":      existing.OwnerID,
@@ -629,8 +865,18 @@ func (h *SectionHandler) UpdatePosition(c *gin.Context) {
 	// Store old position before update
 	oldPosition := existing.Position
 
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Section", uint(id), existing.Version)
+	if !ok {
+		return
+	}
+
 	// Update position
-	if err := h.repo.UpdatePosition(uint(id), req.Position); err != nil {
+	position, err := repos.Section.UpdatePosition(uint(id), at, version)
+	if err != nil {
+		if orderingFailed(c, "Section", uint(id), err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_SECTION_POSITION_DB_ERROR",
 			"where":     "backend/internal/application/handler/section.go",
@@ -640,7 +886,7 @@ func (h *SectionHandler) UpdatePosition(c *gin.Context) {
 			"position":  req.Position,
 			"error":     err.Error(),
 		}).Error("Failed to update section position")
-		response.InternalError(c, "Failed to update section position")
+		response.InternalError(c, i18n.MsgSectionPositionFailed)
 		return
 	}
 
@@ -649,33 +895,210 @@ func (h *SectionHandler) UpdatePosition(c *gin.Context) {
 		"operation":   "UPDATE_SECTION_POSITION",
 		"sectionID":   id,
 		"oldPosition": oldPosition,
-		"newPosition": req.Position,
+		"newPosition": position,
 		"userID":      userID,
 	}).Info("Section position updated successfully")
 
+	setETag(c, version+1)
 	response.OK(c, "message", "Section position updated successfully", "Success")
 }
 
+// Move moves a section to another portfolio of the same owner, placing it
+// among that portfolio's sections
+func (h *SectionHandler) Move(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	sectionID := c.Param("id")
+
+	// Parse section ID
+	id, err := strconv.Atoi(sectionID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "MOVE_SECTION_INVALID_ID",
+			"where":     "backend/internal/application/handler/section.go",
+			"function":  "Move",
+			"userID":    userID,
+			"sectionID": sectionID,
+			"error":     err.Error(),
+		}).Warn("Invalid section ID")
+		response.BadRequest(c, i18n.MsgSectionInvalidID)
+		return
+	}
+
+	// Parse request body
+	var req SectionMoveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "MOVE_SECTION_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/section.go",
+			"function":  "Move",
+			"userID":    userID,
+			"sectionID": id,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+	at, ok := placement(c, req.PositionRequest)
+	if !ok {
+		return
+	}
+
+	// Check if the section exists and belongs to the user
+	existing, err := repos.Section.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "MOVE_SECTION_NOT_FOUND",
+			"where":     "backend/internal/application/handler/section.go",
+			"function":  "Move",
+			"userID":    userID,
+			"sectionID": id,
+			"error":     err.Error(),
+		}).Warn("Section not found")
+		response.NotFound(c, i18n.MsgSectionNotFound)
+		return
+	}
+
+	if existing.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "MOVE_SECTION_FORBIDDEN",
+			"where":     "backend/internal/application/handler/section.go",
+			"function":  "Move",
+			"userID":    userID,
+			"sectionID": id,
+			"ownerID":   existing.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "section",
+			"resource_id":   existing.ID,
+			"owner_id":      existing.OwnerID,
+			"action":        "move",
+		})
+		return
+	}
+
+	// Validate the target portfolio exists and belongs to the user
+	portfolio, err := repos.Portfolio.GetByIDBasic(req.PortfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "MOVE_SECTION_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/section.go",
+			"function":    "Move",
+			"userID":      userID,
+			"sectionID":   id,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	if portfolio.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "MOVE_SECTION_PORTFOLIO_FORBIDDEN",
+			"where":       "backend/internal/application/handler/section.go",
+			"function":    "Move",
+			"userID":      userID,
+			"sectionID":   id,
+			"portfolioID": req.PortfolioID,
+			"ownerID":     portfolio.OwnerID,
+		}).Warn("Access denied to portfolio")
+		response.ForbiddenWithDetails(c, i18n.MsgPortfolioAccessDenied, map[string]interface{}{
+			"resource_type": "portfolio",
+			"resource_id":   portfolio.ID,
+			"owner_id":      portfolio.OwnerID,
+			"action":        "move_section",
+		})
+		return
+	}
+
+	// Check for duplicate title in the target portfolio
+	isDuplicate, err := repos.Section.CheckDuplicate(existing.Title, req.PortfolioID, existing.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "MOVE_SECTION_DUPLICATE_CHECK_ERROR",
+			"where":       "backend/internal/application/handler/section.go",
+			"function":    "Move",
+			"userID":      userID,
+			"sectionID":   id,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Error("Failed to check for duplicate section")
+		response.InternalError(c, i18n.MsgSectionDuplicateCheckFailed)
+		return
+	}
+	if isDuplicate {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "MOVE_SECTION_DUPLICATE_TITLE",
+			"where":       "backend/internal/application/handler/section.go",
+			"function":    "Move",
+			"userID":      userID,
+			"sectionID":   id,
+			"portfolioID": req.PortfolioID,
+			"title":       existing.Title,
+		}).Warn("Section with this title already exists in this portfolio")
+		response.BadRequest(c, i18n.MsgSectionTitleTaken)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Section", uint(id), existing.Version)
+	if !ok {
+		return
+	}
+
+	position, err := repos.Section.MoveToPortfolio(uint(id), req.PortfolioID, at, version)
+	if err != nil {
+		if orderingFailed(c, "Section", uint(id), err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "MOVE_SECTION_DB_ERROR",
+			"where":       "backend/internal/application/handler/section.go",
+			"function":    "Move",
+			"userID":      userID,
+			"sectionID":   id,
+			"fromID":      existing.PortfolioID,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Error("Failed to move section")
+		response.InternalError(c, i18n.MsgSectionMoveFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":   "MOVE_SECTION",
+		"userID":      userID,
+		"sectionID":   id,
+		"fromID":      existing.PortfolioID,
+		"portfolioID": req.PortfolioID,
+		"position":    position,
+	}).Info("Section moved to portfolio")
+
+	existing.PortfolioID = req.PortfolioID
+	existing.Position = position
+	existing.Version = version + 1
+	setETag(c, existing.Version)
+	response.OK(c, "section", existing, "Section moved successfully")
+}
+
 // BulkReorder handles reordering multiple sections atomically
 func (h *SectionHandler) BulkReorder(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	// Get user ID
 	userID := c.GetString("userID") // From auth middleware
 
 	// Parse request
 	var req SectionBulkReorderRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		response.BadRequest(c, "Invalid request: "+err.Error())
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
 		return
 	}
 
 	// Validate no duplicate positions
-	positionMap := make(map[uint]bool)
-	for _, item := range req.Items {
-		if positionMap[item.Position] {
-			response.BadRequest(c, fmt.Sprintf("Duplicate position: %d", item.Position))
-			return
-		}
-		positionMap[item.Position] = true
+	items, ok := reorderItems(c, req.Items)
+	if !ok {
+		return
 	}
 
 	// Verify all sections belong to user's portfolios
@@ -684,27 +1107,27 @@ func (h *SectionHandler) BulkReorder(c *gin.Context) {
 		sectionIDs[i] = item.ID
 	}
 
-	sections, err := h.repo.GetByIDs(sectionIDs)
+	sections, err := repos.Section.GetByIDs(sectionIDs)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "BULK_REORDER_SECTIONS",
 			"userID":    userID,
 			"error":     err.Error(),
 		}).Error("Failed to fetch sections for bulk reorder")
-		response.InternalError(c, "Failed to fetch sections")
+		response.InternalError(c, i18n.MsgSectionListFailed)
 		return
 	}
 
 	if len(sections) != len(req.Items) {
-		response.NotFound(c, "Some sections not found")
+		response.NotFound(c, i18n.MsgSectionSomeNotFound)
 		return
 	}
 
 	// Verify ownership
 	for _, sec := range sections {
-		portfolio, err := h.portfolioRepo.GetByID(sec.PortfolioID)
+		portfolio, err := repos.Portfolio.GetByID(sec.PortfolioID)
 		if err != nil || portfolio.OwnerID != userID {
-			response.ForbiddenWithDetails(c, "Access denied", map[string]interface{}{
+			response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
 				"resource_type": "section",
 				"resource_id":   sec.ID,
 			})
@@ -712,15 +1135,27 @@ func (h *SectionHandler) BulkReorder(c *gin.Context) {
 		}
 	}
 
+	// Reorder within one portfolio
+	portfolioID := sections[0].PortfolioID
+	for _, sec := range sections {
+		if sec.PortfolioID != portfolioID {
+			response.BadRequest(c, i18n.MsgReorderNotSiblings)
+			return
+		}
+	}
+
 	// Update positions in transaction
-	if err := h.repo.BulkUpdatePositions(req.Items); err != nil {
+	if err := repos.Section.BulkUpdatePositions(portfolioID, items); err != nil {
+		if orderingFailed(c, "Section", 0, err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "BULK_REORDER_SECTIONS",
 			"userID":    userID,
 			"itemCount": len(req.Items),
 			"error":     err.Error(),
 		}).Error("Failed to bulk update section positions")
-		response.InternalError(c, "Failed to update positions")
+		response.InternalError(c, i18n.MsgUpdatePositionsFailed)
 		return
 	}
 
@@ -9,6 +9,8 @@ import (
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/ordering"
 	resp "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
 	"github.com/gin-gonic/gin"
@@ -16,23 +18,20 @@ import (
 )
 
 type SectionContentHandler struct {
-	repo          repo.SectionContentRepository
-	sectionRepo   repo.SectionRepository   // For authorization checks
-	portfolioRepo repo.PortfolioRepository // For full ownership validation
-	metrics       *metrics.Collector
+	repos   *repo.Repositories
+	metrics *metrics.Collector
 }
 
-func NewSectionContentHandler(repo repo.SectionContentRepository, sectionRepo repo.SectionRepository, portfolioRepo repo.PortfolioRepository, metrics *metrics.Collector) *SectionContentHandler {
+func NewSectionContentHandler(repos *repo.Repositories, metrics *metrics.Collector) *SectionContentHandler {
 	return &SectionContentHandler{
-		repo:          repo,
-		sectionRepo:   sectionRepo,
-		portfolioRepo: portfolioRepo,
-		metrics:       metrics,
+		repos:   repos,
+		metrics: metrics,
 	}
 }
 
 // Create creates a new section content block
 func (h *SectionContentHandler) Create(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 
 	// Parse request body
@@ -45,12 +44,12 @@ func (h *SectionContentHandler) Create(c *gin.Context) {
 			"userID":    userID,
 			"error":     err.Error(),
 		}).Warn("Invalid request data")
-		resp.BadRequest(c, "Invalid request data")
+		resp.ValidationFailed(c, i18n.MsgInvalidRequest, err)
 		return
 	}
 
 	// Check if section exists and belongs to user's portfolio
-	section, err := h.sectionRepo.GetByID(req.SectionID)
+	section, err := repos.Section.GetByID(req.SectionID)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "CREATE_SECTION_CONTENT_SECTION_NOT_FOUND",
@@ -60,11 +59,11 @@ func (h *SectionContentHandler) Create(c *gin.Context) {
 			"sectionID": req.SectionID,
 			"error":     err.Error(),
 		}).Warn("Section not found")
-		resp.NotFound(c, "Section not found")
+		resp.NotFound(c, i18n.MsgSectionNotFound)
 		return
 	}
 
-	portfolio, err := h.portfolioRepo.GetByIDBasic(section.PortfolioID)
+	portfolio, err := repos.Portfolio.GetByIDBasic(section.PortfolioID)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation":   "CREATE_SECTION_CONTENT_PORTFOLIO_NOT_FOUND",
@@ -75,7 +74,7 @@ func (h *SectionContentHandler) Create(c *gin.Context) {
 			"portfolioID": section.PortfolioID,
 			"error":       err.Error(),
 		}).Warn("Portfolio not found")
-		resp.NotFound(c, "Portfolio not found")
+		resp.NotFound(c, i18n.MsgPortfolioNotFound)
 		return
 	}
 
@@ -89,7 +88,7 @@ func (h *SectionContentHandler) Create(c *gin.Context) {
 			"portfolioID": section.PortfolioID,
 			"ownerID":     portfolio.OwnerID,
 		}).Warn("Access denied: section belongs to another user's portfolio")
-		resp.Forbidden(c, "Access denied: section belongs to another user's portfolio")
+		resp.Forbidden(c, i18n.MsgSectionAccessDenied)
 		return
 	}
 
@@ -118,12 +117,12 @@ func (h *SectionContentHandler) Create(c *gin.Context) {
 			"sectionID": req.SectionID,
 			"error":     err.Error(),
 		}).Warn("Section content validation failed")
-		resp.BadRequest(c, err.Error())
+		resp.Invalid(c, err)
 		return
 	}
 
 	// Create content
-	if err := h.repo.Create(content); err != nil {
+	if err := repos.SectionContent.Create(content); err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "CREATE_SECTION_CONTENT_DB_ERROR",
 			"where":     "backend/internal/application/handler/section_content.go",
@@ -132,7 +131,7 @@ func (h *SectionContentHandler) Create(c *gin.Context) {
 			"sectionID": req.SectionID,
 			"error":     err.Error(),
 		}).Error("Failed to create content")
-		resp.InternalError(c, "Failed to create content")
+		resp.InternalError(c, i18n.MsgContentCreateFailed)
 		return
 	}
 
@@ -141,6 +140,7 @@ func (h *SectionContentHandler) Create(c *gin.Context) {
 
 // GetBySectionID retrieves all content blocks for a section
 func (h *SectionContentHandler) GetBySectionID(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	sectionID := c.Param("sectionId")
 
 	// Parse section ID
@@ -153,12 +153,12 @@ func (h *SectionContentHandler) GetBySectionID(c *gin.Context) {
 			"sectionID": sectionID,
 			"error":     err.Error(),
 		}).Warn("Invalid section ID")
-		resp.BadRequest(c, "Invalid section ID")
+		resp.BadRequest(c, i18n.MsgSectionInvalidID)
 		return
 	}
 
 	// Get contents
-	contents, err := h.repo.GetBySectionID(uint(id))
+	contents, err := repos.SectionContent.GetBySectionID(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "GET_SECTION_CONTENTS_DB_ERROR",
@@ -167,15 +167,28 @@ func (h *SectionContentHandler) GetBySectionID(c *gin.Context) {
 			"sectionID": id,
 			"error":     err.Error(),
 		}).Error("Failed to retrieve contents")
-		resp.InternalError(c, "Failed to retrieve contents")
+		resp.InternalError(c, i18n.MsgContentListFailed)
 		return
 	}
 
+	if len(contents) > 0 && ownerHidden(repos.UserStatus, contents[0].OwnerID, "GetBySectionID") {
+		resp.NotFound(c, i18n.MsgSectionNotFound)
+		return
+	}
+
+	if len(contents) > 0 {
+		translations := localize(c, repos.Translation, models.TranslationSectionContent, contents[0].ID, "GetBySectionID")
+		for i := range contents {
+			translations.ApplySectionContent(&contents[i])
+		}
+	}
+
 	resp.OK(c, "contents", response.ToSectionContentListResponse(contents), "Success")
 }
 
 // GetByID retrieves a single content block
 func (h *SectionContentHandler) GetByID(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	contentID := c.Param("id")
 
 	// Parse content ID
@@ -188,12 +201,12 @@ func (h *SectionContentHandler) GetByID(c *gin.Context) {
 			"contentID": contentID,
 			"error":     err.Error(),
 		}).Warn("Invalid content ID")
-		resp.BadRequest(c, "Invalid content ID")
+		resp.BadRequest(c, i18n.MsgContentInvalidID)
 		return
 	}
 
 	// Get content
-	content, err := h.repo.GetByID(uint(id))
+	content, err := repos.SectionContent.GetByID(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "GET_SECTION_CONTENT_NOT_FOUND",
@@ -202,15 +215,24 @@ func (h *SectionContentHandler) GetByID(c *gin.Context) {
 			"contentID": id,
 			"error":     err.Error(),
 		}).Warn("Content not found")
-		resp.NotFound(c, "Content not found")
+		resp.NotFound(c, i18n.MsgContentNotFound)
+		return
+	}
+
+	if ownerHidden(repos.UserStatus, content.OwnerID, "GetByID") {
+		resp.NotFound(c, i18n.MsgContentNotFound)
 		return
 	}
 
+	localize(c, repos.Translation, models.TranslationSectionContent, content.ID, "GetByID").ApplySectionContent(content)
+
+	setETag(c, content.Version)
 	resp.OK(c, "content", response.ToSectionContentResponse(content), "Success")
 }
 
 // Update updates a section content block
 func (h *SectionContentHandler) Update(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 	contentID := c.Param("id")
 
@@ -225,12 +247,12 @@ func (h *SectionContentHandler) Update(c *gin.Context) {
 			"contentID": contentID,
 			"error":     err.Error(),
 		}).Warn("Invalid content ID")
-		resp.BadRequest(c, "Invalid content ID")
+		resp.BadRequest(c, i18n.MsgContentInvalidID)
 		return
 	}
 
 	// Get existing content
-	existing, err := h.repo.GetByID(uint(id))
+	existing, err := repos.SectionContent.GetByID(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_SECTION_CONTENT_NOT_FOUND",
@@ -240,12 +262,12 @@ func (h *SectionContentHandler) Update(c *gin.Context) {
 			"contentID": id,
 			"error":     err.Error(),
 		}).Warn("Content not found")
-		resp.NotFound(c, "Content not found")
+		resp.NotFound(c, i18n.MsgContentNotFound)
 		return
 	}
 
 	// Check if section belongs to user
-	section, err := h.sectionRepo.GetByID(existing.SectionID)
+	section, err := repos.Section.GetByID(existing.SectionID)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_SECTION_CONTENT_SECTION_NOT_FOUND",
@@ -256,7 +278,7 @@ func (h *SectionContentHandler) Update(c *gin.Context) {
 			"sectionID": existing.SectionID,
 			"error":     err.Error(),
 		}).Warn("Section not found")
-		resp.NotFound(c, "Section not found")
+		resp.NotFound(c, i18n.MsgSectionNotFound)
 		return
 	}
 
@@ -270,7 +292,7 @@ func (h *SectionContentHandler) Update(c *gin.Context) {
 			"sectionID": existing.SectionID,
 			"ownerID":   section.OwnerID,
 		}).Warn("Access denied")
-		resp.Forbidden(c, "Access denied")
+		resp.Forbidden(c, i18n.MsgAccessDenied)
 		return
 	}
 
@@ -285,7 +307,7 @@ func (h *SectionContentHandler) Update(c *gin.Context) {
 			"contentID": id,
 			"error":     err.Error(),
 		}).Warn("Invalid request data")
-		resp.BadRequest(c, "Invalid request data")
+		resp.ValidationFailed(c, i18n.MsgInvalidRequest, err)
 		return
 	}
 
@@ -314,12 +336,22 @@ func (h *SectionContentHandler) Update(c *gin.Context) {
 			"sectionID": existing.SectionID,
 			"error":     err.Error(),
 		}).Warn("Section content validation failed")
-		resp.BadRequest(c, err.Error())
+		resp.Invalid(c, err)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Content", uint(id), existing.Version)
+	if !ok {
 		return
 	}
+	existing.Version = version
 
 	// Update content
-	if err := h.repo.Update(existing); err != nil {
+	if err := repos.SectionContent.Update(existing); err != nil {
+		if versionConflict(c, "Content", uint(id), err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_SECTION_CONTENT_DB_ERROR",
 			"where":     "backend/internal/application/handler/section_content.go",
@@ -329,15 +361,142 @@ func (h *SectionContentHandler) Update(c *gin.Context) {
 			"sectionID": existing.SectionID,
 			"error":     err.Error(),
 		}).Error("Failed to update content")
-		resp.InternalError(c, "Failed to update content")
+		resp.InternalError(c, i18n.MsgContentUpdateFailed)
 		return
 	}
 
+	setETag(c, existing.Version)
+	resp.OK(c, "content", response.ToSectionContentResponse(existing), "Content updated successfully")
+}
+
+// Patch applies a JSON Merge Patch or JSON Patch to a content block
+func (h *SectionContentHandler) Patch(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	contentID := c.Param("id")
+
+	// Parse content ID
+	id, err := strconv.Atoi(contentID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PATCH_SECTION_CONTENT_INVALID_ID",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  "Patch",
+			"userID":    userID,
+			"contentID": contentID,
+			"error":     err.Error(),
+		}).Warn("Invalid content ID")
+		resp.BadRequest(c, i18n.MsgContentInvalidID)
+		return
+	}
+
+	// Get existing content
+	existing, err := repos.SectionContent.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PATCH_SECTION_CONTENT_NOT_FOUND",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  "Patch",
+			"userID":    userID,
+			"contentID": id,
+			"error":     err.Error(),
+		}).Warn("Content not found")
+		resp.NotFound(c, i18n.MsgContentNotFound)
+		return
+	}
+
+	// Check if section belongs to user
+	section, err := repos.Section.GetByID(existing.SectionID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PATCH_SECTION_CONTENT_SECTION_NOT_FOUND",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  "Patch",
+			"userID":    userID,
+			"contentID": id,
+			"sectionID": existing.SectionID,
+			"error":     err.Error(),
+		}).Warn("Section not found")
+		resp.NotFound(c, i18n.MsgSectionNotFound)
+		return
+	}
+
+	if section.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PATCH_SECTION_CONTENT_FORBIDDEN",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  "Patch",
+			"userID":    userID,
+			"contentID": id,
+			"sectionID": existing.SectionID,
+			"ownerID":   section.OwnerID,
+		}).Warn("Access denied")
+		resp.Forbidden(c, i18n.MsgAccessDenied)
+		return
+	}
+
+	// Reject the patch if the client edited an outdated copy
+	version, ok := checkVersion(c, "Content", uint(id), existing.Version)
+	if !ok {
+		return
+	}
+
+	// Apply the patch to the editable fields
+	doc := request.PatchSectionContentRequest{
+		Type:     existing.Type,
+		Content:  existing.Content,
+		Order:    existing.Order,
+		Metadata: existing.Metadata,
+	}
+	if !applyPatch(c, "Content", uint(id), &doc) {
+		return
+	}
+	existing.Type = doc.Type
+	existing.Content = doc.Content
+	existing.Order = doc.Order
+	existing.Metadata = doc.Metadata
+	existing.Version = version
+
+	// Validate content
+	if err := validator.ValidateSectionContent(existing); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PATCH_SECTION_CONTENT_VALIDATION_ERROR",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  "Patch",
+			"userID":    userID,
+			"contentID": id,
+			"sectionID": existing.SectionID,
+			"error":     err.Error(),
+		}).Warn("Section content validation failed")
+		resp.Invalid(c, err)
+		return
+	}
+
+	// Write every editable field so removed ones are cleared
+	if err := repos.SectionContent.Patch(existing); err != nil {
+		if versionConflict(c, "Content", uint(id), err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "PATCH_SECTION_CONTENT_DB_ERROR",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  "Patch",
+			"userID":    userID,
+			"contentID": id,
+			"sectionID": existing.SectionID,
+			"error":     err.Error(),
+		}).Error("Failed to patch content")
+		resp.InternalError(c, i18n.MsgContentUpdateFailed)
+		return
+	}
+
+	setETag(c, existing.Version)
 	resp.OK(c, "content", response.ToSectionContentResponse(existing), "Content updated successfully")
 }
 
 // UpdateOrder updates only the order field of a content block
 func (h *SectionContentHandler) UpdateOrder(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 	contentID := c.Param("id")
 
@@ -352,12 +511,12 @@ func (h *SectionContentHandler) UpdateOrder(c *gin.Context) {
 			"contentID": contentID,
 			"error":     err.Error(),
 		}).Warn("Invalid content ID")
-		resp.BadRequest(c, "Invalid content ID")
+		resp.BadRequest(c, i18n.MsgContentInvalidID)
 		return
 	}
 
 	// Get existing content
-	existing, err := h.repo.GetByID(uint(id))
+	existing, err := repos.SectionContent.GetByID(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_SECTION_CONTENT_ORDER_NOT_FOUND",
@@ -367,12 +526,12 @@ func (h *SectionContentHandler) UpdateOrder(c *gin.Context) {
 			"contentID": id,
 			"error":     err.Error(),
 		}).Warn("Content not found")
-		resp.NotFound(c, "Content not found")
+		resp.NotFound(c, i18n.MsgContentNotFound)
 		return
 	}
 
 	// Check if section belongs to user
-	section, err := h.sectionRepo.GetByID(existing.SectionID)
+	section, err := repos.Section.GetByID(existing.SectionID)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_SECTION_CONTENT_ORDER_SECTION_NOT_FOUND",
@@ -383,7 +542,7 @@ func (h *SectionContentHandler) UpdateOrder(c *gin.Context) {
 			"sectionID": existing.SectionID,
 			"error":     err.Error(),
 		}).Warn("Section not found")
-		resp.NotFound(c, "Section not found")
+		resp.NotFound(c, i18n.MsgSectionNotFound)
 		return
 	}
 
@@ -397,7 +556,7 @@ func (h *SectionContentHandler) UpdateOrder(c *gin.Context) {
 			"sectionID": existing.SectionID,
 			"ownerID":   section.OwnerID,
 		}).Warn("Access denied")
-		resp.Forbidden(c, "Access denied")
+		resp.Forbidden(c, i18n.MsgAccessDenied)
 		return
 	}
 
@@ -412,12 +571,22 @@ func (h *SectionContentHandler) UpdateOrder(c *gin.Context) {
 			"contentID": id,
 			"error":     err.Error(),
 		}).Warn("Invalid request data")
-		resp.BadRequest(c, "Invalid request data")
+		resp.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Content", uint(id), existing.Version)
+	if !ok {
 		return
 	}
 
 	// Update order
-	if err := h.repo.UpdateOrder(uint(id), req.Order); err != nil {
+	order, err := repos.SectionContent.UpdatePosition(uint(id), ordering.Placement{Position: req.Order}, version)
+	if err != nil {
+		if orderingFailed(c, "Content", uint(id), err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "UPDATE_SECTION_CONTENT_ORDER_DB_ERROR",
 			"where":     "backend/internal/application/handler/section_content.go",
@@ -427,18 +596,297 @@ func (h *SectionContentHandler) UpdateOrder(c *gin.Context) {
 			"order":     req.Order,
 			"error":     err.Error(),
 		}).Error("Failed to update content order")
-		resp.InternalError(c, "Failed to update content order")
+		resp.InternalError(c, i18n.MsgContentOrderFailed)
+		return
+	}
+
+	// Reflect the updated order and version in the returned DTO
+	existing.Order = order
+	existing.Version = version + 1
+	setETag(c, existing.Version)
+
+	resp.OK(c, "content", response.ToSectionContentResponse(existing), "Content order updated successfully")
+}
+
+// UpdatePosition moves a content block within its section: to a position, or
+// right before or after another block of the section
+func (h *SectionContentHandler) UpdatePosition(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedContent(c, userID, "UpdatePosition")
+	if !ok {
+		return
+	}
+
+	// Parse request body
+	var req request.PositionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "UPDATE_SECTION_CONTENT_POSITION_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  "UpdatePosition",
+			"userID":    userID,
+			"contentID": existing.ID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		resp.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+	at, ok := placement(c, req)
+	if !ok {
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Content", existing.ID, existing.Version)
+	if !ok {
 		return
 	}
 
-	// Reflect the updated order in the returned DTO
-	existing.Order = req.Order
+	order, err := repos.SectionContent.UpdatePosition(existing.ID, at, version)
+	if err != nil {
+		if orderingFailed(c, "Content", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "UPDATE_SECTION_CONTENT_POSITION_DB_ERROR",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  "UpdatePosition",
+			"userID":    userID,
+			"contentID": existing.ID,
+			"error":     err.Error(),
+		}).Error("Failed to update content position")
+		resp.InternalError(c, i18n.MsgContentOrderFailed)
+		return
+	}
+
+	existing.Order = order
+	existing.Version = version + 1
+	setETag(c, existing.Version)
 
 	resp.OK(c, "content", response.ToSectionContentResponse(existing), "Content order updated successfully")
 }
 
+// Move moves a content block to another section of the user
+func (h *SectionContentHandler) Move(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedContent(c, userID, "Move")
+	if !ok {
+		return
+	}
+
+	// Parse request body
+	var req request.MoveSectionContentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "MOVE_SECTION_CONTENT_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  "Move",
+			"userID":    userID,
+			"contentID": existing.ID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		resp.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+	at, ok := placement(c, req.PositionRequest)
+	if !ok {
+		return
+	}
+
+	if !h.ownSection(c, userID, req.SectionID, "Move") {
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Content", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	order, err := repos.SectionContent.MoveToSection(existing.ID, req.SectionID, at, version)
+	if err != nil {
+		if orderingFailed(c, "Content", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "MOVE_SECTION_CONTENT_DB_ERROR",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  "Move",
+			"userID":    userID,
+			"contentID": existing.ID,
+			"fromID":    existing.SectionID,
+			"sectionID": req.SectionID,
+			"error":     err.Error(),
+		}).Error("Failed to move content")
+		resp.InternalError(c, i18n.MsgContentMoveFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation": "MOVE_SECTION_CONTENT",
+		"userID":    userID,
+		"contentID": existing.ID,
+		"fromID":    existing.SectionID,
+		"sectionID": req.SectionID,
+		"order":     order,
+	}).Info("Content moved to section")
+
+	existing.SectionID = req.SectionID
+	existing.Order = order
+	existing.Version = version + 1
+	setETag(c, existing.Version)
+
+	resp.OK(c, "content", response.ToSectionContentResponse(existing), "Content moved successfully")
+}
+
+// BulkReorder sets the positions of several content blocks of one section
+func (h *SectionContentHandler) BulkReorder(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	// Parse request
+	var req request.ReorderSectionContentsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		resp.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	// Validate no duplicate positions
+	items, ok := reorderItems(c, req.Items)
+	if !ok {
+		return
+	}
+
+	if !h.ownSection(c, userID, req.SectionID, "BulkReorder") {
+		return
+	}
+
+	// Verify all contents are in the section
+	contents, err := repos.SectionContent.GetBySectionID(req.SectionID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "BULK_REORDER_SECTION_CONTENTS",
+			"userID":    userID,
+			"sectionID": req.SectionID,
+			"error":     err.Error(),
+		}).Error("Failed to fetch contents for bulk reorder")
+		resp.InternalError(c, i18n.MsgContentListFailed)
+		return
+	}
+	inSection := make(map[uint]bool, len(contents))
+	for _, content := range contents {
+		inSection[content.ID] = true
+	}
+	for _, item := range req.Items {
+		if !inSection[item.ID] {
+			resp.NotFound(c, i18n.MsgContentSomeNotFound)
+			return
+		}
+	}
+
+	// Update positions in transaction
+	if err := repos.SectionContent.BulkUpdatePositions(req.SectionID, items); err != nil {
+		if orderingFailed(c, "Content", 0, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "BULK_REORDER_SECTION_CONTENTS",
+			"userID":    userID,
+			"sectionID": req.SectionID,
+			"itemCount": len(req.Items),
+			"error":     err.Error(),
+		}).Error("Failed to bulk update content positions")
+		resp.InternalError(c, i18n.MsgUpdatePositionsFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation": "BULK_REORDER_SECTION_CONTENTS",
+		"userID":    userID,
+		"sectionID": req.SectionID,
+		"itemCount": len(req.Items),
+	}).Info("Contents reordered successfully")
+
+	resp.OK(c, "message", "Contents reordered successfully", "Success")
+}
+
+// ownedContent parses :id and loads the content block, whose section the user
+// must own
+func (h *SectionContentHandler) ownedContent(c *gin.Context, userID string, function string) (*models.SectionContent, bool) {
+	repos := h.repos.For(c.Request.Context())
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "SECTION_CONTENT_INVALID_ID",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  function,
+			"userID":    userID,
+			"contentID": c.Param("id"),
+			"error":     err.Error(),
+		}).Warn("Invalid content ID")
+		resp.BadRequest(c, i18n.MsgContentInvalidID)
+		return nil, false
+	}
+
+	content, err := repos.SectionContent.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "SECTION_CONTENT_NOT_FOUND",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  function,
+			"userID":    userID,
+			"contentID": id,
+			"error":     err.Error(),
+		}).Warn("Content not found")
+		resp.NotFound(c, i18n.MsgContentNotFound)
+		return nil, false
+	}
+
+	if !h.ownSection(c, userID, content.SectionID, function) {
+		return nil, false
+	}
+	return content, true
+}
+
+// ownSection checks that the section exists and belongs to the user
+func (h *SectionContentHandler) ownSection(c *gin.Context, userID string, sectionID uint, function string) bool {
+	repos := h.repos.For(c.Request.Context())
+	section, err := repos.Section.GetByID(sectionID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "SECTION_CONTENT_SECTION_NOT_FOUND",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  function,
+			"userID":    userID,
+			"sectionID": sectionID,
+			"error":     err.Error(),
+		}).Warn("Section not found")
+		resp.NotFound(c, i18n.MsgSectionNotFound)
+		return false
+	}
+
+	if section.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "SECTION_CONTENT_FORBIDDEN",
+			"where":     "backend/internal/application/handler/section_content.go",
+			"function":  function,
+			"userID":    userID,
+			"sectionID": sectionID,
+			"ownerID":   section.OwnerID,
+		}).Warn("Access denied")
+		resp.Forbidden(c, i18n.MsgAccessDenied)
+		return false
+	}
+	return true
+}
+
 // Delete deletes a section content block
 func (h *SectionContentHandler) Delete(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID") // From auth middleware
 	contentID := c.Param("id")
 
@@ -453,12 +901,12 @@ func (h *SectionContentHandler) Delete(c *gin.Context) {
 			"contentID": contentID,
 			"error":     err.Error(),
 		}).Warn("Invalid content ID")
-		resp.BadRequest(c, "Invalid content ID")
+		resp.BadRequest(c, i18n.MsgContentInvalidID)
 		return
 	}
 
 	// Get existing content
-	existing, err := h.repo.GetByID(uint(id))
+	existing, err := repos.SectionContent.GetByID(uint(id))
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "DELETE_SECTION_CONTENT_NOT_FOUND",
@@ -468,12 +916,12 @@ func (h *SectionContentHandler) Delete(c *gin.Context) {
 			"contentID": id,
 			"error":     err.Error(),
 		}).Warn("Content not found")
-		resp.NotFound(c, "Content not found")
+		resp.NotFound(c, i18n.MsgContentNotFound)
 		return
 	}
 
 	// Check if section belongs to user
-	section, err := h.sectionRepo.GetByID(existing.SectionID)
+	section, err := repos.Section.GetByID(existing.SectionID)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "DELETE_SECTION_CONTENT_SECTION_NOT_FOUND",
@@ -484,7 +932,7 @@ func (h *SectionContentHandler) Delete(c *gin.Context) {
 			"sectionID": existing.SectionID,
 			"error":     err.Error(),
 		}).Warn("Section not found")
-		resp.NotFound(c, "Section not found")
+		resp.NotFound(c, i18n.MsgSectionNotFound)
 		return
 	}
 
@@ -498,12 +946,21 @@ func (h *SectionContentHandler) Delete(c *gin.Context) {
 			"sectionID": existing.SectionID,
 			"ownerID":   section.OwnerID,
 		}).Warn("Access denied")
-		resp.Forbidden(c, "Access denied")
+		resp.Forbidden(c, i18n.MsgAccessDenied)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Content", uint(id), existing.Version)
+	if !ok {
 		return
 	}
 
 	// Delete content
-	if err := h.repo.Delete(uint(id)); err != nil {
+	if err := repos.SectionContent.Delete(uint(id), version); err != nil {
+		if versionConflict(c, "Content", uint(id), err) {
+			return
+		}
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "DELETE_SECTION_CONTENT_DB_ERROR",
 			"where":     "backend/internal/application/handler/section_content.go",
@@ -514,7 +971,7 @@ func (h *SectionContentHandler) Delete(c *gin.Context) {
 			"error":     err.Error(),
 		}).Error("Failed to delete section content")
 
-		resp.InternalError(c, "Failed to delete content")
+		resp.InternalError(c, i18n.MsgContentDeleteFailed)
 		return
 	}
 
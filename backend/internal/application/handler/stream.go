@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/stream"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// eventStreamContentType must be in the Accept header of stream requests
+	eventStreamContentType = "text/event-stream"
+
+	streamHeartbeat   = 15 * time.Second
+	streamReplayBatch = 500
+)
+
+type StreamHandler struct {
+	repos *repo.Repositories
+	hub   *stream.Hub
+}
+
+func NewStreamHandler(repos *repo.Repositories, hub *stream.Hub) *StreamHandler {
+	return &StreamHandler{
+		repos: repos,
+		hub:   hub,
+	}
+}
+
+// Events streams the changes of a portfolio as Server-Sent Events. A client
+// resuming with Last-Event-ID (or ?last_event_id=) first gets the events it
+// missed, then live ones. The stream ends when the client falls behind; it
+// should reconnect and will catch up from where it stopped.
+func (h *StreamHandler) Events(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	portfolioID := c.Param("id")
+
+	id, err := strconv.Atoi(portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PORTFOLIO_EVENTS_INVALID_ID",
+			"where":       "backend/internal/application/handler/stream.go",
+			"function":    "Events",
+			"userID":      userID,
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid portfolio ID")
+		response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+		return
+	}
+
+	// Buffered clients (such as batch operations) would never see the end of the stream
+	if !strings.Contains(c.GetHeader("Accept"), eventStreamContentType) {
+		response.Error(c, http.StatusNotAcceptable, i18n.MsgStreamAcceptRequired)
+		return
+	}
+
+	lastEventID, err := parseLastEventID(c)
+	if err != nil {
+		response.BadRequest(c, i18n.MsgStreamInvalidEventID)
+		return
+	}
+
+	portfolio, err := repos.Portfolio.GetByIDBasic(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PORTFOLIO_EVENTS_NOT_FOUND",
+			"where":       "backend/internal/application/handler/stream.go",
+			"function":    "Events",
+			"userID":      userID,
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+	if portfolio.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "PORTFOLIO_EVENTS_FORBIDDEN",
+			"where":       "backend/internal/application/handler/stream.go",
+			"function":    "Events",
+			"userID":      userID,
+			"portfolioID": id,
+			"ownerID":     portfolio.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "portfolio",
+			"resource_id":   portfolio.ID,
+			"owner_id":      portfolio.OwnerID,
+			"action":        "stream",
+		})
+		return
+	}
+
+	// Subscribe before replaying so nothing committed in between is missed;
+	// events seen in both are skipped by ID, which is safe because a
+	// portfolio's events commit in ID order (see repo.publishEvent)
+	sub := h.hub.Subscribe(portfolio.ID)
+	defer h.hub.Unsubscribe(sub)
+
+	// The server's write timeout would otherwise cut the stream
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", eventStreamContentType)
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	fmt.Fprint(c.Writer, "retry: 3000\n\n")
+	c.Writer.Flush()
+
+	if lastEventID > 0 {
+		for {
+			events, err := repos.PortfolioEvent.GetSince(portfolio.ID, lastEventID, streamReplayBatch)
+			if err != nil {
+				audit.GetErrorLogger().WithFields(logrus.Fields{
+					"operation":   "PORTFOLIO_EVENTS_REPLAY_ERROR",
+					"where":       "backend/internal/application/handler/stream.go",
+					"function":    "Events",
+					"userID":      userID,
+					"portfolioID": portfolio.ID,
+					"error":       err.Error(),
+				}).Error("Failed to replay portfolio events")
+				return
+			}
+			for i := range events {
+				if !writeEvent(c, &events[i]) {
+					return
+				}
+				lastEventID = events[i].ID
+			}
+			if len(events) < streamReplayBatch {
+				break
+			}
+		}
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event, ok := <-sub.Events:
+			if !ok {
+				return
+			}
+			if event.ID <= lastEventID {
+				continue
+			}
+			if !writeEvent(c, &event) {
+				return
+			}
+			lastEventID = event.ID
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// writeEvent sends one SSE message, reporting whether the client is still there
+func writeEvent(c *gin.Context, event *models.PortfolioEvent) bool {
+	data, err := json.Marshal(dtoresponse.ToPortfolioEventResponse(event))
+	if err != nil {
+		return false
+	}
+	if _, err := fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Event, data); err != nil {
+		return false
+	}
+	c.Writer.Flush()
+	return true
+}
+
+// parseLastEventID reads the resume point from the Last-Event-ID header sent
+// by reconnecting EventSource clients, or from ?last_event_id=
+func parseLastEventID(c *gin.Context) (uint, error) {
+	value := c.GetHeader("Last-Event-ID")
+	if value == "" {
+		value = c.Query("last_event_id")
+	}
+	if value == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseUint(value, 10, 32)
+	return uint(id), err
+}
@@ -0,0 +1,409 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type TemplateHandler struct {
+	repos *repo.Repositories
+}
+
+func NewTemplateHandler(repos *repo.Repositories) *TemplateHandler {
+	return &TemplateHandler{
+		repos: repos,
+	}
+}
+
+// GetAvailable lists the templates anyone can start a portfolio from: the
+// built-in ones, then those users shared
+func (h *TemplateHandler) GetAvailable(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	templates, err := repos.Template.GetAvailable()
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "GET_TEMPLATES_DB_ERROR",
+			"where":     "backend/internal/application/handler/template.go",
+			"function":  "GetAvailable",
+			"error":     err.Error(),
+		}).Error("Failed to retrieve templates")
+		response.InternalError(c, i18n.MsgTemplateListFailed)
+		return
+	}
+
+	response.OK(c, "templates", dtoresponse.ToTemplateListResponse(templates), "Success")
+}
+
+// GetPublic returns a built-in or shared template with what it creates
+func (h *TemplateHandler) GetPublic(c *gin.Context) {
+	template, ok := h.template(c, "GetPublic")
+	if !ok {
+		return
+	}
+
+	if template.Visibility == models.TemplatePrivate {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "GET_TEMPLATE_PRIVATE",
+			"where":      "backend/internal/application/handler/template.go",
+			"function":   "GetPublic",
+			"templateID": template.ID,
+		}).Warn("Template is private")
+		response.NotFound(c, i18n.MsgTemplateNotFound)
+		return
+	}
+
+	response.OK(c, "template", dtoresponse.ToTemplateResponse(template), "Success")
+}
+
+// GetByUser lists the templates the user saved
+func (h *TemplateHandler) GetByUser(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	templates, err := repos.Template.GetByOwnerID(userID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "GET_OWN_TEMPLATES_DB_ERROR",
+			"where":     "backend/internal/application/handler/template.go",
+			"function":  "GetByUser",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Error("Failed to retrieve templates")
+		response.InternalError(c, i18n.MsgTemplateListFailed)
+		return
+	}
+
+	response.OK(c, "templates", dtoresponse.ToTemplateListResponse(templates), "Success")
+}
+
+// GetByID returns a template the user saved with what it creates
+func (h *TemplateHandler) GetByID(c *gin.Context) {
+	template, ok := h.ownedTemplate(c, "GetByID")
+	if !ok {
+		return
+	}
+
+	setETag(c, template.Version)
+	response.OK(c, "template", dtoresponse.ToTemplateResponse(template), "Success")
+}
+
+// Create saves one of the user's portfolios as a template. The sections,
+// content blocks, categories and projects are captured as they are now;
+// later changes to the portfolio don't reach the template.
+func (h *TemplateHandler) Create(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	var req request.CreateTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "CREATE_TEMPLATE_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/template.go",
+			"function":  "Create",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	portfolio, err := repos.Portfolio.GetByIDBasic(req.PortfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_TEMPLATE_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/template.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	if portfolio.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_TEMPLATE_FORBIDDEN",
+			"where":       "backend/internal/application/handler/template.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"ownerID":     portfolio.OwnerID,
+		}).Warn("Access denied to portfolio")
+		response.ForbiddenWithDetails(c, i18n.MsgPortfolioAccessDenied, map[string]interface{}{
+			"resource_type": "portfolio",
+			"resource_id":   portfolio.ID,
+			"owner_id":      portfolio.OwnerID,
+			"action":        "create_template",
+		})
+		return
+	}
+
+	template := models.PortfolioTemplate{
+		OwnerID:     userID,
+		Name:        req.Name,
+		Description: req.Description,
+		Visibility:  req.Visibility,
+	}
+	if template.Visibility == "" {
+		template.Visibility = models.TemplatePrivate
+	}
+
+	if h.nameTaken(c, &template, "Create") {
+		return
+	}
+
+	template.Bundle, err = repos.Template.BundleOf(portfolio.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_TEMPLATE_CAPTURE_ERROR",
+			"where":       "backend/internal/application/handler/template.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to capture portfolio content")
+		response.InternalError(c, i18n.MsgTemplateCreateFailed)
+		return
+	}
+
+	if err := repos.Template.Create(&template); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_TEMPLATE_DB_ERROR",
+			"where":       "backend/internal/application/handler/template.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to create template")
+		response.InternalError(c, i18n.MsgTemplateCreateFailed)
+		return
+	}
+
+	audit.GetCreateLogger().WithFields(logrus.Fields{
+		"operation":   "CREATE_TEMPLATE",
+		"templateID":  template.ID,
+		"portfolioID": portfolio.ID,
+		"visibility":  template.Visibility,
+		"userID":      userID,
+	}).Info("Template created successfully")
+
+	setETag(c, template.Version)
+	response.Created(c, "template", dtoresponse.ToTemplateResponse(&template), "Template created successfully")
+}
+
+// Update renames a template or changes who can use it
+func (h *TemplateHandler) Update(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedTemplate(c, "Update")
+	if !ok {
+		return
+	}
+
+	var req request.UpdateTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "UPDATE_TEMPLATE_BAD_REQUEST",
+			"where":      "backend/internal/application/handler/template.go",
+			"function":   "Update",
+			"userID":     userID,
+			"templateID": existing.ID,
+			"error":      err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	existing.Name = req.Name
+	existing.Description = req.Description
+	existing.Visibility = req.Visibility
+
+	if h.nameTaken(c, existing, "Update") {
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Template", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+	existing.Version = version
+
+	if err := repos.Template.Update(existing); err != nil {
+		if versionConflict(c, "Template", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "UPDATE_TEMPLATE_DB_ERROR",
+			"where":      "backend/internal/application/handler/template.go",
+			"function":   "Update",
+			"userID":     userID,
+			"templateID": existing.ID,
+			"error":      err.Error(),
+		}).Error("Failed to update template")
+		response.InternalError(c, i18n.MsgTemplateUpdateFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":  "UPDATE_TEMPLATE",
+		"templateID": existing.ID,
+		"visibility": existing.Visibility,
+		"userID":     userID,
+	}).Info("Template updated successfully")
+
+	setETag(c, existing.Version)
+	response.OK(c, "template", dtoresponse.ToTemplateResponse(existing), "Template updated successfully")
+}
+
+// Delete removes a template; portfolios created from it stay as they are
+func (h *TemplateHandler) Delete(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedTemplate(c, "Delete")
+	if !ok {
+		return
+	}
+
+	// Reject the delete if the client saw an outdated copy
+	version, ok := checkVersion(c, "Template", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	if err := repos.Template.Delete(existing.ID, version); err != nil {
+		if versionConflict(c, "Template", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "DELETE_TEMPLATE_DB_ERROR",
+			"where":      "backend/internal/application/handler/template.go",
+			"function":   "Delete",
+			"userID":     userID,
+			"templateID": existing.ID,
+			"error":      err.Error(),
+		}).Error("Failed to delete template")
+		response.InternalError(c, i18n.MsgTemplateDeleteFailed)
+		return
+	}
+
+	audit.GetDeleteLogger().WithFields(logrus.Fields{
+		"operation":  "DELETE_TEMPLATE",
+		"templateID": existing.ID,
+		"name":       existing.Name,
+		"userID":     userID,
+	}).Info("Template deleted successfully")
+
+	response.OK(c, "template", nil, "Template deleted successfully")
+}
+
+// nameTaken writes a 409 and returns true when the owner already has another
+// template with the name of template
+func (h *TemplateHandler) nameTaken(c *gin.Context, template *models.PortfolioTemplate, function string) bool {
+	repos := h.repos.For(c.Request.Context())
+	taken, err := repos.Template.CheckDuplicate(template.Name, template.OwnerID, template.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "TEMPLATE_DUPLICATE_CHECK_ERROR",
+			"where":     "backend/internal/application/handler/template.go",
+			"function":  function,
+			"userID":    template.OwnerID,
+			"name":      template.Name,
+			"error":     err.Error(),
+		}).Error("Failed to check for duplicate template")
+		response.InternalError(c, i18n.MsgTemplateCreateFailed)
+		return true
+	}
+	if !taken {
+		return false
+	}
+
+	audit.GetErrorLogger().WithFields(logrus.Fields{
+		"operation": "TEMPLATE_NAME_TAKEN",
+		"where":     "backend/internal/application/handler/template.go",
+		"function":  function,
+		"userID":    template.OwnerID,
+		"name":      template.Name,
+	}).Warn("Template name already in use")
+	response.ErrorWithParams(c, http.StatusConflict, "", i18n.MsgTemplateNameTaken, i18n.Params{"name": template.Name})
+	return true
+}
+
+// template loads the template named by :id, writing the error response when
+// the ID is invalid or there is no such template
+func (h *TemplateHandler) template(c *gin.Context, function string) (*models.PortfolioTemplate, bool) {
+	repos := h.repos.For(c.Request.Context())
+	templateID := c.Param("id")
+
+	id, err := strconv.Atoi(templateID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "TEMPLATE_INVALID_ID",
+			"where":      "backend/internal/application/handler/template.go",
+			"function":   function,
+			"templateID": templateID,
+			"error":      err.Error(),
+		}).Warn("Invalid template ID")
+		response.BadRequest(c, i18n.MsgTemplateInvalidID)
+		return nil, false
+	}
+
+	template, err := repos.Template.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "TEMPLATE_NOT_FOUND",
+			"where":      "backend/internal/application/handler/template.go",
+			"function":   function,
+			"templateID": id,
+			"error":      err.Error(),
+		}).Warn("Template not found")
+		response.NotFound(c, i18n.MsgTemplateNotFound)
+		return nil, false
+	}
+
+	return template, true
+}
+
+// ownedTemplate loads the template named by :id and checks the user saved it,
+// writing the error response otherwise. Built-in templates belong to no one.
+func (h *TemplateHandler) ownedTemplate(c *gin.Context, function string) (*models.PortfolioTemplate, bool) {
+	userID := c.GetString("userID") // From auth middleware
+
+	template, ok := h.template(c, function)
+	if !ok {
+		return nil, false
+	}
+
+	if template.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":  "TEMPLATE_FORBIDDEN",
+			"where":      "backend/internal/application/handler/template.go",
+			"function":   function,
+			"userID":     userID,
+			"templateID": template.ID,
+			"ownerID":    template.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "template",
+			"resource_id":   template.ID,
+			"owner_id":      template.OwnerID,
+			"action":        function,
+		})
+		return nil, false
+	}
+
+	return template, true
+}
@@ -0,0 +1,1108 @@
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// testimonialInviteDays is how long invite links work when the owner doesn't say
+const testimonialInviteDays = 30
+
+type TestimonialHandler struct {
+	repos *repo.Repositories
+}
+
+func NewTestimonialHandler(repos *repo.Repositories) *TestimonialHandler {
+	return &TestimonialHandler{
+		repos: repos,
+	}
+}
+
+// GetByPortfolio lists every testimonial of the user's portfolio in order,
+// optionally filtered by ?status= and ?project_id=
+func (h *TestimonialHandler) GetByPortfolio(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	portfolio, ok := h.ownedPortfolio(c, "GetByPortfolio")
+	if !ok {
+		return
+	}
+
+	projectID, ok := projectQuery(c)
+	if !ok {
+		return
+	}
+
+	status := c.Query("status")
+	if status != "" && !slices.Contains(models.TestimonialStatuses, status) {
+		response.ErrorWithCode(c, http.StatusBadRequest, response.CodeInvalidQuery, i18n.MsgTestimonialInvalidStatus)
+		return
+	}
+
+	testimonials, err := repos.Testimonial.GetByPortfolioID(portfolio.ID, projectID, status)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_TESTIMONIALS_BY_PORTFOLIO_DB_ERROR",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "GetByPortfolio",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to retrieve testimonials")
+		response.InternalError(c, i18n.MsgTestimonialListFailed)
+		return
+	}
+
+	response.OK(c, "testimonials", dtoresponse.ToTestimonialListResponse(testimonials), "Success")
+}
+
+// GetPublicByPortfolio lists the approved testimonials of a portfolio in the
+// owner's order, optionally only those of one project (?project_id=)
+func (h *TestimonialHandler) GetPublicByPortfolio(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	portfolioID := c.Param("id")
+
+	id, err := strconv.Atoi(portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_PUBLIC_TESTIMONIALS_INVALID_ID",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "GetPublicByPortfolio",
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid portfolio ID")
+		response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+		return
+	}
+
+	projectID, ok := projectQuery(c)
+	if !ok {
+		return
+	}
+
+	portfolio, err := repos.Portfolio.GetByIDBasic(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_PUBLIC_TESTIMONIALS_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "GetPublicByPortfolio",
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	// Portfolios of suspended owners are hidden as if they didn't exist
+	if ownerHidden(repos.UserStatus, portfolio.OwnerID, "GetPublicByPortfolio") {
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	testimonials, err := repos.Testimonial.GetApprovedByPortfolioID(portfolio.ID, projectID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_PUBLIC_TESTIMONIALS_DB_ERROR",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "GetPublicByPortfolio",
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to retrieve testimonials")
+		response.InternalError(c, i18n.MsgTestimonialListFailed)
+		return
+	}
+
+	response.OK(c, "testimonials", dtoresponse.ToTestimonialListResponse(testimonials), "Success")
+}
+
+// GetPublicByProject lists the approved testimonials of a project
+func (h *TestimonialHandler) GetPublicByProject(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	projectID := c.Param("id")
+
+	id, err := strconv.Atoi(projectID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "GET_PROJECT_TESTIMONIALS_INVALID_ID",
+			"where":     "backend/internal/application/handler/testimonial.go",
+			"function":  "GetPublicByProject",
+			"projectID": projectID,
+			"error":     err.Error(),
+		}).Warn("Invalid project ID")
+		response.BadRequest(c, i18n.MsgProjectInvalidID)
+		return
+	}
+
+	project, err := repos.Project.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "GET_PROJECT_TESTIMONIALS_NOT_FOUND",
+			"where":     "backend/internal/application/handler/testimonial.go",
+			"function":  "GetPublicByProject",
+			"projectID": id,
+			"error":     err.Error(),
+		}).Warn("Project not found")
+		response.NotFound(c, i18n.MsgProjectNotFound)
+		return
+	}
+
+	if ownerHidden(repos.UserStatus, project.OwnerID, "GetPublicByProject") {
+		response.NotFound(c, i18n.MsgProjectNotFound)
+		return
+	}
+
+	testimonials, err := repos.Testimonial.GetApprovedByProjectID(project.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "GET_PROJECT_TESTIMONIALS_DB_ERROR",
+			"where":     "backend/internal/application/handler/testimonial.go",
+			"function":  "GetPublicByProject",
+			"projectID": project.ID,
+			"error":     err.Error(),
+		}).Error("Failed to retrieve testimonials")
+		response.InternalError(c, i18n.MsgTestimonialListFailed)
+		return
+	}
+
+	response.OK(c, "testimonials", dtoresponse.ToTestimonialListResponse(testimonials), "Success")
+}
+
+func (h *TestimonialHandler) GetByID(c *gin.Context) {
+	testimonial, ok := h.ownedTestimonial(c, "GetByID")
+	if !ok {
+		return
+	}
+
+	setETag(c, testimonial.Version)
+	response.OK(c, "testimonial", dtoresponse.ToTestimonialResponse(testimonial), "Success")
+}
+
+// Create adds a testimonial the owner collected themselves; it is approved
+// right away and placed last
+func (h *TestimonialHandler) Create(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	var req request.CreateTestimonialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "CREATE_TESTIMONIAL_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/testimonial.go",
+			"function":  "Create",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	testimonial := models.Testimonial{
+		PortfolioID: req.PortfolioID,
+		ProjectID:   req.ProjectID,
+		OwnerID:     userID,
+		Status:      models.TestimonialApproved,
+	}
+	applyTestimonial(&testimonial, req.SubmitTestimonialRequest)
+
+	if err := validator.ValidateTestimonial(&testimonial); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_TESTIMONIAL_VALIDATION_ERROR",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Testimonial validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	// Validate portfolio exists and belongs to user
+	portfolio, err := repos.Portfolio.GetByIDBasic(req.PortfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_TESTIMONIAL_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+
+	if portfolio.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_TESTIMONIAL_FORBIDDEN",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"ownerID":     portfolio.OwnerID,
+		}).Warn("Access denied to portfolio")
+		response.ForbiddenWithDetails(c, i18n.MsgPortfolioAccessDenied, map[string]interface{}{
+			"resource_type": "portfolio",
+			"resource_id":   portfolio.ID,
+			"owner_id":      portfolio.OwnerID,
+			"action":        "create_testimonial",
+		})
+		return
+	}
+
+	if !h.projectInPortfolio(c, testimonial.ProjectID, portfolio.ID, "Create") {
+		return
+	}
+
+	if err := repos.Testimonial.Create(&testimonial); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_TESTIMONIAL_DB_ERROR",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to create testimonial")
+		response.InternalError(c, i18n.MsgTestimonialCreateFailed)
+		return
+	}
+
+	audit.GetCreateLogger().WithFields(logrus.Fields{
+		"operation":     "CREATE_TESTIMONIAL",
+		"userID":        userID,
+		"testimonialID": testimonial.ID,
+		"portfolioID":   portfolio.ID,
+		"position":      testimonial.Position,
+	}).Info("Testimonial created successfully")
+
+	setETag(c, testimonial.Version)
+	response.Created(c, "testimonial", dtoresponse.ToTestimonialResponse(&testimonial), "Testimonial created successfully")
+}
+
+// Update edits the content of a testimonial and the project it is about; the
+// state and position have their own endpoints
+func (h *TestimonialHandler) Update(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedTestimonial(c, "Update")
+	if !ok {
+		return
+	}
+
+	var req request.TestimonialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":     "UPDATE_TESTIMONIAL_BAD_REQUEST",
+			"where":         "backend/internal/application/handler/testimonial.go",
+			"function":      "Update",
+			"userID":        userID,
+			"testimonialID": existing.ID,
+			"error":         err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Testimonial", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	applyTestimonial(existing, req.SubmitTestimonialRequest)
+	existing.ProjectID = req.ProjectID
+	existing.Version = version
+
+	if err := validator.ValidateTestimonial(existing); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":     "UPDATE_TESTIMONIAL_VALIDATION_ERROR",
+			"where":         "backend/internal/application/handler/testimonial.go",
+			"function":      "Update",
+			"userID":        userID,
+			"testimonialID": existing.ID,
+			"error":         err.Error(),
+		}).Warn("Testimonial validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	if !h.projectInPortfolio(c, existing.ProjectID, existing.PortfolioID, "Update") {
+		return
+	}
+
+	if err := repos.Testimonial.Update(existing); err != nil {
+		if versionConflict(c, "Testimonial", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":     "UPDATE_TESTIMONIAL_DB_ERROR",
+			"where":         "backend/internal/application/handler/testimonial.go",
+			"function":      "Update",
+			"userID":        userID,
+			"testimonialID": existing.ID,
+			"error":         err.Error(),
+		}).Error("Failed to update testimonial")
+		response.InternalError(c, i18n.MsgTestimonialUpdateFailed)
+		return
+	}
+
+	setETag(c, existing.Version)
+	response.OK(c, "testimonial", dtoresponse.ToTestimonialResponse(existing), "Testimonial updated successfully")
+}
+
+// UpdateStatus approves or rejects a testimonial, or puts it back to pending
+func (h *TestimonialHandler) UpdateStatus(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedTestimonial(c, "UpdateStatus")
+	if !ok {
+		return
+	}
+
+	var req request.UpdateTestimonialStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":     "UPDATE_TESTIMONIAL_STATUS_BAD_REQUEST",
+			"where":         "backend/internal/application/handler/testimonial.go",
+			"function":      "UpdateStatus",
+			"userID":        userID,
+			"testimonialID": existing.ID,
+			"error":         err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Testimonial", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	oldStatus := existing.Status
+	existing.Status = req.Status
+	existing.Version = version
+
+	if err := repos.Testimonial.UpdateStatus(existing); err != nil {
+		if versionConflict(c, "Testimonial", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":     "UPDATE_TESTIMONIAL_STATUS_DB_ERROR",
+			"where":         "backend/internal/application/handler/testimonial.go",
+			"function":      "UpdateStatus",
+			"userID":        userID,
+			"testimonialID": existing.ID,
+			"error":         err.Error(),
+		}).Error("Failed to update testimonial status")
+		response.InternalError(c, i18n.MsgTestimonialUpdateFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":     "UPDATE_TESTIMONIAL_STATUS",
+		"testimonialID": existing.ID,
+		"oldStatus":     oldStatus,
+		"newStatus":     existing.Status,
+		"userID":        userID,
+	}).Info("Testimonial status updated successfully")
+
+	setETag(c, existing.Version)
+	response.OK(c, "testimonial", dtoresponse.ToTestimonialResponse(existing), "Testimonial updated successfully")
+}
+
+// UpdatePosition moves a testimonial within its portfolio
+func (h *TestimonialHandler) UpdatePosition(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedTestimonial(c, "UpdatePosition")
+	if !ok {
+		return
+	}
+
+	var req request.PositionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":     "UPDATE_TESTIMONIAL_POSITION_BAD_REQUEST",
+			"where":         "backend/internal/application/handler/testimonial.go",
+			"function":      "UpdatePosition",
+			"userID":        userID,
+			"testimonialID": existing.ID,
+			"error":         err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+	at, ok := placement(c, req)
+	if !ok {
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Testimonial", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	position, err := repos.Testimonial.UpdatePosition(existing.ID, at, version)
+	if err != nil {
+		if orderingFailed(c, "Testimonial", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":     "UPDATE_TESTIMONIAL_POSITION_DB_ERROR",
+			"where":         "backend/internal/application/handler/testimonial.go",
+			"function":      "UpdatePosition",
+			"userID":        userID,
+			"testimonialID": existing.ID,
+			"error":         err.Error(),
+		}).Error("Failed to update testimonial position")
+		response.InternalError(c, i18n.MsgTestimonialPositionFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":     "UPDATE_TESTIMONIAL_POSITION",
+		"testimonialID": existing.ID,
+		"oldPosition":   existing.Position,
+		"newPosition":   position,
+		"userID":        userID,
+	}).Info("Testimonial position updated successfully")
+
+	setETag(c, version+1)
+	response.OK(c, "message", "Testimonial position updated successfully", "Success")
+}
+
+// BulkReorder puts several testimonials of one portfolio at the given
+// positions at once
+func (h *TestimonialHandler) BulkReorder(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	var req request.ReorderTestimonialsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	// Validate no duplicate positions
+	items, ok := reorderItems(c, req.Items)
+	if !ok {
+		return
+	}
+
+	ids := make([]uint, len(req.Items))
+	for i, item := range req.Items {
+		ids[i] = item.ID
+	}
+
+	testimonials, err := repos.Testimonial.GetByIDs(ids)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "BULK_REORDER_TESTIMONIALS",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Error("Failed to fetch testimonials for bulk reorder")
+		response.InternalError(c, i18n.MsgTestimonialListFailed)
+		return
+	}
+
+	if len(testimonials) != len(req.Items) {
+		response.NotFound(c, i18n.MsgTestimonialSomeNotFound)
+		return
+	}
+
+	// Reorder the user's testimonials within one portfolio
+	portfolioID := testimonials[0].PortfolioID
+	for _, testimonial := range testimonials {
+		if testimonial.OwnerID != userID {
+			response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+				"resource_type": "testimonial",
+				"resource_id":   testimonial.ID,
+			})
+			return
+		}
+		if testimonial.PortfolioID != portfolioID {
+			response.BadRequest(c, i18n.MsgReorderNotSiblings)
+			return
+		}
+	}
+
+	if err := repos.Testimonial.BulkUpdatePositions(portfolioID, items); err != nil {
+		if orderingFailed(c, "Testimonial", 0, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "BULK_REORDER_TESTIMONIALS",
+			"userID":    userID,
+			"itemCount": len(req.Items),
+			"error":     err.Error(),
+		}).Error("Failed to bulk update testimonial positions")
+		response.InternalError(c, i18n.MsgUpdatePositionsFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":   "BULK_REORDER_TESTIMONIALS",
+		"userID":      userID,
+		"portfolioID": portfolioID,
+		"itemCount":   len(req.Items),
+	}).Info("Testimonials reordered successfully")
+
+	response.OK(c, "message", "Testimonials reordered successfully", "Success")
+}
+
+func (h *TestimonialHandler) Delete(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedTestimonial(c, "Delete")
+	if !ok {
+		return
+	}
+
+	// Reject the delete if the client saw an outdated copy
+	version, ok := checkVersion(c, "Testimonial", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	if err := repos.Testimonial.Delete(existing.ID, version); err != nil {
+		if versionConflict(c, "Testimonial", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":     "DELETE_TESTIMONIAL_DB_ERROR",
+			"where":         "backend/internal/application/handler/testimonial.go",
+			"function":      "Delete",
+			"userID":        userID,
+			"testimonialID": existing.ID,
+			"error":         err.Error(),
+		}).Error("Failed to delete testimonial")
+		response.InternalError(c, i18n.MsgTestimonialDeleteFailed)
+		return
+	}
+
+	audit.GetDeleteLogger().WithFields(logrus.Fields{
+		"operation":     "DELETE_TESTIMONIAL",
+		"testimonialID": existing.ID,
+		"portfolioID":   existing.PortfolioID,
+		"userID":        userID,
+	}).Info("Testimonial deleted successfully")
+
+	response.OK(c, "message", "Testimonial deleted successfully", "Success")
+}
+
+// GetInvites lists the invite links of the user's portfolio, newest first
+func (h *TestimonialHandler) GetInvites(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	portfolio, ok := h.ownedPortfolio(c, "GetInvites")
+	if !ok {
+		return
+	}
+
+	invites, err := repos.Testimonial.GetInvitesByPortfolioID(portfolio.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_TESTIMONIAL_INVITES_DB_ERROR",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "GetInvites",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to retrieve testimonial invites")
+		response.InternalError(c, i18n.MsgTestimonialInviteListFailed)
+		return
+	}
+
+	response.OK(c, "invites", dtoresponse.ToTestimonialInviteListResponse(invites), "Success")
+}
+
+// CreateInvite creates a link a client can submit one testimonial with. The
+// token is the only credential, so it is only shown to the owner.
+func (h *TestimonialHandler) CreateInvite(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	portfolio, ok := h.ownedPortfolio(c, "CreateInvite")
+	if !ok {
+		return
+	}
+
+	var req request.CreateTestimonialInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_TESTIMONIAL_INVITE_BAD_REQUEST",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "CreateInvite",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	if !h.projectInPortfolio(c, req.ProjectID, portfolio.ID, "CreateInvite") {
+		return
+	}
+
+	token, err := generateInviteToken()
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_TESTIMONIAL_INVITE_TOKEN_ERROR",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "CreateInvite",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to generate invite token")
+		response.InternalError(c, i18n.MsgTestimonialInviteCreateFailed)
+		return
+	}
+
+	days := req.ExpiresInDays
+	if days == 0 {
+		days = testimonialInviteDays
+	}
+	invite := models.TestimonialInvite{
+		PortfolioID: portfolio.ID,
+		ProjectID:   req.ProjectID,
+		OwnerID:     userID,
+		Token:       token,
+		Note:        strings.TrimSpace(req.Note),
+		ExpiresAt:   time.Now().Add(time.Duration(days) * 24 * time.Hour),
+	}
+
+	if err := repos.Testimonial.CreateInvite(&invite); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_TESTIMONIAL_INVITE_DB_ERROR",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "CreateInvite",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to create testimonial invite")
+		response.InternalError(c, i18n.MsgTestimonialInviteCreateFailed)
+		return
+	}
+
+	audit.GetCreateLogger().WithFields(logrus.Fields{
+		"operation":   "CREATE_TESTIMONIAL_INVITE",
+		"userID":      userID,
+		"inviteID":    invite.ID,
+		"portfolioID": portfolio.ID,
+		"expiresAt":   invite.ExpiresAt,
+	}).Info("Testimonial invite created successfully")
+
+	response.Created(c, "invite", dtoresponse.ToTestimonialInviteResponse(&invite), "Invite created successfully")
+}
+
+// DeleteInvite revokes an invite link; testimonials submitted with it stay
+func (h *TestimonialHandler) DeleteInvite(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	inviteID := c.Param("id")
+
+	id, err := strconv.Atoi(inviteID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "DELETE_TESTIMONIAL_INVITE_INVALID_ID",
+			"where":     "backend/internal/application/handler/testimonial.go",
+			"function":  "DeleteInvite",
+			"userID":    userID,
+			"inviteID":  inviteID,
+			"error":     err.Error(),
+		}).Warn("Invalid invite ID")
+		response.BadRequest(c, i18n.MsgTestimonialInviteInvalidID)
+		return
+	}
+
+	invite, err := repos.Testimonial.GetInviteByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "DELETE_TESTIMONIAL_INVITE_NOT_FOUND",
+			"where":     "backend/internal/application/handler/testimonial.go",
+			"function":  "DeleteInvite",
+			"userID":    userID,
+			"inviteID":  id,
+			"error":     err.Error(),
+		}).Warn("Invite not found")
+		response.NotFound(c, i18n.MsgTestimonialInviteNotFound)
+		return
+	}
+
+	if invite.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "DELETE_TESTIMONIAL_INVITE_FORBIDDEN",
+			"where":     "backend/internal/application/handler/testimonial.go",
+			"function":  "DeleteInvite",
+			"userID":    userID,
+			"inviteID":  id,
+			"ownerID":   invite.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "testimonial_invite",
+			"resource_id":   invite.ID,
+			"owner_id":      invite.OwnerID,
+			"action":        "delete",
+		})
+		return
+	}
+
+	if err := repos.Testimonial.DeleteInvite(invite.ID); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "DELETE_TESTIMONIAL_INVITE_DB_ERROR",
+			"where":     "backend/internal/application/handler/testimonial.go",
+			"function":  "DeleteInvite",
+			"userID":    userID,
+			"inviteID":  id,
+			"error":     err.Error(),
+		}).Error("Failed to delete testimonial invite")
+		response.InternalError(c, i18n.MsgTestimonialInviteDeleteFailed)
+		return
+	}
+
+	audit.GetDeleteLogger().WithFields(logrus.Fields{
+		"operation":   "DELETE_TESTIMONIAL_INVITE",
+		"inviteID":    invite.ID,
+		"portfolioID": invite.PortfolioID,
+		"userID":      userID,
+	}).Info("Testimonial invite deleted successfully")
+
+	response.OK(c, "message", "Invite deleted successfully", "Success")
+}
+
+// GetInvite tells the client following an invite link what the testimonial
+// will be about
+func (h *TestimonialHandler) GetInvite(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	invite, ok := h.usableInvite(c, "GetInvite")
+	if !ok {
+		return
+	}
+
+	portfolio, err := repos.Portfolio.GetByID(invite.PortfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_TESTIMONIAL_INVITE_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    "GetInvite",
+			"inviteID":    invite.ID,
+			"portfolioID": invite.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgTestimonialInviteNotFound)
+		return
+	}
+
+	info := dtoresponse.TestimonialInviteInfoResponse{
+		PortfolioID:    portfolio.ID,
+		PortfolioTitle: portfolio.Title,
+		ProjectID:      invite.ProjectID,
+		ExpiresAt:      invite.ExpiresAt,
+	}
+	if invite.ProjectID != nil {
+		if project, err := repos.Project.GetByID(*invite.ProjectID); err == nil {
+			info.ProjectTitle = project.Title
+		}
+	}
+
+	response.OK(c, "invite", info, "Success")
+}
+
+// Submit stores the testimonial a client sent through an invite link. It
+// waits for the owner's approval before it is shown.
+func (h *TestimonialHandler) Submit(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	invite, ok := h.usableInvite(c, "Submit")
+	if !ok {
+		return
+	}
+
+	var req request.SubmitTestimonialRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "SUBMIT_TESTIMONIAL_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/testimonial.go",
+			"function":  "Submit",
+			"inviteID":  invite.ID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	testimonial := models.Testimonial{
+		PortfolioID: invite.PortfolioID,
+		ProjectID:   invite.ProjectID,
+		OwnerID:     invite.OwnerID,
+		Status:      models.TestimonialPending,
+	}
+	applyTestimonial(&testimonial, req)
+
+	if err := validator.ValidateTestimonial(&testimonial); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "SUBMIT_TESTIMONIAL_VALIDATION_ERROR",
+			"where":     "backend/internal/application/handler/testimonial.go",
+			"function":  "Submit",
+			"inviteID":  invite.ID,
+			"error":     err.Error(),
+		}).Warn("Testimonial validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	if err := repos.Testimonial.Submit(&testimonial, invite); err != nil {
+		// Two submissions with the same link: only the first one counts
+		if errors.Is(err, repo.ErrInviteUnusable) {
+			response.ErrorWithCode(c, http.StatusGone, "", i18n.MsgTestimonialInviteUnusable)
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "SUBMIT_TESTIMONIAL_DB_ERROR",
+			"where":     "backend/internal/application/handler/testimonial.go",
+			"function":  "Submit",
+			"inviteID":  invite.ID,
+			"error":     err.Error(),
+		}).Error("Failed to submit testimonial")
+		response.InternalError(c, i18n.MsgTestimonialSubmitFailed)
+		return
+	}
+
+	audit.GetCreateLogger().WithFields(logrus.Fields{
+		"operation":     "SUBMIT_TESTIMONIAL",
+		"testimonialID": testimonial.ID,
+		"inviteID":      invite.ID,
+		"portfolioID":   testimonial.PortfolioID,
+		"ownerID":       testimonial.OwnerID,
+	}).Info("Testimonial submitted for approval")
+
+	response.Created(c, "testimonial", dtoresponse.ToTestimonialResponse(&testimonial), "Testimonial submitted for approval")
+}
+
+// applyTestimonial copies the content of a request onto the testimonial
+func applyTestimonial(testimonial *models.Testimonial, req request.SubmitTestimonialRequest) {
+	testimonial.AuthorName = strings.TrimSpace(req.AuthorName)
+	testimonial.AuthorRole = strings.TrimSpace(req.AuthorRole)
+	testimonial.Company = strings.TrimSpace(req.Company)
+	testimonial.Quote = strings.TrimSpace(req.Quote)
+	testimonial.AvatarURL = strings.TrimSpace(req.AvatarURL)
+	testimonial.Rating = req.Rating
+}
+
+// projectQuery parses the optional ?project_id= filter, writing a 400 when it
+// isn't an ID
+func projectQuery(c *gin.Context) (uint, bool) {
+	param := c.Query("project_id")
+	if param == "" {
+		return 0, true
+	}
+	id, err := strconv.Atoi(param)
+	if err != nil || id < 1 {
+		response.ErrorWithCode(c, http.StatusBadRequest, response.CodeInvalidQuery, i18n.MsgProjectInvalidID)
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// projectInPortfolio checks that a testimonial about projectID, if any, is
+// about a project shown in the portfolio, writing a 400 otherwise
+func (h *TestimonialHandler) projectInPortfolio(c *gin.Context, projectID *uint, portfolioID uint, function string) bool {
+	repos := h.repos.For(c.Request.Context())
+	if projectID == nil {
+		return true
+	}
+	found, err := repos.Testimonial.ProjectInPortfolio(*projectID, portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "TESTIMONIAL_PROJECT_CHECK_ERROR",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    function,
+			"projectID":   *projectID,
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Error("Failed to check the project of the testimonial")
+		response.InternalError(c, i18n.MsgTestimonialUpdateFailed)
+		return false
+	}
+	if !found {
+		response.BadRequest(c, i18n.MsgTestimonialProjectNotInPortfolio)
+		return false
+	}
+	return true
+}
+
+// usableInvite loads the invite named by :token, writing a 404 when there is
+// none (or its owner is hidden) and a 410 when it expired or was used
+func (h *TestimonialHandler) usableInvite(c *gin.Context, function string) (*models.TestimonialInvite, bool) {
+	repos := h.repos.For(c.Request.Context())
+	invite, err := repos.Testimonial.GetInviteByToken(c.Param("token"))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "TESTIMONIAL_INVITE_NOT_FOUND",
+			"where":     "backend/internal/application/handler/testimonial.go",
+			"function":  function,
+			"ip":        c.ClientIP(),
+			"error":     err.Error(),
+		}).Warn("Invite not found")
+		response.NotFound(c, i18n.MsgTestimonialInviteNotFound)
+		return nil, false
+	}
+
+	if ownerHidden(repos.UserStatus, invite.OwnerID, function) {
+		response.NotFound(c, i18n.MsgTestimonialInviteNotFound)
+		return nil, false
+	}
+
+	if !invite.Usable(time.Now()) {
+		response.ErrorWithCode(c, http.StatusGone, "", i18n.MsgTestimonialInviteUnusable)
+		return nil, false
+	}
+
+	return invite, true
+}
+
+// ownedTestimonial loads the testimonial named by :id and checks it belongs
+// to the user, writing the error response otherwise
+func (h *TestimonialHandler) ownedTestimonial(c *gin.Context, function string) (*models.Testimonial, bool) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	testimonialID := c.Param("id")
+
+	id, err := strconv.Atoi(testimonialID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":     "TESTIMONIAL_INVALID_ID",
+			"where":         "backend/internal/application/handler/testimonial.go",
+			"function":      function,
+			"userID":        userID,
+			"testimonialID": testimonialID,
+			"error":         err.Error(),
+		}).Warn("Invalid testimonial ID")
+		response.BadRequest(c, i18n.MsgTestimonialInvalidID)
+		return nil, false
+	}
+
+	testimonial, err := repos.Testimonial.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":     "TESTIMONIAL_NOT_FOUND",
+			"where":         "backend/internal/application/handler/testimonial.go",
+			"function":      function,
+			"userID":        userID,
+			"testimonialID": id,
+			"error":         err.Error(),
+		}).Warn("Testimonial not found")
+		response.NotFound(c, i18n.MsgTestimonialNotFound)
+		return nil, false
+	}
+
+	if testimonial.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":     "TESTIMONIAL_FORBIDDEN",
+			"where":         "backend/internal/application/handler/testimonial.go",
+			"function":      function,
+			"userID":        userID,
+			"testimonialID": id,
+			"ownerID":       testimonial.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "testimonial",
+			"resource_id":   testimonial.ID,
+			"owner_id":      testimonial.OwnerID,
+			"action":        function,
+		})
+		return nil, false
+	}
+
+	return testimonial, true
+}
+
+// ownedPortfolio loads the portfolio named by :id and checks it belongs to the
+// user, writing the error response otherwise
+func (h *TestimonialHandler) ownedPortfolio(c *gin.Context, function string) (*models.Portfolio, bool) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	portfolioID := c.Param("id")
+
+	id, err := strconv.Atoi(portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "TESTIMONIAL_INVALID_PORTFOLIO_ID",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    function,
+			"userID":      userID,
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid portfolio ID")
+		response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+		return nil, false
+	}
+
+	portfolio, err := repos.Portfolio.GetByIDBasic(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "TESTIMONIAL_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    function,
+			"userID":      userID,
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return nil, false
+	}
+
+	if portfolio.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "TESTIMONIAL_PORTFOLIO_FORBIDDEN",
+			"where":       "backend/internal/application/handler/testimonial.go",
+			"function":    function,
+			"userID":      userID,
+			"portfolioID": id,
+			"ownerID":     portfolio.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "portfolio",
+			"resource_id":   portfolio.ID,
+			"owner_id":      portfolio.OwnerID,
+			"action":        function,
+		})
+		return nil, false
+	}
+
+	return portfolio, true
+}
+
+// generateInviteToken returns the random token of an invite link
+func generateInviteToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
@@ -0,0 +1,509 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type TranslationHandler struct {
+	repos *repo.Repositories
+}
+
+func NewTranslationHandler(repos *repo.Repositories) *TranslationHandler {
+	return &TranslationHandler{
+		repos: repos,
+	}
+}
+
+// SetLocales sets the default locale of the portfolio content and the locales
+// it is published in
+func (h *TranslationHandler) SetLocales(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	portfolio, ok := h.ownedPortfolio(c, "SetLocales")
+	if !ok {
+		return
+	}
+
+	var req request.SetLocalesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SET_LOCALES_BAD_REQUEST",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    "SetLocales",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	defaultLocale, locales, err := validator.ValidateLocales(req.DefaultLocale, req.Locales)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SET_LOCALES_VALIDATION_ERROR",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    "SetLocales",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Warn("Locale validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Portfolio", portfolio.ID, portfolio.Version)
+	if !ok {
+		return
+	}
+
+	if err := repos.Portfolio.UpdateLocales(portfolio.ID, defaultLocale, locales, version); err != nil {
+		if versionConflict(c, "Portfolio", portfolio.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SET_LOCALES_DB_ERROR",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    "SetLocales",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to update portfolio locales")
+		response.InternalError(c, i18n.MsgPortfolioLocalesFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":     "SET_PORTFOLIO_LOCALES",
+		"portfolioID":   portfolio.ID,
+		"defaultLocale": defaultLocale,
+		"locales":       locales,
+		"userID":        userID,
+	}).Info("Portfolio locales updated successfully")
+
+	portfolio.DefaultLocale = defaultLocale
+	portfolio.Locales = locales
+	portfolio.Version = version + 1
+	setETag(c, portfolio.Version)
+	response.OK(c, "portfolio", dtoresponse.ToPortfolioResponse(portfolio), "Portfolio locales updated successfully")
+}
+
+// GetByPortfolio lists the translations of the portfolio, optionally only those of ?locale=
+func (h *TranslationHandler) GetByPortfolio(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	portfolio, ok := h.ownedPortfolio(c, "GetByPortfolio")
+	if !ok {
+		return
+	}
+
+	var locale string
+	if param := c.Query("locale"); param != "" {
+		locale, ok = h.enabledLocale(c, portfolio, param, "GetByPortfolio")
+		if !ok {
+			return
+		}
+	}
+
+	translations, err := repos.Translation.GetByPortfolioID(portfolio.ID, locale)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "GET_TRANSLATIONS_DB_ERROR",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    "GetByPortfolio",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to retrieve translations")
+		response.InternalError(c, i18n.MsgTranslationListFailed)
+		return
+	}
+
+	response.OK(c, "translations", dtoresponse.ToTranslationListResponse(translations), "Success")
+}
+
+// Save upserts the translations of one locale. Every item must name a field
+// the portfolio has; an empty value removes the translation.
+func (h *TranslationHandler) Save(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	portfolio, ok := h.ownedPortfolio(c, "Save")
+	if !ok {
+		return
+	}
+
+	locale, ok := h.enabledLocale(c, portfolio, c.Param("locale"), "Save")
+	if !ok {
+		return
+	}
+	if locale == portfolio.ContentLocales()[0] {
+		response.ErrorWithParams(c, http.StatusBadRequest, "", i18n.MsgTranslationDefaultLocale, i18n.Params{"locale": locale})
+		return
+	}
+
+	var req request.SaveTranslationsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SAVE_TRANSLATIONS_BAD_REQUEST",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    "Save",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	translations := make([]models.Translation, len(req.Translations))
+	for i, item := range req.Translations {
+		translations[i] = models.Translation{
+			PortfolioID:  portfolio.ID,
+			OwnerID:      userID,
+			Locale:       locale,
+			ResourceType: item.ResourceType,
+			ResourceID:   item.ResourceID,
+			Field:        item.Field,
+			Value:        item.Value,
+		}
+		if err := validator.ValidateTranslation(&translations[i]); err != nil {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation":   "SAVE_TRANSLATIONS_VALIDATION_ERROR",
+				"where":       "backend/internal/application/handler/translation.go",
+				"function":    "Save",
+				"userID":      userID,
+				"portfolioID": portfolio.ID,
+				"index":       i,
+				"error":       err.Error(),
+			}).Warn("Translation validation failed")
+			response.Invalid(c, err)
+			return
+		}
+	}
+
+	// Only fields of resources inside this portfolio can be translated
+	sources, err := repos.Translation.GetSources(portfolio.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SAVE_TRANSLATIONS_SOURCES_ERROR",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    "Save",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to load translatable fields")
+		response.InternalError(c, i18n.MsgTranslationSaveFailed)
+		return
+	}
+	resources := make(map[string]map[uint]bool)
+	for _, source := range sources {
+		if resources[source.ResourceType] == nil {
+			resources[source.ResourceType] = make(map[uint]bool)
+		}
+		resources[source.ResourceType][source.ResourceID] = true
+	}
+	for _, t := range translations {
+		if !resources[t.ResourceType][t.ResourceID] {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation":    "SAVE_TRANSLATIONS_RESOURCE_NOT_FOUND",
+				"where":        "backend/internal/application/handler/translation.go",
+				"function":     "Save",
+				"userID":       userID,
+				"portfolioID":  portfolio.ID,
+				"resourceType": t.ResourceType,
+				"resourceID":   t.ResourceID,
+			}).Warn("Translated resource is not part of the portfolio")
+			response.ErrorWithParams(c, http.StatusBadRequest, "", i18n.MsgTranslationResourceNotFound, i18n.Params{
+				"resource": i18n.Message{Key: i18n.Resource(t.ResourceType)},
+				"id":       t.ResourceID,
+			})
+			return
+		}
+	}
+
+	if err := repos.Translation.Save(translations); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SAVE_TRANSLATIONS_DB_ERROR",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    "Save",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"locale":      locale,
+			"error":       err.Error(),
+		}).Error("Failed to save translations")
+		response.InternalError(c, i18n.MsgTranslationSaveFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation":   "SAVE_TRANSLATIONS",
+		"portfolioID": portfolio.ID,
+		"locale":      locale,
+		"count":       len(translations),
+		"userID":      userID,
+	}).Info("Translations saved successfully")
+
+	saved, err := repos.Translation.GetByPortfolioID(portfolio.ID, locale)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "SAVE_TRANSLATIONS_RELOAD_ERROR",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    "Save",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to retrieve translations")
+		response.InternalError(c, i18n.MsgTranslationListFailed)
+		return
+	}
+
+	response.OK(c, "translations", dtoresponse.ToTranslationListResponse(saved), "Translations saved successfully")
+}
+
+// DeleteLocale removes every translation of the portfolio in :locale. The
+// locale stays enabled; its content falls back to the default locale.
+func (h *TranslationHandler) DeleteLocale(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	portfolio, ok := h.ownedPortfolio(c, "DeleteLocale")
+	if !ok {
+		return
+	}
+
+	// Translations of a locale that was disabled since can still be removed
+	locale, valid := i18n.Canonical(c.Param("locale"))
+	if !valid {
+		response.ErrorWithParams(c, http.StatusBadRequest, response.CodeValidationFailed, i18n.MsgValidationLocale, i18n.Params{
+			"field": i18n.Message{Key: "field.locale"},
+		})
+		return
+	}
+
+	deleted, err := repos.Translation.DeleteLocale(portfolio.ID, locale)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "DELETE_TRANSLATIONS_DB_ERROR",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    "DeleteLocale",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"locale":      locale,
+			"error":       err.Error(),
+		}).Error("Failed to delete translations")
+		response.InternalError(c, i18n.MsgTranslationDeleteFailed)
+		return
+	}
+
+	audit.GetDeleteLogger().WithFields(logrus.Fields{
+		"operation":   "DELETE_TRANSLATIONS",
+		"portfolioID": portfolio.ID,
+		"locale":      locale,
+		"deleted":     deleted,
+		"userID":      userID,
+	}).Info("Translations deleted successfully")
+
+	response.OK(c, "deleted", deleted, "Translations deleted successfully")
+}
+
+// Completeness reports, for every enabled locale besides the default, how many
+// translatable fields are translated and which are missing
+func (h *TranslationHandler) Completeness(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	portfolio, ok := h.ownedPortfolio(c, "Completeness")
+	if !ok {
+		return
+	}
+
+	sources, err := repos.Translation.GetSources(portfolio.ID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "TRANSLATION_REPORT_SOURCES_ERROR",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    "Completeness",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to load translatable fields")
+		response.InternalError(c, i18n.MsgTranslationReportFailed)
+		return
+	}
+
+	translations, err := repos.Translation.GetByPortfolioID(portfolio.ID, "")
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "TRANSLATION_REPORT_DB_ERROR",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    "Completeness",
+			"userID":      userID,
+			"portfolioID": portfolio.ID,
+			"error":       err.Error(),
+		}).Error("Failed to retrieve translations")
+		response.InternalError(c, i18n.MsgTranslationReportFailed)
+		return
+	}
+
+	response.OK(c, "report", dtoresponse.ToCompletenessResponse(portfolio, sources, translations), "Success")
+}
+
+// ownedPortfolio loads the portfolio named by :id and checks it belongs to the
+// user, writing the error response otherwise
+func (h *TranslationHandler) ownedPortfolio(c *gin.Context, function string) (*models.Portfolio, bool) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	portfolioID := c.Param("id")
+
+	id, err := strconv.Atoi(portfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "TRANSLATION_INVALID_PORTFOLIO_ID",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    function,
+			"userID":      userID,
+			"portfolioID": portfolioID,
+			"error":       err.Error(),
+		}).Warn("Invalid portfolio ID")
+		response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+		return nil, false
+	}
+
+	portfolio, err := repos.Portfolio.GetByIDBasic(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "TRANSLATION_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    function,
+			"userID":      userID,
+			"portfolioID": id,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return nil, false
+	}
+
+	if portfolio.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "TRANSLATION_FORBIDDEN",
+			"where":       "backend/internal/application/handler/translation.go",
+			"function":    function,
+			"userID":      userID,
+			"portfolioID": id,
+			"ownerID":     portfolio.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "portfolio",
+			"resource_id":   portfolio.ID,
+			"owner_id":      portfolio.OwnerID,
+			"action":        function,
+		})
+		return nil, false
+	}
+
+	return portfolio, true
+}
+
+// enabledLocale returns value in canonical form when the portfolio is published
+// in it, writing a 400 otherwise
+func (h *TranslationHandler) enabledLocale(c *gin.Context, portfolio *models.Portfolio, value, function string) (string, bool) {
+	locale, valid := i18n.Canonical(value)
+	if valid {
+		for _, enabled := range portfolio.ContentLocales() {
+			if enabled == locale {
+				return locale, true
+			}
+		}
+	}
+
+	audit.GetErrorLogger().WithFields(logrus.Fields{
+		"operation":   "TRANSLATION_LOCALE_NOT_ENABLED",
+		"where":       "backend/internal/application/handler/translation.go",
+		"function":    function,
+		"userID":      c.GetString("userID"),
+		"portfolioID": portfolio.ID,
+		"locale":      value,
+	}).Warn("Locale not enabled for portfolio")
+	response.ErrorWithParams(c, http.StatusBadRequest, "", i18n.MsgTranslationLocaleNotEnabled, i18n.Params{"locale": value})
+	return "", false
+}
+
+// localize returns the translations to lay over a response about a resource of
+// a portfolio, or nil when it is served in the default locale. The locale comes
+// from ?lang=, else Accept-Language, matched against the portfolio's locales.
+// Owner requests get the stored content unless they pass ?lang=. A failed
+// lookup is logged and the stored content served.
+func localize(c *gin.Context, translations repo.TranslationRepository, resourceType string, id uint, function string) models.TranslationSet {
+	owner := c.GetString("userID") != ""
+	if !owner {
+		c.Writer.Header().Add("Vary", "Accept-Language")
+	}
+
+	lang := c.Query("lang")
+	header := c.GetHeader("Accept-Language")
+	if lang == "" && (owner || header == "") {
+		return nil
+	}
+
+	portfolio, err := translations.GetPortfolioOf(resourceType, id)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation":    "TRANSLATION_LOOKUP_ERROR",
+				"where":        "backend/internal/application/handler/translation.go",
+				"function":     function,
+				"resourceType": resourceType,
+				"resourceID":   id,
+				"error":        err.Error(),
+			}).Error("Failed to load portfolio locales")
+		}
+		return nil
+	}
+
+	locales := portfolio.ContentLocales()
+	locale := locales[0]
+	requested := lang
+	if requested == "" {
+		requested = header
+	}
+	if matched, ok := i18n.Match(requested, locales); ok {
+		locale = matched
+	}
+	c.Header("Content-Language", locale)
+	if locale == locales[0] {
+		return nil
+	}
+
+	rows, err := translations.GetByPortfolioID(portfolio.ID, locale)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":    "TRANSLATION_LOOKUP_ERROR",
+			"where":        "backend/internal/application/handler/translation.go",
+			"function":     function,
+			"resourceType": resourceType,
+			"resourceID":   id,
+			"locale":       locale,
+			"error":        err.Error(),
+		}).Error("Failed to load translations")
+		return nil
+	}
+	return models.NewTranslationSet(rows)
+}
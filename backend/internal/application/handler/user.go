@@ -1,46 +1,49 @@
 package handler
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"time"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/webhook"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// AuthentikSignatureHeader carries "t=<unix seconds>,v1=<hex hmac>", the
+	// scheme of outgoing webhooks (see webhook.Sign)
+	AuthentikSignatureHeader = "X-Authentik-Signature"
+
+	maxAuthentikEventSize = 64 << 10
+)
+
 type UserHandler struct {
-	portfolioRepo      repo.PortfolioRepository
-	categoryRepo       repo.CategoryRepository
-	sectionRepo        repo.SectionRepository
-	projectRepo        repo.ProjectRepository
-	sectionContentRepo repo.SectionContentRepository
+	repos *repo.Repositories
 }
 
-func NewUserHandler(
-	portfolioRepo repo.PortfolioRepository,
-	categoryRepo repo.CategoryRepository,
-	sectionRepo repo.SectionRepository,
-	projectRepo repo.ProjectRepository,
-	sectionContentRepo repo.SectionContentRepository,
-) *UserHandler {
+func NewUserHandler(repos *repo.Repositories) *UserHandler {
 	return &UserHandler{
-		portfolioRepo:      portfolioRepo,
-		categoryRepo:       categoryRepo,
-		sectionRepo:        sectionRepo,
-		projectRepo:        projectRepo,
-		sectionContentRepo: sectionContentRepo,
+		repos: repos,
 	}
 }
 
-// CleanupUserData deletes all data associated with a user
-// This endpoint should be called when a user is deleted from Authentik
+// CleanupUserData deletes all data associated with the authenticated user.
+// When a user is deleted in Authentik, AuthentikEvent does the same cleanup.
 // Thanks to CASCADE DELETE constraints, deleting portfolios will automatically
 // delete all related categories, sections, projects, and section_contents
 func (h *UserHandler) CleanupUserData(c *gin.Context) {
-	// This endpoint should only be accessible by admin users or webhook
-	// For now, we'll use the authenticated user ID
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID")
 
 	if userID == "" {
@@ -49,31 +52,41 @@ func (h *UserHandler) CleanupUserData(c *gin.Context) {
 			"where":     "backend/internal/application/handler/user.go",
 			"function":  "CleanupUserData",
 		}).Warn("User ID is required")
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "User ID is required",
-		})
+		response.Error(c, http.StatusBadRequest, i18n.MsgUserIDRequired)
+		return
+	}
+
+	portfolioCount, totalSectionContentDeleted, err := h.deleteOwnerData(repos, userID)
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, i18n.MsgUserDeleteFailed)
 		return
 	}
 
+	c.JSON(http.StatusOK, gin.H{
+		"message":               "User data cleaned up successfully",
+		"portfoliosDeleted":     portfolioCount,
+		"sectionContentDeleted": totalSectionContentDeleted,
+	})
+}
+
+// deleteOwnerData deletes every portfolio of the owner and the content below
+// it, returning how many portfolios and section contents were removed
+func (h *UserHandler) deleteOwnerData(repos *repo.Repositories, userID string) (int, int, error) {
 	logrus.WithFields(logrus.Fields{
 		"userID": userID,
 	}).Info("Starting user data cleanup")
 
 	// Get all portfolios for this user
-	portfolios, _, err := h.portfolioRepo.GetByOwnerIDBasic(userID, 1000, 0)
+	portfolios, _, err := repos.Portfolio.GetByOwnerIDBasic(userID, 1000, 0)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "CLEANUP_USER_DATA_DB_ERROR",
 			"where":     "backend/internal/application/handler/user.go",
-			"function":  "CleanupUserData",
+			"function":  "deleteOwnerData",
 			"userID":    userID,
 			"error":     err.Error(),
 		}).Error("Failed to retrieve user portfolios for cleanup")
-
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to retrieve user data",
-		})
-		return
+		return 0, 0, err
 	}
 
 	portfolioCount := len(portfolios)
@@ -83,14 +96,14 @@ func (h *UserHandler) CleanupUserData(c *gin.Context) {
 	// But we need to manually delete section contents due to owner_id check
 	for _, portfolio := range portfolios {
 		// Get and delete all sections for this portfolio
-		sections, err := h.sectionRepo.GetByPortfolioID(fmt.Sprintf("%d", portfolio.ID))
+		sections, err := repos.Section.GetByPortfolioID(fmt.Sprintf("%d", portfolio.ID))
 		if err == nil {
 			for _, section := range sections {
 				// Delete section content (not covered by CASCADE due to owner_id check)
-				sectionContents, err := h.sectionContentRepo.GetBySectionID(section.ID)
+				sectionContents, err := repos.SectionContent.GetBySectionID(section.ID)
 				if err == nil {
 					for _, content := range sectionContents {
-						if err := h.sectionContentRepo.Delete(content.ID); err != nil {
+						if err := repos.SectionContent.Delete(content.ID, 0); err != nil {
 							logrus.WithFields(logrus.Fields{
 								"userID":    userID,
 								"contentID": content.ID,
@@ -105,20 +118,16 @@ func (h *UserHandler) CleanupUserData(c *gin.Context) {
 		}
 
 		// Delete the portfolio (CASCADE will handle categories, sections, and projects)
-		if err := h.portfolioRepo.Delete(portfolio.ID); err != nil {
+		if err := repos.Portfolio.Delete(portfolio.ID, 0); err != nil {
 			audit.GetErrorLogger().WithFields(logrus.Fields{
 				"operation":   "CLEANUP_USER_DATA_DELETE_ERROR",
 				"where":       "backend/internal/application/handler/user.go",
-				"function":    "CleanupUserData",
+				"function":    "deleteOwnerData",
 				"userID":      userID,
 				"portfolioID": portfolio.ID,
 				"error":       err.Error(),
 			}).Error("Failed to delete portfolio during user cleanup")
-
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": "Failed to delete user data",
-			})
-			return
+			return 0, 0, err
 		}
 
 		logrus.WithFields(logrus.Fields{
@@ -128,22 +137,159 @@ func (h *UserHandler) CleanupUserData(c *gin.Context) {
 		}).Info("Portfolio deleted as part of user cleanup (CASCADE: categories, sections, projects)")
 	}
 
+	// The skills taxonomy belongs to the user, not to a portfolio
+	if _, err := repos.Skill.DeleteByOwnerID(userID); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "CLEANUP_USER_DATA_SKILLS_ERROR",
+			"where":     "backend/internal/application/handler/user.go",
+			"function":  "deleteOwnerData",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Error("Failed to delete skills during user cleanup")
+		return 0, 0, err
+	}
+
+	// Saved templates too; portfolios created from them are gone already
+	if _, err := repos.Template.DeleteByOwnerID(userID); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "CLEANUP_USER_DATA_TEMPLATES_ERROR",
+			"where":     "backend/internal/application/handler/user.go",
+			"function":  "deleteOwnerData",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Error("Failed to delete templates during user cleanup")
+		return 0, 0, err
+	}
+
 	logrus.WithFields(logrus.Fields{
 		"userID":                userID,
 		"portfolioCount":        portfolioCount,
 		"sectionContentDeleted": totalSectionContentDeleted,
 	}).Info("User data cleanup completed successfully")
 
+	return portfolioCount, totalSectionContentDeleted, nil
+}
+
+// AuthentikEvent handles user lifecycle events sent by Authentik. The body must
+// be signed with AUTHENTIK_WEBHOOK_SECRET in the X-Authentik-Signature header,
+// with a timestamp within webhook.Tolerance so old events can't be replayed.
+// Deleted users lose all their data, deactivated users are frozen (read-only,
+// hidden from public endpoints) until reactivated, and renames are recorded.
+// Every action is stored as a UserLifecycleEvent.
+func (h *UserHandler) AuthentikEvent(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	secret := os.Getenv("AUTHENTIK_WEBHOOK_SECRET")
+	if secret == "" {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "AUTHENTIK_EVENT_NOT_CONFIGURED",
+			"where":     "backend/internal/application/handler/user.go",
+			"function":  "AuthentikEvent",
+		}).Warn("AUTHENTIK_WEBHOOK_SECRET is not set")
+		response.Error(c, http.StatusServiceUnavailable, i18n.MsgWebhookAuthentikNotConfigured)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxAuthentikEventSize))
+	if err != nil || !webhook.VerifyAt(secret, c.GetHeader(AuthentikSignatureHeader), body, time.Now()) {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "AUTHENTIK_EVENT_INVALID_SIGNATURE",
+			"where":     "backend/internal/application/handler/user.go",
+			"function":  "AuthentikEvent",
+			"clientIP":  c.ClientIP(),
+		}).Warn("Rejected Authentik event with an invalid or expired signature")
+		response.ErrorWithCode(c, http.StatusUnauthorized, response.CodeInvalidSignature, i18n.MsgWebhookInvalidSignature)
+		return
+	}
+
+	var req request.AuthentikUserEvent
+	if err := json.Unmarshal(body, &req); err == nil {
+		err = binding.Validator.ValidateStruct(&req)
+	}
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "AUTHENTIK_EVENT_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/user.go",
+			"function":  "AuthentikEvent",
+			"error":     err.Error(),
+		}).Warn("Invalid Authentik event")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	status, err := repos.UserStatus.GetByOwnerID(req.UserID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "AUTHENTIK_EVENT_DB_ERROR",
+			"where":     "backend/internal/application/handler/user.go",
+			"function":  "AuthentikEvent",
+			"userID":    req.UserID,
+			"error":     err.Error(),
+		}).Error("Failed to load user status")
+		response.Error(c, http.StatusInternalServerError, i18n.MsgWebhookProcessFailed)
+		return
+	}
+	if req.Username != "" {
+		status.Username = req.Username
+	}
+
+	event := &models.UserLifecycleEvent{
+		OwnerID: req.UserID,
+		Event:   req.Event,
+	}
+
+	switch req.Event {
+	case "user.deleted":
+		portfolios, contents, err := h.deleteOwnerData(repos, req.UserID)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, i18n.MsgUserDeleteFailed)
+			return
+		}
+		status.Status = models.UserStatusDeleted
+		event.Action = models.UserActionDataDeleted
+		event.Details = fmt.Sprintf("portfolios deleted: %d, section contents deleted: %d", portfolios, contents)
+	case "user.deactivated":
+		status.Status = models.UserStatusSuspended
+		event.Action = models.UserActionFrozen
+	case "user.reactivated":
+		status.Status = models.UserStatusActive
+		event.Action = models.UserActionUnfrozen
+	case "user.renamed":
+		event.Action = models.UserActionRenamed
+		event.Details = fmt.Sprintf("%s -> %s", req.PreviousUsername, req.Username)
+	}
+
+	if err := repos.UserStatus.Save(status, event); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "AUTHENTIK_EVENT_DB_ERROR",
+			"where":     "backend/internal/application/handler/user.go",
+			"function":  "AuthentikEvent",
+			"userID":    req.UserID,
+			"event":     req.Event,
+			"error":     err.Error(),
+		}).Error("Failed to record user status")
+		response.Error(c, http.StatusInternalServerError, i18n.MsgWebhookProcessFailed)
+		return
+	}
+
+	audit.GetUpdateLogger().WithFields(logrus.Fields{
+		"operation": "AUTHENTIK_USER_EVENT",
+		"userID":    req.UserID,
+		"event":     req.Event,
+		"action":    event.Action,
+		"status":    status.Status,
+		"details":   event.Details,
+	}).Info("Applied Authentik user event")
+
 	c.JSON(http.StatusOK, gin.H{
-		"message":               "User data cleaned up successfully",
-		"portfoliosDeleted":     portfolioCount,
-		"sectionContentDeleted": totalSectionContentDeleted,
+		"message": "Event processed",
+		"data":    event,
 	})
 }
 
 // GetUserDataSummary returns a summary of all data owned by a user
 // Useful for showing users what will be deleted before cleanup
 func (h *UserHandler) GetUserDataSummary(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
 	userID := c.GetString("userID")
 
 	if userID == "" {
@@ -152,14 +298,12 @@ func (h *UserHandler) GetUserDataSummary(c *gin.Context) {
 			"where":     "backend/internal/application/handler/user.go",
 			"function":  "GetUserDataSummary",
 		}).Warn("User ID is required")
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "User ID is required",
-		})
+		response.Error(c, http.StatusBadRequest, i18n.MsgUserIDRequired)
 		return
 	}
 
 	// Get all portfolios for this user
-	portfolios, _, err := h.portfolioRepo.GetByOwnerIDBasic(userID, 1000, 0)
+	portfolios, _, err := repos.Portfolio.GetByOwnerIDBasic(userID, 1000, 0)
 	if err != nil {
 		audit.GetErrorLogger().WithFields(logrus.Fields{
 			"operation": "GET_USER_DATA_SUMMARY_DB_ERROR",
@@ -168,30 +312,31 @@ func (h *UserHandler) GetUserDataSummary(c *gin.Context) {
 			"userID":    userID,
 			"error":     err.Error(),
 		}).Error("Failed to retrieve user data")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Failed to retrieve user data",
-		})
+		response.Error(c, http.StatusInternalServerError, i18n.MsgUserLoadFailed)
 		return
 	}
 
 	// Count all related data
-	var totalCategories, totalSections, totalProjects int
+	var totalCategories, totalSections int
+	projectIDs := make(map[uint]bool) // A project may be in several categories
 
 	for _, portfolio := range portfolios {
-		categories, err := h.categoryRepo.GetByPortfolioID(fmt.Sprintf("%d", portfolio.ID))
+		categories, err := repos.Category.GetByPortfolioID(fmt.Sprintf("%d", portfolio.ID))
 		if err == nil {
 			totalCategories += len(categories)
 
 			// Count projects in each category
 			for _, category := range categories {
-				projects, err := h.projectRepo.GetByCategoryID(fmt.Sprintf("%d", category.ID))
+				projects, err := repos.Project.GetByCategoryID(fmt.Sprintf("%d", category.ID))
 				if err == nil {
-					totalProjects += len(projects)
+					for _, project := range projects {
+						projectIDs[project.ID] = true
+					}
 				}
 			}
 		}
 
-		sections, err := h.sectionRepo.GetByPortfolioID(fmt.Sprintf("%d", portfolio.ID))
+		sections, err := repos.Section.GetByPortfolioID(fmt.Sprintf("%d", portfolio.ID))
 		if err == nil {
 			totalSections += len(sections)
 		}
@@ -202,8 +347,8 @@ func (h *UserHandler) GetUserDataSummary(c *gin.Context) {
 		"portfolios": len(portfolios),
 		"categories": totalCategories,
 		"sections":   totalSections,
-		"projects":   totalProjects,
-		"totalItems": len(portfolios) + totalCategories + totalSections + totalProjects,
+		"projects":   len(projectIDs),
+		"totalItems": len(portfolios) + totalCategories + totalSections + len(projectIDs),
 	}
 
 	logrus.WithFields(logrus.Fields{
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// checkVersion returns the version a write must match. When the request has an
+// If-Match header it must equal the stored version, otherwise a 412 is written
+// and false returned. Without the header the stored version is used, so the
+// repository still rejects a concurrent write that lands between read and update.
+func checkVersion(c *gin.Context, resource string, id uint, current uint) (uint, bool) {
+	expected, ok := middleware.IfMatchVersion(c)
+	if !ok || expected == current {
+		return current, true
+	}
+
+	audit.GetErrorLogger().WithFields(logrus.Fields{
+		"operation":  "VERSION_MISMATCH",
+		"where":      "backend/internal/application/handler/version.go",
+		"function":   "checkVersion",
+		"userID":     c.GetString("userID"),
+		"resource":   resource,
+		"resourceID": id,
+		"expected":   expected,
+		"current":    current,
+	}).Warn("If-Match version does not match stored version")
+	resourceModified(c, resource)
+	return 0, false
+}
+
+// versionConflict writes a 412 and returns true when err is a repository version conflict
+func versionConflict(c *gin.Context, resource string, id uint, err error) bool {
+	if !errors.Is(err, repo.ErrVersionConflict) {
+		return false
+	}
+
+	audit.GetErrorLogger().WithFields(logrus.Fields{
+		"operation":  "VERSION_CONFLICT",
+		"where":      "backend/internal/application/handler/version.go",
+		"function":   "versionConflict",
+		"userID":     c.GetString("userID"),
+		"resource":   resource,
+		"resourceID": id,
+	}).Warn("Concurrent modification detected")
+	resourceModified(c, resource)
+	return true
+}
+
+// resourceModified writes the 412 of a lost update to resource, e.g. "Project"
+func resourceModified(c *gin.Context, resource string) {
+	response.ErrorWithParams(c, http.StatusPreconditionFailed, "", i18n.MsgResourceModified, i18n.Params{
+		"resource": i18n.Message{Key: i18n.Resource(resource)},
+	})
+}
+
+// setETag exposes the resource version so clients can send it back in If-Match.
+// Only owner requests get it; public responses keep the body-hash ETag from HTTPCache.
+func setETag(c *gin.Context, version uint) {
+	if c.GetString("userID") == "" {
+		return
+	}
+	c.Header("ETag", middleware.FormatETag(version))
+}
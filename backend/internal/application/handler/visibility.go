@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/sirupsen/logrus"
+)
+
+// ownerHidden reports whether the owner was deactivated or deleted in Authentik,
+// in which case their content isn't served publicly. A failed lookup is logged
+// and treated as visible rather than hiding every portfolio.
+func ownerHidden(statuses repo.UserStatusRepository, ownerID string, function string) bool {
+	hidden, err := statuses.IsHidden(ownerID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "OWNER_STATUS_LOOKUP_ERROR",
+			"where":     "backend/internal/application/handler/visibility.go",
+			"function":  function,
+			"ownerID":   ownerID,
+			"error":     err.Error(),
+		}).Error("Failed to check owner status")
+		return false
+	}
+	return hidden
+}
@@ -0,0 +1,409 @@
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	dtoresponse "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type WebhookHandler struct {
+	repos *repo.Repositories
+}
+
+func NewWebhookHandler(repos *repo.Repositories) *WebhookHandler {
+	return &WebhookHandler{
+		repos: repos,
+	}
+}
+
+// GetByUser lists the user's webhooks, optionally filtered by ?portfolio_id=
+func (h *WebhookHandler) GetByUser(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	page := 1
+	if pageStr := c.Query("page"); pageStr != "" {
+		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
+			page = p
+		}
+	}
+
+	limit := 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+
+	var portfolioID uint
+	if portfolioParam := c.Query("portfolio_id"); portfolioParam != "" {
+		id, err := strconv.Atoi(portfolioParam)
+		if err != nil || id < 1 {
+			response.BadRequest(c, i18n.MsgPortfolioInvalidID)
+			return
+		}
+		portfolioID = uint(id)
+	}
+
+	webhooks, total, err := repos.Webhook.GetByOwnerID(userID, portfolioID, limit, (page-1)*limit)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "GET_WEBHOOKS_BY_USER_DB_ERROR",
+			"where":     "backend/internal/application/handler/webhook.go",
+			"function":  "GetByUser",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Error("Failed to retrieve webhooks")
+		response.InternalError(c, i18n.MsgWebhookListFailed)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "webhooks", dtoresponse.ToWebhookListResponse(webhooks), page, limit, total)
+}
+
+func (h *WebhookHandler) GetByID(c *gin.Context) {
+	webhook, ok := h.ownedWebhook(c, "GetByID")
+	if !ok {
+		return
+	}
+
+	setETag(c, webhook.Version)
+	response.OK(c, "webhook", dtoresponse.ToWebhookResponse(webhook), "Success")
+}
+
+func (h *WebhookHandler) Create(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	var req request.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "CREATE_WEBHOOK_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/webhook.go",
+			"function":  "Create",
+			"userID":    userID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	webhook := models.Webhook{
+		PortfolioID: req.PortfolioID,
+		OwnerID:     userID,
+		URL:         req.URL,
+		Secret:      req.Secret,
+		Events:      req.Events,
+		Active:      req.Active == nil || *req.Active,
+	}
+
+	if err := validator.ValidateWebhook(&webhook); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_WEBHOOK_VALIDATION_ERROR",
+			"where":       "backend/internal/application/handler/webhook.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Webhook validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	// Check if portfolio exists and belongs to user
+	portfolio, err := repos.Portfolio.GetByIDBasic(req.PortfolioID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_WEBHOOK_PORTFOLIO_NOT_FOUND",
+			"where":       "backend/internal/application/handler/webhook.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Warn("Portfolio not found")
+		response.NotFound(c, i18n.MsgPortfolioNotFound)
+		return
+	}
+	if portfolio.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_WEBHOOK_FORBIDDEN",
+			"where":       "backend/internal/application/handler/webhook.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"ownerID":     portfolio.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "portfolio",
+			"resource_id":   portfolio.ID,
+			"owner_id":      portfolio.OwnerID,
+			"action":        "create_webhook",
+		})
+		return
+	}
+
+	if webhook.Secret == "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation": "CREATE_WEBHOOK_SECRET_ERROR",
+				"where":     "backend/internal/application/handler/webhook.go",
+				"function":  "Create",
+				"userID":    userID,
+				"error":     err.Error(),
+			}).Error("Failed to generate webhook secret")
+			response.InternalError(c, i18n.MsgWebhookCreateFailed)
+			return
+		}
+		webhook.Secret = secret
+	}
+
+	if err := repos.Webhook.Create(&webhook); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "CREATE_WEBHOOK_DB_ERROR",
+			"where":       "backend/internal/application/handler/webhook.go",
+			"function":    "Create",
+			"userID":      userID,
+			"portfolioID": req.PortfolioID,
+			"error":       err.Error(),
+		}).Error("Failed to create webhook")
+		response.InternalError(c, i18n.MsgWebhookCreateFailed)
+		return
+	}
+
+	audit.GetCreateLogger().WithFields(logrus.Fields{
+		"operation":   "CREATE_WEBHOOK",
+		"webhookID":   webhook.ID,
+		"portfolioID": webhook.PortfolioID,
+		"userID":      userID,
+	}).Info("Webhook created successfully")
+
+	// The secret is only ever shown here
+	result := dtoresponse.ToWebhookResponse(&webhook)
+	result.Secret = webhook.Secret
+	response.Created(c, "webhook", result, "Webhook created successfully")
+}
+
+func (h *WebhookHandler) Update(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedWebhook(c, "Update")
+	if !ok {
+		return
+	}
+
+	var req request.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "UPDATE_WEBHOOK_BAD_REQUEST",
+			"where":     "backend/internal/application/handler/webhook.go",
+			"function":  "Update",
+			"userID":    userID,
+			"webhookID": existing.ID,
+			"error":     err.Error(),
+		}).Warn("Invalid request data")
+		response.ValidationFailed(c, i18n.MsgInvalidRequest, err)
+		return
+	}
+
+	// Reject the write if the client edited an outdated copy
+	version, ok := checkVersion(c, "Webhook", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	existing.URL = req.URL
+	existing.Events = req.Events
+	existing.Active = req.Active
+	existing.Version = version
+
+	if err := validator.ValidateWebhook(existing); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "UPDATE_WEBHOOK_VALIDATION_ERROR",
+			"where":     "backend/internal/application/handler/webhook.go",
+			"function":  "Update",
+			"userID":    userID,
+			"webhookID": existing.ID,
+			"error":     err.Error(),
+		}).Warn("Webhook validation failed")
+		response.Invalid(c, err)
+		return
+	}
+
+	if err := repos.Webhook.Update(existing); err != nil {
+		if versionConflict(c, "Webhook", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "UPDATE_WEBHOOK_DB_ERROR",
+			"where":     "backend/internal/application/handler/webhook.go",
+			"function":  "Update",
+			"userID":    userID,
+			"webhookID": existing.ID,
+			"error":     err.Error(),
+		}).Error("Failed to update webhook")
+		response.InternalError(c, i18n.MsgWebhookUpdateFailed)
+		return
+	}
+
+	setETag(c, existing.Version)
+	response.OK(c, "webhook", dtoresponse.ToWebhookResponse(existing), "Webhook updated successfully")
+}
+
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	existing, ok := h.ownedWebhook(c, "Delete")
+	if !ok {
+		return
+	}
+
+	// Reject the delete if the client saw an outdated copy
+	version, ok := checkVersion(c, "Webhook", existing.ID, existing.Version)
+	if !ok {
+		return
+	}
+
+	if err := repos.Webhook.Delete(existing.ID, version); err != nil {
+		if versionConflict(c, "Webhook", existing.ID, err) {
+			return
+		}
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "DELETE_WEBHOOK_DB_ERROR",
+			"where":     "backend/internal/application/handler/webhook.go",
+			"function":  "Delete",
+			"userID":    userID,
+			"webhookID": existing.ID,
+			"error":     err.Error(),
+		}).Error("Failed to delete webhook")
+		response.InternalError(c, i18n.MsgWebhookDeleteFailed)
+		return
+	}
+
+	audit.GetDeleteLogger().WithFields(logrus.Fields{
+		"operation":   "DELETE_WEBHOOK",
+		"webhookID":   existing.ID,
+		"portfolioID": existing.PortfolioID,
+		"userID":      userID,
+	}).Info("Webhook deleted successfully")
+
+	response.OK(c, "webhook", nil, "Webhook deleted successfully")
+}
+
+// GetDeliveries returns the delivery log of a webhook, newest first
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+
+	webhook, ok := h.ownedWebhook(c, "GetDeliveries")
+	if !ok {
+		return
+	}
+	page := 1
+	if pageStr := c.Query("page"); pageStr != "" {
+		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
+			page = p
+		}
+	}
+
+	limit := 10
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+
+	deliveries, total, err := repos.Webhook.GetDeliveries(webhook.ID, limit, (page-1)*limit)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "GET_WEBHOOK_DELIVERIES_DB_ERROR",
+			"where":     "backend/internal/application/handler/webhook.go",
+			"function":  "GetDeliveries",
+			"userID":    userID,
+			"webhookID": webhook.ID,
+			"error":     err.Error(),
+		}).Error("Failed to retrieve webhook deliveries")
+		response.InternalError(c, i18n.MsgWebhookDeliveriesFailed)
+		return
+	}
+
+	response.SuccessWithPagination(c, http.StatusOK, "deliveries", dtoresponse.ToWebhookDeliveryListResponse(deliveries), page, limit, total)
+}
+
+// ownedWebhook loads the webhook named by :id and checks it belongs to the
+// user, writing the error response otherwise
+func (h *WebhookHandler) ownedWebhook(c *gin.Context, function string) (*models.Webhook, bool) {
+	repos := h.repos.For(c.Request.Context())
+	userID := c.GetString("userID") // From auth middleware
+	webhookID := c.Param("id")
+
+	id, err := strconv.Atoi(webhookID)
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "WEBHOOK_INVALID_ID",
+			"where":     "backend/internal/application/handler/webhook.go",
+			"function":  function,
+			"userID":    userID,
+			"webhookID": webhookID,
+			"error":     err.Error(),
+		}).Warn("Invalid webhook ID")
+		response.BadRequest(c, i18n.MsgWebhookInvalidID)
+		return nil, false
+	}
+
+	webhook, err := repos.Webhook.GetByID(uint(id))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "WEBHOOK_NOT_FOUND",
+			"where":     "backend/internal/application/handler/webhook.go",
+			"function":  function,
+			"userID":    userID,
+			"webhookID": id,
+			"error":     err.Error(),
+		}).Warn("Webhook not found")
+		response.NotFound(c, i18n.MsgWebhookNotFound)
+		return nil, false
+	}
+
+	if webhook.OwnerID != userID {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "WEBHOOK_FORBIDDEN",
+			"where":     "backend/internal/application/handler/webhook.go",
+			"function":  function,
+			"userID":    userID,
+			"webhookID": id,
+			"ownerID":   webhook.OwnerID,
+		}).Warn("Access denied")
+		response.ForbiddenWithDetails(c, i18n.MsgAccessDenied, map[string]interface{}{
+			"resource_type": "webhook",
+			"resource_id":   webhook.ID,
+			"owner_id":      webhook.OwnerID,
+			"action":        function,
+		})
+		return nil, false
+	}
+
+	return webhook, true
+}
+
+// generateWebhookSecret returns a random 32-byte secret, hex encoded
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,81 @@
+package models
+
+import "time"
+
+// Kinds of pages whose views are recorded
+const (
+	ViewPortfolio = "portfolio"
+	ViewCategory  = "category"
+	ViewProject   = "project"
+)
+
+// Kinds of view sources counted in DailySourceView
+const (
+	SourceReferrer = "referrer" // Domain of the referring page
+	SourceCountry  = "country"  // ISO 3166-1 alpha-2 code of the visitor
+)
+
+// PageView is one view of a public page, kept until it is rolled up. Visitors
+// are identified by a hash salted with a salt that changes every day, so they
+// can't be recognized from one day to the next, and no IP is stored.
+type PageView struct {
+	ID           uint      `json:"id" gorm:"primarykey"`
+	PortfolioID  uint      `json:"portfolio_id" gorm:"not null;index"`
+	ResourceType string    `json:"resource_type" gorm:"type:varchar(20);not null"`
+	ResourceID   uint      `json:"resource_id" gorm:"not null"`
+	VisitorHash  string    `json:"-" gorm:"type:varchar(64);not null"`
+	Referrer     string    `json:"referrer" gorm:"type:varchar(255);not null;default:''"` // Domain, empty for direct visits
+	Country      string    `json:"country" gorm:"type:varchar(2);not null;default:''"`    // Empty when unknown
+	ViewedAt     time.Time `json:"viewed_at" gorm:"not null;index"`
+}
+
+// AnalyticsSalt is the salt of visitor hashes on one day (UTC). Salts are
+// random and deleted once their day is over.
+type AnalyticsSalt struct {
+	Day  time.Time `gorm:"type:date;primaryKey"`
+	Salt string    `gorm:"type:varchar(64);not null"`
+}
+
+// DailyView counts the views of a portfolio's public pages on one day (UTC)
+type DailyView struct {
+	PortfolioID uint      `json:"portfolio_id" gorm:"primaryKey;autoIncrement:false"`
+	Day         time.Time `json:"day" gorm:"type:date;primaryKey"`
+	Views       int64     `json:"views" gorm:"not null;default:0"`
+	Visitors    int64     `json:"visitors" gorm:"not null;default:0"`
+}
+
+// DailyResourceView counts the views of one category or project page on one day
+type DailyResourceView struct {
+	PortfolioID  uint      `json:"portfolio_id" gorm:"primaryKey;autoIncrement:false"`
+	Day          time.Time `json:"day" gorm:"type:date;primaryKey"`
+	ResourceType string    `json:"resource_type" gorm:"type:varchar(20);primaryKey"`
+	ResourceID   uint      `json:"resource_id" gorm:"primaryKey;autoIncrement:false"`
+	Views        int64     `json:"views" gorm:"not null;default:0"`
+	Visitors     int64     `json:"visitors" gorm:"not null;default:0"`
+}
+
+// DailySourceView counts the views of a portfolio coming from one referrer
+// domain or one country on one day
+type DailySourceView struct {
+	PortfolioID uint      `json:"portfolio_id" gorm:"primaryKey;autoIncrement:false"`
+	Day         time.Time `json:"day" gorm:"type:date;primaryKey"`
+	Kind        string    `json:"kind" gorm:"type:varchar(10);primaryKey"`
+	Value       string    `json:"value" gorm:"type:varchar(255);primaryKey"`
+	Views       int64     `json:"views" gorm:"not null;default:0"`
+	Visitors    int64     `json:"visitors" gorm:"not null;default:0"`
+}
+
+// ResourceViews is a category or project with its views over a period
+type ResourceViews struct {
+	ID       uint   `json:"id"`
+	Title    string `json:"title"`
+	Views    int64  `json:"views"`
+	Visitors int64  `json:"visitors"`
+}
+
+// SourceViews is a referrer domain or country with its views over a period
+type SourceViews struct {
+	Value    string `json:"value"`
+	Views    int64  `json:"views"`
+	Visitors int64  `json:"visitors"`
+}
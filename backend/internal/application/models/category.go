@@ -9,5 +9,6 @@ type Category struct {
 	Position    uint      `json:"position" gorm:"default:0"`
 	OwnerID     string    `json:"ownerId,omitempty"`
 	PortfolioID uint      `json:"portfolio_id"`
+	Version     uint      `json:"version" gorm:"not null;default:1"`
 	Projects    []Project `json:"projects" gorm:"foreignKey:CategoryID;constraint:OnDelete:CASCADE"`
 }
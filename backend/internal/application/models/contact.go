@@ -0,0 +1,78 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// States of contact messages in the owner's inbox
+const (
+	ContactUnread   = "unread"
+	ContactRead     = "read"
+	ContactArchived = "archived"
+)
+
+// ContactMessage is a message a visitor sent through a portfolio's contact form
+type ContactMessage struct {
+	gorm.Model
+	PortfolioID uint       `json:"portfolio_id" gorm:"not null;index"`
+	OwnerID     string     `json:"ownerId,omitempty" gorm:"type:varchar(255);not null;index:idx_contact_messages_inbox"`
+	Name        string     `json:"name" gorm:"type:varchar(100);not null"`
+	Email       string     `json:"email" gorm:"type:varchar(255);not null"`
+	Subject     string     `json:"subject" gorm:"type:varchar(200)"`
+	Body        string     `json:"message" gorm:"type:text;not null"`
+	Status      string     `json:"status" gorm:"type:varchar(20);not null;default:unread;index:idx_contact_messages_inbox"`
+	ReadAt      *time.Time `json:"read_at,omitempty"`
+	// AutoRepliedAt is when the visitor was sent the auto-reply, if they were
+	AutoRepliedAt *time.Time `json:"-" gorm:"index"`
+	Version       uint       `json:"version" gorm:"not null;default:1"`
+}
+
+// Auto-replies go to an address nobody verified, so they are capped: an
+// address gets at most one per portfolio and a portfolio sends at most
+// AutoRepliesPerPortfolio within AutoReplyWindow
+const (
+	AutoReplyWindow         = 24 * time.Hour
+	AutoRepliesPerPortfolio = 20
+)
+
+// VisitorPlaceholders are the placeholders auto-replies used to fill in with
+// what the visitor typed; they are no longer replaced, so nobody can make the
+// server mail text of their choosing to an address of their choosing
+var VisitorPlaceholders = []string{"{name}", "{subject}"}
+
+// ContactSettings configures the contact form of a portfolio. Portfolios
+// without a row accept messages but notify no one.
+type ContactSettings struct {
+	PortfolioID uint   `json:"portfolio_id" gorm:"primaryKey;autoIncrement:false"`
+	OwnerID     string `json:"ownerId,omitempty" gorm:"type:varchar(255);not null;index"`
+	Enabled     bool   `json:"enabled" gorm:"not null"`
+	NotifyEmail string `json:"notify_email" gorm:"type:varchar(255)"` // Where new messages are announced
+	// Auto-reply sent to visitors; {portfolio} is replaced by the portfolio
+	// title. Nothing the visitor typed is put in it.
+	AutoReply        bool      `json:"auto_reply" gorm:"not null;default:false"`
+	AutoReplySubject string    `json:"auto_reply_subject" gorm:"type:varchar(200)"`
+	AutoReplyBody    string    `json:"auto_reply_body" gorm:"type:text"`
+	Version          uint      `json:"version" gorm:"not null;default:1"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// DefaultContactSettings returns the settings of a portfolio that has none
+// stored, at version 1
+func DefaultContactSettings(portfolio *Portfolio) *ContactSettings {
+	return &ContactSettings{PortfolioID: portfolio.ID, OwnerID: portfolio.OwnerID, Enabled: true, Version: 1}
+}
+
+// RenderAutoReply fills the auto-reply templates in for the portfolio.
+// Visitor placeholders saved before they were dropped are left out.
+func (s *ContactSettings) RenderAutoReply(portfolioTitle string) (string, string) {
+	replacer := strings.NewReplacer(
+		"{name}", "",
+		"{subject}", "",
+		"{portfolio}", portfolioTitle,
+	)
+	return replacer.Replace(s.AutoReplySubject), replacer.Replace(s.AutoReplyBody)
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Education is a degree or course in a portfolio. Position orders the
+// education of a portfolio.
+type Education struct {
+	gorm.Model
+	PortfolioID uint       `json:"portfolio_id" gorm:"not null;index"`
+	OwnerID     string     `json:"ownerId,omitempty" gorm:"type:varchar(255);not null;index"`
+	Institution string     `json:"institution" gorm:"type:varchar(150);not null"`
+	Degree      string     `json:"degree" gorm:"type:varchar(150);not null"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	EndDate     *time.Time `json:"end_date,omitempty"` // Or the expected graduation
+	Position    uint       `json:"position" gorm:"default:0"`
+	Version     uint       `json:"version" gorm:"not null;default:1"`
+}
+
+func (Education) TableName() string {
+	return "education"
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Experience is a position in the work history of a portfolio. Position
+// orders the experiences of a portfolio; ProjectIDs links projects shown in
+// the portfolio that were done there.
+type Experience struct {
+	gorm.Model
+	PortfolioID uint        `json:"portfolio_id" gorm:"not null;index"`
+	OwnerID     string      `json:"ownerId,omitempty" gorm:"type:varchar(255);not null;index"`
+	Company     string      `json:"company" gorm:"type:varchar(100);not null"`
+	Title       string      `json:"title" gorm:"type:varchar(100);not null"`
+	Location    string      `json:"location,omitempty" gorm:"type:varchar(100)"`
+	StartDate   time.Time   `json:"start_date" gorm:"not null"`
+	EndDate     *time.Time  `json:"end_date,omitempty"`
+	Current     bool        `json:"current" gorm:"not null;default:false"`
+	Highlights  StringArray `json:"highlights,omitempty" gorm:"type:text[]"`
+	Position    uint        `json:"position" gorm:"default:0"`
+	Version     uint        `json:"version" gorm:"not null;default:1"`
+
+	// ProjectIDs lists the linked projects, stored in experience_projects
+	ProjectIDs []uint `json:"project_ids,omitempty" gorm:"-"`
+}
+
+// ExperienceProject links a project to an experience
+type ExperienceProject struct {
+	ExperienceID uint      `json:"experience_id" gorm:"primaryKey;autoIncrement:false"`
+	ProjectID    uint      `json:"project_id" gorm:"primaryKey;autoIncrement:false;index"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func (Experience) TableName() string {
+	return "experiences"
+}
+
+func (ExperienceProject) TableName() string {
+	return "experience_projects"
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// IdempotencyKey stores the outcome of a create request so that a retry with
+// the same Idempotency-Key header replays it instead of creating a duplicate.
+// A zero StatusCode means the first request is still being processed.
+type IdempotencyKey struct {
+	ID           uint      `json:"id" gorm:"primarykey"`
+	Key          string    `json:"key" gorm:"type:varchar(255);not null;uniqueIndex:idx_idempotency_keys_owner_key"`
+	OwnerID      string    `json:"owner_id" gorm:"type:varchar(255);not null;uniqueIndex:idx_idempotency_keys_owner_key"`
+	Method       string    `json:"method" gorm:"type:varchar(10);not null"`
+	Path         string    `json:"path" gorm:"type:varchar(255);not null"`
+	RequestHash  string    `json:"request_hash" gorm:"type:varchar(64);not null"`
+	StatusCode   int       `json:"status_code" gorm:"not null;default:0"`
+	ContentType  string    `json:"content_type" gorm:"type:varchar(255)"`
+	ResponseBody []byte    `json:"-" gorm:"type:bytea"`
+	CreatedAt    time.Time `json:"created_at"`
+	ExpiresAt    time.Time `json:"expires_at" gorm:"not null;index"`
+}
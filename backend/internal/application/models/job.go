@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// Job statuses
+const (
+	JobPending   = "pending"   // Waiting for run_at
+	JobRunning   = "running"   // Held by a worker until locked_until
+	JobSucceeded = "succeeded" // Kept for a while, then purged
+	JobDead      = "dead"      // Out of attempts or failed for good; only retried by hand
+)
+
+// JobStatuses lists every status a job can have
+var JobStatuses = []string{JobPending, JobRunning, JobSucceeded, JobDead}
+
+// Job is a unit of background work stored in the queue. Workers claim due
+// jobs with SELECT ... FOR UPDATE SKIP LOCKED, so each job runs on one worker
+// at a time; a job whose worker stopped answering is claimed again once
+// locked_until, its visibility timeout, has passed.
+type Job struct {
+	ID          uint       `json:"id" gorm:"primarykey"`
+	Type        string     `json:"type" gorm:"type:varchar(100);not null;index"`
+	Payload     []byte     `json:"-" gorm:"type:jsonb;not null"`
+	Status      string     `json:"status" gorm:"type:varchar(10);not null;default:pending;index:idx_jobs_due,priority:1"`
+	RunAt       time.Time  `json:"run_at" gorm:"not null;index:idx_jobs_due,priority:2"`
+	Attempts    int        `json:"attempts" gorm:"not null;default:0"`
+	MaxAttempts int        `json:"max_attempts" gorm:"not null;default:5"`
+	Timeout     int        `json:"timeout" gorm:"not null;default:60"`                          // Seconds an attempt may take
+	Schedule    string     `json:"schedule" gorm:"type:varchar(100);not null;default:'';index"` // Empty unless enqueued by a schedule
+	LockedBy    string     `json:"locked_by" gorm:"type:varchar(255);not null;default:''"`
+	LockedUntil *time.Time `json:"locked_until"`
+	LastError   string     `json:"last_error" gorm:"type:varchar(500);not null;default:''"`
+	FinishedAt  *time.Time `json:"finished_at" gorm:"index"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// JobSchedule enqueues a job of its type whenever it comes due. The next run
+// is stored, so only one server instance enqueues each run and restarts don't
+// delay it.
+type JobSchedule struct {
+	Name      string     `json:"name" gorm:"type:varchar(100);primaryKey"`
+	Spec      string     `json:"spec" gorm:"type:varchar(100);not null"`
+	Type      string     `json:"type" gorm:"type:varchar(100);not null"`
+	NextRunAt time.Time  `json:"next_run_at" gorm:"not null"`
+	LastRunAt *time.Time `json:"last_run_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// JobCount is how many jobs of a type are in a status
+type JobCount struct {
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	Count  int64  `json:"count"`
+}
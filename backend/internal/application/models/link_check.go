@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+// Link check statuses
+const (
+	LinkPending = "pending" // Not checked yet
+	LinkOK      = "ok"
+	LinkBroken  = "broken"
+)
+
+// Places a checked URL is stored in
+const (
+	LinkSourceProject        = "project"         // Link or links of a project
+	LinkSourceSectionContent = "section_content" // Image section content
+)
+
+// LinkCheck is the latest check of a URL stored in a project or an image
+// section content. Each URL is checked once however many places store it, and
+// is forgotten once none does.
+type LinkCheck struct {
+	ID          uint              `json:"id" gorm:"primarykey"`
+	URL         string            `json:"url" gorm:"type:varchar(2048);not null;uniqueIndex"`
+	Status      string            `json:"status" gorm:"type:varchar(10);not null;default:pending;index"`
+	StatusCode  int               `json:"status_code" gorm:"not null;default:0"` // 0 when no response came
+	Error       string            `json:"error,omitempty" gorm:"type:varchar(500);not null;default:''"`
+	Failures    int               `json:"failures" gorm:"not null;default:0"` // Consecutive failed checks
+	BrokenSince *time.Time        `json:"broken_since,omitempty"`
+	CheckedAt   *time.Time        `json:"checked_at,omitempty" gorm:"index"`
+	CreatedAt   time.Time         `json:"created_at"`
+	History     []LinkCheckResult `json:"history,omitempty" gorm:"foreignKey:LinkCheckID;constraint:OnDelete:CASCADE"`
+}
+
+// LinkCheckResult is one check of a URL. Only the latest ones are kept.
+type LinkCheckResult struct {
+	ID          uint      `json:"-" gorm:"primarykey"`
+	LinkCheckID uint      `json:"-" gorm:"not null;index"`
+	Status      string    `json:"status" gorm:"type:varchar(10);not null"`
+	StatusCode  int       `json:"status_code" gorm:"not null;default:0"`
+	Error       string    `json:"error,omitempty" gorm:"type:varchar(500);not null;default:''"`
+	DurationMs  int64     `json:"duration_ms" gorm:"not null;default:0"`
+	CheckedAt   time.Time `json:"checked_at" gorm:"not null;index"`
+}
+
+// BrokenLink is a broken URL stored in a portfolio, with the place storing it.
+// A URL stored in several places is reported once for each.
+type BrokenLink struct {
+	LinkCheck
+	SourceType  string `json:"source_type"`
+	SourceID    uint   `json:"source_id"`
+	SourceTitle string `json:"source_title"`
+}
@@ -4,9 +4,34 @@ import "gorm.io/gorm"
 
 type Portfolio struct {
 	gorm.Model
-	Title       string     `json:"title"`
-	Description *string    `json:"description,omitempty"`
-	Sections    []Section  `json:"sections" gorm:"foreignKey:PortfolioID;constraint:OnDelete:CASCADE"`
-	Categories  []Category `json:"categories" gorm:"foreignKey:PortfolioID;constraint:OnDelete:CASCADE"`
-	OwnerID     string     `json:"ownerId,omitempty"`
+	Title       string       `json:"title"`
+	Description *string      `json:"description,omitempty"`
+	Sections    []Section    `json:"sections" gorm:"foreignKey:PortfolioID;constraint:OnDelete:CASCADE"`
+	Categories  []Category   `json:"categories" gorm:"foreignKey:PortfolioID;constraint:OnDelete:CASCADE"`
+	Experiences []Experience `json:"experiences" gorm:"foreignKey:PortfolioID"`
+	Education   []Education  `json:"education" gorm:"foreignKey:PortfolioID"`
+	OwnerID     string       `json:"ownerId,omitempty"`
+	Version     uint         `json:"version" gorm:"not null;default:1"`
+	// DefaultLocale is the language of the stored content; Locales lists every
+	// language the portfolio is published in, translations included
+	DefaultLocale string      `json:"default_locale" gorm:"type:varchar(35);not null;default:'en'"`
+	Locales       StringArray `json:"locales" gorm:"type:text[]"`
+}
+
+// DefaultContentLocale is the locale of portfolios that never set one
+const DefaultContentLocale = "en"
+
+// ContentLocales lists the locales the portfolio is published in, default first
+func (p *Portfolio) ContentLocales() []string {
+	defaultLocale := p.DefaultLocale
+	if defaultLocale == "" {
+		defaultLocale = DefaultContentLocale
+	}
+	locales := []string{defaultLocale}
+	for _, locale := range p.Locales {
+		if locale != defaultLocale {
+			locales = append(locales, locale)
+		}
+	}
+	return locales
 }
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// PortfolioEvent is one entry of a portfolio's change stream. IDs are
+// increasing, so a client that reconnects with the last ID it saw gets
+// everything after it.
+type PortfolioEvent struct {
+	ID          uint      `json:"id" gorm:"primarykey"`
+	PortfolioID uint      `json:"portfolio_id" gorm:"not null;index"`
+	Event       string    `json:"event" gorm:"type:varchar(50);not null"`
+	Data        []byte    `json:"-" gorm:"type:jsonb"`
+	CreatedAt   time.Time `json:"created_at" gorm:"index"`
+}
@@ -2,6 +2,9 @@ package models
 
 import (
 	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/lib/pq"
 	"gorm.io/gorm"
@@ -31,14 +34,91 @@ func (a StringArray) Value() (driver.Value, error) {
 	return pq.Array(arr).Value()
 }
 
+// Project statuses
+const (
+	ProjectShipped    = "shipped"
+	ProjectInProgress = "in_progress"
+	ProjectArchived   = "archived"
+)
+
+// ProjectStatuses lists every status a project can have
+var ProjectStatuses = []string{ProjectShipped, ProjectInProgress, ProjectArchived}
+
+// Types of project links
+const (
+	LinkRepo      = "repo"
+	LinkDemo      = "demo"
+	LinkCaseStudy = "case_study"
+	LinkAppStore  = "app_store"
+)
+
+// ProjectLinkTypes lists every type a project link can have
+var ProjectLinkTypes = []string{LinkRepo, LinkDemo, LinkCaseStudy, LinkAppStore}
+
+// ProjectLink is one typed link of a project
+type ProjectLink struct {
+	Type  string `json:"type"`
+	URL   string `json:"url"`
+	Label string `json:"label,omitempty"`
+}
+
+// ProjectLinks is a list of project links stored as a jsonb array
+type ProjectLinks []ProjectLink
+
+// Scan implements the sql.Scanner interface
+func (l *ProjectLinks) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*l = ProjectLinks{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, l)
+	case string:
+		return json.Unmarshal([]byte(v), l)
+	default:
+		return fmt.Errorf("cannot scan %T into ProjectLinks", value)
+	}
+}
+
+// Value implements the driver.Valuer interface
+func (l ProjectLinks) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(l)
+	return string(data), err
+}
+
 type Project struct {
 	gorm.Model
-	Title       string      `json:"title"`
-	Description string      `json:"description" gorm:"type:text"`
-	Skills      StringArray `json:"skills" gorm:"type:text[]"`
-	Client      string      `json:"client"`
-	Link        string      `json:"link"`
-	Position    uint        `json:"position" gorm:"default:0"`
-	OwnerID     string      `json:"ownerId,omitempty"`
-	CategoryID  uint        `json:"category_id"`
+	Title       string       `json:"title"`
+	Description string       `json:"description" gorm:"type:text"`
+	Skills      StringArray  `json:"skills" gorm:"type:text[]"`
+	Client      string       `json:"client"`
+	Link        string       `json:"link"`
+	Links       ProjectLinks `json:"links" gorm:"type:jsonb;not null;default:'[]'"`
+	StartDate   *time.Time   `json:"start_date"`
+	EndDate     *time.Time   `json:"end_date"`
+	Ongoing     bool         `json:"ongoing" gorm:"not null;default:false"`
+	Role        string       `json:"role" gorm:"type:varchar(100)"`
+	TeamSize    uint         `json:"team_size" gorm:"not null;default:0"`
+	Status      string       `json:"status" gorm:"type:varchar(20);not null;default:shipped;index"`
+	Featured    bool         `json:"featured" gorm:"not null;default:false;index"`
+	Position    uint         `json:"position" gorm:"default:0"`
+	OwnerID     string       `json:"ownerId,omitempty"`
+	CategoryID  uint         `json:"category_id"`
+	Version     uint         `json:"version" gorm:"not null;default:1"`
+
+	// CategoryIDs lists every category the project is in, CategoryID first
+	CategoryIDs []uint `json:"category_ids,omitempty" gorm:"-"`
+}
+
+// ProjectCategory places a project in a category. A project is always in its
+// CategoryID, the primary category, and may be added to more; Position orders
+// the projects of each category independently.
+type ProjectCategory struct {
+	ProjectID  uint      `json:"project_id" gorm:"primaryKey;autoIncrement:false"`
+	CategoryID uint      `json:"category_id" gorm:"primaryKey;autoIncrement:false;index:idx_project_categories_category_position,priority:1"`
+	Position   uint      `json:"position" gorm:"not null;default:0;index:idx_project_categories_category_position,priority:2"`
+	CreatedAt  time.Time `json:"created_at"`
 }
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// Git hosting providers projects can be imported from
+const (
+	ProviderGitHub = "github"
+	ProviderGitea  = "gitea"
+)
+
+// GitProviders lists every provider projects can be imported from
+var GitProviders = []string{ProviderGitHub, ProviderGitea}
+
+// ProjectSource ties an imported project to the repository it came from. The
+// upstream RepoID survives renames and transfers, so importing the same
+// repository again updates the project instead of creating another; with
+// Sync set, the project is also refreshed from upstream on a schedule. Digest
+// fingerprints the upstream data last copied, so a resync only writes the
+// project when upstream changed.
+type ProjectSource struct {
+	ProjectID uint       `json:"project_id" gorm:"primaryKey;autoIncrement:false"`
+	OwnerID   string     `json:"-" gorm:"type:varchar(255);not null;uniqueIndex:idx_project_sources_repo,priority:1"`
+	Provider  string     `json:"provider" gorm:"type:varchar(20);not null;uniqueIndex:idx_project_sources_repo,priority:2"`
+	RepoID    int64      `json:"repository_id" gorm:"not null;uniqueIndex:idx_project_sources_repo,priority:3"`
+	FullName  string     `json:"full_name" gorm:"type:varchar(255)"`
+	Digest    string     `json:"-" gorm:"type:varchar(64)"`
+	Sync      bool       `json:"sync" gorm:"not null;default:false;index"`
+	SyncedAt  time.Time  `json:"synced_at"`
+	SyncError string     `json:"sync_error,omitempty" gorm:"type:varchar(500)"`
+	FailedAt  *time.Time `json:"failed_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+func (ProjectSource) TableName() string {
+	return "project_sources"
+}
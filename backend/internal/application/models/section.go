@@ -10,5 +10,6 @@ type Section struct {
 	Position    uint             `json:"position" gorm:"default:0"`
 	OwnerID     string           `json:"ownerId,omitempty"`
 	PortfolioID uint             `json:"portfolio_id"`
+	Version     uint             `json:"version" gorm:"not null;default:1"`
 	Contents    []SectionContent `json:"contents,omitempty" gorm:"foreignKey:SectionID;constraint:OnDelete:CASCADE"`
 }
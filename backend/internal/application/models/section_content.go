@@ -12,6 +12,7 @@ type SectionContent struct {
 	Order     uint    `json:"order" gorm:"column:order;default:0;index"`
 	Metadata  *string `json:"metadata,omitempty" gorm:"type:jsonb"` // JSON for additional properties
 	OwnerID   string  `json:"owner_id,omitempty" gorm:"type:varchar(255);index"`
+	Version   uint    `json:"version" gorm:"not null;default:1"`
 
 	// Relationship back to Section
 	Section Section `json:"-" gorm:"foreignKey:SectionID;constraint:OnDelete:CASCADE"`
@@ -0,0 +1,158 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Kinds of skill; a skill may also have none
+const (
+	SkillKindLanguage  = "language"
+	SkillKindFramework = "framework"
+	SkillKindTool      = "tool"
+)
+
+// Skill is an entry of an owner's skills taxonomy. Projects link to it through
+// ProjectSkill and keep its Name in Project.Skills. Slug and Aliases are what
+// free text is matched against, so "golang", "GoLang" and "Go" are one skill.
+type Skill struct {
+	ID        uint        `json:"id" gorm:"primarykey"`
+	OwnerID   string      `json:"owner_id" gorm:"type:varchar(255);not null;uniqueIndex:idx_skills_owner_slug"`
+	Name      string      `json:"name" gorm:"type:varchar(100);not null"`
+	Slug      string      `json:"slug" gorm:"type:varchar(100);not null;uniqueIndex:idx_skills_owner_slug"`
+	Kind      string      `json:"kind,omitempty" gorm:"type:varchar(16)"`
+	Aliases   StringArray `json:"aliases" gorm:"type:text[]"` // Stored as slugs
+	Version   uint        `json:"version" gorm:"not null;default:1"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// ProjectSkill links a project to a skill of its owner's taxonomy
+type ProjectSkill struct {
+	ProjectID uint `gorm:"primaryKey;autoIncrement:false"`
+	SkillID   uint `gorm:"primaryKey;autoIncrement:false;index"`
+}
+
+// SkillUsage is a skill with the number of projects that use it
+type SkillUsage struct {
+	Skill    `gorm:"embedded"`
+	Projects int64 `json:"projects"`
+}
+
+// Matches reports whether slug is the slug or one of the aliases of the skill
+func (s *Skill) Matches(slug string) bool {
+	if s.Slug == slug {
+		return true
+	}
+	for _, alias := range s.Aliases {
+		if alias == slug {
+			return true
+		}
+	}
+	return false
+}
+
+// SetName sets the name and slug of the skill, and its aliases as slugs
+// without duplicates or the skill's own slug
+func (s *Skill) SetName(name string, aliases []string) {
+	s.Name = strings.TrimSpace(name)
+	s.Slug = SkillSlug(name)
+	s.Aliases = StringArray{}
+	for _, alias := range aliases {
+		slug := SkillSlug(alias)
+		if slug != "" && !s.Matches(slug) {
+			s.Aliases = append(s.Aliases, slug)
+		}
+	}
+}
+
+// SkillSlug is the form skill names are compared in: lowercase, without
+// spaces, dots, dashes or underscores ("Node.js" → "nodejs")
+func SkillSlug(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		switch r {
+		case ' ', '\t', '.', '-', '_':
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// knownSkills seeds the taxonomy: a skill typed the first time is created with
+// the canonical name, kind and aliases listed here
+var knownSkills = []Skill{
+	{Name: "Go", Kind: SkillKindLanguage, Aliases: StringArray{"golang"}},
+	{Name: "JavaScript", Kind: SkillKindLanguage, Aliases: StringArray{"js", "ecmascript"}},
+	{Name: "TypeScript", Kind: SkillKindLanguage, Aliases: StringArray{"ts"}},
+	{Name: "Python", Kind: SkillKindLanguage, Aliases: StringArray{"py", "python3"}},
+	{Name: "Java", Kind: SkillKindLanguage},
+	{Name: "Kotlin", Kind: SkillKindLanguage},
+	{Name: "Rust", Kind: SkillKindLanguage},
+	{Name: "Ruby", Kind: SkillKindLanguage, Aliases: StringArray{"rb"}},
+	{Name: "PHP", Kind: SkillKindLanguage},
+	{Name: "C#", Kind: SkillKindLanguage, Aliases: StringArray{"csharp"}},
+	{Name: "C++", Kind: SkillKindLanguage, Aliases: StringArray{"cpp"}},
+	{Name: "Swift", Kind: SkillKindLanguage},
+	{Name: "SQL", Kind: SkillKindLanguage},
+	{Name: "React", Kind: SkillKindFramework, Aliases: StringArray{"reactjs"}},
+	{Name: "Vue.js", Kind: SkillKindFramework, Aliases: StringArray{"vue"}},
+	{Name: "Angular", Kind: SkillKindFramework, Aliases: StringArray{"angularjs"}},
+	{Name: "Svelte", Kind: SkillKindFramework, Aliases: StringArray{"sveltekit"}},
+	{Name: "Next.js", Kind: SkillKindFramework, Aliases: StringArray{"next"}},
+	{Name: "Django", Kind: SkillKindFramework},
+	{Name: "Flask", Kind: SkillKindFramework},
+	{Name: "Ruby on Rails", Kind: SkillKindFramework, Aliases: StringArray{"rails", "ror"}},
+	{Name: "Spring", Kind: SkillKindFramework, Aliases: StringArray{"springboot"}},
+	{Name: "Express", Kind: SkillKindFramework, Aliases: StringArray{"expressjs"}},
+	{Name: "Gin", Kind: SkillKindFramework},
+	{Name: "Node.js", Kind: SkillKindTool, Aliases: StringArray{"node"}},
+	{Name: "Docker", Kind: SkillKindTool},
+	{Name: "Kubernetes", Kind: SkillKindTool, Aliases: StringArray{"k8s"}},
+	{Name: "PostgreSQL", Kind: SkillKindTool, Aliases: StringArray{"postgres", "psql"}},
+	{Name: "MySQL", Kind: SkillKindTool},
+	{Name: "MongoDB", Kind: SkillKindTool, Aliases: StringArray{"mongo"}},
+	{Name: "Redis", Kind: SkillKindTool},
+	{Name: "GraphQL", Kind: SkillKindTool},
+	{Name: "Git", Kind: SkillKindTool},
+	{Name: "Terraform", Kind: SkillKindTool},
+	{Name: "AWS", Kind: SkillKindTool, Aliases: StringArray{"amazonwebservices"}},
+	{Name: "Google Cloud", Kind: SkillKindTool, Aliases: StringArray{"gcp"}},
+	{Name: "Linux", Kind: SkillKindTool},
+}
+
+func init() {
+	for i := range knownSkills {
+		knownSkills[i].Slug = SkillSlug(knownSkills[i].Name)
+	}
+}
+
+// KnownSkill returns the built-in entry name is the name or an alias of
+func KnownSkill(name string) (Skill, bool) {
+	slug := SkillSlug(name)
+	for _, known := range knownSkills {
+		if known.Matches(slug) {
+			known.Aliases = append(StringArray{}, known.Aliases...)
+			return known, true
+		}
+	}
+	return Skill{}, false
+}
+
+// KnownSkillsWithPrefix returns the built-in entries whose slug or an alias
+// starts with prefix, a slug
+func KnownSkillsWithPrefix(prefix string) []Skill {
+	var found []Skill
+	for _, known := range knownSkills {
+		match := strings.HasPrefix(known.Slug, prefix)
+		for _, alias := range known.Aliases {
+			match = match || strings.HasPrefix(alias, prefix)
+		}
+		if match {
+			known.Aliases = append(StringArray{}, known.Aliases...)
+			found = append(found, known)
+		}
+	}
+	return found
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Visibility of portfolio templates
+const (
+	TemplateBuiltin = "builtin" // Shipped with the application, usable by everyone
+	TemplatePrivate = "private" // Usable by its owner only
+	TemplateShared  = "shared"  // Usable by every user
+)
+
+// TemplateContent is a content block a template adds to a section
+type TemplateContent struct {
+	Type     string  `json:"type"`
+	Content  string  `json:"content"`
+	Metadata *string `json:"metadata,omitempty"` // JSON, as in SectionContent
+}
+
+// TemplateSection is a section a template adds to the portfolio, with its
+// content blocks in order
+type TemplateSection struct {
+	Title       string            `json:"title"`
+	Description *string           `json:"description,omitempty"`
+	Type        string            `json:"type"`
+	Contents    []TemplateContent `json:"contents"`
+}
+
+// TemplateProject is a placeholder project a template adds to a category
+type TemplateProject struct {
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	Skills      StringArray `json:"skills,omitempty"`
+	Role        string      `json:"role,omitempty"`
+}
+
+// TemplateCategory is a category a template adds to the portfolio, with its
+// placeholder projects in order
+type TemplateCategory struct {
+	Title       string            `json:"title"`
+	Description *string           `json:"description,omitempty"`
+	Projects    []TemplateProject `json:"projects"`
+}
+
+// TemplateBundle is what a template creates in a new portfolio, stored as jsonb
+type TemplateBundle struct {
+	Sections   []TemplateSection  `json:"sections"`
+	Categories []TemplateCategory `json:"categories"`
+}
+
+// Scan implements the sql.Scanner interface
+func (b *TemplateBundle) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*b = TemplateBundle{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, b)
+	case string:
+		return json.Unmarshal([]byte(v), b)
+	default:
+		return fmt.Errorf("cannot scan %T into TemplateBundle", value)
+	}
+}
+
+// Value implements the driver.Valuer interface
+func (b TemplateBundle) Value() (driver.Value, error) {
+	data, err := json.Marshal(b)
+	return string(data), err
+}
+
+// PortfolioTemplate is a starting point for new portfolios. Built-in templates
+// are seeded from the bundles shipped with the application and identified by
+// Key; users save their own portfolios as private or shared templates.
+type PortfolioTemplate struct {
+	ID          uint           `json:"id" gorm:"primarykey"`
+	Key         *string        `json:"key,omitempty" gorm:"type:varchar(50);uniqueIndex"` // Built-in templates only
+	OwnerID     string         `json:"owner_id,omitempty" gorm:"type:varchar(255);not null;default:'';index"`
+	Name        string         `json:"name" gorm:"type:varchar(100);not null"`
+	Description string         `json:"description" gorm:"type:text"`
+	Visibility  string         `json:"visibility" gorm:"type:varchar(10);not null;index"`
+	Bundle      TemplateBundle `json:"bundle" gorm:"type:jsonb;not null"`
+	Version     uint           `json:"version" gorm:"not null;default:1"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// UsableBy reports whether userID may create portfolios from the template
+func (t *PortfolioTemplate) UsableBy(userID string) bool {
+	return t.Visibility != TemplatePrivate || t.OwnerID == userID
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Testimonial states; only approved testimonials are public
+const (
+	TestimonialPending  = "pending"
+	TestimonialApproved = "approved"
+	TestimonialRejected = "rejected"
+)
+
+// TestimonialStatuses lists every state a testimonial can be in
+var TestimonialStatuses = []string{TestimonialPending, TestimonialApproved, TestimonialRejected}
+
+// Testimonial is a quote of a client on a portfolio, or on one of its projects
+// when ProjectID is set. The ones clients submit through an invite wait for
+// the owner's approval; the ones the owner adds are approved. Position orders
+// the testimonials of a portfolio, pending ones included.
+type Testimonial struct {
+	gorm.Model
+	PortfolioID uint   `json:"portfolio_id" gorm:"not null;index"`
+	ProjectID   *uint  `json:"project_id,omitempty" gorm:"index"`
+	OwnerID     string `json:"ownerId,omitempty" gorm:"type:varchar(255);not null;index"`
+	AuthorName  string `json:"author_name" gorm:"type:varchar(100);not null"`
+	AuthorRole  string `json:"author_role,omitempty" gorm:"type:varchar(100)"`
+	Company     string `json:"company,omitempty" gorm:"type:varchar(100)"`
+	Quote       string `json:"quote" gorm:"type:text;not null"`
+	AvatarURL   string `json:"avatar_url,omitempty" gorm:"type:varchar(2048)"`
+	Rating      *uint  `json:"rating,omitempty"` // 1 to 5
+	Status      string `json:"status" gorm:"type:varchar(16);not null;default:pending"`
+	Position    uint   `json:"position" gorm:"default:0"`
+	InviteID    *uint  `json:"invite_id,omitempty"`
+	Version     uint   `json:"version" gorm:"not null;default:1"`
+}
+
+// TestimonialInvite lets a client submit one testimonial without an account.
+// The token in the link is its only credential, so it is random, expires and
+// works once.
+type TestimonialInvite struct {
+	gorm.Model
+	PortfolioID uint       `json:"portfolio_id" gorm:"not null;index"`
+	ProjectID   *uint      `json:"project_id,omitempty"`
+	OwnerID     string     `json:"ownerId,omitempty" gorm:"type:varchar(255);not null;index"`
+	Token       string     `json:"token" gorm:"type:varchar(64);not null;uniqueIndex"`
+	Note        string     `json:"note,omitempty" gorm:"type:varchar(255)"` // Who it was sent to, for the owner
+	ExpiresAt   time.Time  `json:"expires_at"`
+	UsedAt      *time.Time `json:"used_at,omitempty"`
+}
+
+// Usable reports whether the invite can still be used to submit a testimonial
+func (i *TestimonialInvite) Usable(now time.Time) bool {
+	return i.UsedAt == nil && now.Before(i.ExpiresAt)
+}
+
+func (Testimonial) TableName() string {
+	return "testimonials"
+}
+
+func (TestimonialInvite) TableName() string {
+	return "testimonial_invites"
+}
@@ -0,0 +1,141 @@
+package models
+
+import "time"
+
+// Resources that have translatable fields
+const (
+	TranslationPortfolio      = "portfolio"
+	TranslationCategory       = "category"
+	TranslationProject        = "project"
+	TranslationSection        = "section"
+	TranslationSectionContent = "section_content"
+)
+
+// TranslatableFields lists the fields of each resource that can be translated
+var TranslatableFields = map[string][]string{
+	TranslationPortfolio:      {"title", "description"},
+	TranslationCategory:       {"title", "description"},
+	TranslationProject:        {"title", "description"},
+	TranslationSection:        {"title", "description"},
+	TranslationSectionContent: {"content"},
+}
+
+// Translation is the text of one field of a portfolio resource in a locale
+// other than the portfolio's default. Rows are replaced, never versioned.
+type Translation struct {
+	ID           uint      `json:"id" gorm:"primarykey"`
+	PortfolioID  uint      `json:"portfolio_id" gorm:"not null;index"`
+	OwnerID      string    `json:"owner_id" gorm:"type:varchar(255);not null;index"`
+	Locale       string    `json:"locale" gorm:"type:varchar(35);not null;uniqueIndex:idx_translations_field"`
+	ResourceType string    `json:"resource_type" gorm:"type:varchar(32);not null;uniqueIndex:idx_translations_field"`
+	ResourceID   uint      `json:"resource_id" gorm:"not null;uniqueIndex:idx_translations_field"`
+	Field        string    `json:"field" gorm:"type:varchar(32);not null;uniqueIndex:idx_translations_field"`
+	Value        string    `json:"value" gorm:"type:text;not null"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TranslationKey identifies one translatable field
+type TranslationKey struct {
+	ResourceType string `json:"resource_type"`
+	ResourceID   uint   `json:"resource_id"`
+	Field        string `json:"field"`
+}
+
+// TranslationSource is the stored (default locale) text of a translatable field
+type TranslationSource struct {
+	TranslationKey
+	Value string
+}
+
+// IsTranslatable reports whether field of resourceType can be translated
+func IsTranslatable(resourceType, field string) bool {
+	for _, f := range TranslatableFields[resourceType] {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// TranslationSet holds the translations of one locale, ready to be laid over
+// the stored content. A nil set leaves content untouched.
+type TranslationSet map[TranslationKey]string
+
+// NewTranslationSet indexes translations by the field they translate
+func NewTranslationSet(translations []Translation) TranslationSet {
+	set := make(TranslationSet, len(translations))
+	for _, t := range translations {
+		set[TranslationKey{ResourceType: t.ResourceType, ResourceID: t.ResourceID, Field: t.Field}] = t.Value
+	}
+	return set
+}
+
+func (s TranslationSet) text(resourceType string, id uint, field string, target *string) {
+	if value, ok := s[TranslationKey{ResourceType: resourceType, ResourceID: id, Field: field}]; ok {
+		*target = value
+	}
+}
+
+// optionalText translates a nullable field; the stored pointer is replaced, not written through
+func (s TranslationSet) optionalText(resourceType string, id uint, field string, target **string) {
+	if value, ok := s[TranslationKey{ResourceType: resourceType, ResourceID: id, Field: field}]; ok {
+		*target = &value
+	}
+}
+
+// ApplyPortfolio translates the portfolio and its loaded sections and categories
+func (s TranslationSet) ApplyPortfolio(portfolio *Portfolio) {
+	if len(s) == 0 {
+		return
+	}
+	s.text(TranslationPortfolio, portfolio.ID, "title", &portfolio.Title)
+	s.optionalText(TranslationPortfolio, portfolio.ID, "description", &portfolio.Description)
+	for i := range portfolio.Sections {
+		s.ApplySection(&portfolio.Sections[i])
+	}
+	for i := range portfolio.Categories {
+		s.ApplyCategory(&portfolio.Categories[i])
+	}
+}
+
+// ApplyCategory translates the category and its loaded projects
+func (s TranslationSet) ApplyCategory(category *Category) {
+	if len(s) == 0 {
+		return
+	}
+	s.text(TranslationCategory, category.ID, "title", &category.Title)
+	s.optionalText(TranslationCategory, category.ID, "description", &category.Description)
+	for i := range category.Projects {
+		s.ApplyProject(&category.Projects[i])
+	}
+}
+
+// ApplyProject translates the project
+func (s TranslationSet) ApplyProject(project *Project) {
+	if len(s) == 0 {
+		return
+	}
+	s.text(TranslationProject, project.ID, "title", &project.Title)
+	s.text(TranslationProject, project.ID, "description", &project.Description)
+}
+
+// ApplySection translates the section and its loaded contents
+func (s TranslationSet) ApplySection(section *Section) {
+	if len(s) == 0 {
+		return
+	}
+	s.text(TranslationSection, section.ID, "title", &section.Title)
+	s.optionalText(TranslationSection, section.ID, "description", &section.Description)
+	for i := range section.Contents {
+		s.ApplySectionContent(&section.Contents[i])
+	}
+}
+
+// ApplySectionContent translates the content block
+func (s TranslationSet) ApplySectionContent(content *SectionContent) {
+	if len(s) == 0 {
+		return
+	}
+	s.text(TranslationSectionContent, content.ID, "content", &content.Content)
+}
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// Account states mirrored from Authentik
+const (
+	UserStatusActive    = "active"
+	UserStatusSuspended = "suspended"
+	UserStatusDeleted   = "deleted"
+)
+
+// Actions taken on an owner's data in response to an Authentik event
+const (
+	UserActionDataDeleted = "data_deleted"
+	UserActionFrozen      = "frozen"
+	UserActionUnfrozen    = "unfrozen"
+	UserActionRenamed     = "renamed"
+)
+
+// UserStatus mirrors the Authentik account state of a content owner. Owners
+// without a row are active; suspended and deleted owners can't write and their
+// portfolios are hidden from public endpoints.
+type UserStatus struct {
+	OwnerID   string    `json:"owner_id" gorm:"type:varchar(255);primaryKey"`
+	Status    string    `json:"status" gorm:"type:varchar(20);not null;default:active"`
+	Username  string    `json:"username" gorm:"type:varchar(255)"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Hidden reports whether the owner's content must not be served or changed
+func (s *UserStatus) Hidden() bool {
+	return s.Status == UserStatusSuspended || s.Status == UserStatusDeleted
+}
+
+// UserLifecycleEvent records what was done to an owner's data because of an
+// Authentik event, for auditing
+type UserLifecycleEvent struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	OwnerID   string    `json:"owner_id" gorm:"type:varchar(255);not null;index"`
+	Event     string    `json:"event" gorm:"type:varchar(50);not null"`
+	Action    string    `json:"action" gorm:"type:varchar(50);not null"`
+	Details   string    `json:"details" gorm:"type:text"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Webhook delivery states
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookEvents lists every event a webhook can subscribe to
+var WebhookEvents = []string{
+	"portfolio.created", "portfolio.updated", "portfolio.deleted",
+	"category.created", "category.updated", "category.deleted",
+	"project.created", "project.updated", "project.deleted",
+	"section.created", "section.updated", "section.deleted",
+	"section_content.created", "section_content.updated", "section_content.deleted",
+	"testimonial.created", "testimonial.updated", "testimonial.deleted",
+	"experience.created", "experience.updated", "experience.deleted",
+	"education.created", "education.updated", "education.deleted",
+}
+
+// Webhook is an endpoint notified about changes inside one portfolio. Events
+// holds filters: an exact event name, a resource wildcard such as "project.*",
+// or "*" for everything.
+type Webhook struct {
+	gorm.Model
+	PortfolioID uint        `json:"portfolio_id" gorm:"not null;index"`
+	OwnerID     string      `json:"ownerId,omitempty" gorm:"type:varchar(255);not null;index"`
+	URL         string      `json:"url" gorm:"type:varchar(2048);not null"`
+	Secret      string      `json:"-" gorm:"type:varchar(255);not null"`
+	Events      StringArray `json:"events" gorm:"type:text[]"`
+	Active      bool        `json:"active" gorm:"not null;default:true"`
+	Version     uint        `json:"version" gorm:"not null;default:1"`
+}
+
+// Subscribes reports whether one of the webhook's filters matches event
+func (w *Webhook) Subscribes(event string) bool {
+	for _, filter := range w.Events {
+		if MatchWebhookEvent(filter, event) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchWebhookEvent reports whether a subscription filter matches event
+func MatchWebhookEvent(filter, event string) bool {
+	if filter == "*" || filter == event {
+		return true
+	}
+	if resource, ok := strings.CutSuffix(filter, ".*"); ok {
+		return strings.HasPrefix(event, resource+".")
+	}
+	return false
+}
+
+// WebhookDelivery is a row of the transactional outbox: it is written in the
+// same transaction as the change it announces and sent later by the dispatcher
+type WebhookDelivery struct {
+	ID             uint       `json:"id" gorm:"primarykey"`
+	WebhookID      uint       `json:"webhook_id" gorm:"not null;index"`
+	Webhook        *Webhook   `json:"-" gorm:"foreignKey:WebhookID"`
+	Event          string     `json:"event" gorm:"type:varchar(100);not null"`
+	Payload        []byte     `json:"-" gorm:"type:jsonb;not null"`
+	Status         string     `json:"status" gorm:"type:varchar(20);not null;default:pending;index:idx_webhook_deliveries_due"`
+	Attempts       int        `json:"attempts" gorm:"not null;default:0"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at" gorm:"not null;index:idx_webhook_deliveries_due"`
+	LastStatusCode int        `json:"last_status_code,omitempty"`
+	LastError      string     `json:"last_error,omitempty" gorm:"type:text"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
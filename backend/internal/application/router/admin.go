@@ -0,0 +1,23 @@
+package router
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *Router) RegisterAdminRoutes(apiGroup *gin.RouterGroup) {
+	admin := apiGroup.Group("/admin")
+
+	// Protected routes - only for the users listed in ADMIN_USER_IDS
+	admin.Use(middleware.AuthMiddleware())
+	admin.Use(middleware.AdminOnly())
+	admin.Use(r.idempotency) // Idempotency-Key support on POST
+	{
+		admin.GET("/jobs", r.jobHandler.List)
+		admin.POST("/jobs", r.jobHandler.Enqueue)
+		admin.GET("/jobs/stats", r.jobHandler.GetStats)
+		admin.GET("/jobs/:id", r.jobHandler.GetByID)
+		admin.POST("/jobs/:id/retry", r.jobHandler.Retry)
+		admin.DELETE("/jobs/:id", r.jobHandler.Delete)
+	}
+}
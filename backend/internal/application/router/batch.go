@@ -0,0 +1,30 @@
+package router
+
+import (
+	handler2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/handler"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *Router) RegisterBatchRoutes(apiGroup *gin.RouterGroup) {
+	basePath := apiGroup.BasePath()
+
+	// Batch operations run through a second engine with the same routes and
+	// handlers, built once. The batch handler hands them the repositories of
+	// its transaction through the request context. Each operation counts
+	// against the client's rate limit like a request of its own.
+	engine := gin.New()
+	engine.Use(middleware.Locale())
+	engine.Use(middleware.RateLimit())
+	r.registerOperationRoutes(engine.Group(basePath))
+	batchHandler := handler2.NewBatchHandler(r.db, basePath, engine)
+
+	// Protected routes - require authentication
+	protected := apiGroup.Group("/batch")
+	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount) // Suspended accounts are read-only
+	protected.Use(r.idempotency)   // Idempotency-Key support on POST
+	{
+		protected.POST("", batchHandler.Execute)
+	}
+}
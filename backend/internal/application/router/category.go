@@ -1,6 +1,7 @@
 package router
 
 import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
 	"github.com/gin-gonic/gin"
 )
@@ -11,18 +12,22 @@ func (r *Router) RegisterCategoryRoutes(apiGroup *gin.RouterGroup) {
 	// Protected routes - require authentication
 	protected := categories.Group("/own")
 	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount)      // Suspended accounts are read-only
+	protected.Use(middleware.IfMatch()) // Optimistic concurrency on PUT/PATCH/DELETE /:id
+	protected.Use(r.idempotency)        // Idempotency-Key support on POST
 	{
 		protected.GET("", r.categoryHandler.GetByUser)
 		protected.POST("", r.categoryHandler.Create)
 		protected.GET("/:id", r.categoryHandler.GetByIDPublic) // Authenticated users can also view
 		protected.PUT("/:id", r.categoryHandler.Update)
+		protected.PATCH("/:id", r.categoryHandler.Patch)
 		protected.PUT("/:id/position", r.categoryHandler.UpdatePosition)
 		protected.PUT("/reorder", r.categoryHandler.BulkReorder)
 		protected.DELETE("/:id", r.categoryHandler.Delete)
 	}
 
-	// Public routes - no auth required
-	categories.GET("/id/:id", r.categoryHandler.GetByIDPublic)
-	categories.GET("/public/:id", r.categoryHandler.GetByIDPublic)
+	// Public routes - no auth required, views are counted
+	categories.GET("/id/:id", middleware.PageView(r.views, models.ViewCategory), r.categoryHandler.GetByIDPublic)
+	categories.GET("/public/:id", middleware.PageView(r.views, models.ViewCategory), r.categoryHandler.GetByIDPublic)
 	categories.GET("/public/:id/projects", r.projectHandler.GetByCategory)
 }
@@ -0,0 +1,24 @@
+package router
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterContactRoutes mounts the inbox of contact messages; the contact form
+// itself and its settings live under /portfolios
+func (r *Router) RegisterContactRoutes(apiGroup *gin.RouterGroup) {
+	messages := apiGroup.Group("/messages")
+
+	// Protected routes - messages are only ever visible to the portfolio owner
+	protected := messages.Group("/own")
+	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount)      // Suspended accounts are read-only
+	protected.Use(middleware.IfMatch()) // Optimistic concurrency on PUT/DELETE /:id
+	{
+		protected.GET("", r.contactHandler.GetByUser)
+		protected.GET("/:id", r.contactHandler.GetByID)
+		protected.PUT("/:id", r.contactHandler.Update)
+		protected.DELETE("/:id", r.contactHandler.Delete)
+	}
+}
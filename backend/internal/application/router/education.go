@@ -0,0 +1,27 @@
+package router
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterEducationRoutes mounts the owner's education routes; the public
+// listing lives under /portfolios
+func (r *Router) RegisterEducationRoutes(apiGroup *gin.RouterGroup) {
+	education := apiGroup.Group("/education")
+
+	// Protected routes - require authentication
+	protected := education.Group("/own")
+	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount)      // Suspended accounts are read-only
+	protected.Use(middleware.IfMatch()) // Optimistic concurrency on PUT/DELETE /:id
+	protected.Use(r.idempotency)        // Idempotency-Key support on POST
+	{
+		protected.POST("", r.educationHandler.Create)
+		protected.PUT("/reorder", r.educationHandler.BulkReorder)
+		protected.GET("/:id", r.educationHandler.GetByID)
+		protected.PUT("/:id", r.educationHandler.Update)
+		protected.DELETE("/:id", r.educationHandler.Delete)
+		protected.PUT("/:id/position", r.educationHandler.UpdatePosition)
+	}
+}
@@ -0,0 +1,27 @@
+package router
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterExperienceRoutes mounts the owner's work history routes; the public
+// listing lives under /portfolios
+func (r *Router) RegisterExperienceRoutes(apiGroup *gin.RouterGroup) {
+	experiences := apiGroup.Group("/experiences")
+
+	// Protected routes - require authentication
+	protected := experiences.Group("/own")
+	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount)      // Suspended accounts are read-only
+	protected.Use(middleware.IfMatch()) // Optimistic concurrency on PUT/DELETE /:id
+	protected.Use(r.idempotency)        // Idempotency-Key support on POST
+	{
+		protected.POST("", r.experienceHandler.Create)
+		protected.PUT("/reorder", r.experienceHandler.BulkReorder)
+		protected.GET("/:id", r.experienceHandler.GetByID)
+		protected.PUT("/:id", r.experienceHandler.Update)
+		protected.DELETE("/:id", r.experienceHandler.Delete)
+		protected.PUT("/:id/position", r.experienceHandler.UpdatePosition)
+	}
+}
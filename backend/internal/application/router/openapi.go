@@ -0,0 +1,387 @@
+package router
+
+import (
+	"net/http"
+	"sync"
+
+	handler2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/handler"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/gitimport"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/response"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/openapi"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	openAPISpecPath   = "/openapi.json"
+	openAPIDocsPath   = "/docs"
+	openAPIBundlePath = openAPIDocsPath + "/" + openapi.BundleFile
+)
+
+var (
+	pageParams = []openapi.Parameter{
+		openapi.QueryParam("page", "integer", "Page number, starting at 1"),
+		openapi.QueryParam("limit", "integer", "Items per page (max 100)"),
+	}
+
+	// cursorParams are accepted by the lists answering with next_cursor;
+	// without limit or cursor every match is returned
+	cursorParams = []openapi.Parameter{
+		openapi.QueryParam("limit", "integer", "Items per page (max 100)"),
+		openapi.QueryParam("cursor", "string", "next_cursor of the previous page"),
+		openapi.QueryParam("sort", "string", "title, position, created_at or updated_at; prefix with - for descending (default position)"),
+		openapi.QueryParam("created_after", "string", "Only items created at or after this date (YYYY-MM-DD or RFC 3339)"),
+		openapi.QueryParam("created_before", "string", "Only items created before this date (YYYY-MM-DD or RFC 3339)"),
+	}
+
+	// Sparse fieldsets of each resource; the id is always returned
+	portfolioSelectionParams = selectionParams("title, description, owner_id, version, default_locale, locales, created_at, updated_at", "sections, categories, experiences, education")
+	categorySelectionParams  = selectionParams("title, description, position, owner_id, portfolio_id, version, created_at, updated_at", "projects")
+	projectSelectionParams   = selectionParams("title, description, skills, client, link, links, start_date, end_date, ongoing, role, team_size, status, featured, position, owner_id, category_id, version, created_at, updated_at", "")
+	sectionSelectionParams   = selectionParams("title, description, type, position, portfolio_id, owner_id, version, created_at, updated_at", "contents")
+
+	skillKindParam = openapi.QueryParam("kind", "string", "Only skills of this kind: language, framework or tool")
+
+	// langParams pick the locale public content is translated into
+	langParams = []openapi.Parameter{
+		openapi.QueryParam("lang", "string", "Locale to translate the content into, e.g. pt-BR; defaults to Accept-Language, then the portfolio's default locale"),
+	}
+
+	categoryListParams  = concatParams(cursorParams, categorySelectionParams, langParams)
+	projectFilterParams = []openapi.Parameter{
+		openapi.QueryParam("skills", "string", "Comma-separated skills the project must all have, matched by name or alias"),
+		openapi.QueryParam("client", "string", "Client name, case-insensitive"),
+		openapi.QueryParam("status", "string", "Comma-separated statuses: shipped, in_progress, archived"),
+		openapi.QueryParam("featured", "boolean", "Only featured (true) or other (false) projects"),
+		openapi.QueryParam("role", "string", "Role on the project, case-insensitive"),
+		openapi.QueryParam("ongoing", "boolean", "Only ongoing (true) or finished (false) projects"),
+		openapi.QueryParam("started_after", "string", "Only projects started at or after this date (YYYY-MM-DD or RFC 3339)"),
+		openapi.QueryParam("started_before", "string", "Only projects started before this date (YYYY-MM-DD or RFC 3339)"),
+	}
+	projectListParams = concatParams(projectFilterParams, cursorParams, projectSelectionParams, langParams)
+	timelineParams    = concatParams(projectFilterParams, []openapi.Parameter{
+		openapi.QueryParam("limit", "integer", "Items per page (max 100)"),
+		openapi.QueryParam("cursor", "string", "next_cursor of the previous page"),
+		openapi.QueryParam("sort", "string", "start_date, or -start_date for the newest first (default start_date)"),
+		openapi.QueryParam("created_after", "string", "Only items created at or after this date (YYYY-MM-DD or RFC 3339)"),
+		openapi.QueryParam("created_before", "string", "Only items created before this date (YYYY-MM-DD or RFC 3339)"),
+	}, projectSelectionParams, langParams)
+	sectionListParams = concatParams([]openapi.Parameter{
+		openapi.QueryParam("type", "string", "Section type"),
+	}, cursorParams, sectionSelectionParams, langParams)
+
+	portfolioPublicParams = concatParams(portfolioSelectionParams, langParams)
+	categoryPublicParams  = concatParams(categorySelectionParams, langParams)
+	projectPublicParams   = concatParams(projectSelectionParams, langParams)
+	sectionPublicParams   = concatParams(sectionSelectionParams, langParams)
+
+	// Public pages count as a view; frontends fetching them for a visitor
+	// pass the visitor's referrer
+	refParams           = []openapi.Parameter{openapi.QueryParam("ref", "string", "Referrer of the visitor the page is fetched for, counted in the owner's analytics instead of the Referer header")}
+	portfolioPageParams = concatParams(portfolioPublicParams, refParams)
+	categoryPageParams  = concatParams(categoryPublicParams, refParams)
+	projectPageParams   = concatParams(projectPublicParams, refParams)
+
+	analyticsParams = []openapi.Parameter{
+		openapi.QueryParam("from", "string", "First day of the report (YYYY-MM-DD); defaults to 29 days before to"),
+		openapi.QueryParam("to", "string", "Last day of the report (YYYY-MM-DD); defaults to today (UTC)"),
+	}
+
+	userSummary = struct {
+		UserID     string `json:"userID"`
+		Portfolios int    `json:"portfolios"`
+		Categories int    `json:"categories"`
+		Sections   int    `json:"sections"`
+		Projects   int    `json:"projects"`
+		TotalItems int    `json:"totalItems"`
+	}{}
+
+	userCleanup = struct {
+		Message               string `json:"message"`
+		PortfoliosDeleted     int    `json:"portfoliosDeleted"`
+		SectionContentDeleted int64  `json:"sectionContentDeleted"`
+	}{}
+)
+
+// openAPITags lists the tag groups shown in the rendered documentation
+var openAPITags = []openapi.Tag{
+	{Name: "Portfolios", Description: "Top-level portfolios owned by a user"},
+	{Name: "Categories", Description: "Project categories inside a portfolio"},
+	{Name: "Projects", Description: "Projects inside one or more categories"},
+	{Name: "Sections", Description: "Content sections inside a portfolio"},
+	{Name: "Section Contents", Description: "Text and image blocks inside a section"},
+	{Name: "Translations", Description: "Portfolio content in other locales"},
+	{Name: "Skills", Description: "The taxonomy project skills are matched against"},
+	{Name: "Templates", Description: "Starting points for new portfolios"},
+	{Name: "Analytics", Description: "Views of public portfolio pages"},
+	{Name: "Link Checks", Description: "Broken URLs stored in portfolios"},
+	{Name: "Contact", Description: "Messages visitors send to portfolio owners"},
+	{Name: "Testimonials", Description: "Client quotes shown on portfolios once approved"},
+	{Name: "Experience", Description: "Work history and education of a portfolio"},
+	{Name: "Users", Description: "Data belonging to the authenticated user"},
+	{Name: "Batch", Description: "Several operations in one transaction"},
+	{Name: "Webhooks", Description: "Signed notifications sent when portfolio content changes"},
+	{Name: "Admin", Description: "Server operation, limited to the users listed in ADMIN_USER_IDS"},
+	{Name: "Documentation", Description: "This API description"},
+}
+
+// openAPIRoutes documents every route registered under the /api group.
+// Adding a route without an entry here fails TestOpenAPI_AllRoutesDocumented.
+var openAPIRoutes = []openapi.Route{
+	// Portfolios
+	{Method: http.MethodGet, Path: "/portfolios/own", Tag: "Portfolios", Auth: true, Summary: "List own portfolios", Query: pageParams, Response: []response.PortfolioResponse{}, Envelope: openapi.EnvelopePaginated},
+	{Method: http.MethodPost, Path: "/portfolios/own", Tag: "Portfolios", Auth: true, Summary: "Create a portfolio", Description: "With template_id, the sections, content blocks, categories and placeholder projects of the template are created with it in one transaction, and the response includes them.", Request: request.CreatePortfolioRequest{}, Response: response.PortfolioResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodGet, Path: "/portfolios/own/:id", Tag: "Portfolios", Auth: true, Summary: "Get a portfolio with its sections and categories", Query: portfolioPublicParams, Response: response.PortfolioDetailResponse{}},
+	{Method: http.MethodPut, Path: "/portfolios/own/:id", Tag: "Portfolios", Auth: true, Summary: "Update a portfolio", Request: request.UpdatePortfolioRequest{}, Response: response.PortfolioResponse{}},
+	{Method: http.MethodPatch, Path: "/portfolios/own/:id", Tag: "Portfolios", Auth: true, Summary: "Partially update a portfolio", Request: request.PatchPortfolioRequest{}, Patch: true, Response: response.PortfolioResponse{}},
+	{Method: http.MethodDelete, Path: "/portfolios/own/:id", Tag: "Portfolios", Auth: true, Summary: "Delete a portfolio and everything inside it"},
+	{Method: http.MethodGet, Path: "/portfolios/own/:id/events", Tag: "Portfolios", Auth: true, Summary: "Stream the portfolio's changes as Server-Sent Events", Description: "Requires Accept: text/event-stream. Each message has an id, an event such as \"section.updated\" or \"project.reordered\", and a JSON data line. Reconnect with Last-Event-ID (or ?last_event_id=) to receive missed events first; events are kept for 24 hours.", Query: []openapi.Parameter{openapi.QueryParam("last_event_id", "integer", "Resume after this event ID")}, Response: response.PortfolioEventResponse{}, Envelope: openapi.EnvelopeNone},
+	{Method: http.MethodGet, Path: "/portfolios/id/:id", Tag: "Portfolios", Summary: "Get a public portfolio", Description: "Counts as a view in the owner's analytics.", Query: portfolioPageParams, Response: response.PortfolioDetailResponse{}},
+	{Method: http.MethodGet, Path: "/portfolios/public/:id", Tag: "Portfolios", Summary: "Get a public portfolio", Description: "Counts as a view in the owner's analytics.", Query: portfolioPageParams, Response: response.PortfolioDetailResponse{}},
+	{Method: http.MethodGet, Path: "/portfolios/public/:id/categories", Tag: "Portfolios", Summary: "List the categories of a portfolio", Query: categoryListParams, Response: []models.Category{}, Envelope: openapi.EnvelopeCursor},
+	{Method: http.MethodGet, Path: "/portfolios/public/:id/sections", Tag: "Portfolios", Summary: "List the sections of a portfolio", Query: sectionListParams, Response: []models.Section{}, Envelope: openapi.EnvelopeCursor},
+	{Method: http.MethodGet, Path: "/portfolios/public/:id/timeline", Tag: "Portfolios", Summary: "List the projects of a portfolio in chronological order", Description: "Every project of the portfolio's categories appears once, with category_ids, ordered by start_date; projects without one are placed by when they were created.", Query: timelineParams, Response: []models.Project{}, Envelope: openapi.EnvelopeCursor},
+
+	// Analytics
+	{Method: http.MethodGet, Path: "/portfolios/own/:id/analytics", Tag: "Analytics", Auth: true, Summary: "Report the views of the portfolio's public pages", Description: "Views of the portfolio, category and project pages per day, with the top projects, categories, referrer domains and countries. Visitors are unique per day; no cookies or IPs are stored. Counts are rolled up every few minutes. Periods are at most 366 days.", Query: analyticsParams, Response: response.AnalyticsResponse{}},
+
+	// Link checks
+	{Method: http.MethodGet, Path: "/portfolios/own/:id/broken-links", Tag: "Link Checks", Auth: true, Summary: "Report the broken URLs stored in a portfolio", Description: "Project links and image content URLs are checked in the background with HEAD, then GET when HEAD is refused; a URL is broken when it can't be reached or answers 400 or above. Each broken URL is listed once for every project or content storing it, with its last 10 checks, latest first. New URLs show up once they have been checked.", Response: []models.BrokenLink{}},
+
+	// Contact form and inbox
+	{Method: http.MethodPost, Path: "/portfolios/public/:id/contact", Tag: "Contact", Summary: "Send a message to the owner of a portfolio", Description: "The message lands in the owner's inbox, who is notified by email when the contact settings name an address; an auto-reply goes to the visitor when configured. Forms must keep the website field hidden: messages filling it in are accepted but dropped as spam. Limited to 5 messages per IP an hour by default.", Request: request.ContactRequest{}, Status: http.StatusAccepted},
+	{Method: http.MethodGet, Path: "/portfolios/own/:id/contact/settings", Tag: "Contact", Auth: true, Summary: "Get the contact form settings of a portfolio", Response: response.ContactSettingsResponse{}},
+	{Method: http.MethodPut, Path: "/portfolios/own/:id/contact/settings", Tag: "Contact", Auth: true, Summary: "Configure the contact form of a portfolio", Description: "{portfolio} in the auto-reply is replaced by the portfolio title. Auto-replies never repeat what the visitor typed and are capped to one per address per portfolio and 20 per portfolio every 24 hours.", Request: request.ContactSettingsRequest{}, Response: response.ContactSettingsResponse{}},
+	{Method: http.MethodGet, Path: "/messages/own", Tag: "Contact", Auth: true, Summary: "List the messages of the inbox", Description: "Newest first. Without status, archived messages are left out.", Query: concatParams([]openapi.Parameter{
+		openapi.QueryParam("portfolio_id", "integer", "Only messages sent to this portfolio"),
+		openapi.QueryParam("status", "string", "Only messages in this state: unread, read or archived"),
+	}, pageParams), Response: []response.ContactMessageResponse{}, Envelope: openapi.EnvelopePaginated},
+	{Method: http.MethodGet, Path: "/messages/own/:id", Tag: "Contact", Auth: true, Summary: "Get a message", Response: response.ContactMessageResponse{}},
+	{Method: http.MethodPut, Path: "/messages/own/:id", Tag: "Contact", Auth: true, Summary: "Mark a message unread, read or archived", Description: "read_at records when the message first left the unread state.", Request: request.UpdateContactMessageRequest{}, Response: response.ContactMessageResponse{}},
+	{Method: http.MethodDelete, Path: "/messages/own/:id", Tag: "Contact", Auth: true, Summary: "Delete a message"},
+
+	// Testimonials and invite links
+	{Method: http.MethodGet, Path: "/portfolios/public/:id/testimonials", Tag: "Testimonials", Summary: "List the approved testimonials of a portfolio", Description: "In the owner's order. Testimonials about deleted projects are left out.", Query: []openapi.Parameter{openapi.QueryParam("project_id", "integer", "Only testimonials about this project")}, Response: []response.TestimonialResponse{}},
+	{Method: http.MethodGet, Path: "/projects/public/:id/testimonials", Tag: "Testimonials", Summary: "List the approved testimonials of a project", Description: "Grouped by portfolio, in each owner's order.", Response: []response.TestimonialResponse{}},
+	{Method: http.MethodGet, Path: "/portfolios/own/:id/testimonials", Tag: "Testimonials", Auth: true, Summary: "List every testimonial of a portfolio", Description: "In order, pending and rejected ones included.", Query: []openapi.Parameter{
+		openapi.QueryParam("status", "string", "Only testimonials in this state: pending, approved or rejected"),
+		openapi.QueryParam("project_id", "integer", "Only testimonials about this project"),
+	}, Response: []response.TestimonialResponse{}},
+	{Method: http.MethodPost, Path: "/testimonials/own", Tag: "Testimonials", Auth: true, Summary: "Add a testimonial", Description: "Testimonials added by the owner are approved right away and placed last. project_id must be a project shown in the portfolio.", Request: request.CreateTestimonialRequest{}, Response: response.TestimonialResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodGet, Path: "/testimonials/own/:id", Tag: "Testimonials", Auth: true, Summary: "Get a testimonial", Response: response.TestimonialResponse{}},
+	{Method: http.MethodPut, Path: "/testimonials/own/:id", Tag: "Testimonials", Auth: true, Summary: "Update a testimonial", Description: "Leaves its state and position as they are.", Request: request.TestimonialRequest{}, Response: response.TestimonialResponse{}},
+	{Method: http.MethodDelete, Path: "/testimonials/own/:id", Tag: "Testimonials", Auth: true, Summary: "Delete a testimonial"},
+	{Method: http.MethodPut, Path: "/testimonials/own/:id/status", Tag: "Testimonials", Auth: true, Summary: "Approve or reject a testimonial", Description: "Only approved testimonials are public.", Request: request.UpdateTestimonialStatusRequest{}, Response: response.TestimonialResponse{}},
+	{Method: http.MethodPut, Path: "/testimonials/own/:id/position", Tag: "Testimonials", Auth: true, Summary: "Move a testimonial to a new position", Description: "Give one of position, before (the ID of a sibling to go right before) or after; none moves it to the end. Positions are renumbered 1..n without gaps.", Request: request.PositionRequest{}},
+	{Method: http.MethodPut, Path: "/testimonials/own/reorder", Tag: "Testimonials", Auth: true, Summary: "Reorder several testimonials of a portfolio at once", Request: request.ReorderTestimonialsRequest{}},
+	{Method: http.MethodGet, Path: "/portfolios/own/:id/testimonials/invites", Tag: "Testimonials", Auth: true, Summary: "List the invite links of a portfolio", Description: "Newest first.", Response: []response.TestimonialInviteResponse{}},
+	{Method: http.MethodPost, Path: "/portfolios/own/:id/testimonials/invites", Tag: "Testimonials", Auth: true, Summary: "Create an invite link", Description: "Share the token with a client so they can submit one testimonial without an account. Links expire after 30 days unless expires_in_days says otherwise.", Request: request.CreateTestimonialInviteRequest{}, Response: response.TestimonialInviteResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodDelete, Path: "/testimonials/own/invites/:id", Tag: "Testimonials", Auth: true, Summary: "Revoke an invite link", Description: "Testimonials already submitted with it stay."},
+	{Method: http.MethodGet, Path: "/testimonials/invite/:token", Tag: "Testimonials", Summary: "Get what an invite link is for", Description: "Responds 410 once the link expired or was used.", Response: response.TestimonialInviteInfoResponse{}},
+	{Method: http.MethodPost, Path: "/testimonials/invite/:token", Tag: "Testimonials", Summary: "Submit a testimonial with an invite link", Description: "The testimonial waits for the owner's approval. Each link works once; responds 410 once it expired or was used.", Request: request.SubmitTestimonialRequest{}, Response: response.TestimonialResponse{}, Status: http.StatusCreated},
+
+	// Work history and education
+	{Method: http.MethodGet, Path: "/portfolios/public/:id/experiences", Tag: "Experience", Summary: "List the work history of a portfolio", Description: "In the owner's order. project_ids leaves out deleted projects.", Response: []response.ExperienceResponse{}},
+	{Method: http.MethodPost, Path: "/experiences/own", Tag: "Experience", Auth: true, Summary: "Add an experience", Description: "Placed last. project_ids must be projects shown in the portfolio; a current position has no end_date.", Request: request.CreateExperienceRequest{}, Response: response.ExperienceResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodGet, Path: "/experiences/own/:id", Tag: "Experience", Auth: true, Summary: "Get an experience", Response: response.ExperienceResponse{}},
+	{Method: http.MethodPut, Path: "/experiences/own/:id", Tag: "Experience", Auth: true, Summary: "Update an experience", Description: "project_ids replaces the linked projects.", Request: request.ExperienceRequest{}, Response: response.ExperienceResponse{}},
+	{Method: http.MethodDelete, Path: "/experiences/own/:id", Tag: "Experience", Auth: true, Summary: "Delete an experience"},
+	{Method: http.MethodPut, Path: "/experiences/own/:id/position", Tag: "Experience", Auth: true, Summary: "Move an experience to a new position", Description: "Give one of position, before (the ID of a sibling to go right before) or after; none moves it to the end. Positions are renumbered 1..n without gaps.", Request: request.PositionRequest{}},
+	{Method: http.MethodPut, Path: "/experiences/own/reorder", Tag: "Experience", Auth: true, Summary: "Reorder several experiences of a portfolio at once", Request: request.ReorderExperiencesRequest{}},
+	{Method: http.MethodGet, Path: "/portfolios/public/:id/education", Tag: "Experience", Summary: "List the education of a portfolio", Description: "In the owner's order.", Response: []response.EducationResponse{}},
+	{Method: http.MethodPost, Path: "/education/own", Tag: "Experience", Auth: true, Summary: "Add an education entry", Description: "Placed last.", Request: request.CreateEducationRequest{}, Response: response.EducationResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodGet, Path: "/education/own/:id", Tag: "Experience", Auth: true, Summary: "Get an education entry", Response: response.EducationResponse{}},
+	{Method: http.MethodPut, Path: "/education/own/:id", Tag: "Experience", Auth: true, Summary: "Update an education entry", Request: request.EducationRequest{}, Response: response.EducationResponse{}},
+	{Method: http.MethodDelete, Path: "/education/own/:id", Tag: "Experience", Auth: true, Summary: "Delete an education entry"},
+	{Method: http.MethodPut, Path: "/education/own/:id/position", Tag: "Experience", Auth: true, Summary: "Move an education entry to a new position", Description: "Give one of position, before (the ID of a sibling to go right before) or after; none moves it to the end. Positions are renumbered 1..n without gaps.", Request: request.PositionRequest{}},
+	{Method: http.MethodPut, Path: "/education/own/reorder", Tag: "Experience", Auth: true, Summary: "Reorder several education entries of a portfolio at once", Request: request.ReorderEducationRequest{}},
+
+	// Translations
+	{Method: http.MethodPut, Path: "/portfolios/own/:id/locales", Tag: "Translations", Auth: true, Summary: "Set the default and enabled locales of a portfolio", Description: "The stored content is in the default locale; the other enabled locales are served from translations, falling back to the stored text.", Request: request.SetLocalesRequest{}, Response: response.PortfolioResponse{}},
+	{Method: http.MethodGet, Path: "/portfolios/own/:id/translations", Tag: "Translations", Auth: true, Summary: "List the translations of a portfolio", Query: []openapi.Parameter{openapi.QueryParam("locale", "string", "Only translations into this locale")}, Response: []response.TranslationResponse{}},
+	{Method: http.MethodPut, Path: "/portfolios/own/:id/translations/:locale", Tag: "Translations", Auth: true, Summary: "Save translations into a locale", Description: "Upserts each field; an empty value removes its translation. The locale must be enabled and not the default.", Request: request.SaveTranslationsRequest{}, Response: []response.TranslationResponse{}},
+	{Method: http.MethodDelete, Path: "/portfolios/own/:id/translations/:locale", Tag: "Translations", Auth: true, Summary: "Delete every translation into a locale"},
+	{Method: http.MethodGet, Path: "/portfolios/own/:id/translations/completeness", Tag: "Translations", Auth: true, Summary: "Report how much of the portfolio is translated into each locale", Response: response.CompletenessResponse{}},
+
+	// Skills
+	{Method: http.MethodGet, Path: "/skills/own", Tag: "Skills", Auth: true, Summary: "List own skills with the number of projects using each", Query: []openapi.Parameter{skillKindParam}, Response: []response.SkillResponse{}},
+	{Method: http.MethodPost, Path: "/skills/own", Tag: "Skills", Auth: true, Summary: "Add a skill to the taxonomy", Description: "Names and aliases are matched ignoring case, spaces, dots, dashes and underscores; each may belong to one skill only.", Request: request.CreateSkillRequest{}, Response: response.SkillResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodGet, Path: "/skills/own/autocomplete", Tag: "Skills", Auth: true, Summary: "Suggest skills starting with what was typed", Description: "Own skills come first, the most used first, followed by built-in skills not in the taxonomy yet (without an id).", Query: []openapi.Parameter{
+		openapi.QueryParam("q", "string", "Start of a skill name or alias"),
+		openapi.QueryParam("limit", "integer", "Maximum suggestions (default 10, max 50)"),
+	}, Response: []response.SkillResponse{}},
+	{Method: http.MethodPut, Path: "/skills/own/:id", Tag: "Skills", Auth: true, Summary: "Rename a skill or change its kind and aliases", Description: "Projects using the skill show the new name.", Request: request.UpdateSkillRequest{}, Response: response.SkillResponse{}},
+	{Method: http.MethodDelete, Path: "/skills/own/:id", Tag: "Skills", Auth: true, Summary: "Delete a skill no project uses", Description: "Skills in use answer 409; merge them into another skill instead."},
+	{Method: http.MethodPost, Path: "/skills/own/:id/merge", Tag: "Skills", Auth: true, Summary: "Merge duplicate skills into this one", Description: "Projects of the merged skills link to this one and show its name; their names and aliases become its aliases.", Request: request.MergeSkillsRequest{}, Response: response.SkillResponse{}},
+
+	// Templates
+	{Method: http.MethodGet, Path: "/templates", Tag: "Templates", Summary: "List the built-in and shared templates", Description: "Built-in templates come first. Listings leave out the bundle of what each template creates.", Response: []response.TemplateResponse{}},
+	{Method: http.MethodGet, Path: "/templates/public/:id", Tag: "Templates", Summary: "Get a built-in or shared template with what it creates", Response: response.TemplateResponse{}},
+	{Method: http.MethodGet, Path: "/templates/own", Tag: "Templates", Auth: true, Summary: "List own templates", Response: []response.TemplateResponse{}},
+	{Method: http.MethodPost, Path: "/templates/own", Tag: "Templates", Auth: true, Summary: "Save a portfolio as a template", Description: "Captures the sections, content blocks, categories and projects of the portfolio as they are now. Private templates, the default, can only be used by their owner; shared ones by every user.", Request: request.CreateTemplateRequest{}, Response: response.TemplateResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodGet, Path: "/templates/own/:id", Tag: "Templates", Auth: true, Summary: "Get an own template with what it creates", Response: response.TemplateResponse{}},
+	{Method: http.MethodPut, Path: "/templates/own/:id", Tag: "Templates", Auth: true, Summary: "Rename a template or change its visibility", Request: request.UpdateTemplateRequest{}, Response: response.TemplateResponse{}},
+	{Method: http.MethodDelete, Path: "/templates/own/:id", Tag: "Templates", Auth: true, Summary: "Delete a template", Description: "Portfolios created from it are kept."},
+	{Method: http.MethodGet, Path: "/portfolios/public/:id/skills", Tag: "Skills", Summary: "Count the projects of a portfolio using each skill", Query: []openapi.Parameter{skillKindParam}, Response: response.SkillStatsResponse{}},
+
+	// Categories
+	{Method: http.MethodGet, Path: "/categories/own", Tag: "Categories", Auth: true, Summary: "List own categories", Query: pageParams, Response: []models.Category{}, Envelope: openapi.EnvelopePaginated},
+	{Method: http.MethodPost, Path: "/categories/own", Tag: "Categories", Auth: true, Summary: "Create a category", Request: request.CreateCategoryRequest{}, Response: models.Category{}, Status: http.StatusCreated},
+	{Method: http.MethodGet, Path: "/categories/own/:id", Tag: "Categories", Auth: true, Summary: "Get a category", Query: categoryPublicParams, Response: models.Category{}},
+	{Method: http.MethodPut, Path: "/categories/own/:id", Tag: "Categories", Auth: true, Summary: "Update a category", Request: request.UpdateCategoryRequest{}, Response: models.Category{}},
+	{Method: http.MethodPatch, Path: "/categories/own/:id", Tag: "Categories", Auth: true, Summary: "Partially update a category", Request: request.PatchCategoryRequest{}, Patch: true, Response: models.Category{}},
+	{Method: http.MethodPut, Path: "/categories/own/:id/position", Tag: "Categories", Auth: true, Summary: "Move a category to a new position", Description: "Give one of position, before (the ID of a sibling to go right before) or after; none moves it to the end. Positions are renumbered 1..n without gaps.", Request: request.PositionRequest{}},
+	{Method: http.MethodPut, Path: "/categories/own/reorder", Tag: "Categories", Auth: true, Summary: "Reorder several categories at once", Request: handler2.BulkReorderRequest{}},
+	{Method: http.MethodDelete, Path: "/categories/own/:id", Tag: "Categories", Auth: true, Summary: "Delete a category"},
+	{Method: http.MethodGet, Path: "/categories/id/:id", Tag: "Categories", Summary: "Get a public category", Description: "Counts as a view in the owner's analytics.", Query: categoryPageParams, Response: models.Category{}},
+	{Method: http.MethodGet, Path: "/categories/public/:id", Tag: "Categories", Summary: "Get a public category", Description: "Counts as a view in the owner's analytics.", Query: categoryPageParams, Response: models.Category{}},
+	{Method: http.MethodGet, Path: "/categories/public/:id/projects", Tag: "Categories", Summary: "List the projects of a category", Query: projectListParams, Response: []models.Project{}, Envelope: openapi.EnvelopeCursor},
+
+	// Projects
+	{Method: http.MethodGet, Path: "/projects/own", Tag: "Projects", Auth: true, Summary: "List own projects", Query: pageParams, Response: []models.Project{}, Envelope: openapi.EnvelopePaginated},
+	{Method: http.MethodPost, Path: "/projects/own", Tag: "Projects", Auth: true, Summary: "Create a project", Request: request.CreateProjectRequest{}, Response: models.Project{}, Status: http.StatusCreated},
+	{Method: http.MethodGet, Path: "/projects/own/:id", Tag: "Projects", Auth: true, Summary: "Get an own project", Query: projectSelectionParams, Response: models.Project{}},
+	{Method: http.MethodPut, Path: "/projects/own/:id", Tag: "Projects", Auth: true, Summary: "Update a project", Request: request.UpdateProjectRequest{}, Response: models.Project{}},
+	{Method: http.MethodPatch, Path: "/projects/own/:id", Tag: "Projects", Auth: true, Summary: "Partially update a project", Request: request.PatchProjectRequest{}, Patch: true, Response: models.Project{}},
+	{Method: http.MethodDelete, Path: "/projects/own/:id", Tag: "Projects", Auth: true, Summary: "Delete a project"},
+	{Method: http.MethodPut, Path: "/projects/own/:id/position", Tag: "Projects", Auth: true, Summary: "Move a project to a new position in one of its categories", Description: "category_id defaults to the project's primary category. Give one of position, before (the ID of a sibling to go right before) or after; none moves it to the end. Positions are renumbered 1..n without gaps.", Request: handler2.ProjectPositionRequest{}},
+	{Method: http.MethodPut, Path: "/projects/own/:id/move", Tag: "Projects", Auth: true, Summary: "Move a project to another category", Description: "from_category_id defaults to the primary category; moving out of it makes the new category primary. Moving to a category the project is already in is a 409.", Request: handler2.ProjectMoveRequest{}, Response: models.Project{}},
+	{Method: http.MethodPut, Path: "/projects/own/reorder", Tag: "Projects", Auth: true, Summary: "Reorder several projects of a category at once", Request: handler2.ProjectBulkReorderRequest{}},
+	{Method: http.MethodPost, Path: "/projects/own/:id/categories/:categoryId", Tag: "Projects", Auth: true, Summary: "Add a project to another category", Description: "The project is listed at the end of the category and keeps its primary category_id. category_ids lists every category it is in.", Response: models.Project{}},
+	{Method: http.MethodDelete, Path: "/projects/own/:id/categories/:categoryId", Tag: "Projects", Auth: true, Summary: "Remove a project from one of its categories", Description: "Removing the primary category makes the next category primary. A project's last category can't be removed (409).", Response: models.Project{}},
+	{Method: http.MethodGet, Path: "/projects/own/import/:provider/repositories", Tag: "Projects", Auth: true, Summary: "List the public repositories of an account on a Git provider", Description: "provider is github, or gitea when the server has a Gitea instance configured (400 otherwise). Repositories already imported by the user carry their project_id and sync state. Unknown accounts are a 404; a provider that can't be reached is a 502.", Query: []openapi.Parameter{openapi.QueryParam("account", "string", "User or organization on the provider")}, Response: []handler2.RepositoryResponse{}},
+	{Method: http.MethodPost, Path: "/projects/own/import", Tag: "Projects", Auth: true, Summary: "Import repositories of a Git provider as projects", Description: "New repositories become projects of the category named after them; repositories imported before update their project wherever it is. The description, topics and languages (as skills), URL (as link) and archived state are copied. Each repository gets a result: created, updated, or skipped with a reason (not_found, title_taken or invalid). sync keeps the projects refreshed from upstream on a schedule.", Request: request.ImportProjectsRequest{}, Response: []gitimport.Result{}},
+	{Method: http.MethodGet, Path: "/projects/public/:id", Tag: "Projects", Summary: "Get a public project", Description: "Counts as a view in the owner's analytics.", Query: projectPageParams, Response: models.Project{}},
+	{Method: http.MethodGet, Path: "/projects/category/:categoryId", Tag: "Projects", Summary: "List the projects of a category", Query: projectListParams, Response: []models.Project{}, Envelope: openapi.EnvelopeCursor},
+	{Method: http.MethodGet, Path: "/projects/search/skills", Tag: "Projects", Summary: "Search projects by skill", Query: []openapi.Parameter{openapi.QueryParam("skills", "string", "Skill to match, repeat for several")}, Response: []models.Project{}},
+	{Method: http.MethodGet, Path: "/projects/search/client", Tag: "Projects", Summary: "Search projects by client", Query: []openapi.Parameter{openapi.QueryParam("client", "string", "Client name")}, Response: []models.Project{}},
+
+	// Sections
+	{Method: http.MethodGet, Path: "/sections/own", Tag: "Sections", Auth: true, Summary: "List own sections", Query: pageParams, Response: []models.Section{}, Envelope: openapi.EnvelopePaginated},
+	{Method: http.MethodPost, Path: "/sections/own", Tag: "Sections", Auth: true, Summary: "Create a section", Request: request.CreateSectionRequest{}, Response: models.Section{}, Status: http.StatusCreated},
+	{Method: http.MethodGet, Path: "/sections/own/:id", Tag: "Sections", Auth: true, Summary: "Get an own section", Query: sectionPublicParams, Response: models.Section{}},
+	{Method: http.MethodPut, Path: "/sections/own/:id", Tag: "Sections", Auth: true, Summary: "Update a section", Request: request.UpdateSectionRequest{}, Response: models.Section{}},
+	{Method: http.MethodPatch, Path: "/sections/own/:id", Tag: "Sections", Auth: true, Summary: "Partially update a section", Request: request.PatchSectionRequest{}, Patch: true, Response: models.Section{}},
+	{Method: http.MethodPut, Path: "/sections/own/:id/position", Tag: "Sections", Auth: true, Summary: "Move a section to a new position", Description: "Give one of position, before (the ID of a sibling to go right before) or after; none moves it to the end. Positions are renumbered 1..n without gaps.", Request: request.PositionRequest{}},
+	{Method: http.MethodPut, Path: "/sections/own/:id/move", Tag: "Sections", Auth: true, Summary: "Move a section to another portfolio", Description: "The portfolio must belong to the same owner. Both portfolios' sections are renumbered.", Request: handler2.SectionMoveRequest{}, Response: models.Section{}},
+	{Method: http.MethodPut, Path: "/sections/own/reorder", Tag: "Sections", Auth: true, Summary: "Reorder several sections at once", Request: handler2.SectionBulkReorderRequest{}},
+	{Method: http.MethodDelete, Path: "/sections/own/:id", Tag: "Sections", Auth: true, Summary: "Delete a section"},
+	{Method: http.MethodGet, Path: "/sections/public/:id", Tag: "Sections", Summary: "Get a public section", Query: sectionPublicParams, Response: models.Section{}},
+	{Method: http.MethodGet, Path: "/sections/portfolio/:id", Tag: "Sections", Summary: "List the sections of a portfolio", Query: sectionListParams, Response: []models.Section{}, Envelope: openapi.EnvelopeCursor},
+	{Method: http.MethodGet, Path: "/sections/type", Tag: "Sections", Summary: "List sections by type", Query: []openapi.Parameter{openapi.QueryParam("type", "string", "Section type")}, Response: []models.Section{}},
+	{Method: http.MethodGet, Path: "/sections/:sectionId/contents", Tag: "Section Contents", Summary: "List the contents of a section", Query: langParams, Response: []response.SectionContentResponse{}},
+
+	// Section contents
+	{Method: http.MethodPost, Path: "/section-contents/own", Tag: "Section Contents", Auth: true, Summary: "Create a section content block", Request: request.CreateSectionContentRequest{}, Response: response.SectionContentResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodPut, Path: "/section-contents/own/:id", Tag: "Section Contents", Auth: true, Summary: "Update a section content block", Request: request.UpdateSectionContentRequest{}, Response: response.SectionContentResponse{}},
+	{Method: http.MethodPatch, Path: "/section-contents/own/:id", Tag: "Section Contents", Auth: true, Summary: "Partially update a section content block", Request: request.PatchSectionContentRequest{}, Patch: true, Response: response.SectionContentResponse{}},
+	{Method: http.MethodPatch, Path: "/section-contents/own/:id/order", Tag: "Section Contents", Auth: true, Summary: "Change the order of a content block", Request: request.UpdateSectionContentOrderRequest{}, Response: response.SectionContentResponse{}},
+	{Method: http.MethodPut, Path: "/section-contents/own/:id/position", Tag: "Section Contents", Auth: true, Summary: "Move a content block to a new position", Description: "Give one of position, before (the ID of a sibling to go right before) or after; none moves it to the end. Positions are renumbered 1..n without gaps.", Request: request.PositionRequest{}, Response: response.SectionContentResponse{}},
+	{Method: http.MethodPut, Path: "/section-contents/own/:id/move", Tag: "Section Contents", Auth: true, Summary: "Move a content block to another section", Request: request.MoveSectionContentRequest{}, Response: response.SectionContentResponse{}},
+	{Method: http.MethodPut, Path: "/section-contents/own/reorder", Tag: "Section Contents", Auth: true, Summary: "Reorder several content blocks of a section at once", Request: request.ReorderSectionContentsRequest{}},
+	{Method: http.MethodDelete, Path: "/section-contents/own/:id", Tag: "Section Contents", Auth: true, Summary: "Delete a section content block"},
+	{Method: http.MethodGet, Path: "/section-contents/:id", Tag: "Section Contents", Summary: "Get a section content block", Query: langParams, Response: response.SectionContentResponse{}},
+
+	// Batch
+	{Method: http.MethodPost, Path: "/batch", Tag: "Batch", Auth: true, Summary: "Run several operations in one transaction", Description: "Operations run in order and may reference earlier results with \"$ops[N].field\". The first failing operation rolls back the whole batch.", Request: request.BatchRequest{}, Response: response.BatchResponse{}},
+
+	// Webhooks
+	{Method: http.MethodGet, Path: "/webhooks/own", Tag: "Webhooks", Auth: true, Summary: "List own webhooks", Query: append([]openapi.Parameter{openapi.QueryParam("portfolio_id", "integer", "Only webhooks of this portfolio")}, pageParams...), Response: []response.WebhookResponse{}, Envelope: openapi.EnvelopePaginated},
+	{Method: http.MethodPost, Path: "/webhooks/own", Tag: "Webhooks", Auth: true, Summary: "Register a webhook", Description: "The signing secret is only returned in this response.", Request: request.CreateWebhookRequest{}, Response: response.WebhookResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodGet, Path: "/webhooks/own/:id", Tag: "Webhooks", Auth: true, Summary: "Get a webhook", Response: response.WebhookResponse{}},
+	{Method: http.MethodPut, Path: "/webhooks/own/:id", Tag: "Webhooks", Auth: true, Summary: "Update a webhook", Request: request.UpdateWebhookRequest{}, Response: response.WebhookResponse{}},
+	{Method: http.MethodDelete, Path: "/webhooks/own/:id", Tag: "Webhooks", Auth: true, Summary: "Delete a webhook"},
+	{Method: http.MethodGet, Path: "/webhooks/own/:id/deliveries", Tag: "Webhooks", Auth: true, Summary: "List the delivery log of a webhook", Query: pageParams, Response: []response.WebhookDeliveryResponse{}, Envelope: openapi.EnvelopePaginated},
+
+	// Users
+	{Method: http.MethodGet, Path: "/users/me/summary", Tag: "Users", Auth: true, Summary: "Summarise the data owned by the current user", Response: userSummary},
+	{Method: http.MethodDelete, Path: "/users/me/data", Tag: "Users", Auth: true, Summary: "Delete all data owned by the current user", Response: userCleanup, Envelope: openapi.EnvelopeNone},
+	{Method: http.MethodPost, Path: "/users/webhooks/authentik", Tag: "Users", Summary: "Receive an Authentik user lifecycle event", Description: "Signed with AUTHENTIK_WEBHOOK_SECRET: the X-Authentik-Signature header holds \"t=<unix seconds>,v1=<hex HMAC-SHA256 of '<t>.<body>'>\"; timestamps more than 5 minutes off are rejected as replays. Deleted users lose all data; deactivated users become read-only and their portfolios are hidden.", Request: request.AuthentikUserEvent{}, Response: models.UserLifecycleEvent{}},
+
+	// Background jobs
+	{Method: http.MethodGet, Path: "/admin/jobs", Tag: "Admin", Auth: true, Summary: "List background jobs", Description: "Latest first.", Query: concatParams([]openapi.Parameter{
+		openapi.QueryParam("status", "string", "Only jobs in this state: pending, running, succeeded or dead"),
+		openapi.QueryParam("type", "string", "Only jobs of this type"),
+	}, pageParams), Response: []response.JobResponse{}, Envelope: openapi.EnvelopePaginated},
+	{Method: http.MethodPost, Path: "/admin/jobs", Tag: "Admin", Auth: true, Summary: "Enqueue a background job", Description: "type must be one of the registered types listed by /admin/jobs/stats, and payload must match what the type expects. Runs at run_at, or right away when omitted.", Request: request.EnqueueJobRequest{}, Response: response.JobResponse{}, Status: http.StatusCreated},
+	{Method: http.MethodGet, Path: "/admin/jobs/stats", Tag: "Admin", Auth: true, Summary: "Summarize the job queue", Description: "The registered job types, how many jobs of each type are in each state, and the schedules with their last and next runs.", Response: response.JobStatsResponse{}},
+	{Method: http.MethodGet, Path: "/admin/jobs/:id", Tag: "Admin", Auth: true, Summary: "Get a background job", Response: response.JobResponse{}},
+	{Method: http.MethodPost, Path: "/admin/jobs/:id/retry", Tag: "Admin", Auth: true, Summary: "Retry a dead job", Description: "The job runs again right away with a fresh set of attempts. Only dead jobs can be retried.", Response: response.JobResponse{}},
+	{Method: http.MethodDelete, Path: "/admin/jobs/:id", Tag: "Admin", Auth: true, Summary: "Delete a background job", Description: "Running jobs can't be deleted."},
+
+	// Documentation
+	{Method: http.MethodGet, Path: openAPISpecPath, Tag: "Documentation", Summary: "OpenAPI 3.1 description of this API", Envelope: openapi.EnvelopeNone},
+	{Method: http.MethodGet, Path: openAPIDocsPath, Tag: "Documentation", Summary: "Rendered API documentation", Envelope: openapi.EnvelopeNone},
+	{Method: http.MethodGet, Path: openAPIBundlePath, Tag: "Documentation", Summary: "Redoc bundle used by the documentation page", Envelope: openapi.EnvelopeNone},
+}
+
+// RegisterDocsRoutes serves the OpenAPI document and its UI. The document is
+// built once, on first request, from the routes registered on the engine so
+// that undocumented or stale entries never reach clients.
+func (r *Router) RegisterDocsRoutes(apiGroup *gin.RouterGroup, routes func() gin.RoutesInfo) {
+	var (
+		once sync.Once
+		doc  *openapi.Document
+	)
+
+	build := func() *openapi.Document {
+		once.Do(func() {
+			basePath := apiGroup.BasePath()
+			registered := routes()
+
+			if missing := openapi.Undocumented(registered, basePath, openAPIRoutes); len(missing) > 0 {
+				logrus.WithField("routes", missing).Warn("Routes missing from the OpenAPI document")
+			}
+
+			active := make(map[string]bool, len(registered))
+			for _, info := range registered {
+				active[info.Method+" "+info.Path] = true
+			}
+
+			documented := make([]openapi.Route, 0, len(openAPIRoutes))
+			for _, route := range openAPIRoutes {
+				if active[route.Key(basePath)] {
+					documented = append(documented, route)
+				}
+			}
+
+			doc = openapi.Build(openapi.Info{
+				Title:       "Portfolio Manager API",
+				Description: "REST API for managing portfolios, sections, categories and projects.",
+				Version:     "1.0.0",
+			}, basePath, openAPITags, documented)
+		})
+		return doc
+	}
+
+	if !openapi.HasBundle() {
+		logrus.Warn("Redoc bundle not embedded; run go generate ./internal/shared/openapi to serve the docs page")
+	}
+
+	apiGroup.GET(openAPISpecPath, openapi.SpecHandler(build))
+	apiGroup.GET(openAPIDocsPath, openapi.UIHandler(apiGroup.BasePath()+openAPISpecPath, apiGroup.BasePath()+openAPIBundlePath))
+	apiGroup.GET(openAPIBundlePath, openapi.BundleHandler())
+}
+
+// selectionParams documents fields= and include= of a resource; include is
+// left out when the resource has no relations
+func selectionParams(fields, relations string) []openapi.Parameter {
+	params := []openapi.Parameter{
+		openapi.QueryParam("fields", "string", "Comma-separated fields to return instead of the full resource: "+fields),
+	}
+	if relations != "" {
+		params = append(params, openapi.QueryParam("include", "string", "Comma-separated relations to embed: "+relations))
+	}
+	return params
+}
+
+// concatParams joins parameter lists into a new slice
+func concatParams(lists ...[]openapi.Parameter) []openapi.Parameter {
+	var params []openapi.Parameter
+	for _, list := range lists {
+		params = append(params, list...)
+	}
+	return params
+}
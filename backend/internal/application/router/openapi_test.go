@@ -0,0 +1,63 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/openapi"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDocsEngine() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	api := engine.Group("/api")
+
+	r := NewRouter(nil, nil, nil, nil)
+	r.RegisterRoutes(api)
+	r.RegisterDocsRoutes(api, engine.Routes)
+	return engine
+}
+
+// TestOpenAPI_AllRoutesDocumented fails when a route is registered without an
+// entry in openAPIRoutes, so the spec can't drift from the router
+func TestOpenAPI_AllRoutesDocumented(t *testing.T) {
+	engine := newDocsEngine()
+
+	registered := make(map[string]bool)
+	for _, info := range engine.Routes() {
+		registered[info.Method+" "+info.Path] = true
+	}
+
+	for _, route := range openAPIRoutes {
+		assert.True(t, registered[route.Key("/api")], "documented route is not registered: %s", route.Key("/api"))
+	}
+
+	missing := openapi.Undocumented(engine.Routes(), "/api", openAPIRoutes)
+	assert.Empty(t, missing, "routes without an OpenAPI entry")
+}
+
+func TestOpenAPI_ServesSpec(t *testing.T) {
+	engine := newDocsEngine()
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	assert.Equal(t, "3.1.0", doc["openapi"])
+
+	paths := doc["paths"].(map[string]interface{})
+	assert.Contains(t, paths, "/projects/own/{id}")
+	assert.Contains(t, paths, "/sections/{sectionId}/contents")
+
+	w = httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/docs", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `spec-url="/api/openapi.json"`)
+}
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
 	"github.com/gin-gonic/gin"
 )
@@ -11,17 +12,41 @@ func (r *Router) RegisterPortfolioRoutes(apiGroup *gin.RouterGroup) {
 	// Protected routes - require authentication
 	protected := portfolios.Group("/own")
 	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount)      // Suspended accounts are read-only
+	protected.Use(middleware.IfMatch()) // Optimistic concurrency on PUT/PATCH/DELETE /:id
+	protected.Use(r.idempotency)        // Idempotency-Key support on POST
 	{
 		protected.GET("", r.portfolioHandler.GetByUser)
 		protected.POST("", r.portfolioHandler.Create)
 		protected.GET("/:id", r.portfolioHandler.GetByIDPublic) // Authenticated users can also view
 		protected.PUT("/:id", r.portfolioHandler.Update)
+		protected.PATCH("/:id", r.portfolioHandler.Patch)
 		protected.DELETE("/:id", r.portfolioHandler.Delete)
+		protected.GET("/:id/events", r.streamHandler.Events) // Server-Sent Events change stream
+		protected.GET("/:id/analytics", r.analyticsHandler.GetByPortfolio)
+		protected.GET("/:id/broken-links", r.linkCheckHandler.GetBrokenByPortfolio)
+		protected.GET("/:id/contact/settings", r.contactHandler.GetSettings)
+		protected.PUT("/:id/contact/settings", r.contactHandler.SaveSettings)
+		protected.GET("/:id/testimonials", r.testimonialHandler.GetByPortfolio)
+		protected.GET("/:id/testimonials/invites", r.testimonialHandler.GetInvites)
+		protected.POST("/:id/testimonials/invites", r.testimonialHandler.CreateInvite)
+
+		// Translations of the portfolio content
+		protected.PUT("/:id/locales", r.translationHandler.SetLocales)
+		protected.GET("/:id/translations", r.translationHandler.GetByPortfolio)
+		protected.GET("/:id/translations/completeness", r.translationHandler.Completeness)
+		protected.PUT("/:id/translations/:locale", r.translationHandler.Save)
+		protected.DELETE("/:id/translations/:locale", r.translationHandler.DeleteLocale)
 	}
 
-	// Public routes - no auth required
-	portfolios.GET("/id/:id", r.portfolioHandler.GetByIDPublic)
-	portfolios.GET("/public/:id", r.portfolioHandler.GetByIDPublic)
+	// Public routes - no auth required, views are counted
+	portfolios.GET("/id/:id", middleware.PageView(r.views, models.ViewPortfolio), r.portfolioHandler.GetByIDPublic)
+	portfolios.GET("/public/:id", middleware.PageView(r.views, models.ViewPortfolio), r.portfolioHandler.GetByIDPublic)
 	portfolios.GET("/public/:id/categories", r.categoryHandler.GetByPortfolio)
 	portfolios.GET("/public/:id/sections", r.sectionHandler.GetByPortfolio)
+	portfolios.GET("/public/:id/timeline", r.projectHandler.GetTimeline)
+	portfolios.GET("/public/:id/testimonials", r.testimonialHandler.GetPublicByPortfolio)
+	portfolios.GET("/public/:id/experiences", r.experienceHandler.GetByPortfolio)
+	portfolios.GET("/public/:id/education", r.educationHandler.GetByPortfolio)
+	portfolios.POST("/public/:id/contact", middleware.ContactRateLimit(), r.contactHandler.Submit)
 }
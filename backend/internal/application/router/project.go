@@ -1,6 +1,7 @@
 package router
 
 import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
 	"github.com/gin-gonic/gin"
 )
@@ -11,16 +12,28 @@ func (r *Router) RegisterProjectRoutes(apiGroup *gin.RouterGroup) {
 	// Protected routes - require authentication
 	protected := projects.Group("/own")
 	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount)      // Suspended accounts are read-only
+	protected.Use(middleware.IfMatch()) // Optimistic concurrency on PUT/PATCH/DELETE /:id
+	protected.Use(r.idempotency)        // Idempotency-Key support on POST
 	{
 		protected.GET("", r.projectHandler.GetByUser)
 		protected.POST("", r.projectHandler.Create)
 		protected.GET("/:id", r.projectHandler.GetByID)
 		protected.PUT("/:id", r.projectHandler.Update)
+		protected.PATCH("/:id", r.projectHandler.Patch)
 		protected.DELETE("/:id", r.projectHandler.Delete)
+		protected.PUT("/:id/position", r.projectHandler.UpdatePosition)
+		protected.PUT("/:id/move", r.projectHandler.Move)
+		protected.PUT("/reorder", r.projectHandler.BulkReorder)
+		protected.POST("/:id/categories/:categoryId", r.projectHandler.AddCategory)
+		protected.DELETE("/:id/categories/:categoryId", r.projectHandler.RemoveCategory)
+		protected.GET("/import/:provider/repositories", r.projectImportHandler.GetRepositories)
+		protected.POST("/import", r.projectImportHandler.Import)
 	}
 
-	// Public routes - no auth required
-	projects.GET("/public/:id", r.projectHandler.GetByIDPublic)
+	// Public routes - no auth required, views of project pages are counted
+	projects.GET("/public/:id", middleware.PageView(r.views, models.ViewProject), r.projectHandler.GetByIDPublic)
+	projects.GET("/public/:id/testimonials", r.testimonialHandler.GetPublicByProject)
 	projects.GET("/category/:categoryId", r.projectHandler.GetByCategory)
 	projects.GET("/search/skills", r.projectHandler.GetBySkills)
 	projects.GET("/search/client", r.projectHandler.GetByClient)
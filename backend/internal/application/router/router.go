@@ -2,8 +2,14 @@ package router
 
 import (
 	handler2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/handler"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/analytics"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/gitimport"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/jobs"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/metrics"
 	repo2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/stream"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
+	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 )
 
@@ -12,44 +18,84 @@ type Router struct {
 	portfolioHandler      *handler2.PortfolioHandler
 	categoryHandler       *handler2.CategoryHandler
 	projectHandler        *handler2.ProjectHandler
+	projectImportHandler  *handler2.ProjectImportHandler
 	sectionHandler        *handler2.SectionHandler
 	sectionContentHandler *handler2.SectionContentHandler
 	userHandler           *handler2.UserHandler
+	webhookHandler        *handler2.WebhookHandler
+	streamHandler         *handler2.StreamHandler
+	translationHandler    *handler2.TranslationHandler
+	skillHandler          *handler2.SkillHandler
+	templateHandler       *handler2.TemplateHandler
+	analyticsHandler      *handler2.AnalyticsHandler
+	linkCheckHandler      *handler2.LinkCheckHandler
+	contactHandler        *handler2.ContactHandler
+	testimonialHandler    *handler2.TestimonialHandler
+	experienceHandler     *handler2.ExperienceHandler
+	educationHandler      *handler2.EducationHandler
+	jobHandler            *handler2.JobHandler
+	hub                   *stream.Hub
+	idempotency           gin.HandlerFunc
+	activeAccount         gin.HandlerFunc
+	views                 *analytics.Recorder
 	metrics               *metrics.Collector
+	jobs                  *jobs.Runner
 }
 
-func NewRouter(db *gorm.DB, metrics *metrics.Collector) *Router {
-	portfolioRepo := repo2.NewPortfolioRepository(db)
-	portfolioHandler := handler2.NewPortfolioHandler(portfolioRepo, metrics)
-
-	categoryRepo := repo2.NewCategoryRepository(db)
-	categoryHandler := handler2.NewCategoryHandler(categoryRepo, portfolioRepo, metrics)
-
-	projectRepo := repo2.NewProjectRepository(db)
-	projectHandler := handler2.NewProjectHandler(projectRepo, categoryRepo, portfolioRepo, metrics)
-
-	sectionRepo := repo2.NewSectionRepository(db)
-	sectionHandler := handler2.NewSectionHandler(sectionRepo, portfolioRepo, metrics)
-
-	sectionContentRepo := repo2.NewSectionContentRepository(db)
-	sectionContentHandler := handler2.NewSectionContentHandler(sectionContentRepo, sectionRepo, portfolioRepo, metrics)
-
-	userHandler := handler2.NewUserHandler(
-		portfolioRepo,
-		categoryRepo,
-		sectionRepo,
-		projectRepo,
-		sectionContentRepo,
-	)
+func NewRouter(db *gorm.DB, metrics *metrics.Collector, hub *stream.Hub, runner *jobs.Runner) *Router {
+	repos := repo2.NewRepositories(db)
 
 	return &Router{
 		db:                    db,
-		portfolioHandler:      portfolioHandler,
-		categoryHandler:       categoryHandler,
-		projectHandler:        projectHandler,
-		sectionHandler:        sectionHandler,
-		sectionContentHandler: sectionContentHandler,
-		userHandler:           userHandler,
+		portfolioHandler:      handler2.NewPortfolioHandler(repos, metrics),
+		categoryHandler:       handler2.NewCategoryHandler(repos, metrics),
+		projectHandler:        handler2.NewProjectHandler(repos, metrics),
+		projectImportHandler:  handler2.NewProjectImportHandler(repos, gitimport.NewProviders()),
+		sectionHandler:        handler2.NewSectionHandler(repos, metrics),
+		sectionContentHandler: handler2.NewSectionContentHandler(repos, metrics),
+		userHandler:           handler2.NewUserHandler(repos),
+		webhookHandler:        handler2.NewWebhookHandler(repos),
+		streamHandler:         handler2.NewStreamHandler(repos, hub),
+		translationHandler:    handler2.NewTranslationHandler(repos),
+		skillHandler:          handler2.NewSkillHandler(repos),
+		templateHandler:       handler2.NewTemplateHandler(repos),
+		analyticsHandler:      handler2.NewAnalyticsHandler(repos),
+		linkCheckHandler:      handler2.NewLinkCheckHandler(repos),
+		contactHandler:        handler2.NewContactHandler(repos, runner),
+		testimonialHandler:    handler2.NewTestimonialHandler(repos),
+		experienceHandler:     handler2.NewExperienceHandler(repos),
+		educationHandler:      handler2.NewEducationHandler(repos),
+		jobHandler:            handler2.NewJobHandler(repos, runner),
+		hub:                   hub,
+		idempotency:           middleware.Idempotency(repos.IdempotencyKey),
+		activeAccount:         middleware.ActiveAccount(repos.UserStatus),
+		views:                 analytics.NewRecorder(repos.Analytics),
 		metrics:               metrics,
+		jobs:                  runner,
 	}
 }
+
+// RegisterRoutes mounts every API route on the given group
+func (r *Router) RegisterRoutes(apiGroup *gin.RouterGroup) {
+	r.registerOperationRoutes(apiGroup)
+	r.RegisterBatchRoutes(apiGroup)
+}
+
+// registerOperationRoutes mounts the routes a batch operation may call: all
+// of them but the batch route itself
+func (r *Router) registerOperationRoutes(apiGroup *gin.RouterGroup) {
+	r.RegisterPortfolioRoutes(apiGroup)
+	r.RegisterCategoryRoutes(apiGroup)
+	r.RegisterProjectRoutes(apiGroup)
+	r.RegisterSectionRoutes(apiGroup)
+	r.RegisterSectionContentRoutes(apiGroup)
+	r.RegisterUserRoutes(apiGroup)
+	r.RegisterWebhookRoutes(apiGroup)
+	r.RegisterSkillRoutes(apiGroup)
+	r.RegisterTemplateRoutes(apiGroup)
+	r.RegisterContactRoutes(apiGroup)
+	r.RegisterTestimonialRoutes(apiGroup)
+	r.RegisterExperienceRoutes(apiGroup)
+	r.RegisterEducationRoutes(apiGroup)
+	r.RegisterAdminRoutes(apiGroup)
+}
@@ -11,12 +11,17 @@ func (r *Router) RegisterSectionRoutes(apiGroup *gin.RouterGroup) {
 	// Protected routes - require authentication
 	protected := sections.Group("/own")
 	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount)      // Suspended accounts are read-only
+	protected.Use(middleware.IfMatch()) // Optimistic concurrency on PUT/PATCH/DELETE /:id
+	protected.Use(r.idempotency)        // Idempotency-Key support on POST
 	{
 		protected.GET("", r.sectionHandler.GetByUser)
 		protected.POST("", r.sectionHandler.Create)
 		protected.GET("/:id", r.sectionHandler.GetByID)
 		protected.PUT("/:id", r.sectionHandler.Update)
+		protected.PATCH("/:id", r.sectionHandler.Patch)
 		protected.PUT("/:id/position", r.sectionHandler.UpdatePosition)
+		protected.PUT("/:id/move", r.sectionHandler.Move)
 		protected.PUT("/reorder", r.sectionHandler.BulkReorder)
 		protected.DELETE("/:id", r.sectionHandler.Delete)
 	}
@@ -11,10 +11,17 @@ func (r *Router) RegisterSectionContentRoutes(apiGroup *gin.RouterGroup) {
 	// Protected routes - require authentication
 	protected := sectionContents.Group("/own")
 	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount)      // Suspended accounts are read-only
+	protected.Use(middleware.IfMatch()) // Optimistic concurrency on PUT/PATCH/DELETE /:id
+	protected.Use(r.idempotency)        // Idempotency-Key support on POST
 	{
 		protected.POST("", r.sectionContentHandler.Create)
 		protected.PUT("/:id", r.sectionContentHandler.Update)
+		protected.PATCH("/:id", r.sectionContentHandler.Patch)
 		protected.PATCH("/:id/order", r.sectionContentHandler.UpdateOrder)
+		protected.PUT("/:id/position", r.sectionContentHandler.UpdatePosition)
+		protected.PUT("/:id/move", r.sectionContentHandler.Move)
+		protected.PUT("/reorder", r.sectionContentHandler.BulkReorder)
 		protected.DELETE("/:id", r.sectionContentHandler.Delete)
 	}
 
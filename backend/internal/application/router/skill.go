@@ -0,0 +1,28 @@
+package router
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *Router) RegisterSkillRoutes(apiGroup *gin.RouterGroup) {
+	skills := apiGroup.Group("/skills")
+
+	// Protected routes - each user has their own taxonomy
+	protected := skills.Group("/own")
+	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount)      // Suspended accounts are read-only
+	protected.Use(middleware.IfMatch()) // Optimistic concurrency on PUT/DELETE /:id
+	protected.Use(r.idempotency)        // Idempotency-Key support on POST
+	{
+		protected.GET("", r.skillHandler.GetByUser)
+		protected.POST("", r.skillHandler.Create)
+		protected.GET("/autocomplete", r.skillHandler.Autocomplete)
+		protected.PUT("/:id", r.skillHandler.Update)
+		protected.DELETE("/:id", r.skillHandler.Delete)
+		protected.POST("/:id/merge", r.skillHandler.Merge)
+	}
+
+	// Public routes - usage statistics of a portfolio
+	apiGroup.GET("/portfolios/public/:id/skills", r.skillHandler.GetPortfolioStats)
+}
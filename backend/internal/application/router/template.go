@@ -0,0 +1,28 @@
+package router
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *Router) RegisterTemplateRoutes(apiGroup *gin.RouterGroup) {
+	templates := apiGroup.Group("/templates")
+
+	// Protected routes - templates saved by the user
+	protected := templates.Group("/own")
+	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount)      // Suspended accounts are read-only
+	protected.Use(middleware.IfMatch()) // Optimistic concurrency on PUT/DELETE /:id
+	protected.Use(r.idempotency)        // Idempotency-Key support on POST
+	{
+		protected.GET("", r.templateHandler.GetByUser)
+		protected.POST("", r.templateHandler.Create)
+		protected.GET("/:id", r.templateHandler.GetByID)
+		protected.PUT("/:id", r.templateHandler.Update)
+		protected.DELETE("/:id", r.templateHandler.Delete)
+	}
+
+	// Public routes - built-in and shared templates
+	templates.GET("", r.templateHandler.GetAvailable)
+	templates.GET("/public/:id", r.templateHandler.GetPublic)
+}
@@ -0,0 +1,33 @@
+package router
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterTestimonialRoutes mounts testimonials and the invite links clients
+// submit them with; the listings of a portfolio live under /portfolios
+func (r *Router) RegisterTestimonialRoutes(apiGroup *gin.RouterGroup) {
+	testimonials := apiGroup.Group("/testimonials")
+
+	// Protected routes - require authentication
+	protected := testimonials.Group("/own")
+	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount)      // Suspended accounts are read-only
+	protected.Use(middleware.IfMatch()) // Optimistic concurrency on PUT/DELETE /:id
+	protected.Use(r.idempotency)        // Idempotency-Key support on POST
+	{
+		protected.POST("", r.testimonialHandler.Create)
+		protected.PUT("/reorder", r.testimonialHandler.BulkReorder)
+		protected.GET("/:id", r.testimonialHandler.GetByID)
+		protected.PUT("/:id", r.testimonialHandler.Update)
+		protected.DELETE("/:id", r.testimonialHandler.Delete)
+		protected.PUT("/:id/status", r.testimonialHandler.UpdateStatus)
+		protected.PUT("/:id/position", r.testimonialHandler.UpdatePosition)
+		protected.DELETE("/invites/:id", r.testimonialHandler.DeleteInvite)
+	}
+
+	// Public routes - the token in the link is the credential
+	testimonials.GET("/invite/:token", r.testimonialHandler.GetInvite)
+	testimonials.POST("/invite/:token", r.testimonialHandler.Submit)
+}
@@ -9,6 +9,7 @@ func (r *Router) RegisterUserRoutes(apiGroup *gin.RouterGroup) {
 	// User management routes - require authentication
 	users := apiGroup.Group("/users")
 	users.Use(middleware2.AuthMiddleware())
+	users.Use(r.activeAccount) // Suspended accounts are read-only
 	{
 		// Get data summary for authenticated user
 		users.GET("/me/summary", r.userHandler.GetUserDataSummary)
@@ -16,4 +17,7 @@ func (r *Router) RegisterUserRoutes(apiGroup *gin.RouterGroup) {
 		// Delete all data for authenticated user (GDPR compliance)
 		users.DELETE("/me/data", r.userHandler.CleanupUserData)
 	}
+
+	// Authentik user lifecycle events - authenticated by the shared webhook secret
+	apiGroup.POST("/users/webhooks/authentik", r.userHandler.AuthentikEvent)
 }
@@ -0,0 +1,25 @@
+package router
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func (r *Router) RegisterWebhookRoutes(apiGroup *gin.RouterGroup) {
+	webhooks := apiGroup.Group("/webhooks")
+
+	// Protected routes - webhooks are only ever visible to their owner
+	protected := webhooks.Group("/own")
+	protected.Use(middleware.AuthMiddleware())
+	protected.Use(r.activeAccount)      // Suspended accounts are read-only
+	protected.Use(middleware.IfMatch()) // Optimistic concurrency on PUT/DELETE /:id
+	protected.Use(r.idempotency)        // Idempotency-Key support on POST
+	{
+		protected.GET("", r.webhookHandler.GetByUser)
+		protected.POST("", r.webhookHandler.Create)
+		protected.GET("/:id", r.webhookHandler.GetByID)
+		protected.PUT("/:id", r.webhookHandler.Update)
+		protected.DELETE("/:id", r.webhookHandler.Delete)
+		protected.GET("/:id/deliveries", r.webhookHandler.GetDeliveries)
+	}
+}
@@ -0,0 +1,39 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+)
+
+// Aggregator rolls page views up into the daily counts, then drops the views
+// and salts no longer needed
+type Aggregator struct {
+	repo repo.AnalyticsRepository
+	now  func() time.Time
+}
+
+// NewAggregator creates an aggregator
+func NewAggregator(views repo.AnalyticsRepository) *Aggregator {
+	return &Aggregator{
+		repo: views,
+		now:  time.Now,
+	}
+}
+
+// Rollup recounts the days that still have page views. Views are kept until
+// the day after theirs is over, so a view recorded while the previous rollup
+// ran is still counted; salts only until their day is over, after which
+// visitors can't be recognized anymore.
+func (a *Aggregator) Rollup() error {
+	if err := a.repo.Rollup(); err != nil {
+		return err
+	}
+
+	today := startOfDay(a.now())
+	if _, err := a.repo.DeleteViewsBefore(today.AddDate(0, 0, -1)); err != nil {
+		return err
+	}
+	_, err := a.repo.DeleteSaltsBefore(today)
+	return err
+}
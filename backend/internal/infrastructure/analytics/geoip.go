@@ -0,0 +1,105 @@
+package analytics
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+)
+
+// GeoIP resolves IP addresses to countries from a local table of networks
+type GeoIP struct {
+	ranges []ipRange // Sorted by start, not overlapping
+}
+
+type ipRange struct {
+	start   net.IP // 16-byte form
+	end     net.IP // 16-byte form
+	country string
+}
+
+// LoadGeoIP reads a CSV file whose rows are a network in CIDR notation and
+// its ISO 3166-1 alpha-2 country code, as in "203.0.113.0/24,BR". Further
+// columns are ignored, as are a header row and rows without a country, so
+// exports such as the GeoLite2 country blocks joined with their locations can
+// be used as they are.
+func LoadGeoIP(path string) (*GeoIP, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ParseGeoIP(file)
+}
+
+// ParseGeoIP reads a GeoIP table in the format of LoadGeoIP
+func ParseGeoIP(r io.Reader) (*GeoIP, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+
+	geo := &GeoIP{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("line %d: expected a network and a country", line)
+		}
+
+		_, network, err := net.ParseCIDR(strings.TrimSpace(record[0]))
+		if err != nil {
+			if line == 1 {
+				continue // Header
+			}
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		country := strings.ToUpper(strings.TrimSpace(record[1]))
+		if len(country) != 2 {
+			continue
+		}
+
+		start := network.IP.To16()
+		end := make(net.IP, len(start))
+		mask := network.Mask
+		if len(mask) == net.IPv4len {
+			// IPv4 addresses are the last 4 bytes of their 16-byte form
+			mask = append(net.CIDRMask(96, 128)[:12:12], mask...)
+		}
+		for i := range start {
+			end[i] = start[i] | ^mask[i]
+		}
+		geo.ranges = append(geo.ranges, ipRange{start: start, end: end, country: country})
+	}
+
+	sort.Slice(geo.ranges, func(i, j int) bool {
+		return bytes.Compare(geo.ranges[i].start, geo.ranges[j].start) < 0
+	})
+	return geo, nil
+}
+
+// Country returns the country code of ip, or "" when it is unknown or g is nil
+func (g *GeoIP) Country(ip net.IP) string {
+	if g == nil || ip == nil {
+		return ""
+	}
+	ip = ip.To16()
+
+	// The last range starting at or before ip is the only one that can hold it
+	i := sort.Search(len(g.ranges), func(i int) bool {
+		return bytes.Compare(g.ranges[i].start, ip) > 0
+	}) - 1
+	if i < 0 || bytes.Compare(ip, g.ranges[i].end) > 0 {
+		return ""
+	}
+	return g.ranges[i].country
+}
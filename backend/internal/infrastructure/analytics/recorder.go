@@ -0,0 +1,186 @@
+// Package analytics records views of public pages without cookies or stored
+// IPs, and rolls them up into daily counts owners can look at
+package analytics
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/sirupsen/logrus"
+)
+
+// botMarkers are found in the User-Agent of crawlers and link previewers,
+// whose requests aren't views
+var botMarkers = []string{"bot", "crawl", "spider", "slurp", "preview", "facebookexternalhit", "headless"}
+
+// Recorder stores views of public pages. Visitors are told apart by a hash of
+// their IP and User-Agent salted with a random salt that changes every day.
+type Recorder struct {
+	repo repo.AnalyticsRepository
+	geo  *GeoIP // nil when no GeoIP file is configured
+	now  func() time.Time
+
+	ownDomains map[string]bool // Navigation between these isn't a referral
+
+	mu      sync.Mutex
+	saltDay time.Time
+	salt    string
+}
+
+// NewRecorder creates a recorder configured from the environment:
+// ANALYTICS_GEOIP_FILE names a CSV file mapping networks to countries (see
+// LoadGeoIP); without one, countries aren't counted. Referrals from the
+// ALLOWED_ORIGINS, the frontends, aren't counted either.
+func NewRecorder(views repo.AnalyticsRepository) *Recorder {
+	recorder := &Recorder{
+		repo:       views,
+		now:        time.Now,
+		ownDomains: make(map[string]bool),
+	}
+	for _, origin := range strings.Split(os.Getenv("ALLOWED_ORIGINS"), ",") {
+		if domain := ReferrerDomain(strings.TrimSpace(origin)); domain != "" && domain != "*" {
+			recorder.ownDomains[domain] = true
+		}
+	}
+
+	if path := os.Getenv("ANALYTICS_GEOIP_FILE"); path != "" {
+		geo, err := LoadGeoIP(path)
+		if err != nil {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation": "ANALYTICS_GEOIP_LOAD_ERROR",
+				"where":     "backend/internal/infrastructure/analytics/recorder.go",
+				"function":  "NewRecorder",
+				"path":      path,
+				"error":     err.Error(),
+			}).Error("Failed to load GeoIP file, countries won't be counted")
+		} else {
+			recorder.geo = geo
+		}
+	}
+
+	return recorder
+}
+
+// Record stores a view of a page by the client of req, whose IP is clientIP.
+// Frontends that fetch the page for the visitor pass the visitor's referrer as
+// ?ref=, which is used instead of the Referer header. Requests from bots are
+// ignored.
+func (r *Recorder) Record(req *http.Request, clientIP string, resourceType string, resourceID uint) error {
+	userAgent := req.UserAgent()
+	if IsBot(userAgent) {
+		return nil
+	}
+
+	now := r.now().UTC()
+	salt, err := r.saltOf(now)
+	if err != nil {
+		return err
+	}
+
+	view := models.PageView{
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		VisitorHash:  VisitorHash(salt, clientIP, userAgent),
+		Referrer:     r.referrer(req),
+		Country:      r.geo.Country(net.ParseIP(clientIP)),
+		ViewedAt:     now,
+	}
+	return r.repo.RecordView(&view)
+}
+
+// referrer returns the domain the visitor of req came from, or "" for direct
+// visits and navigation within the site
+func (r *Recorder) referrer(req *http.Request) string {
+	referer := req.URL.Query().Get("ref")
+	if referer == "" {
+		referer = req.Referer()
+	}
+
+	domain := ReferrerDomain(referer)
+	if domain == ReferrerDomain(req.Host) || r.ownDomains[domain] {
+		return ""
+	}
+	return domain
+}
+
+// saltOf returns the salt of the day of now, shared with other instances
+// through the database
+func (r *Recorder) saltOf(now time.Time) (string, error) {
+	day := startOfDay(now)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.saltDay.Equal(day) {
+		return r.salt, nil
+	}
+
+	candidate := make([]byte, 32)
+	if _, err := rand.Read(candidate); err != nil {
+		return "", err
+	}
+	salt, err := r.repo.Salt(day, hex.EncodeToString(candidate))
+	if err != nil {
+		return "", err
+	}
+
+	r.saltDay, r.salt = day, salt
+	return salt, nil
+}
+
+// VisitorHash identifies a visitor for one day without storing their IP
+func VisitorHash(salt, clientIP, userAgent string) string {
+	sum := sha256.Sum256([]byte(salt + "\n" + clientIP + "\n" + userAgent))
+	return hex.EncodeToString(sum[:16])
+}
+
+// IsBot reports whether userAgent belongs to a crawler or is missing
+func IsBot(userAgent string) bool {
+	userAgent = strings.ToLower(userAgent)
+	if userAgent == "" {
+		return true
+	}
+	for _, marker := range botMarkers {
+		if strings.Contains(userAgent, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReferrerDomain returns the domain of referer, lowercased and without
+// "www.", or "" when referer has none
+func ReferrerDomain(referer string) string {
+	domain := referer
+	if i := strings.Index(domain, "://"); i >= 0 {
+		domain = domain[i+3:]
+	}
+	if i := strings.IndexAny(domain, "/?#"); i >= 0 {
+		domain = domain[:i]
+	}
+	if i := strings.LastIndex(domain, "@"); i >= 0 {
+		domain = domain[i+1:]
+	}
+	if host, _, err := net.SplitHostPort(domain); err == nil {
+		domain = host
+	}
+	domain = strings.TrimPrefix(strings.ToLower(domain), "www.")
+	if len(domain) > 255 {
+		domain = domain[:255]
+	}
+	return domain
+}
+
+// startOfDay returns midnight UTC of the day of t
+func startOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
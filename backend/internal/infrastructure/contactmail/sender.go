@@ -0,0 +1,159 @@
+// Package contactmail sends the mails that follow a contact form message: the
+// owner's notification and the visitor's auto-reply. Both run as background
+// jobs queued with the message, so a slow or failing mail server neither holds
+// the visitor up nor loses the mail.
+package contactmail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/jobs"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/notify"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Background job types sending the mails of a contact message; the payload
+// of both is a Payload
+const (
+	JobNotifyOwner = "contact.notify_owner"
+	JobAutoReply   = "contact.auto_reply"
+)
+
+// Timeout bounds one attempt at sending a contact mail
+const Timeout = time.Minute
+
+// Payload names the message a mail job is about
+type Payload struct {
+	MessageID uint `json:"message_id"`
+}
+
+// Sender sends contact mails through notifier
+type Sender struct {
+	contacts   repo.ContactRepository
+	portfolios repo.PortfolioRepository
+	notifier   notify.Notifier
+}
+
+func NewSender(contacts repo.ContactRepository, portfolios repo.PortfolioRepository, notifier notify.Notifier) *Sender {
+	return &Sender{
+		contacts:   contacts,
+		portfolios: portfolios,
+		notifier:   notifier,
+	}
+}
+
+// Jobs prepares the mail jobs settings ask for after message, ready to be
+// stored in the transaction that stores the message
+func Jobs(runner *jobs.Runner, message *models.ContactMessage, settings *models.ContactSettings) ([]*models.Job, error) {
+	var types []string
+	if settings.NotifyEmail != "" {
+		types = append(types, JobNotifyOwner)
+	}
+	if settings.AutoReply {
+		types = append(types, JobAutoReply)
+	}
+
+	queued := make([]*models.Job, 0, len(types))
+	for _, jobType := range types {
+		job, err := runner.Prepare(jobType, Payload{MessageID: message.ID}, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		queued = append(queued, job)
+	}
+	return queued, nil
+}
+
+// NotifyOwner runs the JobNotifyOwner job: it emails the owner about the
+// message, with the visitor as Reply-To
+func (s *Sender) NotifyOwner(ctx context.Context, payload Payload) error {
+	message, portfolio, settings, err := s.load(payload)
+	if err != nil || message == nil || settings.NotifyEmail == "" {
+		return err
+	}
+
+	subject := fmt.Sprintf("New message on %s from %s", portfolio.Title, message.Name)
+	if message.Subject != "" {
+		subject = fmt.Sprintf("New message on %s: %s", portfolio.Title, message.Subject)
+	}
+	return s.notifier.Notify(ctx, notify.Message{
+		To:      []string{settings.NotifyEmail},
+		ReplyTo: &mail.Address{Name: message.Name, Address: message.Email},
+		Subject: subject,
+		Body: fmt.Sprintf("%s <%s> wrote through the contact form of %s:\n\n%s\n\nReply to this email to answer.",
+			message.Name, message.Email, portfolio.Title, message.Body),
+	})
+}
+
+// SendAutoReply runs the JobAutoReply job: it sends the visitor the
+// auto-reply unless the address is capped (see models.AutoReplyWindow). A
+// retry resends the reply the message already claimed.
+func (s *Sender) SendAutoReply(ctx context.Context, payload Payload) error {
+	message, portfolio, settings, err := s.load(payload)
+	if err != nil || message == nil || !settings.AutoReply {
+		return err
+	}
+
+	if message.AutoRepliedAt == nil {
+		claimed, err := s.contacts.ClaimAutoReply(message)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation":   "CONTACT_AUTO_REPLY_CAPPED",
+				"where":       "backend/internal/infrastructure/contactmail/sender.go",
+				"function":    "SendAutoReply",
+				"messageID":   message.ID,
+				"portfolioID": message.PortfolioID,
+			}).Warn("Auto-reply skipped, cap reached")
+			return nil
+		}
+	}
+
+	subject, body := settings.RenderAutoReply(portfolio.Title)
+	reply := notify.Message{To: []string{message.Email}, Subject: subject, Body: body}
+	if settings.NotifyEmail != "" {
+		reply.ReplyTo = &mail.Address{Address: settings.NotifyEmail}
+	}
+	return s.notifier.Notify(ctx, reply)
+}
+
+// load reads what a mail job needs. The message is nil when it or its
+// portfolio was deleted since, leaving nothing to send; settings are read
+// now, so the mails follow the owner's latest choice.
+func (s *Sender) load(payload Payload) (*models.ContactMessage, *models.Portfolio, *models.ContactSettings, error) {
+	message, err := s.contacts.GetByID(payload.MessageID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// The full portfolio, notifications name it by its title
+	portfolio, err := s.portfolios.GetByID(message.PortfolioID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	settings, err := s.contacts.GetSettings(portfolio.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		settings, err = models.DefaultContactSettings(portfolio), nil
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return message, portfolio, settings, nil
+}
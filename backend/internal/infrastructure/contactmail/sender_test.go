@@ -0,0 +1,59 @@
+package contactmail
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newTestRunner() *jobs.Runner {
+	runner := jobs.NewRunner(nil, nil)
+	sender := NewSender(nil, nil, nil)
+	jobs.Register(runner, JobNotifyOwner, sender.NotifyOwner, jobs.WithTimeout(Timeout))
+	jobs.Register(runner, JobAutoReply, sender.SendAutoReply, jobs.WithTimeout(Timeout))
+	return runner
+}
+
+func TestJobs(t *testing.T) {
+	runner := newTestRunner()
+	message := &models.ContactMessage{Model: gorm.Model{ID: 7}}
+
+	tests := []struct {
+		name     string
+		settings models.ContactSettings
+		want     []string
+	}{
+		{"nothing to send", models.ContactSettings{}, []string{}},
+		{"owner only", models.ContactSettings{NotifyEmail: "owner@example.com"}, []string{JobNotifyOwner}},
+		{"auto-reply only", models.ContactSettings{AutoReply: true}, []string{JobAutoReply}},
+		{"both", models.ContactSettings{NotifyEmail: "owner@example.com", AutoReply: true}, []string{JobNotifyOwner, JobAutoReply}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queued, err := Jobs(runner, message, &tt.settings)
+			require.NoError(t, err)
+
+			types := make([]string, 0, len(queued))
+			for _, job := range queued {
+				types = append(types, job.Type)
+				assert.Equal(t, models.JobPending, job.Status)
+
+				var payload Payload
+				require.NoError(t, json.Unmarshal(job.Payload, &payload))
+				assert.Equal(t, uint(7), payload.MessageID)
+			}
+			assert.Equal(t, tt.want, types)
+		})
+	}
+}
+
+func TestJobs_UnregisteredType(t *testing.T) {
+	_, err := Jobs(jobs.NewRunner(nil, nil), &models.ContactMessage{Model: gorm.Model{ID: 7}}, &models.ContactSettings{AutoReply: true})
+	assert.ErrorIs(t, err, jobs.ErrUnknownType)
+}
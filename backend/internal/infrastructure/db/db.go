@@ -101,6 +101,34 @@ func (d *Database) Migrate() error {
 		&models2.SectionContent{},
 		&models2.Category{},
 		&models2.Project{},
+		&models2.IdempotencyKey{},
+		&models2.Webhook{},
+		&models2.WebhookDelivery{},
+		&models2.UserStatus{},
+		&models2.UserLifecycleEvent{},
+		&models2.PortfolioEvent{},
+		&models2.Translation{},
+		&models2.Skill{},
+		&models2.ProjectSkill{},
+		&models2.ProjectCategory{},
+		&models2.PortfolioTemplate{},
+		&models2.PageView{},
+		&models2.AnalyticsSalt{},
+		&models2.DailyView{},
+		&models2.DailyResourceView{},
+		&models2.DailySourceView{},
+		&models2.ContactMessage{},
+		&models2.ContactSettings{},
+		&models2.Testimonial{},
+		&models2.TestimonialInvite{},
+		&models2.Experience{},
+		&models2.ExperienceProject{},
+		&models2.Education{},
+		&models2.ProjectSource{},
+		&models2.LinkCheck{},
+		&models2.LinkCheckResult{},
+		&models2.Job{},
+		&models2.JobSchedule{},
 	)
 
 	if err != nil {
@@ -124,6 +152,16 @@ func (d *Database) Migrate() error {
 		return fmt.Errorf("failed to create project position trigger: %w", err)
 	}
 
+	// Create triggers that link projects to their primary category
+	if err := CreateProjectCategoryTriggers(d.DB); err != nil {
+		return fmt.Errorf("failed to create project category triggers: %w", err)
+	}
+
+	// Link existing projects to their category; listings read the links
+	if err := BackfillProjectCategories(d.DB); err != nil {
+		return fmt.Errorf("failed to backfill project categories: %w", err)
+	}
+
 	// Create trigger for automatic section position assignment
 	if err := CreateSectionPositionTrigger(d.DB); err != nil {
 		return fmt.Errorf("failed to create section position trigger: %w", err)
@@ -135,6 +173,28 @@ func (d *Database) Migrate() error {
 		// Don't return error - non-critical for new installations
 	}
 
+	// Create trigger for automatic section content order assignment
+	if err := CreateSectionContentOrderTrigger(d.DB); err != nil {
+		return fmt.Errorf("failed to create section content order trigger: %w", err)
+	}
+
+	// Close the gaps and duplicates in existing positions
+	if err := NormalizePositions(d.DB); err != nil {
+		log.Printf("Warning: position normalization failed: %v", err)
+		// Don't return error - listings still order by position and creation
+	}
+
+	// Move the free-text skills of existing projects into the taxonomy
+	if err := BackfillProjectSkills(d.DB); err != nil {
+		log.Printf("Warning: project skills backfill failed: %v", err)
+		// Don't return error - projects keep their skills array either way
+	}
+
+	// Seed the built-in templates new portfolios can start from
+	if err := SeedBuiltinTemplates(d.DB); err != nil {
+		return fmt.Errorf("failed to seed built-in templates: %w", err)
+	}
+
 	// Drop the redundant category_count column from portfolios
 	if err := DropCategoryCountColumn(d.DB); err != nil {
 		return fmt.Errorf("failed to drop category_count column: %w", err)
@@ -153,6 +213,12 @@ func (d *Database) Migrate() error {
 	return nil
 }
 
+// DSN returns the connection string, for clients that need their own
+// connection such as the LISTEN/NOTIFY listener
+func (d *Database) DSN() string {
+	return d.buildDSN()
+}
+
 func (d *Database) buildDSN() string {
 	host := d.getEnv("DB_HOST", "localhost")
 	port := d.getEnv("DB_PORT", "5432")
@@ -5,6 +5,8 @@ import (
 	"log"
 	"strings"
 
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/templates"
 	"gorm.io/gorm"
 )
 
@@ -162,7 +164,8 @@ func CreateCategoryPositionTrigger(db *gorm.DB) error {
 
 // CreateProjectPositionTrigger creates a database trigger to automatically set
 // the position field for new projects based on the current maximum position
-// within the same category. This ensures proper ordering without race conditions.
+// within the same category, counting every project linked to it through
+// project_categories. This ensures proper ordering without race conditions.
 func CreateProjectPositionTrigger(db *gorm.DB) error {
 	log.Println("Creating project position trigger...")
 
@@ -173,10 +176,11 @@ func CreateProjectPositionTrigger(db *gorm.DB) error {
 		BEGIN
 			-- Only set position if it's NULL or 0
 			IF NEW.position IS NULL OR NEW.position = 0 THEN
-				SELECT COALESCE(MAX(position) + 1, 1) INTO NEW.position
-				FROM projects
-				WHERE category_id = NEW.category_id
-				AND deleted_at IS NULL;
+				SELECT COALESCE(MAX(project_categories.position) + 1, 1) INTO NEW.position
+				FROM project_categories
+				JOIN projects ON projects.id = project_categories.project_id
+				WHERE project_categories.category_id = NEW.category_id
+				AND projects.deleted_at IS NULL;
 			END IF;
 			RETURN NEW;
 		END;
@@ -205,6 +209,113 @@ func CreateProjectPositionTrigger(db *gorm.DB) error {
 	return nil
 }
 
+// CreateProjectCategoryTriggers creates the database triggers that keep a
+// project linked to its primary category in project_categories: a new project
+// is linked to its category_id at its position, and changing category_id moves
+// the link, appending the project to the new category unless it was already
+// in it. Rows written without the repository stay consistent this way.
+func CreateProjectCategoryTriggers(db *gorm.DB) error {
+	log.Println("Creating project category triggers...")
+
+	// Create the trigger functions
+	if err := db.Exec(`
+		CREATE OR REPLACE FUNCTION link_project_category()
+		RETURNS TRIGGER AS $$
+		BEGIN
+			INSERT INTO project_categories (project_id, category_id, position, created_at)
+			VALUES (NEW.id, NEW.category_id, NEW.position, NOW())
+			ON CONFLICT (project_id, category_id) DO NOTHING;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create link_project_category function: %w", err)
+	}
+
+	if err := db.Exec(`
+		CREATE OR REPLACE FUNCTION move_project_category()
+		RETURNS TRIGGER AS $$
+		BEGIN
+			DELETE FROM project_categories
+			WHERE project_id = OLD.id
+			AND category_id = OLD.category_id;
+
+			-- Keep the position the project already has in the new category
+			SELECT position INTO NEW.position
+			FROM project_categories
+			WHERE project_id = NEW.id
+			AND category_id = NEW.category_id;
+
+			IF NOT FOUND THEN
+				SELECT COALESCE(MAX(project_categories.position) + 1, 1) INTO NEW.position
+				FROM project_categories
+				JOIN projects ON projects.id = project_categories.project_id
+				WHERE project_categories.category_id = NEW.category_id
+				AND projects.deleted_at IS NULL;
+
+				INSERT INTO project_categories (project_id, category_id, position, created_at)
+				VALUES (NEW.id, NEW.category_id, NEW.position, NOW());
+			END IF;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create move_project_category function: %w", err)
+	}
+
+	// Drop triggers if they exist and create them
+	if err := db.Exec(`
+		DROP TRIGGER IF EXISTS after_insert_project ON projects;
+		DROP TRIGGER IF EXISTS before_update_project_category ON projects;
+	`).Error; err != nil {
+		return fmt.Errorf("failed to drop existing triggers: %w", err)
+	}
+
+	if err := db.Exec(`
+		CREATE TRIGGER after_insert_project
+		AFTER INSERT ON projects
+		FOR EACH ROW
+		EXECUTE FUNCTION link_project_category();
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create after_insert_project trigger: %w", err)
+	}
+
+	if err := db.Exec(`
+		CREATE TRIGGER before_update_project_category
+		BEFORE UPDATE OF category_id ON projects
+		FOR EACH ROW
+		WHEN (OLD.category_id IS DISTINCT FROM NEW.category_id)
+		EXECUTE FUNCTION move_project_category();
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create before_update_project_category trigger: %w", err)
+	}
+
+	log.Println("Project category triggers created successfully")
+	return nil
+}
+
+// BackfillProjectCategories links existing projects to their category_id in
+// project_categories at their current position. Existing links are kept, so it
+// only does work once per project.
+func BackfillProjectCategories(db *gorm.DB) error {
+	log.Println("Backfilling project categories for existing data...")
+
+	result := db.Exec(`
+		INSERT INTO project_categories (project_id, category_id, position, created_at)
+		SELECT id, category_id, position, created_at
+		FROM projects
+		WHERE category_id IS NOT NULL
+		AND category_id <> 0
+		ON CONFLICT (project_id, category_id) DO NOTHING
+	`)
+	if result.Error != nil {
+		return fmt.Errorf("failed to link projects to their categories: %w", result.Error)
+	}
+
+	log.Printf("Backfill complete: linked %d projects to their categories", result.RowsAffected)
+	return nil
+}
+
 // CreateSectionPositionTrigger creates a database trigger to automatically set
 // the position field for new sections based on the current maximum position
 // within the same portfolio. This ensures proper ordering without race conditions.
@@ -302,6 +413,178 @@ func BackfillSectionPositions(db *gorm.DB) error {
 	return nil
 }
 
+// CreateSectionContentOrderTrigger creates a database trigger to automatically
+// set the order field for new content blocks based on the current maximum
+// order within the same section, like the position triggers of the other
+// ordered tables.
+func CreateSectionContentOrderTrigger(db *gorm.DB) error {
+	log.Println("Creating section content order trigger...")
+
+	// Create the trigger function
+	if err := db.Exec(`
+		CREATE OR REPLACE FUNCTION set_section_content_order()
+		RETURNS TRIGGER AS $$
+		BEGIN
+			-- Only set order if it's NULL or 0
+			IF NEW."order" IS NULL OR NEW."order" = 0 THEN
+				SELECT COALESCE(MAX("order") + 1, 1) INTO NEW."order"
+				FROM section_contents
+				WHERE section_id = NEW.section_id
+				AND deleted_at IS NULL;
+			END IF;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create set_section_content_order function: %w", err)
+	}
+
+	// Drop trigger if it exists and create it
+	if err := db.Exec(`
+		DROP TRIGGER IF EXISTS before_insert_section_content ON section_contents;
+	`).Error; err != nil {
+		return fmt.Errorf("failed to drop existing trigger: %w", err)
+	}
+
+	if err := db.Exec(`
+		CREATE TRIGGER before_insert_section_content
+		BEFORE INSERT ON section_contents
+		FOR EACH ROW
+		EXECUTE FUNCTION set_section_content_order();
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create before_insert_section_content trigger: %w", err)
+	}
+
+	log.Println("Section content order trigger created successfully")
+	return nil
+}
+
+// NormalizePositions renumbers the categories and sections of every portfolio,
+// the projects of every category and the content blocks of every section
+// 1..n in their current order, closing the gaps deletes used to leave. Rows
+// already at their position are left alone, so it only does work once.
+func NormalizePositions(db *gorm.DB) error {
+	log.Println("Normalizing positions of ordered rows...")
+
+	statements := []struct {
+		name  string
+		query string
+	}{
+		{"categories", `
+			UPDATE categories SET position = ranked.position
+			FROM (
+				SELECT id, ROW_NUMBER() OVER (PARTITION BY portfolio_id ORDER BY position, created_at, id) AS position
+				FROM categories
+				WHERE deleted_at IS NULL
+			) AS ranked
+			WHERE ranked.id = categories.id
+			AND categories.position IS DISTINCT FROM ranked.position
+		`},
+		{"sections", `
+			UPDATE sections SET position = ranked.position
+			FROM (
+				SELECT id, ROW_NUMBER() OVER (PARTITION BY portfolio_id ORDER BY position, created_at, id) AS position
+				FROM sections
+				WHERE deleted_at IS NULL
+			) AS ranked
+			WHERE ranked.id = sections.id
+			AND sections.position IS DISTINCT FROM ranked.position
+		`},
+		{"section contents", `
+			UPDATE section_contents SET "order" = ranked.position
+			FROM (
+				SELECT id, ROW_NUMBER() OVER (PARTITION BY section_id ORDER BY "order", created_at, id) AS position
+				FROM section_contents
+				WHERE deleted_at IS NULL
+			) AS ranked
+			WHERE ranked.id = section_contents.id
+			AND section_contents."order" IS DISTINCT FROM ranked.position
+		`},
+		{"project categories", `
+			UPDATE project_categories SET position = ranked.position
+			FROM (
+				SELECT project_categories.project_id, project_categories.category_id,
+					ROW_NUMBER() OVER (PARTITION BY project_categories.category_id
+						ORDER BY project_categories.position, project_categories.created_at, project_categories.project_id) AS position
+				FROM project_categories
+				JOIN projects ON projects.id = project_categories.project_id AND projects.deleted_at IS NULL
+			) AS ranked
+			WHERE ranked.project_id = project_categories.project_id
+			AND ranked.category_id = project_categories.category_id
+			AND project_categories.position IS DISTINCT FROM ranked.position
+		`},
+		// projects.position mirrors the position in the primary category
+		{"projects", `
+			UPDATE projects SET position = project_categories.position
+			FROM project_categories
+			WHERE project_categories.project_id = projects.id
+			AND project_categories.category_id = projects.category_id
+			AND projects.position IS DISTINCT FROM project_categories.position
+		`},
+	}
+
+	for _, statement := range statements {
+		result := db.Exec(statement.query)
+		if result.Error != nil {
+			return fmt.Errorf("failed to normalize %s positions: %w", statement.name, result.Error)
+		}
+		log.Printf("Normalized %d %s", result.RowsAffected, statement.name)
+	}
+	return nil
+}
+
+// BackfillProjectSkills moves the free-text skills of existing projects into
+// the skills taxonomy. Projects that already have links are skipped, so it only
+// does work once per project.
+func BackfillProjectSkills(db *gorm.DB) error {
+	log.Println("Backfilling project skills into the taxonomy...")
+
+	var projectIDs []uint
+	if err := db.Raw(`
+		SELECT id
+		FROM projects
+		WHERE deleted_at IS NULL
+		AND cardinality(skills) > 0
+		AND NOT EXISTS (SELECT 1 FROM project_skills WHERE project_skills.project_id = projects.id)
+		ORDER BY id
+	`).Scan(&projectIDs).Error; err != nil {
+		return fmt.Errorf("failed to get projects with unlinked skills: %w", err)
+	}
+
+	linkedCount := 0
+	for _, projectID := range projectIDs {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			_, err := repo.LinkProjectSkills(tx, projectID)
+			return err
+		})
+		if err != nil {
+			log.Printf("Warning: failed to link skills of project %d: %v", projectID, err)
+			continue
+		}
+		linkedCount++
+	}
+
+	log.Printf("Backfill complete: linked skills of %d of %d projects", linkedCount, len(projectIDs))
+	return nil
+}
+
+// SeedBuiltinTemplates creates the built-in portfolio templates embedded in the
+// binary, or refreshes them when their bundles changed in this version
+func SeedBuiltinTemplates(db *gorm.DB) error {
+	log.Println("Seeding built-in portfolio templates...")
+
+	builtin, err := templates.Builtin()
+	if err != nil {
+		return fmt.Errorf("failed to load built-in templates: %w", err)
+	}
+	if err := repo.SeedTemplates(db, builtin); err != nil {
+		return fmt.Errorf("failed to seed built-in templates: %w", err)
+	}
+
+	log.Printf("Seeding complete: %d built-in templates", len(builtin))
+	return nil
+}
+
 // DropCategoryCountColumn removes the category_count field from portfolios table
 // This field is redundant and can cause sync issues; position is now managed by trigger
 func DropCategoryCountColumn(db *gorm.DB) error {
@@ -376,6 +659,22 @@ func AddCascadeDeleteConstraints(db *gorm.DB) error {
 			refTable:   "categories",
 			refColumn:  "id",
 		},
+		// ProjectCategories -> Projects
+		{
+			table:      "project_categories",
+			constraint: "fk_project_categories_project",
+			column:     "project_id",
+			refTable:   "projects",
+			refColumn:  "id",
+		},
+		// ProjectCategories -> Categories
+		{
+			table:      "project_categories",
+			constraint: "fk_project_categories_category",
+			column:     "category_id",
+			refTable:   "categories",
+			refColumn:  "id",
+		},
 		// SectionContent -> Sections (already has CASCADE, but update for consistency)
 		{
 			table:      "section_contents",
@@ -0,0 +1,132 @@
+// Package gitimport imports projects from the public repositories of Git
+// hosting providers, and keeps the ones that ask for it in sync with upstream
+package gitimport
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+// ErrNotFound is returned for accounts and repositories the provider doesn't
+// know, and for private repositories, which are never imported
+var ErrNotFound = errors.New("gitimport: not found")
+
+// ProviderError is a request to a provider that failed for another reason
+// than ErrNotFound, such as the provider being down or rate limiting
+type ProviderError struct {
+	Provider string
+	Err      error
+}
+
+func (e *ProviderError) Error() string {
+	return e.Provider + ": " + e.Err.Error()
+}
+
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// providerError wraps the errors of provider other than ErrNotFound
+func providerError(provider string, err error) error {
+	if err == nil || errors.Is(err, ErrNotFound) {
+		return err
+	}
+	return &ProviderError{Provider: provider, Err: err}
+}
+
+// Repository is a repository as a provider describes it
+type Repository struct {
+	ID          int64     `json:"id"` // Stable across renames and transfers
+	Name        string    `json:"name"`
+	FullName    string    `json:"full_name"` // owner/name
+	Description string    `json:"description"`
+	URL         string    `json:"url"`
+	Homepage    string    `json:"homepage,omitempty"`
+	Language    string    `json:"language,omitempty"` // The main language
+	Languages   []string  `json:"languages,omitempty"`
+	Topics      []string  `json:"topics"`
+	Fork        bool      `json:"fork"`
+	Archived    bool      `json:"archived"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Provider reads repositories from a Git hosting provider. Implementations
+// must be safe for concurrent use.
+type Provider interface {
+	// Repositories lists the public repositories of an account, without Languages
+	Repositories(ctx context.Context, account string) ([]Repository, error)
+	// Repository fetches a public repository by ID, with its Languages
+	Repository(ctx context.Context, id int64) (*Repository, error)
+}
+
+// NewProviders creates the providers configured in the environment, by name:
+// GitHub at GITHUB_API_URL (default https://api.github.com), and Gitea when
+// GITEA_URL is set. GITHUB_TOKEN and GITEA_TOKEN, when set, only raise the
+// rate limits; private repositories are never imported.
+func NewProviders() map[string]Provider {
+	apiURL := os.Getenv("GITHUB_API_URL")
+	if apiURL == "" {
+		apiURL = "https://api.github.com"
+	}
+	providers := map[string]Provider{
+		models.ProviderGitHub: NewGitHub(apiURL, os.Getenv("GITHUB_TOKEN")),
+	}
+	if baseURL := os.Getenv("GITEA_URL"); baseURL != "" {
+		providers[models.ProviderGitea] = NewGitea(baseURL, os.Getenv("GITEA_TOKEN"))
+	}
+	return providers
+}
+
+// Apply copies what a project shows from the repository: its description,
+// its topics and languages as skills, its URL as the link, and its archived
+// state. An empty description keeps the project's own, or falls back to the
+// repository's full name.
+func Apply(project *models.Project, repository Repository) {
+	if description := strings.TrimSpace(repository.Description); description != "" {
+		project.Description = description
+	} else if project.Description == "" {
+		project.Description = repository.FullName
+	}
+	project.Skills = Skills(repository)
+	project.Link = repository.URL
+	if repository.Archived {
+		project.Status = models.ProjectArchived
+	}
+}
+
+// Skills returns the topics of the repository followed by its languages,
+// without repeating any of them
+func Skills(repository Repository) models.StringArray {
+	skills := models.StringArray{}
+	seen := make(map[string]bool)
+	for _, skill := range append(append([]string{}, repository.Topics...), repository.Languages...) {
+		skill = strings.TrimSpace(skill)
+		key := strings.ToLower(skill)
+		if skill == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		skills = append(skills, skill)
+	}
+	return skills
+}
+
+// Digest fingerprints what Apply copies from the repository, so a resync only
+// writes the project when upstream changed and edits made since are kept
+func Digest(repository Repository) string {
+	hash := sha256.New()
+	for _, value := range append([]string{repository.Description, repository.URL, strconv.FormatBool(repository.Archived)},
+		Skills(repository)...) {
+		hash.Write([]byte(value))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,146 @@
+package gitimport
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/gitimport/gittest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTProvider_Repositories(t *testing.T) {
+	server := gittest.NewServer()
+	defer server.Close()
+
+	// More than a page of repositories
+	for i := 1; i <= pageSize+5; i++ {
+		server.Put(gittest.Repository{ID: int64(i), Owner: "ana", Name: fmt.Sprintf("repo-%d", i)})
+	}
+	server.Put(gittest.Repository{ID: 100, Owner: "ana", Name: "secret", Private: true})
+	server.Put(gittest.Repository{ID: 101, Owner: "bruno", Name: "other"})
+
+	providers := map[string]Provider{
+		"github": NewGitHub(server.URL(), "token"),
+		"gitea":  NewGitea(server.URL()+"/", ""),
+	}
+	for name, provider := range providers {
+		t.Run(name, func(t *testing.T) {
+			repositories, err := provider.Repositories(context.Background(), "ana")
+			require.NoError(t, err)
+			require.Len(t, repositories, pageSize+5, "private repositories are left out")
+			assert.Equal(t, int64(1), repositories[0].ID)
+			assert.Equal(t, "ana/repo-1", repositories[0].FullName)
+			assert.Equal(t, "https://git.example.org/ana/repo-1", repositories[0].URL)
+			assert.Equal(t, []string{}, repositories[0].Topics)
+
+			_, err = provider.Repositories(context.Background(), "nobody")
+			assert.ErrorIs(t, err, ErrNotFound)
+		})
+	}
+}
+
+func TestRESTProvider_Repository(t *testing.T) {
+	server := gittest.NewServer()
+	defer server.Close()
+
+	server.Put(gittest.Repository{
+		ID:          7,
+		Owner:       "ana",
+		Name:        "billing",
+		Description: "Invoices and payments",
+		Homepage:    "https://billing.example.org",
+		Topics:      []string{"payments", "api"},
+		Languages:   map[string]int64{"Shell": 300, "Go": 90000, "Dockerfile": 300},
+		Archived:    true,
+	})
+	server.Put(gittest.Repository{ID: 8, Owner: "ana", Name: "secret", Private: true})
+
+	for name, provider := range map[string]Provider{"github": NewGitHub(server.URL(), ""), "gitea": NewGitea(server.URL(), "")} {
+		t.Run(name, func(t *testing.T) {
+			repository, err := provider.Repository(context.Background(), 7)
+			require.NoError(t, err)
+			assert.Equal(t, "billing", repository.Name)
+			assert.Equal(t, "Invoices and payments", repository.Description)
+			assert.Equal(t, "https://billing.example.org", repository.Homepage)
+			assert.Equal(t, "Go", repository.Language)
+			assert.Equal(t, []string{"Go", "Dockerfile", "Shell"}, repository.Languages, "most used first, then by name")
+			assert.True(t, repository.Archived)
+
+			_, err = provider.Repository(context.Background(), 8)
+			assert.ErrorIs(t, err, ErrNotFound, "private")
+			_, err = provider.Repository(context.Background(), 9)
+			assert.ErrorIs(t, err, ErrNotFound, "missing")
+		})
+	}
+
+	server.Fail(true)
+	_, err := NewGitHub(server.URL(), "").Repository(context.Background(), 7)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "github responded with 503")
+}
+
+func TestApply(t *testing.T) {
+	repository := Repository{
+		FullName:    "ana/billing",
+		Description: "  Invoices and payments ",
+		URL:         "https://git.example.org/ana/billing",
+		Topics:      []string{"payments", "Go", ""},
+		Languages:   []string{"Go", "Shell"},
+	}
+
+	tests := []struct {
+		name        string
+		project     models.Project
+		repository  func(Repository) Repository
+		description string
+		status      string
+	}{
+		{"copies the description", models.Project{Description: "Old"}, nil, "Invoices and payments", ""},
+		{"keeps the description without one upstream", models.Project{Description: "Old"},
+			func(r Repository) Repository { r.Description = ""; return r }, "Old", ""},
+		{"falls back to the full name", models.Project{},
+			func(r Repository) Repository { r.Description = " "; return r }, "ana/billing", ""},
+		{"archives", models.Project{Status: models.ProjectShipped},
+			func(r Repository) Repository { r.Archived = true; return r }, "Invoices and payments", models.ProjectArchived},
+		{"doesn't unarchive", models.Project{Status: models.ProjectArchived}, nil, "Invoices and payments", models.ProjectArchived},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := repository
+			if tt.repository != nil {
+				r = tt.repository(r)
+			}
+			project := tt.project
+			Apply(&project, r)
+			assert.Equal(t, tt.description, project.Description)
+			assert.Equal(t, models.StringArray{"payments", "Go", "Shell"}, project.Skills)
+			assert.Equal(t, "https://git.example.org/ana/billing", project.Link)
+			assert.Equal(t, tt.status, project.Status)
+		})
+	}
+}
+
+func TestDigest(t *testing.T) {
+	repository := Repository{ID: 7, Name: "billing", Description: "Invoices", URL: "https://git.example.org/ana/billing", Topics: []string{"api"}}
+
+	renamed := repository
+	renamed.Name, renamed.Language = "payments", "Go"
+	assert.Equal(t, Digest(repository), Digest(renamed), "only what Apply copies counts")
+
+	for name, change := range map[string]func(*Repository){
+		"description": func(r *Repository) { r.Description = "Payments" },
+		"url":         func(r *Repository) { r.URL = "https://git.example.org/ana/payments" },
+		"archived":    func(r *Repository) { r.Archived = true },
+		"topics":      func(r *Repository) { r.Topics = []string{"api", "payments"} },
+		"languages":   func(r *Repository) { r.Languages = []string{"Go"} },
+	} {
+		changed := repository
+		change(&changed)
+		assert.NotEqual(t, Digest(repository), Digest(changed), name)
+	}
+}
@@ -0,0 +1,198 @@
+// Package gittest runs a local stand-in for the GitHub and Gitea REST APIs in
+// tests. It serves the repositories added to it under the GitHub paths, and
+// under the same paths prefixed with /api/v1 as Gitea does, so one stand-in
+// can back both providers.
+package gittest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Repository is a repository served by the stand-in
+type Repository struct {
+	ID          int64
+	Owner       string
+	Name        string
+	Description string
+	Homepage    string
+	Topics      []string
+	Languages   map[string]int64 // Bytes of code by language
+	Fork        bool
+	Archived    bool
+	Private     bool
+}
+
+// URL is where the stand-in says the repository is browsed
+func (r Repository) URL() string {
+	return "https://git.example.org/" + r.Owner + "/" + r.Name
+}
+
+// Server is a Git hosting stand-in listening on a loopback address
+type Server struct {
+	server *httptest.Server
+
+	mu           sync.Mutex
+	repositories []Repository
+	failing      bool
+}
+
+// NewServer starts a stand-in on a random loopback port
+func NewServer() *Server {
+	s := &Server{}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// URL returns the base URL of the stand-in, both the GitHub API URL and the
+// Gitea instance URL
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close stops the stand-in
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Put adds the repository, or replaces the one with its ID
+func (s *Server) Put(repository Repository) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.repositories {
+		if s.repositories[i].ID == repository.ID {
+			s.repositories[i] = repository
+			return
+		}
+	}
+	s.repositories = append(s.repositories, repository)
+}
+
+// Fail makes every request fail with 503 until called with false
+func (s *Server) Fail(failing bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failing = failing
+}
+
+// Reset forgets every repository and stops failing
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.repositories = nil
+	s.failing = false
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failing {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v1"), "/"), "/")
+	switch {
+	case len(parts) == 3 && parts[0] == "users" && parts[2] == "repos":
+		s.list(w, r, parts[1])
+	case len(parts) == 2 && parts[0] == "repositories":
+		id, _ := strconv.ParseInt(parts[1], 10, 64)
+		if repository, ok := s.find(func(r Repository) bool { return r.ID == id }); ok {
+			writeJSON(w, repositoryJSON(repository))
+			return
+		}
+		http.NotFound(w, r)
+	case len(parts) == 4 && parts[0] == "repos" && parts[3] == "languages":
+		if repository, ok := s.find(func(r Repository) bool { return r.Owner == parts[1] && r.Name == parts[2] }); ok {
+			languages := repository.Languages
+			if languages == nil {
+				languages = map[string]int64{}
+			}
+			writeJSON(w, languages)
+			return
+		}
+		http.NotFound(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// list serves a page of the repositories of an account; accounts without any
+// repository, private ones included, don't exist
+func (s *Server) list(w http.ResponseWriter, r *http.Request, owner string) {
+	var owned []interface{}
+	for _, repository := range s.repositories {
+		if repository.Owner == owner {
+			owned = append(owned, repositoryJSON(repository))
+		}
+	}
+	if len(owned) == 0 {
+		http.NotFound(w, r)
+		return
+	}
+
+	size := 30
+	for _, param := range []string{"per_page", "limit"} {
+		if value, err := strconv.Atoi(r.URL.Query().Get(param)); err == nil && value > 0 {
+			size = value
+		}
+	}
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	start := min((page-1)*size, len(owned))
+	writeJSON(w, append([]interface{}{}, owned[start:min(start+size, len(owned))]...))
+}
+
+func (s *Server) find(match func(Repository) bool) (Repository, bool) {
+	for _, repository := range s.repositories {
+		if match(repository) {
+			return repository, true
+		}
+	}
+	return Repository{}, false
+}
+
+// repositoryJSON renders a repository with the fields of both APIs
+func repositoryJSON(r Repository) map[string]interface{} {
+	language := ""
+	var most int64
+	for name, bytes := range r.Languages {
+		if bytes > most || (bytes == most && name < language) {
+			language, most = name, bytes
+		}
+	}
+	topics := r.Topics
+	if topics == nil {
+		topics = []string{}
+	}
+	return map[string]interface{}{
+		"id":          r.ID,
+		"name":        r.Name,
+		"full_name":   r.Owner + "/" + r.Name,
+		"description": r.Description,
+		"html_url":    r.URL(),
+		"homepage":    r.Homepage,
+		"website":     r.Homepage,
+		"language":    language,
+		"topics":      topics,
+		"fork":        r.Fork,
+		"archived":    r.Archived,
+		"private":     r.Private,
+		"updated_at":  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
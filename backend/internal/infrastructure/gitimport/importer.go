@@ -0,0 +1,261 @@
+package gitimport
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	syncBatchSize   = 20
+	maxSyncErrorLen = 500
+)
+
+// ErrUnknownProvider is returned for providers that aren't configured
+var ErrUnknownProvider = errors.New("gitimport: unknown provider")
+
+// What happened to each repository of an import
+const (
+	ResultCreated = "created"
+	ResultUpdated = "updated"
+	ResultSkipped = "skipped"
+)
+
+// Why a repository was skipped
+const (
+	ReasonNotFound   = "not_found"   // Unknown or private
+	ReasonTitleTaken = "title_taken" // Another project of the category has its name
+	ReasonInvalid    = "invalid"     // It doesn't make a valid project
+)
+
+// Result tells what an import did with one repository
+type Result struct {
+	RepositoryID int64           `json:"repository_id"`
+	FullName     string          `json:"full_name,omitempty"`
+	Status       string          `json:"status"`
+	Reason       string          `json:"reason,omitempty"`
+	Project      *models.Project `json:"project,omitempty"`
+}
+
+// Importer creates and updates projects from repositories, tracking where
+// each came from
+type Importer struct {
+	providers map[string]Provider
+	projects  repo.ProjectRepository
+	sources   repo.ProjectSourceRepository
+	now       func() time.Time
+}
+
+// NewImporter creates an importer reading from the providers, by name
+func NewImporter(providers map[string]Provider, projects repo.ProjectRepository, sources repo.ProjectSourceRepository) *Importer {
+	return &Importer{
+		providers: providers,
+		projects:  projects,
+		sources:   sources,
+		now:       time.Now,
+	}
+}
+
+// Repositories lists the public repositories of an account on the provider,
+// with the owner's projects imported from them by repository ID
+func (i *Importer) Repositories(ctx context.Context, ownerID, provider, account string) ([]Repository, map[int64]models.ProjectSource, error) {
+	source, ok := i.providers[provider]
+	if !ok {
+		return nil, nil, ErrUnknownProvider
+	}
+	repositories, err := source.Repositories(ctx, account)
+	if err != nil {
+		return nil, nil, providerError(provider, err)
+	}
+	sources, err := i.sources.GetByOwnerID(ownerID, provider)
+	if err != nil {
+		return nil, nil, err
+	}
+	imported := make(map[int64]models.ProjectSource, len(sources))
+	for _, s := range sources {
+		imported[s.RepoID] = s
+	}
+	return repositories, imported, nil
+}
+
+// Import brings the repositories into the owner's category. Repositories the
+// owner imported before update their project wherever it is; the others
+// become projects named after them. sync turns the scheduled resync of every
+// one of them on or off. Every repository is fetched before anything is
+// written, so a provider failure leaves the projects as they were.
+func (i *Importer) Import(ctx context.Context, ownerID, provider string, categoryID uint, ids []int64, sync bool) ([]Result, error) {
+	source, ok := i.providers[provider]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+
+	repositories := make([]*Repository, len(ids))
+	for n, id := range ids {
+		repository, err := source.Repository(ctx, id)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return nil, providerError(provider, err)
+		}
+		repositories[n] = repository
+	}
+
+	results := make([]Result, len(ids))
+	for n, repository := range repositories {
+		if repository == nil {
+			results[n] = Result{RepositoryID: ids[n], Status: ResultSkipped, Reason: ReasonNotFound}
+			continue
+		}
+		result, err := i.importRepository(ownerID, provider, categoryID, *repository, sync)
+		if err != nil {
+			return nil, err
+		}
+		results[n] = result
+	}
+	return results, nil
+}
+
+// importRepository creates or updates the owner's project of one repository
+func (i *Importer) importRepository(ownerID, provider string, categoryID uint, repository Repository, sync bool) (Result, error) {
+	result := Result{RepositoryID: repository.ID, FullName: repository.FullName}
+
+	existing, err := i.sources.GetByRepoID(ownerID, provider, repository.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return result, err
+	}
+
+	var project *models.Project
+	if existing != nil {
+		if project, err = i.projects.GetByID(existing.ProjectID); err != nil {
+			return result, err
+		}
+		result.Status = ResultUpdated
+	} else {
+		project = &models.Project{Title: repository.Name, CategoryID: categoryID, OwnerID: ownerID}
+		result.Status = ResultCreated
+	}
+
+	Apply(project, repository)
+	if err := validator.ValidateProject(project); err != nil {
+		result.Status, result.Reason = ResultSkipped, ReasonInvalid
+		return result, nil
+	}
+
+	if existing != nil {
+		err = i.projects.Patch(project)
+	} else {
+		var taken bool
+		if taken, err = i.projects.CheckDuplicate(project.Title, categoryID, 0); err != nil {
+			return result, err
+		}
+		if taken {
+			result.Status, result.Reason = ResultSkipped, ReasonTitleTaken
+			return result, nil
+		}
+		err = i.projects.Create(project)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	if err := i.sources.Save(&models.ProjectSource{
+		ProjectID: project.ID,
+		OwnerID:   ownerID,
+		Provider:  provider,
+		RepoID:    repository.ID,
+		FullName:  repository.FullName,
+		Digest:    Digest(repository),
+		Sync:      sync,
+		SyncedAt:  i.now(),
+	}); err != nil {
+		return result, err
+	}
+	result.Project = project
+	return result, nil
+}
+
+// SyncDue resyncs every project with sync on that wasn't synced, nor failed
+// to, since before, and returns how many were attempted
+func (i *Importer) SyncDue(ctx context.Context, before time.Time) (int, error) {
+	attempted := 0
+	for {
+		sources, err := i.sources.GetDue(before, syncBatchSize)
+		if err != nil {
+			return attempted, err
+		}
+
+		for n := range sources {
+			if err := i.resync(ctx, &sources[n]); err != nil {
+				return attempted, err
+			}
+		}
+		attempted += len(sources)
+
+		if len(sources) < syncBatchSize || ctx.Err() != nil {
+			return attempted, nil
+		}
+	}
+}
+
+// resync writes what changed upstream since the last sync to the project.
+// Provider failures and conflicting edits are recorded on the source and
+// retried on the next interval; only storage errors are returned.
+func (i *Importer) resync(ctx context.Context, source *models.ProjectSource) error {
+	provider, ok := i.providers[source.Provider]
+	if !ok {
+		return i.fail(source, ErrUnknownProvider)
+	}
+	repository, err := provider.Repository(ctx, source.RepoID)
+	if err != nil {
+		return i.fail(source, err)
+	}
+
+	digest := Digest(*repository)
+	if digest != source.Digest {
+		project, err := i.projects.GetByID(source.ProjectID)
+		if err != nil {
+			return err
+		}
+		Apply(project, *repository)
+		if err := validator.ValidateProject(project); err != nil {
+			return i.fail(source, err)
+		}
+		if err := i.projects.Patch(project); err != nil {
+			if errors.Is(err, repo.ErrVersionConflict) {
+				return i.fail(source, err)
+			}
+			return err
+		}
+	}
+
+	source.FullName = repository.FullName
+	source.Digest = digest
+	source.SyncedAt = i.now()
+	source.SyncError = ""
+	source.FailedAt = nil
+	return i.sources.Save(source)
+}
+
+// fail records a failed resync on the source
+func (i *Importer) fail(source *models.ProjectSource, cause error) error {
+	message := cause.Error()
+	if len(message) > maxSyncErrorLen {
+		message = message[:maxSyncErrorLen]
+	}
+	audit.GetErrorLogger().WithFields(logrus.Fields{
+		"operation":    "GIT_IMPORT_SYNC_FAILED",
+		"where":        "backend/internal/infrastructure/gitimport/importer.go",
+		"function":     "fail",
+		"projectID":    source.ProjectID,
+		"provider":     source.Provider,
+		"repositoryID": source.RepoID,
+		"error":        message,
+	}).Warn("Failed to resync imported project")
+
+	return i.sources.MarkFailed(source.ProjectID, message, i.now())
+}
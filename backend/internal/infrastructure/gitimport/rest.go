@@ -0,0 +1,181 @@
+package gitimport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+const (
+	pageSize       = 50 // Gitea's default maximum; GitHub allows 100
+	maxPages       = 20
+	requestTimeout = 15 * time.Second
+	maxBodySize    = 4 << 20
+)
+
+// RESTProvider reads repositories through the REST API shared, as far as
+// importing goes, by GitHub and Gitea
+type RESTProvider struct {
+	name      string
+	apiURL    string
+	token     string
+	sizeParam string // Query parameter of the page size
+	client    *http.Client
+}
+
+// NewGitHub creates a provider for the GitHub API at apiURL, such as
+// https://api.github.com or https://github.example.org/api/v3
+func NewGitHub(apiURL, token string) *RESTProvider {
+	return newRESTProvider(models.ProviderGitHub, apiURL, token, "per_page")
+}
+
+// NewGitea creates a provider for the Gitea instance at baseURL, such as
+// https://gitea.example.org
+func NewGitea(baseURL, token string) *RESTProvider {
+	return newRESTProvider(models.ProviderGitea, strings.TrimSuffix(baseURL, "/")+"/api/v1", token, "limit")
+}
+
+func newRESTProvider(name, apiURL, token, sizeParam string) *RESTProvider {
+	return &RESTProvider{
+		name:      name,
+		apiURL:    strings.TrimSuffix(apiURL, "/"),
+		token:     token,
+		sizeParam: sizeParam,
+		client:    &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// repositoryJSON is a repository as both APIs return it
+type repositoryJSON struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	FullName    string    `json:"full_name"`
+	Description string    `json:"description"`
+	HTMLURL     string    `json:"html_url"`
+	Homepage    string    `json:"homepage"` // GitHub
+	Website     string    `json:"website"`  // Gitea
+	Language    string    `json:"language"`
+	Topics      []string  `json:"topics"`
+	Fork        bool      `json:"fork"`
+	Archived    bool      `json:"archived"`
+	Private     bool      `json:"private"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func (r repositoryJSON) repository() Repository {
+	homepage := r.Homepage
+	if homepage == "" {
+		homepage = r.Website
+	}
+	topics := r.Topics
+	if topics == nil {
+		topics = []string{}
+	}
+	return Repository{
+		ID:          r.ID,
+		Name:        r.Name,
+		FullName:    r.FullName,
+		Description: r.Description,
+		URL:         r.HTMLURL,
+		Homepage:    homepage,
+		Language:    r.Language,
+		Topics:      topics,
+		Fork:        r.Fork,
+		Archived:    r.Archived,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+// Repositories lists the public repositories of an account, a user or an
+// organization, reading up to maxPages pages
+func (p *RESTProvider) Repositories(ctx context.Context, account string) ([]Repository, error) {
+	repositories := []Repository{}
+	for page := 1; page <= maxPages; page++ {
+		query := url.Values{p.sizeParam: {strconv.Itoa(pageSize)}, "page": {strconv.Itoa(page)}}
+		var batch []repositoryJSON
+		if err := p.get(ctx, "/users/"+url.PathEscape(account)+"/repos?"+query.Encode(), &batch); err != nil {
+			return nil, err
+		}
+		for _, r := range batch {
+			if !r.Private {
+				repositories = append(repositories, r.repository())
+			}
+		}
+		// Don't rely on the page size: instances may be configured with a smaller one
+		if len(batch) == 0 {
+			break
+		}
+	}
+	return repositories, nil
+}
+
+// Repository fetches a public repository by ID, with its languages from the
+// most used to the least
+func (p *RESTProvider) Repository(ctx context.Context, id int64) (*Repository, error) {
+	var r repositoryJSON
+	if err := p.get(ctx, "/repositories/"+strconv.FormatInt(id, 10), &r); err != nil {
+		return nil, err
+	}
+	if r.Private {
+		return nil, ErrNotFound
+	}
+
+	owner, name, _ := strings.Cut(r.FullName, "/")
+	var bytes map[string]int64
+	if err := p.get(ctx, "/repos/"+url.PathEscape(owner)+"/"+url.PathEscape(name)+"/languages", &bytes); err != nil {
+		return nil, err
+	}
+
+	repository := r.repository()
+	repository.Languages = make([]string, 0, len(bytes))
+	for language := range bytes {
+		repository.Languages = append(repository.Languages, language)
+	}
+	sort.Slice(repository.Languages, func(i, j int) bool {
+		a, b := repository.Languages[i], repository.Languages[j]
+		if bytes[a] != bytes[b] {
+			return bytes[a] > bytes[b]
+		}
+		return a < b
+	})
+	return &repository, nil
+}
+
+// get decodes the JSON at path, relative to the API URL, into v
+func (p *RESTProvider) get(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "portfolio-manager-import/1.0")
+	if p.token != "" {
+		req.Header.Set("Authorization", "token "+p.token)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return fmt.Errorf("%s responded with %d", p.name, resp.StatusCode)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxBodySize)).Decode(v); err != nil {
+		return fmt.Errorf("%s sent an invalid response: %w", p.name, err)
+	}
+	return nil
+}
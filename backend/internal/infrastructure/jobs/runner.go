@@ -0,0 +1,460 @@
+// Package jobs runs background work from a job queue stored in PostgreSQL.
+// Jobs survive restarts and are shared by every server instance; failed
+// attempts are retried with exponential backoff until the job runs out of
+// attempts and is dead-lettered. Schedules enqueue jobs periodically.
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/metrics"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultWorkers      = 4
+	defaultPollInterval = time.Second
+	defaultTimeout      = time.Minute
+	defaultMaxAttempts  = 5
+	lockGrace           = 30 * time.Second // Added to the job timeout before another worker may claim the job
+	baseBackoff         = 10 * time.Second
+	maxBackoff          = time.Hour
+	maxErrorLength      = 500
+)
+
+// Results of an attempt, as counted in the metrics
+const (
+	resultSucceeded = "succeeded"
+	resultRetried   = "retried"
+	resultDead      = "dead"
+)
+
+// ErrUnknownType is returned when enqueueing a job type no handler is registered for
+var ErrUnknownType = errors.New("no handler is registered for the job type")
+
+// ErrInvalidPayload is returned when a payload doesn't decode into the type
+// its handler expects
+var ErrInvalidPayload = errors.New("invalid job payload")
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks a handler error that retrying won't fix, so the job is
+// dead-lettered right away
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// Option configures a job type
+type Option func(*definition)
+
+// WithTimeout bounds each attempt: its context is cancelled once the timeout
+// passes. The job is also held that long, plus a grace period, before another
+// worker may claim it, so it's the job's visibility timeout too.
+func WithTimeout(timeout time.Duration) Option {
+	return func(d *definition) {
+		d.timeout = timeout
+	}
+}
+
+// WithMaxAttempts sets how many attempts a job gets before it's dead-lettered
+func WithMaxAttempts(attempts int) Option {
+	return func(d *definition) {
+		d.maxAttempts = attempts
+	}
+}
+
+// definition is a registered job type
+type definition struct {
+	run         func(ctx context.Context, payload []byte) error
+	validate    func(payload []byte) error
+	timeout     time.Duration
+	maxAttempts int
+}
+
+type schedule struct {
+	name    string
+	spec    Spec
+	jobType string
+}
+
+// Runner claims due jobs from the queue and runs them with the handler
+// registered for their type. Handlers and schedules must be registered before
+// Run is called.
+type Runner struct {
+	repo         repo.JobRepository
+	metrics      *metrics.Collector // Optional
+	definitions  map[string]*definition
+	schedules    []schedule
+	workers      int
+	pollInterval time.Duration
+	worker       string // Identifies this process in locked_by
+	now          func() time.Time
+}
+
+// NewRunner creates a runner configured from the environment: JOB_WORKERS,
+// how many jobs run at once (default 4), and JOB_POLL_INTERVAL, how long an
+// idle worker waits before looking for due jobs again (default 1s). Attempts
+// are counted in collector when it isn't nil.
+func NewRunner(jobs repo.JobRepository, collector *metrics.Collector) *Runner {
+	workers := defaultWorkers
+	if value, err := strconv.Atoi(os.Getenv("JOB_WORKERS")); err == nil && value > 0 {
+		workers = value
+	}
+	pollInterval := defaultPollInterval
+	if value, err := time.ParseDuration(os.Getenv("JOB_POLL_INTERVAL")); err == nil && value > 0 {
+		pollInterval = value
+	}
+	hostname, _ := os.Hostname()
+
+	return &Runner{
+		repo:         jobs,
+		metrics:      collector,
+		definitions:  make(map[string]*definition),
+		workers:      workers,
+		pollInterval: pollInterval,
+		worker:       fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		now:          time.Now,
+	}
+}
+
+// Register sets the handler for jobType. Payloads are decoded from JSON into
+// T; a payload that doesn't decode dead-letters the job. A handler error
+// retries the job unless it's Permanent.
+func Register[T any](r *Runner, jobType string, handle func(ctx context.Context, payload T) error, options ...Option) {
+	if _, ok := r.definitions[jobType]; ok {
+		panic("jobs: " + jobType + " is registered twice")
+	}
+
+	def := &definition{
+		run: func(ctx context.Context, payload []byte) error {
+			var decoded T
+			if err := json.Unmarshal(payload, &decoded); err != nil {
+				return Permanent(fmt.Errorf("%w: %v", ErrInvalidPayload, err))
+			}
+			return handle(ctx, decoded)
+		},
+		validate: func(payload []byte) error {
+			var decoded T
+			decoder := json.NewDecoder(bytes.NewReader(payload))
+			decoder.DisallowUnknownFields()
+			if err := decoder.Decode(&decoded); err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+			}
+			return nil
+		},
+		timeout:     defaultTimeout,
+		maxAttempts: defaultMaxAttempts,
+	}
+	for _, option := range options {
+		option(def)
+	}
+	r.definitions[jobType] = def
+}
+
+// Schedule enqueues a job of the registered type, with an empty payload,
+// whenever spec comes due. The name identifies the schedule across restarts
+// and server instances, so each run is enqueued once; a run is skipped while
+// the previous run's job hasn't finished.
+func (r *Runner) Schedule(name string, spec Spec, jobType string) {
+	if _, ok := r.definitions[jobType]; !ok {
+		panic("jobs: schedule " + name + " uses unregistered type " + jobType)
+	}
+	r.schedules = append(r.schedules, schedule{name: name, spec: spec, jobType: jobType})
+}
+
+// Types lists the registered job types in alphabetical order
+func (r *Runner) Types() []string {
+	types := make([]string, 0, len(r.definitions))
+	for jobType := range r.definitions {
+		types = append(types, jobType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Enqueue queues a job of a registered type to run at runAt, with payload
+// encoded as JSON. The payload must decode into the handler's type without
+// unknown fields.
+func (r *Runner) Enqueue(jobType string, payload interface{}, runAt time.Time) (*models.Job, error) {
+	job, err := r.Prepare(jobType, payload, runAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.repo.Create(job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// Prepare builds the job Enqueue would queue without storing it, so it can be
+// inserted in the same transaction as the change it follows up on
+func (r *Runner) Prepare(jobType string, payload interface{}, runAt time.Time) (*models.Job, error) {
+	def, ok := r.definitions[jobType]
+	if !ok {
+		return nil, ErrUnknownType
+	}
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	if err := def.validate(encoded); err != nil {
+		return nil, err
+	}
+	return newJob(jobType, def, encoded, runAt), nil
+}
+
+func newJob(jobType string, def *definition, payload []byte, runAt time.Time) *models.Job {
+	return &models.Job{
+		Type:        jobType,
+		Payload:     payload,
+		Status:      models.JobPending,
+		RunAt:       runAt,
+		MaxAttempts: max(def.maxAttempts, 1),
+		Timeout:     max(int(math.Ceil(def.timeout.Seconds())), 1),
+	}
+}
+
+// Run works the queue and enqueues scheduled jobs until ctx is cancelled.
+// It then stops claiming jobs and returns once the attempts in progress
+// finished: they aren't cancelled with ctx, only bounded by their timeout.
+func (r *Runner) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r.enqueueScheduled(ctx)
+	}()
+
+	for n := 0; n < r.workers; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.work(ctx)
+		}()
+	}
+
+	wg.Wait()
+}
+
+// enqueueScheduled enqueues the scheduled jobs coming due every poll interval
+func (r *Runner) enqueueScheduled(ctx context.Context) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.EnqueueDue(); err != nil {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation": "JOB_SCHEDULE_ERROR",
+				"where":     "backend/internal/infrastructure/jobs/runner.go",
+				"function":  "enqueueScheduled",
+				"error":     err.Error(),
+			}).Error("Failed to enqueue scheduled jobs")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// work runs due jobs one at a time, waiting a poll interval whenever none is due
+func (r *Runner) work(ctx context.Context) {
+	for ctx.Err() == nil {
+		ran, err := r.runNext(ctx)
+		if err != nil {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation": "JOB_WORKER_ERROR",
+				"where":     "backend/internal/infrastructure/jobs/runner.go",
+				"function":  "work",
+				"worker":    r.worker,
+				"error":     err.Error(),
+			}).Error("Failed to work the job queue")
+		}
+		if ran && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.pollInterval):
+		}
+	}
+}
+
+// WorkDue runs the jobs due one after another until none is left, returning
+// how many ran
+func (r *Runner) WorkDue(ctx context.Context) (int, error) {
+	ran := 0
+	for ctx.Err() == nil {
+		ok, err := r.runNext(ctx)
+		if ok {
+			ran++
+		}
+		if err != nil || !ok {
+			return ran, err
+		}
+	}
+	return ran, nil
+}
+
+// runNext claims one due job and runs it, reporting whether there was one
+func (r *Runner) runNext(ctx context.Context) (bool, error) {
+	if len(r.definitions) == 0 {
+		return false, nil
+	}
+	jobs, err := r.repo.Claim(r.Types(), r.worker, r.now(), 1, lockGrace)
+	if err != nil || len(jobs) == 0 {
+		return false, err
+	}
+	return true, r.process(ctx, &jobs[0])
+}
+
+// process runs one attempt of the claimed job and stores its outcome
+func (r *Runner) process(ctx context.Context, job *models.Job) error {
+	def := r.definitions[job.Type]
+	attemptCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Duration(job.Timeout)*time.Second)
+	defer cancel()
+
+	started := time.Now()
+	err := attempt(attemptCtx, def, job.Payload)
+	elapsed := time.Since(started)
+	now := r.now()
+
+	if err == nil {
+		r.record(job.Type, resultSucceeded, elapsed)
+		return r.repo.Complete(job.ID, r.worker, now)
+	}
+
+	message := err.Error()
+	if len(message) > maxErrorLength {
+		message = message[:maxErrorLength]
+	}
+
+	var permanent *permanentError
+	var retryAt *time.Time
+	result := resultDead
+	if !errors.As(err, &permanent) && job.Attempts < job.MaxAttempts {
+		at := now.Add(Backoff(job.Attempts))
+		retryAt, result = &at, resultRetried
+	}
+
+	entry := audit.GetErrorLogger().WithFields(logrus.Fields{
+		"operation": "JOB_FAILED",
+		"where":     "backend/internal/infrastructure/jobs/runner.go",
+		"function":  "process",
+		"jobID":     job.ID,
+		"type":      job.Type,
+		"attempt":   job.Attempts,
+		"error":     message,
+	})
+	if retryAt != nil {
+		entry.Warn("Background job failed, retrying")
+	} else {
+		entry.Error("Background job failed, dead-lettered")
+	}
+
+	r.record(job.Type, result, elapsed)
+	return r.repo.Fail(job.ID, r.worker, message, retryAt, now)
+}
+
+// attempt runs the handler, turning a panic into an error so one bad job
+// can't take its worker down
+func attempt(ctx context.Context, def *definition, payload []byte) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+	return def.run(ctx, payload)
+}
+
+func (r *Runner) record(jobType, result string, elapsed time.Duration) {
+	if r.metrics != nil {
+		r.metrics.RecordJob(jobType, result, elapsed.Seconds())
+	}
+}
+
+// EnqueueDue enqueues a job for each of the runner's schedules that came due
+// and returns how many were enqueued. Schedules not stored yet, or whose spec
+// or type changed, are stored first with their next run.
+func (r *Runner) EnqueueDue() (int, error) {
+	if len(r.schedules) == 0 {
+		return 0, nil
+	}
+	stored, err := r.repo.GetSchedules()
+	if err != nil {
+		return 0, err
+	}
+	byName := make(map[string]models.JobSchedule, len(stored))
+	for _, schedule := range stored {
+		byName[schedule.Name] = schedule
+	}
+
+	now := r.now()
+	enqueued := 0
+	for _, schedule := range r.schedules {
+		current, ok := byName[schedule.name]
+		if !ok || current.Spec != schedule.spec.String() || current.Type != schedule.jobType {
+			if err := r.repo.SaveSchedule(&models.JobSchedule{
+				Name:      schedule.name,
+				Spec:      schedule.spec.String(),
+				Type:      schedule.jobType,
+				NextRunAt: schedule.spec.Next(now),
+			}); err != nil {
+				return enqueued, err
+			}
+			continue
+		}
+		if current.NextRunAt.After(now) {
+			continue
+		}
+
+		job := newJob(schedule.jobType, r.definitions[schedule.jobType], []byte("{}"), now)
+		ok, err := r.repo.EnqueueScheduled(&current, schedule.spec.Next(now), job)
+		if err != nil {
+			return enqueued, err
+		}
+		if ok {
+			enqueued++
+		}
+	}
+	return enqueued, nil
+}
+
+// Backoff returns the wait before retrying after the given attempt number:
+// 10s, 20s, 40s... capped at 1h
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	wait := baseBackoff
+	for i := 1; i < attempt; i++ {
+		wait *= 2
+		if wait >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return wait
+}
@@ -0,0 +1,387 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRepository keeps jobs and schedules in memory; only the runner methods are implemented
+type fakeRepository struct {
+	mu        sync.Mutex
+	jobs      []*models.Job
+	schedules map[string]*models.JobSchedule
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{schedules: make(map[string]*models.JobSchedule)}
+}
+
+func (r *fakeRepository) Create(job *models.Job) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job.ID = uint(len(r.jobs) + 1)
+	stored := *job
+	r.jobs = append(r.jobs, &stored)
+	return nil
+}
+
+func (r *fakeRepository) GetByID(id uint) (*models.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job := *r.jobs[id-1]
+	return &job, nil
+}
+
+func (r *fakeRepository) List(string, string, int, int) ([]models.Job, int64, error) {
+	return nil, 0, nil
+}
+
+func (r *fakeRepository) Claim(types []string, worker string, now time.Time, limit int, grace time.Duration) ([]models.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var claimed []models.Job
+	for _, job := range r.jobs {
+		due := job.Status == models.JobPending && !job.RunAt.After(now)
+		if !due || !slices.Contains(types, job.Type) || len(claimed) == limit {
+			continue
+		}
+		lockedUntil := now.Add(time.Duration(job.Timeout)*time.Second + grace)
+		job.Status, job.LockedBy, job.LockedUntil = models.JobRunning, worker, &lockedUntil
+		job.Attempts++
+		claimed = append(claimed, *job)
+	}
+	return claimed, nil
+}
+
+func (r *fakeRepository) Complete(id uint, worker string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job := r.jobs[id-1]
+	if job.LockedBy != worker {
+		return repo.ErrJobNotHeld
+	}
+	job.Status, job.FinishedAt, job.LockedBy = models.JobSucceeded, &at, ""
+	return nil
+}
+
+func (r *fakeRepository) Fail(id uint, worker string, message string, retryAt *time.Time, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job := r.jobs[id-1]
+	if job.LockedBy != worker {
+		return repo.ErrJobNotHeld
+	}
+	job.LastError, job.LockedBy = message, ""
+	if retryAt != nil {
+		job.Status, job.RunAt = models.JobPending, *retryAt
+	} else {
+		job.Status, job.FinishedAt = models.JobDead, &at
+	}
+	return nil
+}
+
+func (r *fakeRepository) Retry(uint, time.Time) error                    { return nil }
+func (r *fakeRepository) Delete(uint) error                              { return nil }
+func (r *fakeRepository) Counts() ([]models.JobCount, error)             { return nil, nil }
+func (r *fakeRepository) DeleteSucceededBefore(time.Time) (int64, error) { return 0, nil }
+
+func (r *fakeRepository) SaveSchedule(schedule *models.JobSchedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *schedule
+	r.schedules[schedule.Name] = &stored
+	return nil
+}
+
+func (r *fakeRepository) GetSchedules() ([]models.JobSchedule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var schedules []models.JobSchedule
+	for _, schedule := range r.schedules {
+		schedules = append(schedules, *schedule)
+	}
+	return schedules, nil
+}
+
+func (r *fakeRepository) EnqueueScheduled(schedule *models.JobSchedule, next time.Time, job *models.Job) (bool, error) {
+	r.mu.Lock()
+	stored := r.schedules[schedule.Name]
+	if !stored.NextRunAt.Equal(schedule.NextRunAt) {
+		r.mu.Unlock()
+		return false, nil
+	}
+	stored.NextRunAt = next
+	for _, existing := range r.jobs {
+		if existing.Schedule == schedule.Name && (existing.Status == models.JobPending || existing.Status == models.JobRunning) {
+			r.mu.Unlock()
+			return false, nil
+		}
+	}
+	r.mu.Unlock()
+
+	job.Schedule = schedule.Name
+	return true, r.Create(job)
+}
+
+// newTestRunner returns a runner on a fake repository whose clock is at now
+func newTestRunner(now time.Time) (*Runner, *fakeRepository) {
+	jobs := newFakeRepository()
+	return &Runner{
+		repo:         jobs,
+		definitions:  make(map[string]*definition),
+		workers:      2,
+		pollInterval: 10 * time.Millisecond,
+		worker:       "test-worker",
+		now:          func() time.Time { return now },
+	}, jobs
+}
+
+type greeting struct {
+	Name string `json:"name"`
+}
+
+func TestRunner_Outcomes(t *testing.T) {
+	now := time.Date(2026, time.March, 18, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		handle      func(ctx context.Context, payload greeting) error
+		maxAttempts int
+		attempts    int // Attempts already made before this one
+		status      string
+		runAt       time.Time
+		lastError   string
+	}{
+		{
+			name:   "succeeds",
+			handle: func(ctx context.Context, payload greeting) error { return nil },
+			status: models.JobSucceeded,
+			runAt:  now,
+		},
+		{
+			name:      "retried with backoff",
+			handle:    func(ctx context.Context, payload greeting) error { return errors.New("upstream down") },
+			attempts:  2,
+			status:    models.JobPending,
+			runAt:     now.Add(40 * time.Second),
+			lastError: "upstream down",
+		},
+		{
+			name:        "dead after the last attempt",
+			handle:      func(ctx context.Context, payload greeting) error { return errors.New("upstream down") },
+			maxAttempts: 3,
+			attempts:    2,
+			status:      models.JobDead,
+			runAt:       now,
+			lastError:   "upstream down",
+		},
+		{
+			name:      "dead right away when permanent",
+			handle:    func(ctx context.Context, payload greeting) error { return Permanent(errors.New("no such user")) },
+			status:    models.JobDead,
+			runAt:     now,
+			lastError: "no such user",
+		},
+		{
+			name:      "panics are retried",
+			handle:    func(ctx context.Context, payload greeting) error { panic("nil map") },
+			status:    models.JobPending,
+			runAt:     now.Add(10 * time.Second),
+			lastError: "panic: nil map",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner, jobs := newTestRunner(now)
+			var options []Option
+			if tt.maxAttempts > 0 {
+				options = append(options, WithMaxAttempts(tt.maxAttempts))
+			}
+			Register(runner, "greet", tt.handle, options...)
+
+			job, err := runner.Enqueue("greet", greeting{Name: "Ada"}, now)
+			require.NoError(t, err)
+			jobs.jobs[0].Attempts = tt.attempts
+
+			ran, err := runner.WorkDue(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, 1, ran)
+
+			stored, _ := jobs.GetByID(job.ID)
+			assert.Equal(t, tt.status, stored.Status)
+			assert.Equal(t, tt.attempts+1, stored.Attempts)
+			assert.Equal(t, tt.runAt, stored.RunAt)
+			assert.Equal(t, tt.lastError, stored.LastError)
+			assert.Empty(t, stored.LockedBy)
+		})
+	}
+}
+
+func TestRunner_TypedPayloads(t *testing.T) {
+	now := time.Now()
+	runner, jobs := newTestRunner(now)
+
+	var got []greeting
+	Register(runner, "greet", func(ctx context.Context, payload greeting) error {
+		got = append(got, payload)
+		return nil
+	})
+
+	_, err := runner.Enqueue("greet", greeting{Name: "Ada"}, now)
+	require.NoError(t, err)
+	_, err = runner.Enqueue("greet", []byte(`{"name": "Grace"}`), now)
+	assert.ErrorIs(t, err, ErrInvalidPayload, "bytes are encoded, not taken as JSON")
+	_, err = runner.Enqueue("greet", map[string]string{"nmae": "Grace"}, now)
+	assert.ErrorIs(t, err, ErrInvalidPayload, "unknown fields are rejected")
+	_, err = runner.Enqueue("wave", greeting{}, now)
+	assert.ErrorIs(t, err, ErrUnknownType)
+
+	// A payload stored by an older version no longer decoding
+	require.NoError(t, jobs.Create(&models.Job{Type: "greet", Payload: []byte(`{"name": 42}`), Status: models.JobPending, RunAt: now, MaxAttempts: 5, Timeout: 60}))
+
+	_, err = runner.WorkDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []greeting{{Name: "Ada"}}, got)
+	assert.Equal(t, models.JobDead, jobs.jobs[1].Status)
+	assert.Contains(t, jobs.jobs[1].LastError, "invalid job payload")
+}
+
+func TestRunner_OnlyDueJobs(t *testing.T) {
+	now := time.Now()
+	runner, jobs := newTestRunner(now)
+	Register(runner, "greet", func(ctx context.Context, payload greeting) error { return nil })
+
+	_, err := runner.Enqueue("greet", greeting{}, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, jobs.Create(&models.Job{Type: "other", Payload: []byte("{}"), Status: models.JobPending, RunAt: now, MaxAttempts: 5, Timeout: 60}))
+
+	ran, err := runner.WorkDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, ran, "later jobs wait, and types without a handler are left to other servers")
+}
+
+func TestRunner_Timeout(t *testing.T) {
+	now := time.Now()
+	runner, jobs := newTestRunner(now)
+	Register(runner, "slow", func(ctx context.Context, payload struct{}) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}, WithTimeout(time.Second))
+
+	_, err := runner.Enqueue("slow", struct{}{}, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, jobs.jobs[0].Timeout)
+
+	started := time.Now()
+	_, err = runner.WorkDue(context.Background())
+	require.NoError(t, err)
+	assert.Less(t, time.Since(started), 3*time.Second)
+	assert.Equal(t, models.JobPending, jobs.jobs[0].Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), jobs.jobs[0].LastError)
+}
+
+func TestRunner_EnqueueDue(t *testing.T) {
+	now := time.Date(2026, time.March, 18, 10, 0, 0, 0, time.UTC)
+	runner, jobs := newTestRunner(now)
+	Register(runner, "purge", func(ctx context.Context, payload struct{}) error { return nil })
+	runner.Schedule("hourly-purge", Every(time.Hour), "purge")
+
+	enqueued, err := runner.EnqueueDue()
+	require.NoError(t, err)
+	assert.Equal(t, 0, enqueued, "a new schedule runs after its first interval")
+	require.Contains(t, jobs.schedules, "hourly-purge")
+	assert.Equal(t, now.Add(time.Hour), jobs.schedules["hourly-purge"].NextRunAt)
+	assert.Equal(t, "@every 1h0m0s", jobs.schedules["hourly-purge"].Spec)
+
+	jobs.schedules["hourly-purge"].NextRunAt = now
+	enqueued, err = runner.EnqueueDue()
+	require.NoError(t, err)
+	assert.Equal(t, 1, enqueued)
+	require.Len(t, jobs.jobs, 1)
+	assert.Equal(t, "hourly-purge", jobs.jobs[0].Schedule)
+	assert.Equal(t, "{}", string(jobs.jobs[0].Payload))
+	assert.Equal(t, now.Add(time.Hour), jobs.schedules["hourly-purge"].NextRunAt)
+
+	enqueued, err = runner.EnqueueDue()
+	require.NoError(t, err)
+	assert.Equal(t, 0, enqueued, "not due again until the next run")
+
+	// A changed spec restarts the schedule
+	runner.schedules[0].spec = Every(2 * time.Hour)
+	_, err = runner.EnqueueDue()
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(2*time.Hour), jobs.schedules["hourly-purge"].NextRunAt)
+}
+
+func TestRunner_RunStopsGracefully(t *testing.T) {
+	runner, jobs := newTestRunner(time.Now())
+	runner.now = time.Now
+
+	started := make(chan struct{})
+	Register(runner, "slow", func(ctx context.Context, payload struct{}) error {
+		close(started)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+			return nil
+		}
+	})
+	_, err := runner.Enqueue("slow", struct{}{}, time.Now())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		runner.Run(ctx)
+		close(done)
+	}()
+
+	<-started
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run didn't return after ctx was cancelled")
+	}
+
+	stored, _ := jobs.GetByID(1)
+	assert.Equal(t, models.JobSucceeded, stored.Status, "the job in progress finished instead of being cancelled")
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, 10 * time.Second},
+		{1, 10 * time.Second},
+		{2, 20 * time.Second},
+		{3, 40 * time.Second},
+		{8, 1280 * time.Second},
+		{9, 2560 * time.Second},
+		{10, time.Hour},
+		{50, time.Hour},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Backoff(tt.attempt), "attempt %d", tt.attempt)
+	}
+}
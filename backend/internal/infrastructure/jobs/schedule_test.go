@@ -0,0 +1,79 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpec_Next(t *testing.T) {
+	// A Wednesday
+	after := time.Date(2026, time.March, 18, 10, 17, 42, 0, time.UTC)
+
+	tests := []struct {
+		spec string
+		next time.Time
+	}{
+		{"@every 90s", after.Add(90 * time.Second)},
+		{"@every 24h", after.Add(24 * time.Hour)},
+		{"@hourly", time.Date(2026, time.March, 18, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, time.March, 19, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2026, time.March, 22, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"* * * * *", time.Date(2026, time.March, 18, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, time.March, 18, 10, 30, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2026, time.March, 18, 10, 25, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2026, time.March, 18, 13, 0, 0, 0, time.UTC)},
+		{"30 2 * * 1-5", time.Date(2026, time.March, 19, 2, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, time.March, 22, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1,15 * *", time.Date(2026, time.April, 1, 12, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either one matches
+		{"0 0 1 * 5", time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			spec, err := ParseSpec(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.next, spec.Next(after))
+		})
+	}
+}
+
+func TestParseSpec_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"@yearly",
+		"@every",
+		"@every soon",
+		"@every 500ms",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"0 0 31 2 *",
+	}
+
+	for _, spec := range tests {
+		t.Run(spec, func(t *testing.T) {
+			_, err := ParseSpec(spec)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSpec_String(t *testing.T) {
+	spec, err := ParseSpec("@daily")
+	require.NoError(t, err)
+	assert.Equal(t, "@daily", spec.String())
+	assert.Equal(t, "@every 1h0m0s", Every(time.Hour).String())
+}
@@ -0,0 +1,225 @@
+// Package linkcheck checks the URLs stored in projects and image section
+// contents, so owners learn about broken links before their visitors do
+package linkcheck
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/metrics"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/netguard"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+)
+
+const (
+	defaultPerHost   = 2
+	defaultTimeout   = 10 * time.Second
+	defaultWorkers   = 8
+	defaultBatchSize = 50
+	historySize      = 10 // Checks kept for each URL
+	maxRedirects     = 5
+	maxErrorLength   = 500
+	userAgent        = "portfolio-manager-linkcheck/1.0"
+)
+
+// Checker checks every stored URL once per interval, a few at a time per host
+// so no site gets more than perHost requests at once
+type Checker struct {
+	repo      repo.LinkCheckRepository
+	client    *http.Client
+	metrics   *metrics.Collector // Optional
+	perHost   int
+	workers   int
+	batchSize int
+	now       func() time.Time
+}
+
+// NewChecker creates a checker configured from the environment:
+// LINK_CHECK_PER_HOST (default 2), LINK_CHECK_TIMEOUT (default 10s) and
+// LINK_CHECK_ALLOW_PRIVATE_TARGETS, which allows loopback and private network
+// URLs (off by default). Checks are counted in collector when it isn't nil.
+func NewChecker(links repo.LinkCheckRepository, collector *metrics.Collector) *Checker {
+	perHost := defaultPerHost
+	if value, err := strconv.Atoi(os.Getenv("LINK_CHECK_PER_HOST")); err == nil && value > 0 {
+		perHost = value
+	}
+	timeout := defaultTimeout
+	if value, err := time.ParseDuration(os.Getenv("LINK_CHECK_TIMEOUT")); err == nil && value > 0 {
+		timeout = value
+	}
+
+	return &Checker{
+		repo:      links,
+		client:    newClient(os.Getenv("LINK_CHECK_ALLOW_PRIVATE_TARGETS") == "true", timeout),
+		metrics:   collector,
+		perHost:   perHost,
+		workers:   defaultWorkers,
+		batchSize: defaultBatchSize,
+		now:       time.Now,
+	}
+}
+
+// CheckDue picks up the URLs stored since the last run, then checks every URL
+// not checked since before and returns how many were checked. Checks cut short
+// by ctx aren't recorded; those links stay due for the next run.
+func (c *Checker) CheckDue(ctx context.Context, before time.Time) (int, error) {
+	if err := c.repo.Track(); err != nil {
+		return 0, err
+	}
+
+	checked := 0
+	for {
+		links, err := c.repo.GetDue(before, c.batchSize)
+		if err != nil {
+			return checked, err
+		}
+
+		for _, result := range c.checkAll(ctx, links) {
+			if err := c.repo.Record(&result, historySize); err != nil {
+				return checked, err
+			}
+			checked++
+		}
+
+		if len(links) < c.batchSize || ctx.Err() != nil {
+			return checked, nil
+		}
+	}
+}
+
+// checkAll checks the links concurrently, at most workers at once and at most
+// perHost at once on the same host, and returns the results of the checks
+// that finished
+func (c *Checker) checkAll(ctx context.Context, links []models.LinkCheck) []models.LinkCheckResult {
+	byHost := make(map[string][]int)
+	for i, link := range links {
+		host := hostOf(link.URL)
+		byHost[host] = append(byHost[host], i)
+	}
+
+	results := make([]models.LinkCheckResult, len(links))
+	finished := make([]bool, len(links))
+	slots := make(chan struct{}, c.workers)
+	var wg sync.WaitGroup
+	for _, indexes := range byHost {
+		queue := make(chan int, len(indexes))
+		for _, i := range indexes {
+			queue <- i
+		}
+		close(queue)
+
+		for n := 0; n < min(c.perHost, len(indexes)); n++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range queue {
+					slots <- struct{}{}
+					results[i], finished[i] = c.check(ctx, links[i])
+					<-slots
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	done := results[:0]
+	for i := range results {
+		if finished[i] {
+			done = append(done, results[i])
+		}
+	}
+	return done
+}
+
+// check requests the URL with HEAD, then with GET when HEAD is refused, as
+// some servers only answer GET. Any answer below 400 means the link works.
+// It reports false when ctx ended the check, which says nothing about the link.
+func (c *Checker) check(ctx context.Context, link models.LinkCheck) (models.LinkCheckResult, bool) {
+	if ctx.Err() != nil {
+		return models.LinkCheckResult{}, false
+	}
+
+	started := time.Now()
+	statusCode, err := c.request(ctx, http.MethodHead, link.URL)
+	if err == nil && statusCode >= 400 {
+		statusCode, err = c.request(ctx, http.MethodGet, link.URL)
+	}
+	elapsed := time.Since(started)
+	if err != nil && ctx.Err() != nil {
+		return models.LinkCheckResult{}, false
+	}
+
+	result := models.LinkCheckResult{
+		LinkCheckID: link.ID,
+		Status:      models.LinkOK,
+		StatusCode:  statusCode,
+		DurationMs:  elapsed.Milliseconds(),
+		CheckedAt:   c.now(),
+	}
+	switch {
+	case err != nil:
+		result.Status, result.Error = models.LinkBroken, err.Error()
+	case statusCode >= 400:
+		result.Status, result.Error = models.LinkBroken, fmt.Sprintf("responded with %d", statusCode)
+	}
+	if len(result.Error) > maxErrorLength {
+		result.Error = result.Error[:maxErrorLength]
+	}
+
+	if c.metrics != nil {
+		c.metrics.RecordLinkCheck(result.Status, elapsed.Seconds())
+	}
+	return result, true
+}
+
+// request sends one request and returns the status code of the answer,
+// following redirects
+func (c *Checker) request(ctx context.Context, method, target string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	return resp.StatusCode, nil
+}
+
+// hostOf returns the host the URL is requested from, or the URL itself when
+// it can't be parsed
+func hostOf(link string) string {
+	parsed, err := url.Parse(link)
+	if err != nil || parsed.Host == "" {
+		return link
+	}
+	return strings.ToLower(parsed.Hostname())
+}
+
+// newClient builds the HTTP client used for checks, refusing private targets
+// unless allowPrivate is set (see netguard.Transport)
+func newClient(allowPrivate bool, timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: netguard.Transport(allowPrivate),
+		Timeout:   timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			return nil
+		},
+	}
+}
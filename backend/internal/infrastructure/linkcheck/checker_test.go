@@ -0,0 +1,214 @@
+package linkcheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRepository keeps link checks in memory, tracking the given URLs
+type fakeRepository struct {
+	mu      sync.Mutex
+	links   []*models.LinkCheck
+	results []models.LinkCheckResult
+}
+
+func newFakeRepository(urls ...string) *fakeRepository {
+	repo := &fakeRepository{}
+	for i, url := range urls {
+		repo.links = append(repo.links, &models.LinkCheck{ID: uint(i + 1), URL: url, Status: models.LinkPending})
+	}
+	return repo
+}
+
+func (r *fakeRepository) Track() error { return nil }
+
+func (r *fakeRepository) GetDue(before time.Time, limit int) ([]models.LinkCheck, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []models.LinkCheck
+	for _, link := range r.links {
+		if (link.CheckedAt == nil || link.CheckedAt.Before(before)) && len(due) < limit {
+			due = append(due, *link)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeRepository) Record(result *models.LinkCheckResult, keep int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.results = append(r.results, *result)
+	link := r.links[result.LinkCheckID-1]
+	link.Status, link.StatusCode, link.Error = result.Status, result.StatusCode, result.Error
+	link.CheckedAt = &result.CheckedAt
+	if result.Status == models.LinkOK {
+		link.Failures = 0
+	} else {
+		link.Failures++
+	}
+	return nil
+}
+
+func (r *fakeRepository) GetBrokenByPortfolio(uint) ([]models.BrokenLink, error) { return nil, nil }
+
+func newTestChecker(repo *fakeRepository, allowPrivate bool) *Checker {
+	return &Checker{
+		repo:      repo,
+		client:    newClient(allowPrivate, 500*time.Millisecond),
+		perHost:   defaultPerHost,
+		workers:   defaultWorkers,
+		batchSize: 2,
+		now:       time.Now,
+	}
+}
+
+func TestCheckDue_Statuses(t *testing.T) {
+	var gets atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/get-only", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		gets.Add(1)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Second)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	tests := []struct {
+		url        string
+		status     string
+		statusCode int
+		error      string
+	}{
+		{server.URL + "/ok", models.LinkOK, http.StatusOK, ""},
+		{server.URL + "/moved", models.LinkOK, http.StatusOK, ""},
+		{server.URL + "/get-only", models.LinkOK, http.StatusOK, ""},
+		{server.URL + "/missing", models.LinkBroken, http.StatusNotFound, "responded with 404"},
+		{server.URL + "/slow", models.LinkBroken, 0, "Timeout"},
+		{closed.URL, models.LinkBroken, 0, "refused"},
+	}
+
+	urls := make([]string, len(tests))
+	for i, tt := range tests {
+		urls[i] = tt.url
+	}
+	repo := newFakeRepository(urls...)
+	checker := newTestChecker(repo, true)
+
+	checked, err := checker.CheckDue(context.Background(), time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, len(tests), checked, "every batch is checked")
+	assert.Equal(t, int32(1), gets.Load(), "GET after a refused HEAD")
+
+	for i, tt := range tests {
+		link := repo.links[i]
+		assert.Equal(t, tt.status, link.Status, tt.url)
+		assert.Equal(t, tt.statusCode, link.StatusCode, tt.url)
+		if tt.error == "" {
+			assert.Empty(t, link.Error, tt.url)
+		} else {
+			assert.Contains(t, link.Error, tt.error, tt.url)
+		}
+	}
+
+	// Checked links aren't due again until the next interval
+	checked, err = checker.CheckDue(context.Background(), time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 0, checked)
+}
+
+func TestCheckDue_PerHostLimit(t *testing.T) {
+	var current, most atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			seen := most.Load()
+			if n <= seen || most.CompareAndSwap(seen, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	var urls []string
+	for _, path := range []string{"/a", "/b", "/c", "/d", "/e", "/f"} {
+		urls = append(urls, server.URL+path)
+	}
+	repo := newFakeRepository(urls...)
+	checker := newTestChecker(repo, true)
+	checker.batchSize = len(urls)
+
+	_, err := checker.CheckDue(context.Background(), time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int32(defaultPerHost), most.Load())
+	for _, link := range repo.links {
+		assert.Equal(t, models.LinkOK, link.Status)
+	}
+}
+
+func TestCheckDue_RefusesPrivateTargets(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	repo := newFakeRepository(server.URL)
+	_, err := newTestChecker(repo, false).CheckDue(context.Background(), time.Now())
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, models.LinkBroken, repo.links[0].Status)
+	assert.Contains(t, repo.links[0].Error, "private address")
+}
+
+func TestCheckDue_CancelledChecksAreNotRecorded(t *testing.T) {
+	started := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	repo := newFakeRepository(server.URL+"/a", server.URL+"/b")
+	checker := newTestChecker(repo, true)
+	checker.client.Timeout = time.Minute
+	checker.perHost = 1
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	checked, err := checker.CheckDue(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 0, checked)
+	assert.Empty(t, repo.results, "a cancelled check says nothing about the link")
+	for _, link := range repo.links {
+		assert.Equal(t, models.LinkPending, link.Status)
+	}
+}
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"context"
 	"strconv"
 	"time"
 
@@ -21,6 +22,12 @@ type Collector struct {
 	CategoriesTotal     prometheus.Gauge
 	SectionsTotal       prometheus.Gauge
 	ProjectsTotal       prometheus.Gauge
+	LinkChecksTotal     *prometheus.CounterVec
+	LinkCheckDuration   prometheus.Histogram
+	BrokenLinksTotal    prometheus.Gauge
+	JobsProcessedTotal  *prometheus.CounterVec
+	JobDuration         *prometheus.HistogramVec
+	JobsQueued          *prometheus.GaugeVec
 }
 
 func NewCollector() *Collector {
@@ -114,6 +121,54 @@ func NewCollector() *Collector {
 				Help: "Total number of projects across all categories",
 			},
 		),
+
+		LinkChecksTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "link_checks_total",
+				Help: "Total number of stored URLs checked",
+			},
+			[]string{"status"}, // ok, broken
+		),
+
+		LinkCheckDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:    "link_check_duration_seconds",
+				Help:    "Duration of stored URL checks in seconds",
+				Buckets: prometheus.DefBuckets,
+			},
+		),
+
+		BrokenLinksTotal: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "broken_links_total",
+				Help: "Total number of stored URLs whose last check failed",
+			},
+		),
+
+		JobsProcessedTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "jobs_processed_total",
+				Help: "Total number of background job attempts",
+			},
+			[]string{"type", "result"}, // succeeded, retried, dead
+		),
+
+		JobDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "job_duration_seconds",
+				Help:    "Duration of background job attempts in seconds",
+				Buckets: []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 300, 900},
+			},
+			[]string{"type"},
+		),
+
+		JobsQueued: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "jobs_queued",
+				Help: "Number of background jobs in the queue by type and status",
+			},
+			[]string{"type", "status"},
+		),
 	}
 
 	collector.registerMetrics()
@@ -134,6 +189,12 @@ func (c *Collector) registerMetrics() {
 		c.CategoriesTotal,
 		c.SectionsTotal,
 		c.ProjectsTotal,
+		c.LinkChecksTotal,
+		c.LinkCheckDuration,
+		c.BrokenLinksTotal,
+		c.JobsProcessedTotal,
+		c.JobDuration,
+		c.JobsQueued,
 	)
 }
 
@@ -198,13 +259,51 @@ func (c *Collector) IncImagesDeleted() {
 	c.ImagesDeleted.Inc()
 }
 
-// Background metrics collection
-func (c *Collector) StartMetricsCollection(db *gorm.DB) {
+// Link Check Metrics
+func (c *Collector) RecordLinkCheck(status string, duration float64) {
+	c.LinkChecksTotal.WithLabelValues(status).Inc()
+	c.LinkCheckDuration.Observe(duration)
+}
+
+func (c *Collector) UpdateBrokenLinksTotal(count int64) {
+	c.BrokenLinksTotal.Set(float64(count))
+}
+
+// Job Metrics
+func (c *Collector) RecordJob(jobType, result string, duration float64) {
+	c.JobsProcessedTotal.WithLabelValues(jobType, result).Inc()
+	c.JobDuration.WithLabelValues(jobType).Observe(duration)
+}
+
+// UpdateJobsQueued replaces the queue counts, so types and statuses without
+// jobs left drop out
+func (c *Collector) UpdateJobsQueued(counts []JobCount) {
+	c.JobsQueued.Reset()
+	for _, count := range counts {
+		c.JobsQueued.WithLabelValues(count.Type, count.Status).Set(float64(count.Count))
+	}
+}
+
+// JobCount is how many jobs of a type are in a status
+type JobCount struct {
+	Type   string
+	Status string
+	Count  int64
+}
+
+// Background metrics collection, until ctx is cancelled
+func (c *Collector) StartMetricsCollection(ctx context.Context, db *gorm.DB) {
 	ticker := time.NewTicker(30 * time.Second)
 	go func() {
-		for range ticker.C {
-			c.collectBusinessMetrics(db)
-			c.UpdateDatabaseConnections(db)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.collectBusinessMetrics(db)
+				c.UpdateDatabaseConnections(db)
+			}
 		}
 	}()
 }
@@ -239,4 +338,16 @@ func (c *Collector) collectBusinessMetrics(db *gorm.DB) {
 	if err := db.Table("projects").Count(&projectCount).Error; err == nil {
 		c.UpdateProjectsTotal(projectCount)
 	}
+
+	// Count broken links
+	var brokenCount int64
+	if err := db.Table("link_checks").Where("status = ?", "broken").Count(&brokenCount).Error; err == nil {
+		c.UpdateBrokenLinksTotal(brokenCount)
+	}
+
+	// Count queued jobs
+	var jobCounts []JobCount
+	if err := db.Table("jobs").Select("type, status, COUNT(*) AS count").Group("type, status").Scan(&jobCounts).Error; err == nil {
+		c.UpdateJobsQueued(jobCounts)
+	}
 }
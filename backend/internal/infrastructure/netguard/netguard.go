@@ -0,0 +1,49 @@
+// Package netguard builds HTTP transports for requests to URLs that users
+// store, such as webhook targets and project links, so those URLs can't be
+// used to probe internal services
+package netguard
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// dialTimeout bounds establishing a connection
+const dialTimeout = 5 * time.Second
+
+// ErrPrivateTarget is returned when a URL resolves to a non-public address
+var ErrPrivateTarget = errors.New("target resolves to a private address")
+
+// Transport returns a clone of the default transport that ignores proxy
+// settings. Unless allowPrivate is set, connections to loopback, private and
+// link-local addresses are refused at dial time, redirects included, and fail
+// with ErrPrivateTarget.
+func Transport(allowPrivate bool) *http.Transport {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !IsPublic(net.ParseIP(host)) {
+				return ErrPrivateTarget
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.Proxy = nil
+	return transport
+}
+
+// IsPublic reports whether ip may be connected to by a guarded transport
+func IsPublic(ip net.IP) bool {
+	return ip != nil && !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() && !ip.IsUnspecified() && !ip.IsMulticast()
+}
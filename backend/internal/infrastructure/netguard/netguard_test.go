@@ -0,0 +1,53 @@
+package netguard
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		ip     string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"192.168.0.10", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"0.0.0.0", false},
+		{"224.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.public, IsPublic(net.ParseIP(tt.ip)), tt.ip)
+	}
+	assert.False(t, IsPublic(nil))
+}
+
+func TestTransport_RefusesPrivateTargets(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	_, err := (&http.Client{Transport: Transport(false)}).Get(server.URL)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrPrivateTarget))
+	assert.Equal(t, 0, calls)
+
+	resp, err := (&http.Client{Transport: Transport(true)}).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,46 @@
+// Package notify sends notifications to people, such as portfolio owners told
+// about a new contact message and the visitors who sent it
+package notify
+
+import (
+	"context"
+	"net/mail"
+	"os"
+)
+
+// Message is a plain text notification
+type Message struct {
+	To      []string
+	ReplyTo *mail.Address // Where replies go, e.g. the visitor who wrote to an owner
+	Subject string
+	Body    string
+}
+
+// Notifier delivers messages. Implementations must be safe for concurrent use.
+type Notifier interface {
+	Notify(ctx context.Context, message Message) error
+}
+
+// NewNotifier creates the notifier configured in the environment: an SMTP
+// notifier when SMTP_HOST is set (see NewSMTPNotifier), otherwise one that
+// drops every message
+func NewNotifier() Notifier {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		return Discard{}
+	}
+
+	port := os.Getenv("SMTP_PORT")
+	if port == "" {
+		port = "587"
+	}
+	return NewSMTPNotifier(host, port, os.Getenv("SMTP_FROM"), os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"))
+}
+
+// Discard is a Notifier that drops every message, used when none is configured
+type Discard struct{}
+
+// Notify does nothing
+func (Discard) Notify(context.Context, Message) error {
+	return nil
+}
@@ -0,0 +1,156 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// smtpTimeout bounds a whole delivery when ctx has no earlier deadline
+const smtpTimeout = 30 * time.Second
+
+// SMTPNotifier sends messages as email through an SMTP server
+type SMTPNotifier struct {
+	host string
+	port string
+	from mail.Address
+	auth smtp.Auth // nil when no username is configured
+}
+
+// NewSMTPNotifier creates a notifier sending through host:port from the address
+// from. STARTTLS is used when the server offers it; with a username, PLAIN
+// authentication is used, which net/smtp only allows over TLS or to localhost.
+func NewSMTPNotifier(host, port, from, username, password string) *SMTPNotifier {
+	if from == "" {
+		from = "no-reply@" + host
+	}
+	address, err := mail.ParseAddress(from)
+	if err != nil {
+		address = &mail.Address{Address: from}
+	}
+
+	notifier := &SMTPNotifier{host: host, port: port, from: *address}
+	if username != "" {
+		notifier.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return notifier
+}
+
+// Notify sends message in a single SMTP session
+func (n *SMTPNotifier) Notify(ctx context.Context, message Message) error {
+	if len(message.To) == 0 {
+		return errors.New("notify: message has no recipients")
+	}
+	data, err := n.compose(message, time.Now())
+	if err != nil {
+		return err
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(smtpTimeout)
+	}
+	dialer := net.Dialer{Deadline: deadline}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(n.host, n.port))
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, n.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.host}); err != nil {
+			return err
+		}
+	}
+	if n.auth != nil {
+		if err := client.Auth(n.auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(n.from.Address); err != nil {
+		return err
+	}
+	for _, to := range message.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// compose renders message as a plain text email. Header values come from
+// visitors, so line breaks are removed from them to prevent header injection.
+func (n *SMTPNotifier) compose(message Message, date time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+	}
+
+	to := make([]string, len(message.To))
+	for i, address := range message.To {
+		parsed, err := mail.ParseAddress(singleLine(address))
+		if err != nil {
+			return nil, fmt.Errorf("notify: invalid recipient %q: %w", address, err)
+		}
+		to[i] = parsed.String()
+	}
+
+	header("From", n.from.String())
+	header("To", strings.Join(to, ", "))
+	if message.ReplyTo != nil {
+		replyTo := mail.Address{Name: singleLine(message.ReplyTo.Name), Address: singleLine(message.ReplyTo.Address)}
+		header("Reply-To", replyTo.String())
+	}
+	header("Subject", mime.QEncoding.Encode("utf-8", singleLine(message.Subject)))
+	header("Date", date.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+
+	body := quotedprintable.NewWriter(&buf)
+	lines := strings.Split(strings.ReplaceAll(message.Body, "\r\n", "\n"), "\n")
+	if _, err := body.Write([]byte(strings.Join(lines, "\r\n"))); err != nil {
+		return nil, err
+	}
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\r\n")
+	return buf.Bytes(), nil
+}
+
+// singleLine joins the lines of a header value with spaces
+func singleLine(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
@@ -0,0 +1,106 @@
+package notify
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/notify/smtptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPNotifier_Notify(t *testing.T) {
+	server, err := smtptest.NewServer()
+	require.NoError(t, err)
+	defer server.Close()
+
+	notifier := NewSMTPNotifier(server.Host(), server.Port(), "Portfolio Manager <portfolio@example.com>", "", "")
+	err = notifier.Notify(context.Background(), Message{
+		To:      []string{"owner@example.com", "copy@example.com"},
+		ReplyTo: &mail.Address{Name: "Ana Souza", Address: "ana@example.org"},
+		Subject: "Olá, let's talk",
+		Body:    "First line\nSecond line with ümlauts\n.\nAfter a lone dot",
+	})
+	require.NoError(t, err)
+
+	messages := server.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, "portfolio@example.com", messages[0].From)
+	assert.Equal(t, []string{"owner@example.com", "copy@example.com"}, messages[0].To)
+
+	parsed, err := messages[0].Parse()
+	require.NoError(t, err)
+	assert.Equal(t, `"Portfolio Manager" <portfolio@example.com>`, parsed.Header.Get("From"))
+	assert.Equal(t, "<owner@example.com>, <copy@example.com>", parsed.Header.Get("To"))
+	assert.Equal(t, `"Ana Souza" <ana@example.org>`, parsed.Header.Get("Reply-To"))
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Olá, let's talk", subject)
+
+	body, err := io.ReadAll(quotedprintable.NewReader(parsed.Body))
+	require.NoError(t, err)
+	assert.Equal(t, "First line\nSecond line with ümlauts\n.\nAfter a lone dot\n", string(body))
+}
+
+func TestSMTPNotifier_HeaderInjection(t *testing.T) {
+	server, err := smtptest.NewServer()
+	require.NoError(t, err)
+	defer server.Close()
+
+	notifier := NewSMTPNotifier(server.Host(), server.Port(), "portfolio@example.com", "", "")
+	err = notifier.Notify(context.Background(), Message{
+		To:      []string{"owner@example.com"},
+		ReplyTo: &mail.Address{Name: "Eve\r\nBcc: victim@example.com", Address: "eve@example.org"},
+		Subject: "Hi\r\nBcc: victim@example.com",
+		Body:    "Hello",
+	})
+	require.NoError(t, err)
+
+	messages := server.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, []string{"owner@example.com"}, messages[0].To)
+	parsed, err := messages[0].Parse()
+	require.NoError(t, err)
+	assert.Empty(t, parsed.Header.Get("Bcc"))
+}
+
+func TestSMTPNotifier_Errors(t *testing.T) {
+	server, err := smtptest.NewServer()
+	require.NoError(t, err)
+	notifier := NewSMTPNotifier(server.Host(), server.Port(), "portfolio@example.com", "", "")
+
+	err = notifier.Notify(context.Background(), Message{Subject: "No one"})
+	assert.Error(t, err, "no recipients")
+
+	err = notifier.Notify(context.Background(), Message{To: []string{"not an address"}})
+	assert.Error(t, err)
+	assert.Empty(t, server.Messages())
+
+	// Nothing listens once the stand-in is closed
+	require.NoError(t, server.Close())
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.Error(t, notifier.Notify(ctx, Message{To: []string{"owner@example.com"}}))
+}
+
+func TestNewNotifier(t *testing.T) {
+	t.Setenv("SMTP_HOST", "")
+	assert.IsType(t, Discard{}, NewNotifier())
+	assert.NoError(t, Discard{}.Notify(context.Background(), Message{}))
+
+	t.Setenv("SMTP_HOST", "mail.example.com")
+	t.Setenv("SMTP_PORT", "")
+	t.Setenv("SMTP_FROM", "")
+	t.Setenv("SMTP_USERNAME", "")
+	notifier, ok := NewNotifier().(*SMTPNotifier)
+	require.True(t, ok)
+	assert.Equal(t, "587", notifier.port)
+	assert.Equal(t, "no-reply@mail.example.com", notifier.from.Address)
+	assert.Nil(t, notifier.auth, "no username")
+}
@@ -0,0 +1,155 @@
+// Package smtptest runs a local SMTP stand-in for tests: it accepts every
+// message without TLS or authentication and keeps it in memory
+package smtptest
+
+import (
+	"io"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+)
+
+// Message is an email received by the stand-in
+type Message struct {
+	From string   // Envelope sender
+	To   []string // Envelope recipients
+	Data []byte   // The message as sent, headers included
+}
+
+// Parse reads the headers and body of the message
+func (m Message) Parse() (*mail.Message, error) {
+	return mail.ReadMessage(strings.NewReader(string(m.Data)))
+}
+
+// Server is an SMTP stand-in listening on a loopback address
+type Server struct {
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewServer starts a stand-in on a random loopback port
+func NewServer() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	server := &Server{listener: listener}
+	server.wg.Add(1)
+	go server.serve()
+	return server, nil
+}
+
+// Host returns the host the stand-in listens on
+func (s *Server) Host() string {
+	host, _, _ := net.SplitHostPort(s.listener.Addr().String())
+	return host
+}
+
+// Port returns the port the stand-in listens on
+func (s *Server) Port() string {
+	_, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return port
+}
+
+// Messages returns the messages received so far
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// Reset forgets the messages received so far
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = nil
+}
+
+// Close stops the stand-in and waits for open sessions to end
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.session(conn)
+		}()
+	}
+}
+
+// session speaks just enough SMTP for net/smtp clients
+func (s *Server) session(conn net.Conn) {
+	text := textproto.NewConn(conn)
+
+	var current Message
+	if text.PrintfLine("220 smtptest ready") != nil {
+		return
+	}
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			err = text.PrintfLine("250-smtptest\r\n250 8BITMIME")
+		case "HELO", "NOOP":
+			err = text.PrintfLine("250 OK")
+		case "RSET":
+			current = Message{}
+			err = text.PrintfLine("250 OK")
+		case "MAIL":
+			current = Message{From: address(arg)}
+			err = text.PrintfLine("250 OK")
+		case "RCPT":
+			current.To = append(current.To, address(arg))
+			err = text.PrintfLine("250 OK")
+		case "DATA":
+			if err = text.PrintfLine("354 End data with <CR><LF>.<CR><LF>"); err != nil {
+				return
+			}
+			if current.Data, err = io.ReadAll(text.DotReader()); err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, current)
+			s.mu.Unlock()
+			current = Message{}
+			err = text.PrintfLine("250 OK")
+		case "QUIT":
+			text.PrintfLine("221 Bye")
+			return
+		default:
+			err = text.PrintfLine("502 Command not implemented")
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// address extracts the mailbox of "FROM:<a@b>" or "TO:<a@b>"
+func address(arg string) string {
+	_, value, _ := strings.Cut(arg, ":")
+	value, _, _ = strings.Cut(strings.TrimSpace(value), " ")
+	return strings.Trim(value, "<>")
+}
@@ -0,0 +1,179 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// viewDay is the UTC day of a page view, the day its rollups count it in
+const viewDay = "(viewed_at AT TIME ZONE 'UTC')::date"
+
+type analyticsRepository struct {
+	db *gorm.DB
+}
+
+func NewAnalyticsRepository(db *gorm.DB) AnalyticsRepository {
+	return &analyticsRepository{
+		db: db,
+	}
+}
+
+// RecordView stores a page view, setting view.PortfolioID to the portfolio of
+// the viewed page. Returns gorm.ErrRecordNotFound when the page is gone.
+func (r *analyticsRepository) RecordView(view *models.PageView) error {
+	var portfolioIDs []uint
+	switch view.ResourceType {
+	case models.ViewPortfolio:
+		portfolioIDs = []uint{view.ResourceID}
+	case models.ViewCategory:
+		if err := r.db.Model(&models.Category{}).
+			Where("id = ?", view.ResourceID).
+			Pluck("portfolio_id", &portfolioIDs).Error; err != nil {
+			return err
+		}
+	case models.ViewProject:
+		if err := r.db.Model(&models.Project{}).
+			Joins("JOIN categories ON categories.id = projects.category_id").
+			Where("projects.id = ?", view.ResourceID).
+			Pluck("categories.portfolio_id", &portfolioIDs).Error; err != nil {
+			return err
+		}
+	}
+	if len(portfolioIDs) == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	view.PortfolioID = portfolioIDs[0]
+	return r.db.Create(view).Error
+}
+
+// Salt returns the salt of visitor hashes on day, storing candidate as the
+// salt when the day has none yet. Every server instance gets the same salt.
+func (r *analyticsRepository) Salt(day time.Time, candidate string) (string, error) {
+	salt := models.AnalyticsSalt{Day: day, Salt: candidate}
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&salt).Error; err != nil {
+		return "", err
+	}
+
+	var stored models.AnalyticsSalt
+	err := r.db.Where("day = ?", day.Format(time.DateOnly)).First(&stored).Error
+	return stored.Salt, err
+}
+
+// Rollup recounts the daily views of every day that still has page views,
+// replacing the counts rolled up before. Raw views are only deleted once their
+// day is over, so each recount sees the whole day.
+func (r *analyticsRepository) Rollup() error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			INSERT INTO daily_views (portfolio_id, day, views, visitors)
+			SELECT portfolio_id, ` + viewDay + `, COUNT(*), COUNT(DISTINCT visitor_hash)
+			FROM page_views
+			GROUP BY 1, 2
+			ON CONFLICT (portfolio_id, day) DO UPDATE
+			SET views = EXCLUDED.views, visitors = EXCLUDED.visitors
+		`).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec(`
+			INSERT INTO daily_resource_views (portfolio_id, day, resource_type, resource_id, views, visitors)
+			SELECT portfolio_id, `+viewDay+`, resource_type, resource_id, COUNT(*), COUNT(DISTINCT visitor_hash)
+			FROM page_views
+			WHERE resource_type <> ?
+			GROUP BY 1, 2, 3, 4
+			ON CONFLICT (portfolio_id, day, resource_type, resource_id) DO UPDATE
+			SET views = EXCLUDED.views, visitors = EXCLUDED.visitors
+		`, models.ViewPortfolio).Error; err != nil {
+			return err
+		}
+
+		// Direct visits and unknown countries aren't sources
+		for kind, column := range map[string]string{models.SourceReferrer: "referrer", models.SourceCountry: "country"} {
+			if err := tx.Exec(`
+				INSERT INTO daily_source_views (portfolio_id, day, kind, value, views, visitors)
+				SELECT portfolio_id, `+viewDay+`, ?, `+column+`, COUNT(*), COUNT(DISTINCT visitor_hash)
+				FROM page_views
+				WHERE `+column+` <> ''
+				GROUP BY 1, 2, 4
+				ON CONFLICT (portfolio_id, day, kind, value) DO UPDATE
+				SET views = EXCLUDED.views, visitors = EXCLUDED.visitors
+			`, kind).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// DeleteViewsBefore removes the page views made before the given time
+func (r *analyticsRepository) DeleteViewsBefore(before time.Time) (int64, error) {
+	result := r.db.Where("viewed_at < ?", before).Delete(&models.PageView{})
+	return result.RowsAffected, result.Error
+}
+
+// DeleteSaltsBefore removes the salts of the days before day
+func (r *analyticsRepository) DeleteSaltsBefore(day time.Time) (int64, error) {
+	result := r.db.Where("day < ?", day.Format(time.DateOnly)).Delete(&models.AnalyticsSalt{})
+	return result.RowsAffected, result.Error
+}
+
+// GetDaily lists the daily views of a portfolio from one day to another,
+// inclusive, by day. Days without views are left out.
+func (r *analyticsRepository) GetDaily(portfolioID uint, from, to time.Time) ([]models.DailyView, error) {
+	var days []models.DailyView
+	err := r.db.Where("portfolio_id = ? AND day BETWEEN ? AND ?", portfolioID, from.Format(time.DateOnly), to.Format(time.DateOnly)).
+		Order("day ASC").
+		Find(&days).Error
+	return days, err
+}
+
+// GetTopResources lists the categories or projects of a portfolio with the
+// most views from one day to another, inclusive. Deleted ones are left out.
+func (r *analyticsRepository) GetTopResources(portfolioID uint, resourceType string, from, to time.Time, limit int) ([]models.ResourceViews, error) {
+	table := "projects"
+	if resourceType == models.ViewCategory {
+		table = "categories"
+	}
+
+	var resources []models.ResourceViews
+	err := r.db.Table("daily_resource_views").
+		Select(table+".id, "+table+".title, SUM(daily_resource_views.views) AS views, SUM(daily_resource_views.visitors) AS visitors").
+		Joins("JOIN "+table+" ON "+table+".id = daily_resource_views.resource_id AND "+table+".deleted_at IS NULL").
+		Where("daily_resource_views.portfolio_id = ? AND daily_resource_views.resource_type = ?", portfolioID, resourceType).
+		Where("daily_resource_views.day BETWEEN ? AND ?", from.Format(time.DateOnly), to.Format(time.DateOnly)).
+		Group(table + ".id, " + table + ".title").
+		Order("views DESC, " + table + ".title ASC").
+		Limit(limit).
+		Scan(&resources).Error
+	return resources, err
+}
+
+// GetTopSources lists the referrer domains or countries a portfolio's views
+// came from most often from one day to another, inclusive
+func (r *analyticsRepository) GetTopSources(portfolioID uint, kind string, from, to time.Time, limit int) ([]models.SourceViews, error) {
+	var sources []models.SourceViews
+	err := r.db.Model(&models.DailySourceView{}).
+		Select("value, SUM(views) AS views, SUM(visitors) AS visitors").
+		Where("portfolio_id = ? AND kind = ?", portfolioID, kind).
+		Where("day BETWEEN ? AND ?", from.Format(time.DateOnly), to.Format(time.DateOnly)).
+		Group("value").
+		Order("views DESC, value ASC").
+		Limit(limit).
+		Scan(&sources).Error
+	return sources, err
+}
+
+// deletePortfolioViews removes the page views of a portfolio and their rollups
+func deletePortfolioViews(tx *gorm.DB, portfolioID uint) error {
+	for _, model := range []interface{}{&models.PageView{}, &models.DailyView{}, &models.DailyResourceView{}, &models.DailySourceView{}} {
+		if err := tx.Where("portfolio_id = ?", portfolioID).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
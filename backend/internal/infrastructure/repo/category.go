@@ -2,6 +2,8 @@ package repo
 
 import (
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/ordering"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
 	"gorm.io/gorm"
 )
 
@@ -16,13 +18,39 @@ func NewCategoryRepository(db *gorm.DB) CategoryRepository {
 }
 
 func (r *categoryRepository) Create(category *models.Category) error {
-	return r.db.Create(category).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// The trigger appends the category while the portfolio is locked
+		if err := lockParents(tx, categoryOrder, category.PortfolioID); err != nil {
+			return err
+		}
+		requested := category.Position
+		if err := tx.Create(category).Error; err != nil {
+			return err
+		}
+		if requested != 0 {
+			position, err := place(tx, categoryOrder, category.ID, category.PortfolioID, category.PortfolioID, ordering.Placement{Position: requested})
+			if err != nil {
+				return err
+			}
+			category.Position = position
+		}
+		return recordChange(tx, "category", "created", category.ID, category)
+	})
 }
 
 // GetByID For basic category info
 func (r *categoryRepository) GetByID(id uint) (*models.Category, error) {
 	var category models.Category
-	err := r.db.Select("id, title, description, position, owner_id, portfolio_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, position, owner_id, portfolio_id, version, created_at, updated_at").
+		Where("id = ?", id).
+		First(&category).Error
+	return &category, err
+}
+
+// GetByIDSelected For detail views - only the columns and relations picked by sel
+func (r *categoryRepository) GetByIDSelected(id uint, sel query.Selection) (*models.Category, error) {
+	var category models.Category
+	err := preloadIncluded(r.db.Select(selectedColumns(sel, categoryColumns)), sel, categoryRelations).
 		Where("id = ?", id).
 		First(&category).Error
 	return &category, err
@@ -31,7 +59,7 @@ func (r *categoryRepository) GetByID(id uint) (*models.Category, error) {
 // GetByIDBasic For authorization checks - only id and owner_id
 func (r *categoryRepository) GetByIDBasic(id uint) (*models.Category, error) {
 	var category models.Category
-	err := r.db.Select("id, owner_id").
+	err := r.db.Select("id, owner_id, version").
 		Where("id = ?", id).
 		First(&category).Error
 	return &category, err
@@ -40,7 +68,9 @@ func (r *categoryRepository) GetByIDBasic(id uint) (*models.Category, error) {
 // GetByIDWithRelations For detail views - with projects preloaded
 func (r *categoryRepository) GetByIDWithRelations(id uint) (*models.Category, error) {
 	var category models.Category
-	err := r.db.Preload("Projects").
+	err := r.db.Preload("Projects", func(db *gorm.DB) *gorm.DB {
+		return db.Table(linkedProjects).Order("position ASC, created_at ASC")
+	}).
 		Where("id = ?", id).
 		First(&category).Error
 	return &category, err
@@ -49,18 +79,34 @@ func (r *categoryRepository) GetByIDWithRelations(id uint) (*models.Category, er
 // GetByPortfolioID For list views - only basic category info
 func (r *categoryRepository) GetByPortfolioID(portfolioID string) ([]models.Category, error) {
 	var categories []models.Category
-	err := r.db.Select("id, title, description, position, owner_id, portfolio_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, position, owner_id, portfolio_id, version, created_at, updated_at").
 		Where("portfolio_id = ?", portfolioID).
 		Order("position ASC, created_at ASC").
 		Find(&categories).Error
 	return categories, err
 }
 
+// ListByPortfolioID For list views - categories of a portfolio, filtered, sorted
+// and paginated by spec. Returns the cursor of the next page, or "" on the last one.
+func (r *categoryRepository) ListByPortfolioID(portfolioID string, spec query.Spec) ([]models.Category, string, error) {
+	var categories []models.Category
+	err := applySpec(preloadIncluded(r.db.Select(selectedColumns(spec.Selection, categoryColumns, sortColumn(spec))), spec.Selection, categoryRelations).
+		Where("portfolio_id = ?", portfolioID), spec).
+		Find(&categories).Error
+	if err != nil {
+		return nil, "", err
+	}
+	categories, next := listPage(categories, spec, func(c models.Category) (interface{}, uint) {
+		return sortValue(spec, c.Title, c.Position, c.CreatedAt, c.UpdatedAt), c.ID
+	})
+	return categories, next, nil
+}
+
 // GetByPortfolioIDWithRelations For detail views - with projects preloaded
 func (r *categoryRepository) GetByPortfolioIDWithRelations(portfolioID string) ([]models.Category, error) {
 	var categories []models.Category
 	err := r.db.Preload("Projects", func(db *gorm.DB) *gorm.DB {
-		return db.Order("projects.position ASC, projects.created_at ASC")
+		return db.Table(linkedProjects).Order("projects.position ASC, projects.created_at ASC")
 	}).
 		Where("portfolio_id = ?", portfolioID).
 		Order("position ASC, created_at ASC").
@@ -68,13 +114,46 @@ func (r *categoryRepository) GetByPortfolioIDWithRelations(portfolioID string) (
 	return categories, err
 }
 
+// Update writes the category if category.Version still matches the stored row,
+// returning ErrVersionConflict otherwise. A new position or portfolio moves it
+// there, renumbering the categories around it.
 func (r *categoryRepository) Update(category *models.Category) error {
-	return r.db.Model(category).Where("id = ?", category.ID).Updates(category).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		before, err := lockSlot(tx, categoryOrder, category.ID)
+		if err != nil {
+			return err
+		}
+		if err := updateVersioned(tx, category, category.ID, &category.Version); err != nil {
+			return err
+		}
+		if category.Position, err = settle(tx, categoryOrder, category.ID, before); err != nil {
+			return err
+		}
+		return recordChange(tx, "category", "updated", category.ID, category)
+	})
+}
+
+// Patch writes every editable field, empty values included, if category.Version
+// still matches the stored row, returning ErrVersionConflict otherwise
+func (r *categoryRepository) Patch(category *models.Category) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateVersioned(tx, category, category.ID, &category.Version, "title", "description"); err != nil {
+			return err
+		}
+		return recordChange(tx, "category", "updated", category.ID, category)
+	})
 }
 
-// UpdatePosition updates only the position field of a category
-func (r *categoryRepository) UpdatePosition(id uint, position uint) error {
-	return r.db.Model(&models.Category{}).Where("id = ?", id).Update("position", position).Error
+// UpdatePosition moves the category within its portfolio if version still
+// matches the stored row, returning the position it ends up at
+func (r *categoryRepository) UpdatePosition(id uint, at ordering.Placement, version uint) (uint, error) {
+	var position uint
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		position, err = reposition(tx, categoryOrder, id, version, 0, at)
+		return err
+	})
+	return position, err
 }
 
 // GetByIDs fetches multiple categories by their IDs
@@ -86,30 +165,36 @@ func (r *categoryRepository) GetByIDs(ids []uint) ([]*models.Category, error) {
 	return categories, nil
 }
 
-// BulkUpdatePositions updates positions for multiple categories in a transaction
-func (r *categoryRepository) BulkUpdatePositions(items []struct {
-	ID       uint `json:"id" binding:"required"`
-	Position uint `json:"position" binding:"required,min=1"`
-}) error {
+// BulkUpdatePositions puts several categories of a portfolio at the given
+// positions in a transaction; the others keep their relative order
+func (r *categoryRepository) BulkUpdatePositions(portfolioID uint, items []ordering.Item) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		for _, item := range items {
-			if err := tx.Model(&models.Category{}).
-				Where("id = ?", item.ID).
-				Update("position", item.Position).Error; err != nil {
-				return err
-			}
-		}
-		return nil
+		return arrange(tx, categoryOrder, portfolioID, items)
 	})
 }
 
-func (r *categoryRepository) Delete(id uint) error {
-	return r.db.Delete(&models.Category{}, id).Error
+func (r *categoryRepository) Delete(id uint, version uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		before, err := lockSlot(tx, categoryOrder, id)
+		if err != nil {
+			return err
+		}
+		if err := deleteVersioned(tx, &models.Category{}, id, version); err != nil {
+			return err
+		}
+		if err := unlinkCategories(tx, []uint{id}); err != nil {
+			return err
+		}
+		if err := renumber(tx, categoryOrder, before.Parent); err != nil {
+			return err
+		}
+		return recordChange(tx, "category", "deleted", id, map[string]interface{}{"id": id})
+	})
 }
 
 func (r *categoryRepository) List(limit, offset int) ([]models.Category, error) {
 	var categories []models.Category
-	err := r.db.Select("id, title, description, position, owner_id, portfolio_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, position, owner_id, portfolio_id, version, created_at, updated_at").
 		Limit(limit).Offset(offset).
 		Find(&categories).Error
 	return categories, err
@@ -128,7 +213,7 @@ func (r *categoryRepository) GetByOwnerIDBasic(ownerID string, limit, offset int
 	}
 
 	// Get paginated results
-	err := r.db.Select("id, title, description, position, owner_id, portfolio_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, position, owner_id, portfolio_id, version, created_at, updated_at").
 		Where("owner_id = ?", ownerID).
 		Limit(limit).Offset(offset).
 		Find(&categories).Error
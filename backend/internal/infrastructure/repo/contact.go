@@ -0,0 +1,173 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type contactRepository struct {
+	db *gorm.DB
+}
+
+func NewContactRepository(db *gorm.DB) ContactRepository {
+	return &contactRepository{
+		db: db,
+	}
+}
+
+// Create stores the message along with the jobs that follow it up, all or
+// nothing. followUps builds the jobs once the message has its ID; it may be nil.
+func (r *contactRepository) Create(message *models.ContactMessage, followUps func(*models.ContactMessage) ([]*models.Job, error)) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(message).Error; err != nil {
+			return err
+		}
+		if followUps == nil {
+			return nil
+		}
+
+		jobs, err := followUps(message)
+		if err != nil {
+			return err
+		}
+		for _, job := range jobs {
+			if err := tx.Create(job).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *contactRepository) GetByID(id uint) (*models.ContactMessage, error) {
+	var message models.ContactMessage
+	err := r.db.First(&message, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// GetByOwnerID lists the owner's messages, newest first, optionally only those
+// of one portfolio. Without a status, archived messages are left out.
+func (r *contactRepository) GetByOwnerID(ownerID string, portfolioID uint, status string, limit, offset int) ([]models.ContactMessage, int64, error) {
+	var messages []models.ContactMessage
+	var total int64
+
+	query := r.db.Model(&models.ContactMessage{}).Where("owner_id = ?", ownerID)
+	if portfolioID != 0 {
+		query = query.Where("portfolio_id = ?", portfolioID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	} else {
+		query = query.Where("status <> ?", models.ContactArchived)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("id DESC").Limit(limit).Offset(offset).Find(&messages).Error
+	return messages, total, err
+}
+
+// UpdateStatus moves the message to message.Status if message.Version still
+// matches the stored row, returning ErrVersionConflict otherwise. The first
+// time a message leaves the unread state its read time is recorded.
+func (r *contactRepository) UpdateStatus(message *models.ContactMessage) error {
+	if message.Status != models.ContactUnread && message.ReadAt == nil {
+		now := time.Now()
+		message.ReadAt = &now
+	}
+	return updateVersioned(r.db, message, message.ID, &message.Version, "status", "read_at")
+}
+
+// ClaimAutoReply records that the visitor who sent message gets the
+// auto-reply and reports true, or reports false when the address already got
+// one from the portfolio or the portfolio sent its share within
+// models.AutoReplyWindow. The settings row is locked so concurrent messages
+// count each other.
+func (r *contactRepository) ClaimAutoReply(message *models.ContactMessage) (bool, error) {
+	claimed := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT 1 FROM contact_settings WHERE portfolio_id = ? FOR UPDATE", message.PortfolioID).Error; err != nil {
+			return err
+		}
+
+		var sent struct {
+			Portfolio int64
+			Recipient int64
+		}
+		if err := tx.Raw(`SELECT COUNT(*) AS portfolio, COUNT(*) FILTER (WHERE lower(email) = lower(?)) AS recipient
+			FROM contact_messages
+			WHERE portfolio_id = ? AND id <> ? AND auto_replied_at > ?`,
+			message.Email, message.PortfolioID, message.ID, time.Now().Add(-models.AutoReplyWindow)).
+			Scan(&sent).Error; err != nil {
+			return err
+		}
+		if sent.Recipient > 0 || sent.Portfolio >= models.AutoRepliesPerPortfolio {
+			return nil
+		}
+
+		now := time.Now()
+		if err := tx.Model(&models.ContactMessage{}).Where("id = ?", message.ID).
+			UpdateColumn("auto_replied_at", now).Error; err != nil {
+			return err
+		}
+		message.AutoRepliedAt = &now
+		claimed = true
+		return nil
+	})
+	return claimed, err
+}
+
+func (r *contactRepository) Delete(id uint, version uint) error {
+	return deleteVersioned(r.db, &models.ContactMessage{}, id, version)
+}
+
+// GetSettings returns the contact settings of a portfolio, or
+// gorm.ErrRecordNotFound if it has none stored
+func (r *contactRepository) GetSettings(portfolioID uint) (*models.ContactSettings, error) {
+	var settings models.ContactSettings
+	err := r.db.Where("portfolio_id = ?", portfolioID).First(&settings).Error
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// SaveSettings stores settings if settings.Version still matches the stored
+// row, returning ErrVersionConflict otherwise. Portfolios without a row have
+// the default settings at version 1, which the first save replaces.
+func (r *contactRepository) SaveSettings(settings *models.ContactSettings) error {
+	expected := settings.Version
+	settings.Version = expected + 1
+
+	result := r.db.Model(settings).
+		Select("enabled", "notify_email", "auto_reply", "auto_reply_subject", "auto_reply_body", "version", "updated_at").
+		Where("portfolio_id = ? AND version = ?", settings.PortfolioID, expected).
+		Updates(settings)
+	if result.Error == nil && result.RowsAffected == 0 && expected == 1 {
+		result = r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(settings)
+	}
+	if result.Error == nil && result.RowsAffected == 0 {
+		settings.Version = expected
+		return ErrVersionConflict
+	}
+	if result.Error != nil {
+		settings.Version = expected
+	}
+	return result.Error
+}
+
+// deletePortfolioContact deletes the contact messages and settings of a portfolio
+func deletePortfolioContact(tx *gorm.DB, portfolioID uint) error {
+	if err := tx.Where("portfolio_id = ?", portfolioID).Delete(&models.ContactMessage{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("portfolio_id = ?", portfolioID).Delete(&models.ContactSettings{}).Error
+}
@@ -0,0 +1,117 @@
+package repo
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/ordering"
+	"gorm.io/gorm"
+)
+
+type educationRepository struct {
+	db *gorm.DB
+}
+
+func NewEducationRepository(db *gorm.DB) EducationRepository {
+	return &educationRepository{
+		db: db,
+	}
+}
+
+// Create appends the education entry to the ones of its portfolio
+func (r *educationRepository) Create(education *models.Education) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockParents(tx, educationOrder, education.PortfolioID); err != nil {
+			return err
+		}
+		var count int64
+		if err := tx.Model(&models.Education{}).
+			Where("portfolio_id = ?", education.PortfolioID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		education.Position = uint(count) + 1
+		if err := tx.Create(education).Error; err != nil {
+			return err
+		}
+		return recordChange(tx, "education", "created", education.ID, education)
+	})
+}
+
+func (r *educationRepository) GetByID(id uint) (*models.Education, error) {
+	var education models.Education
+	err := r.db.First(&education, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &education, nil
+}
+
+// GetByIDs fetches multiple education entries by their IDs
+func (r *educationRepository) GetByIDs(ids []uint) ([]*models.Education, error) {
+	var education []*models.Education
+	if err := r.db.Where("id IN ?", ids).Find(&education).Error; err != nil {
+		return nil, err
+	}
+	return education, nil
+}
+
+// GetByPortfolioID lists the education of a portfolio in order
+func (r *educationRepository) GetByPortfolioID(portfolioID uint) ([]models.Education, error) {
+	var education []models.Education
+	err := r.db.Where("portfolio_id = ?", portfolioID).
+		Order("position ASC, created_at ASC").
+		Find(&education).Error
+	return education, err
+}
+
+// Update writes the education entry if education.Version still matches the
+// stored row, returning ErrVersionConflict otherwise
+func (r *educationRepository) Update(education *models.Education) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateVersioned(tx, education, education.ID, &education.Version,
+			"institution", "degree", "start_date", "end_date"); err != nil {
+			return err
+		}
+		return recordChange(tx, "education", "updated", education.ID, education)
+	})
+}
+
+// UpdatePosition moves the education entry within its portfolio if version
+// still matches the stored row, returning the position it ends up at
+func (r *educationRepository) UpdatePosition(id uint, at ordering.Placement, version uint) (uint, error) {
+	var position uint
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		position, err = reposition(tx, educationOrder, id, version, 0, at)
+		return err
+	})
+	return position, err
+}
+
+// BulkUpdatePositions puts several education entries of a portfolio at the
+// given positions in a transaction; the others keep their relative order
+func (r *educationRepository) BulkUpdatePositions(portfolioID uint, items []ordering.Item) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return arrange(tx, educationOrder, portfolioID, items)
+	})
+}
+
+func (r *educationRepository) Delete(id uint, version uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		before, err := lockSlot(tx, educationOrder, id)
+		if err != nil {
+			return err
+		}
+		if err := deleteVersioned(tx, &models.Education{}, id, version); err != nil {
+			return err
+		}
+		if err := renumber(tx, educationOrder, before.Parent); err != nil {
+			return err
+		}
+		return recordChange(tx, "education", "deleted", id, map[string]interface{}{"id": id})
+	})
+}
+
+// deletePortfolioEducation deletes the education of a portfolio
+func deletePortfolioEducation(tx *gorm.DB, portfolioID uint) error {
+	return tx.Where("portfolio_id = ?", portfolioID).Delete(&models.Education{}).Error
+}
@@ -0,0 +1,199 @@
+package repo
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/ordering"
+	"gorm.io/gorm"
+)
+
+type experienceRepository struct {
+	db *gorm.DB
+}
+
+func NewExperienceRepository(db *gorm.DB) ExperienceRepository {
+	return &experienceRepository{
+		db: db,
+	}
+}
+
+// Create appends the experience to the ones of its portfolio and links its projects
+func (r *experienceRepository) Create(experience *models.Experience) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockParents(tx, experienceOrder, experience.PortfolioID); err != nil {
+			return err
+		}
+		var count int64
+		if err := tx.Model(&models.Experience{}).
+			Where("portfolio_id = ?", experience.PortfolioID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		experience.Position = uint(count) + 1
+		if err := tx.Create(experience).Error; err != nil {
+			return err
+		}
+		if err := linkExperienceProjects(tx, experience.ID, experience.ProjectIDs); err != nil {
+			return err
+		}
+		return recordChange(tx, "experience", "created", experience.ID, experience)
+	})
+}
+
+func (r *experienceRepository) GetByID(id uint) (*models.Experience, error) {
+	var experience models.Experience
+	if err := r.db.First(&experience, id).Error; err != nil {
+		return nil, err
+	}
+	projectIDs, err := experienceProjectIDs(r.db, []uint{id})
+	experience.ProjectIDs = projectIDs[id]
+	return &experience, err
+}
+
+// GetByIDs fetches multiple experiences by their IDs
+func (r *experienceRepository) GetByIDs(ids []uint) ([]*models.Experience, error) {
+	var experiences []*models.Experience
+	if err := r.db.Where("id IN ?", ids).Find(&experiences).Error; err != nil {
+		return nil, err
+	}
+	return experiences, nil
+}
+
+// GetByPortfolioID lists the experiences of a portfolio in order, with their
+// linked projects
+func (r *experienceRepository) GetByPortfolioID(portfolioID uint) ([]models.Experience, error) {
+	var experiences []models.Experience
+	if err := r.db.Where("portfolio_id = ?", portfolioID).
+		Order("position ASC, created_at ASC").
+		Find(&experiences).Error; err != nil {
+		return nil, err
+	}
+	return experiences, withExperienceProjects(r.db, experiences)
+}
+
+// Update writes the experience and replaces its linked projects if
+// experience.Version still matches the stored row, returning
+// ErrVersionConflict otherwise
+func (r *experienceRepository) Update(experience *models.Experience) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateVersioned(tx, experience, experience.ID, &experience.Version,
+			"company", "title", "location", "start_date", "end_date", "current", "highlights"); err != nil {
+			return err
+		}
+		if err := tx.Where("experience_id = ?", experience.ID).
+			Delete(&models.ExperienceProject{}).Error; err != nil {
+			return err
+		}
+		if err := linkExperienceProjects(tx, experience.ID, experience.ProjectIDs); err != nil {
+			return err
+		}
+		return recordChange(tx, "experience", "updated", experience.ID, experience)
+	})
+}
+
+// UpdatePosition moves the experience within its portfolio if version still
+// matches the stored row, returning the position it ends up at
+func (r *experienceRepository) UpdatePosition(id uint, at ordering.Placement, version uint) (uint, error) {
+	var position uint
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		position, err = reposition(tx, experienceOrder, id, version, 0, at)
+		return err
+	})
+	return position, err
+}
+
+// BulkUpdatePositions puts several experiences of a portfolio at the given
+// positions in a transaction; the others keep their relative order
+func (r *experienceRepository) BulkUpdatePositions(portfolioID uint, items []ordering.Item) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return arrange(tx, experienceOrder, portfolioID, items)
+	})
+}
+
+func (r *experienceRepository) Delete(id uint, version uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		before, err := lockSlot(tx, experienceOrder, id)
+		if err != nil {
+			return err
+		}
+		if err := deleteVersioned(tx, &models.Experience{}, id, version); err != nil {
+			return err
+		}
+		if err := tx.Where("experience_id = ?", id).
+			Delete(&models.ExperienceProject{}).Error; err != nil {
+			return err
+		}
+		if err := renumber(tx, experienceOrder, before.Parent); err != nil {
+			return err
+		}
+		return recordChange(tx, "experience", "deleted", id, map[string]interface{}{"id": id})
+	})
+}
+
+// ProjectsInPortfolio reports whether every project is shown in one of the
+// categories of the portfolio
+func (r *experienceRepository) ProjectsInPortfolio(projectIDs []uint, portfolioID uint) (bool, error) {
+	if len(projectIDs) == 0 {
+		return true, nil
+	}
+	var count int64
+	err := r.db.Model(&models.Project{}).
+		Where("id IN ? AND id IN (?)", projectIDs, portfolioProjectIDs(r.db, portfolioID)).
+		Count(&count).Error
+	return count == int64(len(projectIDs)), err
+}
+
+// linkExperienceProjects links the projects to the experience
+func linkExperienceProjects(tx *gorm.DB, experienceID uint, projectIDs []uint) error {
+	if len(projectIDs) == 0 {
+		return nil
+	}
+	links := make([]models.ExperienceProject, len(projectIDs))
+	for i, projectID := range projectIDs {
+		links[i] = models.ExperienceProject{ExperienceID: experienceID, ProjectID: projectID}
+	}
+	return tx.Create(&links).Error
+}
+
+// experienceProjectIDs returns the live projects linked to each of the
+// experiences, in the order they were linked
+func experienceProjectIDs(db *gorm.DB, ids []uint) (map[uint][]uint, error) {
+	projectIDs := make(map[uint][]uint, len(ids))
+	if len(ids) == 0 {
+		return projectIDs, nil
+	}
+	var links []models.ExperienceProject
+	err := db.Model(&models.ExperienceProject{}).
+		Select("experience_projects.experience_id, experience_projects.project_id").
+		Joins("JOIN projects ON projects.id = experience_projects.project_id AND projects.deleted_at IS NULL").
+		Where("experience_projects.experience_id IN ?", ids).
+		Order("experience_projects.created_at ASC, experience_projects.project_id ASC").
+		Find(&links).Error
+	for _, link := range links {
+		projectIDs[link.ExperienceID] = append(projectIDs[link.ExperienceID], link.ProjectID)
+	}
+	return projectIDs, err
+}
+
+// withExperienceProjects fills in the linked projects of the experiences
+func withExperienceProjects(db *gorm.DB, experiences []models.Experience) error {
+	ids := make([]uint, len(experiences))
+	for i := range experiences {
+		ids[i] = experiences[i].ID
+	}
+	projectIDs, err := experienceProjectIDs(db, ids)
+	for i := range experiences {
+		experiences[i].ProjectIDs = projectIDs[experiences[i].ID]
+	}
+	return err
+}
+
+// deletePortfolioExperiences deletes the experiences of a portfolio and their links
+func deletePortfolioExperiences(tx *gorm.DB, portfolioID uint) error {
+	if err := tx.Where("experience_id IN (?)",
+		tx.Model(&models.Experience{}).Select("id").Where("portfolio_id = ?", portfolioID)).
+		Delete(&models.ExperienceProject{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("portfolio_id = ?", portfolioID).Delete(&models.Experience{}).Error
+}
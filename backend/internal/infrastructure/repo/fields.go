@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
+	"gorm.io/gorm"
+)
+
+// Columns of each resource as returned by default. A sparse fieldset may only
+// pick from these, so client input never reaches SELECT unchecked.
+var (
+	portfolioColumns = []string{"id", "title", "description", "owner_id", "version", "default_locale", "locales", "created_at", "updated_at"}
+	categoryColumns  = []string{"id", "title", "description", "position", "owner_id", "portfolio_id", "version", "created_at", "updated_at"}
+	projectColumns   = []string{"id", "title", "description", "skills", "client", "link", "links", "start_date", "end_date", "ongoing", "role", "team_size", "status", "featured", "position", "owner_id", "category_id", "version", "created_at", "updated_at"}
+	sectionColumns   = []string{"id", "title", "description", "type", "position", "portfolio_id", "owner_id", "version", "created_at", "updated_at"}
+)
+
+// linkedProjects stands in for the projects table where projects are read per
+// category: one row per project_categories link, with the link's category_id
+// and position. It keeps the name projects, so conditions written against the
+// table still apply.
+var linkedProjects = func() string {
+	columns := make([]string, 0, len(projectColumns)+1)
+	for _, column := range append(projectColumns, "deleted_at") {
+		switch column {
+		case "category_id", "position":
+			columns = append(columns, "project_categories."+column)
+		default:
+			columns = append(columns, "projects."+column)
+		}
+	}
+	return "(SELECT " + strings.Join(columns, ", ") +
+		" FROM projects JOIN project_categories ON project_categories.project_id = projects.id) AS projects"
+}()
+
+// selectedColumns returns the columns to load for sel: every column when no
+// fields were picked, otherwise the picked ones plus id, owner_id and version,
+// which the handlers need for visibility checks and ETags, plus extra
+func selectedColumns(sel query.Selection, columns []string, extra ...string) []string {
+	if len(sel.Fields) == 0 {
+		return columns
+	}
+	var selected []string
+	for _, column := range columns {
+		switch {
+		case column == "id", column == "owner_id", column == "version":
+		case contains(sel.Fields, column), contains(extra, column):
+		default:
+			continue
+		}
+		selected = append(selected, column)
+	}
+	return selected
+}
+
+// relation is a has-many association that include= can load, optionally
+// from a table other than the association's own
+type relation struct {
+	association string
+	order       string
+	table       string
+}
+
+var (
+	portfolioRelations = map[string]relation{
+		"sections":    {association: "Sections", order: "position ASC, created_at ASC"},
+		"categories":  {association: "Categories", order: "position ASC, created_at ASC"},
+		"experiences": {association: "Experiences", order: "position ASC, created_at ASC"},
+		"education":   {association: "Education", order: "position ASC, created_at ASC"},
+	}
+	categoryRelations = map[string]relation{
+		"projects": {association: "Projects", order: "position ASC, created_at ASC", table: linkedProjects},
+	}
+	sectionRelations = map[string]relation{
+		"contents": {association: "Contents", order: "\"order\" ASC, created_at ASC"},
+	}
+)
+
+// preloadIncluded preloads the relations named by include=
+func preloadIncluded(db *gorm.DB, sel query.Selection, relations map[string]relation) *gorm.DB {
+	for name, rel := range relations {
+		if sel.Includes(name) {
+			db = db.Preload(rel.association, func(db *gorm.DB) *gorm.DB {
+				if rel.table != "" {
+					db = db.Table(rel.table)
+				}
+				return db.Order(rel.order)
+			})
+		}
+	}
+	return db
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
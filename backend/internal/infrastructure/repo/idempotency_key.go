@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type idempotencyKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyKeyRepository(db *gorm.DB) IdempotencyKeyRepository {
+	return &idempotencyKeyRepository{
+		db: db,
+	}
+}
+
+// Reserve inserts the key if the caller hasn't used it yet. When the key
+// already exists the stored record is returned with created=false. An expired
+// record is replaced, so the key can be reused after its TTL.
+func (r *idempotencyKeyRepository) Reserve(record *models.IdempotencyKey) (*models.IdempotencyKey, bool, error) {
+	var existing models.IdempotencyKey
+	created := false
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Drop an expired record for this key so the insert below can take its place
+		if err := tx.Where("owner_id = ? AND key = ? AND expires_at <= ?", record.OwnerID, record.Key, time.Now()).
+			Delete(&models.IdempotencyKey{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 1 {
+			created = true
+			return nil
+		}
+
+		return tx.Where("owner_id = ? AND key = ?", record.OwnerID, record.Key).First(&existing).Error
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if created {
+		return record, true, nil
+	}
+	return &existing, false, nil
+}
+
+// Complete stores the response of the request that reserved the key
+func (r *idempotencyKeyRepository) Complete(id uint, statusCode int, contentType string, body []byte) error {
+	return r.db.Model(&models.IdempotencyKey{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status_code":   statusCode,
+		"content_type":  contentType,
+		"response_body": body,
+	}).Error
+}
+
+// Release removes a reservation so the request can be retried with the same key
+func (r *idempotencyKeyRepository) Release(id uint) error {
+	return r.db.Delete(&models.IdempotencyKey{}, id).Error
+}
+
+// DeleteExpired purges keys past their expiry and returns how many were removed
+func (r *idempotencyKeyRepository) DeleteExpired(now time.Time) (int64, error) {
+	result := r.db.Where("expires_at <= ?", now).Delete(&models.IdempotencyKey{})
+	return result.RowsAffected, result.Error
+}
@@ -1,17 +1,25 @@
 package repo
 
 import (
+	"time"
+
 	models2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/ordering"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
 )
 
 type PortfolioRepository interface {
 	Create(portfolio *models2.Portfolio) error
+	CreateFromTemplate(portfolio *models2.Portfolio, bundle models2.TemplateBundle) error
 	GetByID(id uint) (*models2.Portfolio, error)
 	GetByIDWithRelations(id uint) (*models2.Portfolio, error)
+	GetByIDSelected(id uint, sel query.Selection) (*models2.Portfolio, error)
 	GetByOwnerIDBasic(ownerID string, limit, offset int) ([]models2.Portfolio, int64, error)
 	GetByIDBasic(id uint) (*models2.Portfolio, error)
 	Update(portfolio *models2.Portfolio) error
-	Delete(id uint) error
+	Patch(portfolio *models2.Portfolio) error
+	UpdateLocales(id uint, defaultLocale string, locales []string, version uint) error
+	Delete(id uint, version uint) error
 	List(limit, offset int) ([]models2.Portfolio, error)
 	CheckDuplicate(title string, ownerID string, id uint) (bool, error)
 }
@@ -19,11 +27,20 @@ type PortfolioRepository interface {
 type ProjectRepository interface {
 	Create(project *models2.Project) error
 	GetByID(id uint) (*models2.Project, error)
+	GetByIDSelected(id uint, sel query.Selection) (*models2.Project, error)
 	GetByOwnerIDBasic(ownerID string, limit, offset int) ([]models2.Project, int64, error)
 	GetByCategoryID(categoryID string) ([]models2.Project, error)
+	ListByCategoryID(categoryID string, spec query.Spec) ([]models2.Project, string, error)
+	ListTimeline(portfolioID uint, spec query.Spec) ([]models2.Project, string, error)
 	Update(project *models2.Project) error
-	UpdatePosition(id uint, position uint) error
-	Delete(id uint) error
+	Patch(project *models2.Project) error
+	UpdatePosition(id uint, categoryID uint, at ordering.Placement, version uint) (uint, error)
+	BulkUpdatePositions(categoryID uint, items []ordering.Item) error
+	MoveToCategory(project *models2.Project, from uint, to uint, at ordering.Placement) error
+	GetCategoryIDs(id uint) ([]uint, error)
+	AddCategory(project *models2.Project, categoryID uint) error
+	RemoveCategory(project *models2.Project, categoryID uint) error
+	Delete(id uint, version uint) error
 	List(limit, offset int) ([]models2.Project, error)
 	GetBySkills(skills []string) ([]models2.Project, error)
 	GetByClient(client string) ([]models2.Project, error)
@@ -34,18 +51,19 @@ type SectionRepository interface {
 	Create(section *models2.Section) error
 	GetByID(id uint) (*models2.Section, error)
 	GetByIDWithRelations(id uint) (*models2.Section, error)
+	GetByIDSelected(id uint, sel query.Selection) (*models2.Section, error)
 	GetByIDs(ids []uint) ([]*models2.Section, error)
 	GetByOwnerID(ownerID string, limit, offset int) ([]models2.Section, int64, error)
 	GetByPortfolioID(portfolioID string) ([]models2.Section, error)
+	ListByPortfolioID(portfolioID string, spec query.Spec) ([]models2.Section, string, error)
 	GetByPortfolioIDWithRelations(portfolioID string) ([]models2.Section, error)
 	GetByType(sectionType string) ([]models2.Section, error)
 	Update(section *models2.Section) error
-	UpdatePosition(id uint, position uint) error
-	BulkUpdatePositions(items []struct {
-		ID       uint `json:"id" binding:"required"`
-		Position uint `json:"position" binding:"required,min=1"`
-	}) error
-	Delete(id uint) error
+	Patch(section *models2.Section) error
+	UpdatePosition(id uint, at ordering.Placement, version uint) (uint, error)
+	MoveToPortfolio(id uint, portfolioID uint, at ordering.Placement, version uint) (uint, error)
+	BulkUpdatePositions(portfolioID uint, items []ordering.Item) error
+	Delete(id uint, version uint) error
 	List(limit, offset int) ([]models2.Section, error)
 	CheckDuplicate(title string, portfolioID uint, id uint) (bool, error)
 }
@@ -55,9 +73,11 @@ type SectionContentRepository interface {
 	GetByID(id uint) (*models2.SectionContent, error)
 	GetBySectionID(sectionID uint) ([]models2.SectionContent, error)
 	Update(content *models2.SectionContent) error
-	UpdateOrder(id uint, order uint) error
-	Delete(id uint) error
-	CheckDuplicateOrder(sectionID uint, order uint, id uint) (bool, error)
+	Patch(content *models2.SectionContent) error
+	UpdatePosition(id uint, at ordering.Placement, version uint) (uint, error)
+	MoveToSection(id uint, sectionID uint, at ordering.Placement, version uint) (uint, error)
+	BulkUpdatePositions(sectionID uint, items []ordering.Item) error
+	Delete(id uint, version uint) error
 }
 
 type CategoryRepository interface {
@@ -65,16 +85,180 @@ type CategoryRepository interface {
 	GetByID(id uint) (*models2.Category, error)
 	GetByIDBasic(id uint) (*models2.Category, error)
 	GetByIDWithRelations(id uint) (*models2.Category, error)
+	GetByIDSelected(id uint, sel query.Selection) (*models2.Category, error)
 	GetByIDs(ids []uint) ([]*models2.Category, error)
 	GetByPortfolioID(portfolioID string) ([]models2.Category, error)
+	ListByPortfolioID(portfolioID string, spec query.Spec) ([]models2.Category, string, error)
 	GetByPortfolioIDWithRelations(portfolioID string) ([]models2.Category, error)
 	GetByOwnerIDBasic(ownerID string, limit, offset int) ([]models2.Category, int64, error)
 	Update(category *models2.Category) error
-	UpdatePosition(id uint, position uint) error
-	BulkUpdatePositions(items []struct {
-		ID       uint `json:"id" binding:"required"`
-		Position uint `json:"position" binding:"required,min=1"`
-	}) error
-	Delete(id uint) error
+	Patch(category *models2.Category) error
+	UpdatePosition(id uint, at ordering.Placement, version uint) (uint, error)
+	BulkUpdatePositions(portfolioID uint, items []ordering.Item) error
+	Delete(id uint, version uint) error
 	List(limit, offset int) ([]models2.Category, error)
 }
+
+type IdempotencyKeyRepository interface {
+	Reserve(record *models2.IdempotencyKey) (*models2.IdempotencyKey, bool, error)
+	Complete(id uint, statusCode int, contentType string, body []byte) error
+	Release(id uint) error
+	DeleteExpired(now time.Time) (int64, error)
+}
+
+type WebhookRepository interface {
+	Create(webhook *models2.Webhook) error
+	GetByID(id uint) (*models2.Webhook, error)
+	GetByOwnerID(ownerID string, portfolioID uint, limit, offset int) ([]models2.Webhook, int64, error)
+	Update(webhook *models2.Webhook) error
+	Delete(id uint, version uint) error
+	GetDeliveries(webhookID uint, limit, offset int) ([]models2.WebhookDelivery, int64, error)
+	ClaimDueDeliveries(now time.Time, limit int, lease time.Duration) ([]models2.WebhookDelivery, error)
+	MarkDelivered(id uint, statusCode int) error
+	MarkAttemptFailed(id uint, statusCode int, message string, nextAttempt *time.Time) error
+}
+
+type UserStatusRepository interface {
+	GetByOwnerID(ownerID string) (*models2.UserStatus, error)
+	IsHidden(ownerID string) (bool, error)
+	Save(status *models2.UserStatus, event *models2.UserLifecycleEvent) error
+	GetEvents(ownerID string) ([]models2.UserLifecycleEvent, error)
+}
+
+type PortfolioEventRepository interface {
+	GetByID(id uint) (*models2.PortfolioEvent, error)
+	GetSince(portfolioID uint, afterID uint, limit int) ([]models2.PortfolioEvent, error)
+	DeleteBefore(cutoff time.Time) (int64, error)
+}
+
+type TranslationRepository interface {
+	GetByPortfolioID(portfolioID uint, locale string) ([]models2.Translation, error)
+	Save(translations []models2.Translation) error
+	DeleteLocale(portfolioID uint, locale string) (int64, error)
+	GetSources(portfolioID uint) ([]models2.TranslationSource, error)
+	GetPortfolioOf(resourceType string, id uint) (*models2.Portfolio, error)
+}
+
+type SkillRepository interface {
+	Create(skill *models2.Skill) error
+	GetByID(id uint) (*models2.Skill, error)
+	GetByIDs(ownerID string, ids []uint) ([]models2.Skill, error)
+	GetByOwnerID(ownerID string, kind string) ([]models2.SkillUsage, error)
+	Search(ownerID string, prefix string, limit int) ([]models2.SkillUsage, error)
+	FindConflict(ownerID string, slugs []string, id uint) (*models2.Skill, error)
+	CountProjects(id uint) (int64, error)
+	Update(skill *models2.Skill, previousName string) error
+	Delete(id uint, version uint) error
+	Merge(target *models2.Skill, sources []models2.Skill) error
+	GetUsageByPortfolioID(portfolioID uint, kind string) ([]models2.SkillUsage, int64, error)
+	DeleteByOwnerID(ownerID string) (int64, error)
+}
+
+type TemplateRepository interface {
+	Create(template *models2.PortfolioTemplate) error
+	GetByID(id uint) (*models2.PortfolioTemplate, error)
+	GetAvailable() ([]models2.PortfolioTemplate, error)
+	GetByOwnerID(ownerID string) ([]models2.PortfolioTemplate, error)
+	CheckDuplicate(name string, ownerID string, id uint) (bool, error)
+	Update(template *models2.PortfolioTemplate) error
+	Delete(id uint, version uint) error
+	DeleteByOwnerID(ownerID string) (int64, error)
+	BundleOf(portfolioID uint) (models2.TemplateBundle, error)
+}
+
+type AnalyticsRepository interface {
+	RecordView(view *models2.PageView) error
+	Salt(day time.Time, candidate string) (string, error)
+	Rollup() error
+	DeleteViewsBefore(before time.Time) (int64, error)
+	DeleteSaltsBefore(day time.Time) (int64, error)
+	GetDaily(portfolioID uint, from, to time.Time) ([]models2.DailyView, error)
+	GetTopResources(portfolioID uint, resourceType string, from, to time.Time, limit int) ([]models2.ResourceViews, error)
+	GetTopSources(portfolioID uint, kind string, from, to time.Time, limit int) ([]models2.SourceViews, error)
+}
+
+type ContactRepository interface {
+	Create(message *models2.ContactMessage, followUps func(*models2.ContactMessage) ([]*models2.Job, error)) error
+	GetByID(id uint) (*models2.ContactMessage, error)
+	GetByOwnerID(ownerID string, portfolioID uint, status string, limit, offset int) ([]models2.ContactMessage, int64, error)
+	UpdateStatus(message *models2.ContactMessage) error
+	ClaimAutoReply(message *models2.ContactMessage) (bool, error)
+	Delete(id uint, version uint) error
+	GetSettings(portfolioID uint) (*models2.ContactSettings, error)
+	SaveSettings(settings *models2.ContactSettings) error
+}
+
+type TestimonialRepository interface {
+	Create(testimonial *models2.Testimonial) error
+	Submit(testimonial *models2.Testimonial, invite *models2.TestimonialInvite) error
+	GetByID(id uint) (*models2.Testimonial, error)
+	GetByIDs(ids []uint) ([]*models2.Testimonial, error)
+	GetByPortfolioID(portfolioID uint, projectID uint, status string) ([]models2.Testimonial, error)
+	GetApprovedByPortfolioID(portfolioID uint, projectID uint) ([]models2.Testimonial, error)
+	GetApprovedByProjectID(projectID uint) ([]models2.Testimonial, error)
+	Update(testimonial *models2.Testimonial) error
+	UpdateStatus(testimonial *models2.Testimonial) error
+	UpdatePosition(id uint, at ordering.Placement, version uint) (uint, error)
+	BulkUpdatePositions(portfolioID uint, items []ordering.Item) error
+	Delete(id uint, version uint) error
+	ProjectInPortfolio(projectID uint, portfolioID uint) (bool, error)
+	CreateInvite(invite *models2.TestimonialInvite) error
+	GetInvitesByPortfolioID(portfolioID uint) ([]models2.TestimonialInvite, error)
+	GetInviteByID(id uint) (*models2.TestimonialInvite, error)
+	GetInviteByToken(token string) (*models2.TestimonialInvite, error)
+	DeleteInvite(id uint) error
+}
+
+type ExperienceRepository interface {
+	Create(experience *models2.Experience) error
+	GetByID(id uint) (*models2.Experience, error)
+	GetByIDs(ids []uint) ([]*models2.Experience, error)
+	GetByPortfolioID(portfolioID uint) ([]models2.Experience, error)
+	Update(experience *models2.Experience) error
+	UpdatePosition(id uint, at ordering.Placement, version uint) (uint, error)
+	BulkUpdatePositions(portfolioID uint, items []ordering.Item) error
+	Delete(id uint, version uint) error
+	ProjectsInPortfolio(projectIDs []uint, portfolioID uint) (bool, error)
+}
+
+type EducationRepository interface {
+	Create(education *models2.Education) error
+	GetByID(id uint) (*models2.Education, error)
+	GetByIDs(ids []uint) ([]*models2.Education, error)
+	GetByPortfolioID(portfolioID uint) ([]models2.Education, error)
+	Update(education *models2.Education) error
+	UpdatePosition(id uint, at ordering.Placement, version uint) (uint, error)
+	BulkUpdatePositions(portfolioID uint, items []ordering.Item) error
+	Delete(id uint, version uint) error
+}
+
+type ProjectSourceRepository interface {
+	GetByRepoID(ownerID string, provider string, repoID int64) (*models2.ProjectSource, error)
+	GetByOwnerID(ownerID string, provider string) ([]models2.ProjectSource, error)
+	Save(source *models2.ProjectSource) error
+	GetDue(before time.Time, limit int) ([]models2.ProjectSource, error)
+	MarkFailed(projectID uint, message string, at time.Time) error
+}
+
+type LinkCheckRepository interface {
+	Track() error
+	GetDue(before time.Time, limit int) ([]models2.LinkCheck, error)
+	Record(result *models2.LinkCheckResult, keep int) error
+	GetBrokenByPortfolio(portfolioID uint) ([]models2.BrokenLink, error)
+}
+
+type JobRepository interface {
+	Create(job *models2.Job) error
+	GetByID(id uint) (*models2.Job, error)
+	List(status, jobType string, limit, offset int) ([]models2.Job, int64, error)
+	Claim(types []string, worker string, now time.Time, limit int, grace time.Duration) ([]models2.Job, error)
+	Complete(id uint, worker string, at time.Time) error
+	Fail(id uint, worker string, message string, retryAt *time.Time, at time.Time) error
+	Retry(id uint, runAt time.Time) error
+	Delete(id uint) error
+	Counts() ([]models2.JobCount, error)
+	DeleteSucceededBefore(before time.Time) (int64, error)
+	SaveSchedule(schedule *models2.JobSchedule) error
+	GetSchedules() ([]models2.JobSchedule, error)
+	EnqueueScheduled(schedule *models2.JobSchedule, next time.Time, job *models2.Job) (bool, error)
+}
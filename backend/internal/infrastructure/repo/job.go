@@ -0,0 +1,260 @@
+package repo
+
+import (
+	"errors"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrJobNotHeld is returned when a worker reports on a job it no longer holds,
+// because its visibility timeout passed and another worker claimed it
+var ErrJobNotHeld = errors.New("job is no longer held by this worker")
+
+type jobRepository struct {
+	db *gorm.DB
+}
+
+func NewJobRepository(db *gorm.DB) JobRepository {
+	return &jobRepository{
+		db: db,
+	}
+}
+
+func (r *jobRepository) Create(job *models.Job) error {
+	return r.db.Create(job).Error
+}
+
+func (r *jobRepository) GetByID(id uint) (*models.Job, error) {
+	var job models.Job
+	err := r.db.First(&job, id).Error
+	return &job, err
+}
+
+// List returns a page of jobs, optionally of one status and type, latest
+// first, with the total count
+func (r *jobRepository) List(status, jobType string, limit, offset int) ([]models.Job, int64, error) {
+	query := r.db.Model(&models.Job{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if jobType != "" {
+		query = query.Where("type = ?", jobType)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var jobs []models.Job
+	err := query.Order("id DESC").Limit(limit).Offset(offset).Find(&jobs).Error
+	return jobs, total, err
+}
+
+// Claim hands worker up to limit due jobs of the given types: pending jobs
+// whose run_at passed, and running jobs whose worker let locked_until pass.
+// Each claim counts as an attempt and holds the job until the attempt's
+// timeout plus grace. Jobs let go on their last attempt are dead-lettered
+// instead of claimed again. Rows locked by other claims are skipped, so
+// concurrent workers never get the same job.
+func (r *jobRepository) Claim(types []string, worker string, now time.Time, limit int, grace time.Duration) ([]models.Job, error) {
+	var jobs []models.Job
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Job{}).
+			Where("status = ? AND locked_until < ? AND attempts >= max_attempts", models.JobRunning, now).
+			Updates(map[string]interface{}{
+				"status":       models.JobDead,
+				"last_error":   "visibility timeout expired on the last attempt",
+				"locked_by":    "",
+				"locked_until": nil,
+				"finished_at":  now,
+			}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("type IN ?", types).
+			Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_until < ?)", models.JobPending, now, models.JobRunning, now).
+			Order("run_at, id").
+			Limit(limit).
+			Find(&jobs).Error; err != nil {
+			return err
+		}
+
+		for i := range jobs {
+			lockedUntil := now.Add(time.Duration(jobs[i].Timeout)*time.Second + grace)
+			jobs[i].Status = models.JobRunning
+			jobs[i].Attempts++
+			jobs[i].LockedBy = worker
+			jobs[i].LockedUntil = &lockedUntil
+			if err := tx.Model(&models.Job{}).Where("id = ?", jobs[i].ID).Updates(map[string]interface{}{
+				"status":       jobs[i].Status,
+				"attempts":     jobs[i].Attempts,
+				"locked_by":    worker,
+				"locked_until": lockedUntil,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// Complete records that the worker finished the job
+func (r *jobRepository) Complete(id uint, worker string, at time.Time) error {
+	return r.release(id, worker, map[string]interface{}{
+		"status":      models.JobSucceeded,
+		"finished_at": at,
+	})
+}
+
+// Fail records a failed attempt of the job held by worker. The job runs again
+// at retryAt, or is dead-lettered when retryAt is nil.
+func (r *jobRepository) Fail(id uint, worker string, message string, retryAt *time.Time, at time.Time) error {
+	columns := map[string]interface{}{
+		"last_error": message,
+	}
+	if retryAt != nil {
+		columns["status"] = models.JobPending
+		columns["run_at"] = *retryAt
+	} else {
+		columns["status"] = models.JobDead
+		columns["finished_at"] = at
+	}
+	return r.release(id, worker, columns)
+}
+
+// release updates the job and unlocks it, as long as worker still holds it
+func (r *jobRepository) release(id uint, worker string, columns map[string]interface{}) error {
+	columns["locked_by"] = ""
+	columns["locked_until"] = nil
+	result := r.db.Model(&models.Job{}).
+		Where("id = ? AND status = ? AND locked_by = ?", id, models.JobRunning, worker).
+		Updates(columns)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrJobNotHeld
+	}
+	return nil
+}
+
+// Retry queues a dead job to run at runAt with a fresh set of attempts.
+// It returns gorm.ErrRecordNotFound unless the job is dead.
+func (r *jobRepository) Retry(id uint, runAt time.Time) error {
+	result := r.db.Model(&models.Job{}).
+		Where("id = ? AND status = ?", id, models.JobDead).
+		Updates(map[string]interface{}{
+			"status":      models.JobPending,
+			"run_at":      runAt,
+			"attempts":    0,
+			"finished_at": nil,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Delete removes the job unless a worker holds it. It returns
+// gorm.ErrRecordNotFound when no such job is waiting or finished.
+func (r *jobRepository) Delete(id uint) error {
+	result := r.db.Where("id = ? AND status <> ?", id, models.JobRunning).Delete(&models.Job{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Counts returns how many jobs of each type are in each status
+func (r *jobRepository) Counts() ([]models.JobCount, error) {
+	var counts []models.JobCount
+	err := r.db.Model(&models.Job{}).
+		Select("type, status, COUNT(*) AS count").
+		Group("type, status").
+		Order("type, status").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// DeleteSucceededBefore removes the jobs that succeeded before the given
+// time. Dead jobs stay until retried or deleted by hand.
+func (r *jobRepository) DeleteSucceededBefore(before time.Time) (int64, error) {
+	result := r.db.Where("status = ? AND finished_at < ?", models.JobSucceeded, before).Delete(&models.Job{})
+	return result.RowsAffected, result.Error
+}
+
+// SaveSchedule stores the schedule if it's new. A stored schedule keeps its
+// next run unless its spec or type changed.
+func (r *jobRepository) SaveSchedule(schedule *models.JobSchedule) error {
+	return r.db.Exec(`
+		INSERT INTO job_schedules (name, spec, type, next_run_at, updated_at)
+		VALUES (?, ?, ?, ?, NOW())
+		ON CONFLICT (name) DO UPDATE SET
+			next_run_at = CASE
+				WHEN job_schedules.spec = EXCLUDED.spec AND job_schedules.type = EXCLUDED.type THEN job_schedules.next_run_at
+				ELSE EXCLUDED.next_run_at
+			END,
+			spec = EXCLUDED.spec,
+			type = EXCLUDED.type,
+			updated_at = NOW()
+	`, schedule.Name, schedule.Spec, schedule.Type, schedule.NextRunAt).Error
+}
+
+func (r *jobRepository) GetSchedules() ([]models.JobSchedule, error) {
+	var schedules []models.JobSchedule
+	err := r.db.Order("name").Find(&schedules).Error
+	return schedules, err
+}
+
+// EnqueueScheduled moves the due schedule to its next run and creates job
+// for this run, unless the previous run's job is still waiting or running.
+// Moving the schedule only succeeds while its stored next run is the one
+// read, so a single server instance enqueues each run; enqueued reports
+// whether it was this call.
+func (r *jobRepository) EnqueueScheduled(schedule *models.JobSchedule, next time.Time, job *models.Job) (bool, error) {
+	enqueued := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.JobSchedule{}).
+			Where("name = ? AND next_run_at = ?", schedule.Name, schedule.NextRunAt).
+			Updates(map[string]interface{}{
+				"next_run_at": next,
+				"last_run_at": job.RunAt,
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		var unfinished int64
+		if err := tx.Model(&models.Job{}).
+			Where("schedule = ? AND status IN ?", schedule.Name, []string{models.JobPending, models.JobRunning}).
+			Count(&unfinished).Error; err != nil {
+			return err
+		}
+		if unfinished > 0 {
+			return nil
+		}
+
+		job.Schedule = schedule.Name
+		if err := tx.Create(job).Error; err != nil {
+			return err
+		}
+		enqueued = true
+		return nil
+	})
+	return enqueued, err
+}
@@ -0,0 +1,135 @@
+package repo
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"gorm.io/gorm"
+)
+
+// maxLinkLength is the longest URL checked; longer ones can't be tracked
+const maxLinkLength = 2048
+
+// linkUsages selects every absolute http(s) URL stored in a live project or
+// image section content, with its portfolio and the place storing it
+var linkUsages = `
+	SELECT * FROM (
+		SELECT categories.portfolio_id, '` + models.LinkSourceProject + `' AS source_type, projects.id AS source_id,
+			projects.title AS source_title, btrim(projects.link) AS url
+		FROM projects
+		JOIN categories ON categories.id = projects.category_id AND categories.deleted_at IS NULL
+		WHERE projects.deleted_at IS NULL
+		UNION ALL
+		SELECT categories.portfolio_id, '` + models.LinkSourceProject + `', projects.id, projects.title, btrim(link->>'url')
+		FROM projects
+		JOIN categories ON categories.id = projects.category_id AND categories.deleted_at IS NULL
+		CROSS JOIN jsonb_array_elements(projects.links) AS link
+		WHERE projects.deleted_at IS NULL
+		UNION ALL
+		SELECT sections.portfolio_id, '` + models.LinkSourceSectionContent + `', section_contents.id, sections.title,
+			btrim(section_contents.content)
+		FROM section_contents
+		JOIN sections ON sections.id = section_contents.section_id AND sections.deleted_at IS NULL
+		WHERE section_contents.deleted_at IS NULL AND section_contents.type = 'image'
+	) AS usages
+	WHERE url ~* '^https?://[^/]' AND length(url) <= ` + strconv.Itoa(maxLinkLength)
+
+type linkCheckRepository struct {
+	db *gorm.DB
+}
+
+func NewLinkCheckRepository(db *gorm.DB) LinkCheckRepository {
+	return &linkCheckRepository{
+		db: db,
+	}
+}
+
+// Track starts tracking the URLs stored since the last call, and forgets the
+// ones no longer stored anywhere along with their history
+func (r *linkCheckRepository) Track() error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			INSERT INTO link_checks (url, status, created_at)
+			SELECT DISTINCT url, ?, NOW() FROM (`+linkUsages+`) AS stored
+			ON CONFLICT (url) DO NOTHING
+		`, models.LinkPending).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`DELETE FROM link_checks WHERE url NOT IN (SELECT url FROM (` + linkUsages + `) AS stored)`).Error
+	})
+}
+
+// GetDue lists up to limit URLs not checked since before, the ones never
+// checked first
+func (r *linkCheckRepository) GetDue(before time.Time, limit int) ([]models.LinkCheck, error) {
+	var links []models.LinkCheck
+	err := r.db.Where("checked_at IS NULL OR checked_at < ?", before).
+		Order("checked_at ASC NULLS FIRST, id ASC").
+		Limit(limit).
+		Find(&links).Error
+	return links, err
+}
+
+// Record stores a check of the URL as its latest, keeping the last keep
+// checks as its history
+func (r *linkCheckRepository) Record(result *models.LinkCheckResult, keep int) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(result).Error; err != nil {
+			return err
+		}
+
+		ok := result.Status == models.LinkOK
+		if err := tx.Exec(`
+			UPDATE link_checks
+			SET status = ?, status_code = ?, error = ?, checked_at = ?,
+				failures = CASE WHEN ? THEN 0 ELSE failures + 1 END,
+				broken_since = CASE WHEN ? THEN NULL ELSE COALESCE(broken_since, ?) END
+			WHERE id = ?
+		`, result.Status, result.StatusCode, result.Error, result.CheckedAt, ok, ok, result.CheckedAt, result.LinkCheckID).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec(`
+			DELETE FROM link_check_results
+			WHERE link_check_id = ? AND id NOT IN (
+				SELECT id FROM link_check_results WHERE link_check_id = ? ORDER BY checked_at DESC, id DESC LIMIT ?
+			)
+		`, result.LinkCheckID, result.LinkCheckID, keep).Error
+	})
+}
+
+// GetBrokenByPortfolio lists the broken URLs stored in the portfolio, once
+// for each place storing them, with their history, latest check first
+func (r *linkCheckRepository) GetBrokenByPortfolio(portfolioID uint) ([]models.BrokenLink, error) {
+	var broken []models.BrokenLink
+	err := r.db.Table("link_checks").
+		Select("link_checks.*, usages.source_type, usages.source_id, usages.source_title").
+		Joins("JOIN ("+linkUsages+") AS usages ON usages.url = link_checks.url").
+		Where("usages.portfolio_id = ? AND link_checks.status = ?", portfolioID, models.LinkBroken).
+		Order("link_checks.broken_since ASC, link_checks.url ASC, usages.source_type ASC, usages.source_id ASC").
+		Scan(&broken).Error
+	if err != nil || len(broken) == 0 {
+		return broken, err
+	}
+
+	ids := make([]uint, len(broken))
+	for i := range broken {
+		ids[i] = broken[i].ID
+	}
+	var results []models.LinkCheckResult
+	if err := r.db.Where("link_check_id IN ?", ids).
+		Order("checked_at DESC, id DESC").
+		Find(&results).Error; err != nil {
+		return nil, err
+	}
+
+	history := make(map[uint][]models.LinkCheckResult)
+	for _, result := range results {
+		history[result.LinkCheckID] = append(history[result.LinkCheckID], result)
+	}
+	for i := range broken {
+		broken[i].History = history[broken[i].ID]
+	}
+	return broken, nil
+}
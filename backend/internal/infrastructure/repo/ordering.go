@@ -0,0 +1,335 @@
+package repo
+
+import (
+	"fmt"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/ordering"
+	"gorm.io/gorm"
+)
+
+// sequence describes one kind of ordered rows: the parent they are ordered
+// under and how their positions are read and written. Every change of
+// position goes through place, arrange or renumber, which lock the parent row
+// and write positions 1..n, so concurrent reorders of the same siblings run
+// one after the other and never leave gaps or duplicates.
+type sequence struct {
+	resource string
+	// parents is the table of the parent rows and parentResource their
+	// resource, which routes reorder events to the parent's portfolio
+	parents        string
+	parentResource string
+	// parentField and positionField name the columns in reorder events
+	parentField   string
+	positionField string
+	// siblings selects the id and position of the live children of a parent
+	siblings string
+	// model returns the row whose version is bumped when its position changes
+	model func() interface{}
+	// reparent moves a child to another parent before it is placed there; nil
+	// when write already sets the parent
+	reparent func(tx *gorm.DB, id, from, to uint) error
+	// write stores the parent and position of a child
+	write func(tx *gorm.DB, id, parentID, position uint) error
+}
+
+var categoryOrder = sequence{
+	resource:       "category",
+	parents:        "portfolios",
+	parentResource: "portfolio",
+	parentField:    "portfolio_id",
+	positionField:  "position",
+	siblings: `SELECT id, position FROM categories
+		WHERE portfolio_id = ? AND deleted_at IS NULL
+		ORDER BY position ASC, created_at ASC, id ASC`,
+	model: func() interface{} { return &models.Category{} },
+	write: func(tx *gorm.DB, id, portfolioID, position uint) error {
+		return tx.Model(&models.Category{}).Where("id = ?", id).
+			UpdateColumns(map[string]interface{}{"portfolio_id": portfolioID, "position": position}).Error
+	},
+}
+
+var sectionOrder = sequence{
+	resource:       "section",
+	parents:        "portfolios",
+	parentResource: "portfolio",
+	parentField:    "portfolio_id",
+	positionField:  "position",
+	siblings: `SELECT id, position FROM sections
+		WHERE portfolio_id = ? AND deleted_at IS NULL
+		ORDER BY position ASC, created_at ASC, id ASC`,
+	model: func() interface{} { return &models.Section{} },
+	write: func(tx *gorm.DB, id, portfolioID, position uint) error {
+		return tx.Model(&models.Section{}).Where("id = ?", id).
+			UpdateColumns(map[string]interface{}{"portfolio_id": portfolioID, "position": position}).Error
+	},
+}
+
+var contentOrder = sequence{
+	resource:       "section_content",
+	parents:        "sections",
+	parentResource: "section",
+	parentField:    "section_id",
+	positionField:  "order",
+	siblings: `SELECT id, "order" AS position FROM section_contents
+		WHERE section_id = ? AND deleted_at IS NULL
+		ORDER BY "order" ASC, created_at ASC, id ASC`,
+	model: func() interface{} { return &models.SectionContent{} },
+	write: func(tx *gorm.DB, id, sectionID, order uint) error {
+		return tx.Model(&models.SectionContent{}).Where("id = ?", id).
+			UpdateColumns(map[string]interface{}{"section_id": sectionID, "order": order}).Error
+	},
+}
+
+var testimonialOrder = sequence{
+	resource:       "testimonial",
+	parents:        "portfolios",
+	parentResource: "portfolio",
+	parentField:    "portfolio_id",
+	positionField:  "position",
+	siblings: `SELECT id, position FROM testimonials
+		WHERE portfolio_id = ? AND deleted_at IS NULL
+		ORDER BY position ASC, created_at ASC, id ASC`,
+	model: func() interface{} { return &models.Testimonial{} },
+	write: func(tx *gorm.DB, id, portfolioID, position uint) error {
+		return tx.Model(&models.Testimonial{}).Where("id = ?", id).
+			UpdateColumns(map[string]interface{}{"portfolio_id": portfolioID, "position": position}).Error
+	},
+}
+
+var experienceOrder = sequence{
+	resource:       "experience",
+	parents:        "portfolios",
+	parentResource: "portfolio",
+	parentField:    "portfolio_id",
+	positionField:  "position",
+	siblings: `SELECT id, position FROM experiences
+		WHERE portfolio_id = ? AND deleted_at IS NULL
+		ORDER BY position ASC, created_at ASC, id ASC`,
+	model: func() interface{} { return &models.Experience{} },
+	write: func(tx *gorm.DB, id, portfolioID, position uint) error {
+		return tx.Model(&models.Experience{}).Where("id = ?", id).
+			UpdateColumns(map[string]interface{}{"portfolio_id": portfolioID, "position": position}).Error
+	},
+}
+
+var educationOrder = sequence{
+	resource:       "education",
+	parents:        "portfolios",
+	parentResource: "portfolio",
+	parentField:    "portfolio_id",
+	positionField:  "position",
+	siblings: `SELECT id, position FROM education
+		WHERE portfolio_id = ? AND deleted_at IS NULL
+		ORDER BY position ASC, created_at ASC, id ASC`,
+	model: func() interface{} { return &models.Education{} },
+	write: func(tx *gorm.DB, id, portfolioID, position uint) error {
+		return tx.Model(&models.Education{}).Where("id = ?", id).
+			UpdateColumns(map[string]interface{}{"portfolio_id": portfolioID, "position": position}).Error
+	},
+}
+
+// projectOrder orders the projects linked to a category; projects.position
+// mirrors the position in the primary category
+var projectOrder = sequence{
+	resource:       "project",
+	parents:        "categories",
+	parentResource: "category",
+	parentField:    "category_id",
+	positionField:  "position",
+	siblings: `SELECT project_categories.project_id AS id, project_categories.position FROM project_categories
+		JOIN projects ON projects.id = project_categories.project_id AND projects.deleted_at IS NULL
+		WHERE project_categories.category_id = ?
+		ORDER BY project_categories.position ASC, project_categories.created_at ASC, project_categories.project_id ASC`,
+	model:    func() interface{} { return &models.Project{} },
+	reparent: relinkProject,
+	write:    setCategoryPosition,
+}
+
+// sibling is a row read by sequence.siblings
+type sibling struct {
+	ID       uint
+	Position uint
+}
+
+// slot is where a child is: its parent and its position there
+type slot struct {
+	Parent   uint
+	Position uint
+}
+
+// slotOf reads the parent and position of a child; the zero slot when it
+// doesn't exist. For a project it is its primary category.
+func slotOf(tx *gorm.DB, seq sequence, id uint) (slot, error) {
+	var current slot
+	err := tx.Model(seq.model()).
+		Select(fmt.Sprintf("%s AS parent, %q AS position", seq.parentField, seq.positionField)).
+		Where("id = ?", id).
+		Scan(&current).Error
+	return current, err
+}
+
+// lockSlot reads where a child is and locks its parent. Writers take the
+// parent lock before touching any child row, so they can't deadlock on the
+// siblings.
+func lockSlot(tx *gorm.DB, seq sequence, id uint) (slot, error) {
+	current, err := slotOf(tx, seq, id)
+	if err != nil || current.Parent == 0 {
+		return current, err
+	}
+	return current, lockParents(tx, seq, current.Parent)
+}
+
+// reposition checks and bumps the version of a child, as updateColumnsVersioned
+// does, then places it within its parent, or under the parent to when it is
+// non-zero. It returns the new position.
+func reposition(tx *gorm.DB, seq sequence, id, version, to uint, at ordering.Placement) (uint, error) {
+	current, err := slotOf(tx, seq, id)
+	if err != nil {
+		return 0, err
+	}
+	if current.Parent == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+	if to == 0 {
+		to = current.Parent
+	}
+	if err := lockParents(tx, seq, current.Parent, to); err != nil {
+		return 0, err
+	}
+	if err := updateColumnsVersioned(tx, seq.model(), id, version, map[string]interface{}{}); err != nil {
+		return 0, err
+	}
+	return place(tx, seq, id, current.Parent, to, at)
+}
+
+// settle places a child after a full write that may have changed its parent or
+// position, before being where it was. A position written as is only holds
+// once the siblings are renumbered around it. It returns the new position.
+func settle(tx *gorm.DB, seq sequence, id uint, before slot) (uint, error) {
+	after, err := slotOf(tx, seq, id)
+	if err != nil {
+		return 0, err
+	}
+	if after == before {
+		return after.Position, nil
+	}
+	return place(tx, seq, id, before.Parent, after.Parent, ordering.Placement{Position: after.Position})
+}
+
+// place moves the child id to the parent to, coming from the parent from (the
+// same one for moves within a parent), and renumbers both. It returns the new
+// position. The caller checks and bumps the version of the moved row; the
+// siblings whose position changes are bumped here.
+func place(tx *gorm.DB, seq sequence, id, from, to uint, at ordering.Placement) (uint, error) {
+	if err := lockParents(tx, seq, from, to); err != nil {
+		return 0, err
+	}
+	if from != to && seq.reparent != nil {
+		if err := seq.reparent(tx, id, from, to); err != nil {
+			return 0, err
+		}
+	}
+
+	order, current, err := siblingsOf(tx, seq, to)
+	if err != nil {
+		return 0, err
+	}
+	order, err = ordering.Move(order, id, at)
+	if err != nil {
+		return 0, err
+	}
+	if err := apply(tx, seq, to, order, current, id); err != nil {
+		return 0, err
+	}
+
+	if from != to {
+		if err := renumber(tx, seq, from); err != nil {
+			return 0, err
+		}
+	}
+	return ordering.Position(order, id), nil
+}
+
+// arrange puts several children of a parent at the given positions at once
+func arrange(tx *gorm.DB, seq sequence, parentID uint, items []ordering.Item) error {
+	if err := lockParents(tx, seq, parentID); err != nil {
+		return err
+	}
+	order, current, err := siblingsOf(tx, seq, parentID)
+	if err != nil {
+		return err
+	}
+	order, err = ordering.Arrange(order, items)
+	if err != nil {
+		return err
+	}
+	return apply(tx, seq, parentID, order, current, 0)
+}
+
+// renumber closes the gaps left in a parent's children, after a delete or a
+// move out of it
+func renumber(tx *gorm.DB, seq sequence, parentID uint) error {
+	if err := lockParents(tx, seq, parentID); err != nil {
+		return err
+	}
+	order, current, err := siblingsOf(tx, seq, parentID)
+	if err != nil {
+		return err
+	}
+	return apply(tx, seq, parentID, order, current, 0)
+}
+
+// lockParents locks the parent rows in id order, so two moves between the same
+// parents can't deadlock
+func lockParents(tx *gorm.DB, seq sequence, ids ...uint) error {
+	var locked []uint
+	return tx.Raw("SELECT id FROM "+seq.parents+" WHERE id IN ? ORDER BY id FOR UPDATE", ids).
+		Scan(&locked).Error
+}
+
+// siblingsOf returns the children of a parent in order with their stored positions
+func siblingsOf(tx *gorm.DB, seq sequence, parentID uint) ([]uint, map[uint]uint, error) {
+	var rows []sibling
+	if err := tx.Raw(seq.siblings, parentID).Scan(&rows).Error; err != nil {
+		return nil, nil, err
+	}
+	order := make([]uint, len(rows))
+	current := make(map[uint]uint, len(rows))
+	for i, row := range rows {
+		order[i] = row.ID
+		current[row.ID] = row.Position
+	}
+	return order, current, nil
+}
+
+// apply writes the order as positions 1..n. Only rows whose position changes
+// are written, bumped and announced, plus moved, which is always written.
+func apply(tx *gorm.DB, seq sequence, parentID uint, order []uint, current map[uint]uint, moved uint) error {
+	var bumped []uint
+	for i, id := range order {
+		position := uint(i + 1)
+		if id != moved && current[id] == position {
+			continue
+		}
+		if err := seq.write(tx, id, parentID, position); err != nil {
+			return err
+		}
+		if id != moved {
+			bumped = append(bumped, id)
+		}
+		if err := recordReorder(tx, seq, parentID, map[string]interface{}{
+			"id":              id,
+			seq.parentField:   parentID,
+			seq.positionField: position,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(bumped) == 0 {
+		return nil
+	}
+	return tx.Model(seq.model()).Where("id IN ?", bumped).
+		UpdateColumns(map[string]interface{}{"version": gorm.Expr("version + 1"), "updated_at": gorm.Expr("NOW()")}).Error
+}
@@ -0,0 +1,182 @@
+package repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"gorm.io/gorm"
+)
+
+// portfolioLookups finds the portfolio a row belongs to. They ignore
+// deleted_at so deletes can still be attributed after the soft delete.
+var portfolioLookups = map[string]string{
+	"portfolio":       "SELECT id FROM portfolios WHERE id = ?",
+	"category":        "SELECT portfolio_id FROM categories WHERE id = ?",
+	"section":         "SELECT portfolio_id FROM sections WHERE id = ?",
+	"project":         "SELECT c.portfolio_id FROM projects p JOIN categories c ON c.id = p.category_id WHERE p.id = ?",
+	"section_content": "SELECT s.portfolio_id FROM section_contents sc JOIN sections s ON s.id = sc.section_id WHERE sc.id = ?",
+	"testimonial":     "SELECT portfolio_id FROM testimonials WHERE id = ?",
+	"experience":      "SELECT portfolio_id FROM experiences WHERE id = ?",
+	"education":       "SELECT portfolio_id FROM education WHERE id = ?",
+}
+
+// webhookPayload is the JSON body delivered to webhook endpoints
+type webhookPayload struct {
+	Event       string      `json:"event"`
+	OccurredAt  time.Time   `json:"occurred_at"`
+	PortfolioID uint        `json:"portfolio_id"`
+	Data        interface{} `json:"data"`
+}
+
+// PortfolioEventChannel is the PostgreSQL NOTIFY channel announcing new
+// portfolio events; the payload is "<portfolio id>:<event id>"
+const PortfolioEventChannel = "portfolio_events"
+
+// portfolioEventLock is the first key of the advisory lock serializing event
+// IDs per portfolio; the portfolio ID is the second
+const portfolioEventLock = 1
+
+// portfolioFanout overrides portfolioLookups for rows that show up in several
+// portfolios; their changes are published to each of them. Links are read
+// regardless of deleted_at, like the lookups.
+var portfolioFanout = map[string]string{
+	"project": `SELECT DISTINCT c.portfolio_id FROM project_categories pc
+		JOIN categories c ON c.id = pc.category_id
+		WHERE pc.project_id = ?`,
+}
+
+// recordChange publishes "<resource>.<action>" on the change stream of every
+// portfolio the row belongs to and queues a delivery for every active webhook
+// of those portfolios that subscribes to it. It must run on the same
+// transaction as the write so the event exists exactly when the change does.
+func recordChange(tx *gorm.DB, resource, action string, id uint, data interface{}) error {
+	portfolioIDs, err := portfoliosOf(tx, resource, id)
+	if err != nil {
+		return err
+	}
+	return record(tx, portfolioIDs, resource, action, action, data)
+}
+
+// recordChangeFrom is recordChange for writes that may take the row out of
+// some portfolios: those in previous, read before the write, are told too
+func recordChangeFrom(tx *gorm.DB, previous []uint, resource, action string, id uint, data interface{}) error {
+	portfolioIDs, err := portfoliosOf(tx, resource, id)
+	if err != nil {
+		return err
+	}
+	return record(tx, append(portfolioIDs, previous...), resource, action, action, data)
+}
+
+// recordReorder is recordChange for position changes under parentID: the
+// change stream sees "<resource>.reordered" while webhooks keep receiving
+// "<resource>.updated". It goes to the portfolio of the parent, the one whose
+// ordering changed.
+func recordReorder(tx *gorm.DB, seq sequence, parentID uint, data interface{}) error {
+	portfolioIDs, err := portfoliosOf(tx, seq.parentResource, parentID)
+	if err != nil {
+		return err
+	}
+	return record(tx, portfolioIDs, seq.resource, "reordered", "updated", data)
+}
+
+// portfoliosOf returns the portfolios a row of resource belongs to
+func portfoliosOf(tx *gorm.DB, resource string, id uint) ([]uint, error) {
+	lookup, ok := portfolioFanout[resource]
+	if !ok {
+		lookup = portfolioLookups[resource]
+	}
+	var portfolioIDs []uint
+	err := tx.Raw(lookup, id).Scan(&portfolioIDs).Error
+	return portfolioIDs, err
+}
+
+// record publishes to each portfolio once, in ascending ID order so
+// transactions touching several portfolios take their event locks in the same
+// order
+func record(tx *gorm.DB, portfolioIDs []uint, resource, streamAction, webhookAction string, data interface{}) error {
+	sort.Slice(portfolioIDs, func(i, j int) bool { return portfolioIDs[i] < portfolioIDs[j] })
+	for i, portfolioID := range portfolioIDs {
+		if portfolioID == 0 || (i > 0 && portfolioID == portfolioIDs[i-1]) {
+			continue
+		}
+		if err := publishEvent(tx, portfolioID, resource+"."+streamAction, data); err != nil {
+			return err
+		}
+		if err := queueWebhookDeliveries(tx, portfolioID, resource+"."+webhookAction, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// publishEvent stores the event and notifies listeners. PostgreSQL only
+// delivers the notification once the transaction commits.
+//
+// Stream clients resume from the highest event ID they have seen, so a
+// portfolio's events must commit in ID order: one committing after a higher ID
+// was sent would never reach them. The advisory lock, held until commit, makes
+// transactions writing to the same portfolio take their IDs one at a time.
+func publishEvent(tx *gorm.DB, portfolioID uint, event string, data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?::int, ?::int)", portfolioEventLock, portfolioID).Error; err != nil {
+		return err
+	}
+
+	entry := models.PortfolioEvent{
+		PortfolioID: portfolioID,
+		Event:       event,
+		Data:        encoded,
+	}
+	if err := tx.Create(&entry).Error; err != nil {
+		return err
+	}
+	return tx.Exec("SELECT pg_notify(?, ?)", PortfolioEventChannel, fmt.Sprintf("%d:%d", portfolioID, entry.ID)).Error
+}
+
+// queueWebhookDeliveries queues event for every active webhook of the
+// portfolio that subscribes to it
+func queueWebhookDeliveries(tx *gorm.DB, portfolioID uint, event string, data interface{}) error {
+	var webhooks []models.Webhook
+	if err := tx.Where("portfolio_id = ? AND active = ?", portfolioID, true).Find(&webhooks).Error; err != nil {
+		return err
+	}
+
+	var deliveries []models.WebhookDelivery
+	var payload []byte
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(event) {
+			continue
+		}
+		if payload == nil {
+			encoded, err := json.Marshal(webhookPayload{
+				Event:       event,
+				OccurredAt:  time.Now().UTC(),
+				PortfolioID: portfolioID,
+				Data:        data,
+			})
+			if err != nil {
+				return err
+			}
+			payload = encoded
+		}
+		deliveries = append(deliveries, models.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			Event:         event,
+			Payload:       payload,
+			Status:        models.WebhookDeliveryPending,
+			NextAttemptAt: time.Now(),
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return tx.Create(&deliveries).Error
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
 	"gorm.io/gorm"
 )
 
@@ -16,7 +17,26 @@ func NewPortfolioRepository(db *gorm.DB) PortfolioRepository {
 }
 
 func (r *portfolioRepository) Create(portfolio *models.Portfolio) error {
-	return r.db.Create(portfolio).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(portfolio).Error; err != nil {
+			return err
+		}
+		return recordChange(tx, "portfolio", "created", portfolio.ID, portfolio)
+	})
+}
+
+// CreateFromTemplate creates the portfolio with the sections, content blocks,
+// categories and placeholder projects of bundle, all or nothing
+func (r *portfolioRepository) CreateFromTemplate(portfolio *models.Portfolio, bundle models.TemplateBundle) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(portfolio).Error; err != nil {
+			return err
+		}
+		if err := instantiateTemplate(tx, portfolio, bundle); err != nil {
+			return err
+		}
+		return recordChange(tx, "portfolio", "created", portfolio.ID, portfolio)
+	})
 }
 
 // For list views - only basic portfolio info
@@ -32,7 +52,7 @@ func (r *portfolioRepository) GetByOwnerIDBasic(ownerID string, limit, offset in
 	}
 
 	// Get paginated results
-	err := r.db.Select("id, title, description, owner_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, owner_id, version, default_locale, locales, created_at, updated_at").
 		Where("owner_id = ?", ownerID).
 		Limit(limit).Offset(offset).
 		Find(&portfolios).Error
@@ -51,6 +71,18 @@ func (r *portfolioRepository) GetByIDWithRelations(id uint) (*models.Portfolio,
 	return &portfolio, err
 }
 
+// GetByIDSelected For detail views - only the columns and relations picked by sel
+func (r *portfolioRepository) GetByIDSelected(id uint, sel query.Selection) (*models.Portfolio, error) {
+	var portfolio models.Portfolio
+	err := preloadIncluded(r.db.Select(selectedColumns(sel, portfolioColumns)), sel, portfolioRelations).
+		Where("id = ?", id).
+		First(&portfolio).Error
+	if err == nil && sel.Includes("experiences") {
+		err = withExperienceProjects(r.db, portfolio.Experiences)
+	}
+	return &portfolio, err
+}
+
 func (r *portfolioRepository) GetByID(id uint) (*models.Portfolio, error) {
 	var portfolio models.Portfolio
 	err := r.db.First(&portfolio, id).Error
@@ -62,20 +94,71 @@ func (r *portfolioRepository) GetByID(id uint) (*models.Portfolio, error) {
 
 func (r *portfolioRepository) GetByIDBasic(id uint) (*models.Portfolio, error) {
 	var portfolio models.Portfolio
-	err := r.db.Select("id, owner_id").First(&portfolio, id).Error
+	err := r.db.Select("id, owner_id, version, default_locale, locales").First(&portfolio, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &portfolio, nil
 }
 
+// Update writes the portfolio if portfolio.Version still matches the stored row,
+// returning ErrVersionConflict otherwise
 func (r *portfolioRepository) Update(portfolio *models.Portfolio) error {
-	return r.db.Model(portfolio).Where("id = ?", portfolio.ID).Updates(portfolio).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateVersioned(tx, portfolio, portfolio.ID, &portfolio.Version); err != nil {
+			return err
+		}
+		return recordChange(tx, "portfolio", "updated", portfolio.ID, portfolio)
+	})
+}
+
+// Patch writes every editable field, empty values included, if portfolio.Version
+// still matches the stored row, returning ErrVersionConflict otherwise
+func (r *portfolioRepository) Patch(portfolio *models.Portfolio) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateVersioned(tx, portfolio, portfolio.ID, &portfolio.Version, "title", "description"); err != nil {
+			return err
+		}
+		return recordChange(tx, "portfolio", "updated", portfolio.ID, portfolio)
+	})
+}
+
+// UpdateLocales sets the default and enabled locales of the portfolio if version
+// still matches the stored row, returning ErrVersionConflict otherwise
+func (r *portfolioRepository) UpdateLocales(id uint, defaultLocale string, locales []string, version uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateColumnsVersioned(tx, &models.Portfolio{}, id, version, map[string]interface{}{
+			"default_locale": defaultLocale,
+			"locales":        models.StringArray(locales),
+		}); err != nil {
+			return err
+		}
+		return recordChange(tx, "portfolio", "updated", id, map[string]interface{}{
+			"id":             id,
+			"default_locale": defaultLocale,
+			"locales":        locales,
+		})
+	})
 }
 
-func (r *portfolioRepository) Delete(id uint) error {
+// Delete soft deletes the portfolio and everything inside it. A non-zero
+// version must match the stored portfolio or nothing is deleted.
+func (r *portfolioRepository) Delete(id uint, version uint) error {
 	// Use a transaction to ensure all cascading deletes succeed or none do
 	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Lock the portfolio row first so the version can't change underneath us
+		if version != 0 {
+			result := tx.Model(&models.Portfolio{}).
+				Where("id = ? AND version = ?", id, version).
+				UpdateColumn("version", gorm.Expr("version + 1"))
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return ErrVersionConflict
+			}
+		}
+
 		// First, get all categories for this portfolio
 		var categoryIDs []uint
 		if err := tx.Model(&models.Category{}).
@@ -84,8 +167,12 @@ func (r *portfolioRepository) Delete(id uint) error {
 			return err
 		}
 
-		// Soft delete all projects in those categories
+		// Soft delete all projects in those categories, except the ones that
+		// are also in categories of other portfolios
 		if len(categoryIDs) > 0 {
+			if err := unlinkCategories(tx, categoryIDs); err != nil {
+				return err
+			}
 			if err := tx.Where("category_id IN ?", categoryIDs).
 				Delete(&models.Project{}).Error; err != nil {
 				return err
@@ -120,18 +207,51 @@ func (r *portfolioRepository) Delete(id uint) error {
 			return err
 		}
 
+		// Translations aren't soft deleted, they go with the portfolio
+		if err := tx.Where("portfolio_id = ?", id).
+			Delete(&models.Translation{}).Error; err != nil {
+			return err
+		}
+
+		// Views and their rollups go with the portfolio too
+		if err := deletePortfolioViews(tx, id); err != nil {
+			return err
+		}
+
+		// And so do contact messages and settings
+		if err := deletePortfolioContact(tx, id); err != nil {
+			return err
+		}
+
+		// Testimonials and their invites as well
+		if err := deletePortfolioTestimonials(tx, id); err != nil {
+			return err
+		}
+
+		// And the work history and education
+		if err := deletePortfolioExperiences(tx, id); err != nil {
+			return err
+		}
+		if err := deletePortfolioEducation(tx, id); err != nil {
+			return err
+		}
+
 		// Finally, soft delete the portfolio itself
 		if err := tx.Delete(&models.Portfolio{}, id).Error; err != nil {
 			return err
 		}
 
-		return nil
+		// Announce the deletion, then retire the portfolio's webhooks
+		if err := recordChange(tx, "portfolio", "deleted", id, map[string]interface{}{"id": id}); err != nil {
+			return err
+		}
+		return tx.Where("portfolio_id = ?", id).Delete(&models.Webhook{}).Error
 	})
 }
 
 func (r *portfolioRepository) List(limit, offset int) ([]models.Portfolio, error) {
 	var portfolios []models.Portfolio
-	err := r.db.Select("id, title, description, owner_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, owner_id, version, default_locale, locales, created_at, updated_at").
 		Preload("Sections").
 		Preload("Categories").
 		Limit(limit).Offset(offset).
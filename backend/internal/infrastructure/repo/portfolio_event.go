@@ -0,0 +1,40 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"gorm.io/gorm"
+)
+
+type portfolioEventRepository struct {
+	db *gorm.DB
+}
+
+func NewPortfolioEventRepository(db *gorm.DB) PortfolioEventRepository {
+	return &portfolioEventRepository{
+		db: db,
+	}
+}
+
+func (r *portfolioEventRepository) GetByID(id uint) (*models.PortfolioEvent, error) {
+	var event models.PortfolioEvent
+	err := r.db.Where("id = ?", id).First(&event).Error
+	return &event, err
+}
+
+// GetSince returns up to limit events of the portfolio with an ID above afterID, oldest first
+func (r *portfolioEventRepository) GetSince(portfolioID uint, afterID uint, limit int) ([]models.PortfolioEvent, error) {
+	var events []models.PortfolioEvent
+	err := r.db.Where("portfolio_id = ? AND id > ?", portfolioID, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+// DeleteBefore removes events older than the cutoff, returning how many were removed
+func (r *portfolioEventRepository) DeleteBefore(cutoff time.Time) (int64, error) {
+	result := r.db.Where("created_at < ?", cutoff).Delete(&models.PortfolioEvent{})
+	return result.RowsAffected, result.Error
+}
@@ -2,6 +2,8 @@ package repo
 
 import (
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/ordering"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
 	"gorm.io/gorm"
 )
 
@@ -16,17 +18,47 @@ func NewProjectRepository(db *gorm.DB) ProjectRepository {
 }
 
 func (r *projectRepository) Create(project *models.Project) error {
-	if err := r.db.Create(project).Error; err != nil {
-		return err
-	}
-	// Reload the record to pick up any database-side defaults or trigger modifications
-	return r.db.Where("id = ?", project.ID).First(project).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// The trigger appends the project while the category is locked
+		if err := lockParents(tx, projectOrder, project.CategoryID); err != nil {
+			return err
+		}
+		if err := tx.Create(project).Error; err != nil {
+			return err
+		}
+		// Reload the record to pick up any database-side defaults or trigger modifications
+		if err := tx.Where("id = ?", project.ID).First(project).Error; err != nil {
+			return err
+		}
+		skills, err := LinkProjectSkills(tx, project.ID)
+		if err != nil {
+			return err
+		}
+		project.Skills = skills
+		if project.CategoryIDs, err = categoryIDsOf(tx, project.ID); err != nil {
+			return err
+		}
+		return recordChange(tx, "project", "created", project.ID, project)
+	})
 }
 
-// GetByID For basic project info
+// GetByID For basic project info, with the categories it is in
 func (r *projectRepository) GetByID(id uint) (*models.Project, error) {
 	var project models.Project
-	err := r.db.Select("id, title, description, skills, client, link, position, owner_id, category_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, skills, client, link, links, start_date, end_date, ongoing, role, team_size, status, featured, position, owner_id, category_id, version, created_at, updated_at").
+		Where("id = ?", id).
+		First(&project).Error
+	if err != nil {
+		return &project, err
+	}
+	project.CategoryIDs, err = categoryIDsOf(r.db, id)
+	return &project, err
+}
+
+// GetByIDSelected For detail views - only the columns picked by sel
+func (r *projectRepository) GetByIDSelected(id uint, sel query.Selection) (*models.Project, error) {
+	var project models.Project
+	err := r.db.Select(selectedColumns(sel, projectColumns)).
 		Where("id = ?", id).
 		First(&project).Error
 	return &project, err
@@ -45,7 +77,7 @@ func (r *projectRepository) GetByOwnerIDBasic(ownerID string, limit, offset int)
 	}
 
 	// Get paginated results
-	err := r.db.Select("id, title, description, skills, client, link, position, owner_id, category_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, skills, client, link, links, start_date, end_date, ongoing, role, team_size, status, featured, position, owner_id, category_id, version, created_at, updated_at").
 		Where("owner_id = ?", ownerID).
 		Order("position ASC, created_at ASC").
 		Limit(limit).Offset(offset).
@@ -54,42 +86,186 @@ func (r *projectRepository) GetByOwnerIDBasic(ownerID string, limit, offset int)
 	return projects, total, err
 }
 
-// GetByCategoryID For list views - projects in a category
+// GetByCategoryID For list views - projects in a category, primary or not, at
+// their position in it
 func (r *projectRepository) GetByCategoryID(categoryID string) ([]models.Project, error) {
 	var projects []models.Project
-	err := r.db.Select("id, title, description, skills, client, link, position, owner_id, category_id, created_at, updated_at").
+	err := r.db.Table(linkedProjects).
+		Select("id, title, description, skills, client, link, links, start_date, end_date, ongoing, role, team_size, status, featured, position, owner_id, category_id, version, created_at, updated_at").
 		Where("category_id = ?", categoryID).
 		Order("position ASC, created_at ASC").
 		Find(&projects).Error
 	return projects, err
 }
 
+// ListByCategoryID For list views - projects in a category, filtered, sorted and
+// paginated by spec. Returns the cursor of the next page, or "" on the last one.
+func (r *projectRepository) ListByCategoryID(categoryID string, spec query.Spec) ([]models.Project, string, error) {
+	var projects []models.Project
+	err := applySpec(r.db.Table(linkedProjects).Select(selectedColumns(spec.Selection, projectColumns, projectSortColumns(spec)...)).
+		Where("category_id = ?", categoryID), spec).
+		Find(&projects).Error
+	if err != nil {
+		return nil, "", err
+	}
+	projects, next := listPage(projects, spec, func(p models.Project) (interface{}, uint) {
+		return projectSortValue(spec, p), p.ID
+	})
+	return projects, next, nil
+}
+
+// ListTimeline For timeline views - the projects of every category of a
+// portfolio, each once, filtered and paginated by spec and ordered by start
+// date. Returns the cursor of the next page, or "" on the last one.
+func (r *projectRepository) ListTimeline(portfolioID uint, spec query.Spec) ([]models.Project, string, error) {
+	var projects []models.Project
+	err := applySpec(r.db.Select(selectedColumns(spec.Selection, projectColumns, projectSortColumns(spec)...)).
+		Where("id IN (?)", portfolioProjectIDs(r.db, portfolioID)), spec).
+		Find(&projects).Error
+	if err != nil {
+		return nil, "", err
+	}
+	projects, next := listPage(projects, spec, func(p models.Project) (interface{}, uint) {
+		return projectSortValue(spec, p), p.ID
+	})
+
+	ids := make([]uint, len(projects))
+	for i := range projects {
+		ids[i] = projects[i].ID
+	}
+	categoryIDs, err := categoryIDsOfAll(r.db, ids)
+	if err != nil {
+		return nil, "", err
+	}
+	for i := range projects {
+		projects[i].CategoryIDs = categoryIDs[projects[i].ID]
+	}
+	return projects, next, nil
+}
+
+// Update writes the project if project.Version still matches the stored row,
+// returning ErrVersionConflict otherwise
 func (r *projectRepository) Update(project *models.Project) error {
-	return r.db.Model(project).Where("id = ?", project.ID).Updates(project).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		before, err := lockSlot(tx, projectOrder, project.ID)
+		if err != nil {
+			return err
+		}
+		previous, err := portfoliosOf(tx, "project", project.ID)
+		if err != nil {
+			return err
+		}
+		if err := updateVersioned(tx, project, project.ID, &project.Version); err != nil {
+			return err
+		}
+		if err := settleProject(tx, project, before); err != nil {
+			return err
+		}
+		skills, err := LinkProjectSkills(tx, project.ID)
+		if err != nil {
+			return err
+		}
+		if project.Skills != nil {
+			project.Skills = skills
+		}
+		if project.CategoryIDs, err = categoryIDsOf(tx, project.ID); err != nil {
+			return err
+		}
+		return recordChangeFrom(tx, previous, "project", "updated", project.ID, project)
+	})
 }
 
-// UpdatePosition updates only the position field of a project
-func (r *projectRepository) UpdatePosition(id uint, position uint) error {
-	return r.db.Model(&models.Project{}).Where("id = ?", id).Update("position", position).Error
+// Patch writes every editable field, empty values included, if project.Version
+// still matches the stored row, returning ErrVersionConflict otherwise
+func (r *projectRepository) Patch(project *models.Project) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		before, err := lockSlot(tx, projectOrder, project.ID)
+		if err != nil {
+			return err
+		}
+		previous, err := portfoliosOf(tx, "project", project.ID)
+		if err != nil {
+			return err
+		}
+		if err := updateVersioned(tx, project, project.ID, &project.Version, "title", "description", "skills", "client", "link",
+			"links", "start_date", "end_date", "ongoing", "role", "team_size", "status", "featured", "category_id"); err != nil {
+			return err
+		}
+		if err := settleProject(tx, project, before); err != nil {
+			return err
+		}
+		skills, err := LinkProjectSkills(tx, project.ID)
+		if err != nil {
+			return err
+		}
+		project.Skills = skills
+		if project.CategoryIDs, err = categoryIDsOf(tx, project.ID); err != nil {
+			return err
+		}
+		return recordChangeFrom(tx, previous, "project", "updated", project.ID, project)
+	})
 }
 
-func (r *projectRepository) Delete(id uint) error {
-	return r.db.Delete(&models.Project{}, id).Error
+func (r *projectRepository) Delete(id uint, version uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		categoryIDs, err := categoryIDsOf(tx, id)
+		if err != nil {
+			return err
+		}
+		if err := lockParents(tx, projectOrder, categoryIDs...); err != nil {
+			return err
+		}
+		if err := deleteVersioned(tx, &models.Project{}, id, version); err != nil {
+			return err
+		}
+		for _, categoryID := range categoryIDs {
+			if err := renumber(tx, projectOrder, categoryID); err != nil {
+				return err
+			}
+		}
+		return recordChange(tx, "project", "deleted", id, map[string]interface{}{"id": id})
+	})
+}
+
+// settleProject renumbers around a project after a full write that may have
+// changed its primary category or its position there. A new category_id was
+// already appended to by the trigger, so only the old one needs closing up.
+func settleProject(tx *gorm.DB, project *models.Project, before slot) error {
+	after, err := slotOf(tx, projectOrder, project.ID)
+	if err != nil {
+		return err
+	}
+	switch {
+	case after.Parent != before.Parent:
+		err = renumber(tx, projectOrder, before.Parent)
+	case after.Position != before.Position:
+		after.Position, err = place(tx, projectOrder, project.ID, after.Parent, after.Parent, ordering.Placement{Position: after.Position})
+	}
+	project.Position = after.Position
+	return err
 }
 
 func (r *projectRepository) List(limit, offset int) ([]models.Project, error) {
 	var projects []models.Project
-	err := r.db.Select("id, title, description, skills, client, link, position, owner_id, category_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, skills, client, link, links, start_date, end_date, ongoing, role, team_size, status, featured, position, owner_id, category_id, version, created_at, updated_at").
 		Limit(limit).Offset(offset).
 		Find(&projects).Error
 	return projects, err
 }
 
-// GetBySkills Find projects by skills
+// GetBySkills Find projects using any of the skills, matched by name or alias
 func (r *projectRepository) GetBySkills(skills []string) ([]models.Project, error) {
 	var projects []models.Project
-	err := r.db.Select("id, title, description, skills, client, link, position, owner_id, category_id, created_at, updated_at").
-		Where("skills && ?", skills).
+	matches := r.db
+	for i, skill := range skills {
+		if i == 0 {
+			matches = matches.Where(skillCondition(skill))
+		} else {
+			matches = matches.Or(skillCondition(skill))
+		}
+	}
+	err := r.db.Select("id, title, description, skills, client, link, links, start_date, end_date, ongoing, role, team_size, status, featured, position, owner_id, category_id, version, created_at, updated_at").
+		Where(matches).
 		Find(&projects).Error
 	return projects, err
 }
@@ -97,17 +273,20 @@ func (r *projectRepository) GetBySkills(skills []string) ([]models.Project, erro
 // GetByClient Find projects by client name
 func (r *projectRepository) GetByClient(client string) ([]models.Project, error) {
 	var projects []models.Project
-	err := r.db.Select("id, title, description, skills, client, link, position, owner_id, category_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, skills, client, link, links, start_date, end_date, ongoing, role, team_size, status, featured, position, owner_id, category_id, version, created_at, updated_at").
 		Where("client = ?", client).
 		Find(&projects).Error
 	return projects, err
 }
 
-// CheckDuplicate checks if a project with the same title exists for the same category
-// excluding the project with the given id (useful for updates)
+// CheckDuplicate checks if a project with the same title is in the category, or
+// in any other category the project with the given id is in, excluding that
+// project (useful for updates)
 func (r *projectRepository) CheckDuplicate(title string, categoryID uint, id uint) (bool, error) {
 	var count int64
-	query := r.db.Model(&models.Project{}).Where("title = ? AND category_id = ?", title, categoryID)
+	categories := r.db.Model(&models.ProjectCategory{}).Select("category_id").Where("project_id = ?", id)
+	query := r.db.Model(&models.Project{}).Where("title = ? AND id IN (?)", title,
+		r.db.Model(&models.ProjectCategory{}).Select("project_id").Where("category_id = ? OR category_id IN (?)", categoryID, categories))
 
 	// Exclude the current project when checking for duplicates (for updates)
 	if id != 0 {
@@ -120,3 +299,23 @@ func (r *projectRepository) CheckDuplicate(title string, categoryID uint, id uin
 	}
 	return count > 0, nil
 }
+
+// projectSortColumns returns the columns the sort value of a project is read from
+func projectSortColumns(spec query.Spec) []string {
+	if spec.Sort == query.SortStartDate {
+		return []string{"start_date", "created_at"}
+	}
+	return []string{sortColumn(spec)}
+}
+
+// projectSortValue picks the value of the sort column out of a project; the
+// timeline places projects without a start date by when they were created
+func projectSortValue(spec query.Spec, project models.Project) interface{} {
+	if spec.Sort == query.SortStartDate {
+		if project.StartDate != nil {
+			return *project.StartDate
+		}
+		return project.CreatedAt
+	}
+	return sortValue(spec, project.Title, project.Position, project.CreatedAt, project.UpdatedAt)
+}
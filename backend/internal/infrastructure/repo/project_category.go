@@ -0,0 +1,282 @@
+package repo
+
+import (
+	"errors"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/ordering"
+	"gorm.io/gorm"
+)
+
+// ErrLastCategory is returned when removing a project from the only category
+// it is in
+var ErrLastCategory = errors.New("project must stay in at least one category")
+
+// ErrAlreadyInCategory is returned when moving a project to a category it is
+// already in
+var ErrAlreadyInCategory = errors.New("project is already in the category")
+
+// GetCategoryIDs returns the categories the project is in, the primary one first
+func (r *projectRepository) GetCategoryIDs(id uint) ([]uint, error) {
+	return categoryIDsOf(r.db, id)
+}
+
+// AddCategory puts the project in one more category, after the projects already
+// in it, if project.Version still matches the stored row. Adding it to a
+// category it is in changes nothing but the version.
+func (r *projectRepository) AddCategory(project *models.Project, categoryID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateColumnsVersioned(tx, &models.Project{}, project.ID, project.Version, map[string]interface{}{}); err != nil {
+			return err
+		}
+		project.Version++
+
+		if err := lockParents(tx, projectOrder, categoryID); err != nil {
+			return err
+		}
+		if err := tx.Exec(`
+			INSERT INTO project_categories (project_id, category_id, position, created_at)
+			SELECT ?, ?, COALESCE(MAX(project_categories.position) + 1, 1), NOW()
+			FROM project_categories
+			JOIN projects ON projects.id = project_categories.project_id
+			WHERE project_categories.category_id = ?
+			AND projects.deleted_at IS NULL
+			ON CONFLICT (project_id, category_id) DO NOTHING
+		`, project.ID, categoryID, categoryID).Error; err != nil {
+			return err
+		}
+
+		categoryIDs, err := categoryIDsOf(tx, project.ID)
+		if err != nil {
+			return err
+		}
+		project.CategoryIDs = categoryIDs
+		return recordChange(tx, "project", "updated", project.ID, project)
+	})
+}
+
+// RemoveCategory takes the project out of a category if project.Version still
+// matches the stored row. Removing the primary category makes the category the
+// project was added to next the primary one; ErrLastCategory is returned when
+// there is none.
+func (r *projectRepository) RemoveCategory(project *models.Project, categoryID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockParents(tx, projectOrder, categoryID); err != nil {
+			return err
+		}
+		previous, err := portfoliosOf(tx, "project", project.ID)
+		if err != nil {
+			return err
+		}
+		columns := map[string]interface{}{}
+		if categoryID == project.CategoryID {
+			var next []uint
+			if err := tx.Model(&models.ProjectCategory{}).
+				Where("project_id = ? AND category_id <> ?", project.ID, categoryID).
+				Order("created_at ASC, category_id ASC").
+				Limit(1).
+				Pluck("category_id", &next).Error; err != nil {
+				return err
+			}
+			if len(next) == 0 {
+				return ErrLastCategory
+			}
+			// The trigger on projects.category_id drops the link
+			columns["category_id"] = next[0]
+		}
+
+		if err := updateColumnsVersioned(tx, &models.Project{}, project.ID, project.Version, columns); err != nil {
+			return err
+		}
+		if err := tx.Where("project_id = ? AND category_id = ?", project.ID, categoryID).
+			Delete(&models.ProjectCategory{}).Error; err != nil {
+			return err
+		}
+		if err := renumber(tx, projectOrder, categoryID); err != nil {
+			return err
+		}
+
+		if err := tx.Where("id = ?", project.ID).First(project).Error; err != nil {
+			return err
+		}
+		categoryIDs, err := categoryIDsOf(tx, project.ID)
+		if err != nil {
+			return err
+		}
+		project.CategoryIDs = categoryIDs
+		// The portfolio of the category left behind is told as well
+		return recordChangeFrom(tx, previous, "project", "updated", project.ID, project)
+	})
+}
+
+// UpdatePosition moves the project within one of its categories if version
+// still matches the stored row, returning the position it ends up at. The
+// position in the primary category is also kept in projects.position.
+func (r *projectRepository) UpdatePosition(id uint, categoryID uint, at ordering.Placement, version uint) (uint, error) {
+	var position uint
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockParents(tx, projectOrder, categoryID); err != nil {
+			return err
+		}
+		if err := updateColumnsVersioned(tx, &models.Project{}, id, version, map[string]interface{}{}); err != nil {
+			return err
+		}
+		var err error
+		position, err = place(tx, projectOrder, id, categoryID, categoryID, at)
+		return err
+	})
+	return position, err
+}
+
+// BulkUpdatePositions puts several projects of a category at the given
+// positions in a transaction; the others keep their relative order
+func (r *projectRepository) BulkUpdatePositions(categoryID uint, items []ordering.Item) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return arrange(tx, projectOrder, categoryID, items)
+	})
+}
+
+// MoveToCategory moves the project from one of its categories to another one
+// if project.Version still matches the stored row. Moving it out of its
+// primary category makes the new one primary. Both categories are renumbered;
+// ErrAlreadyInCategory is returned when the project is in the new one already.
+func (r *projectRepository) MoveToCategory(project *models.Project, from uint, to uint, at ordering.Placement) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockParents(tx, projectOrder, from, to); err != nil {
+			return err
+		}
+		previous, err := portfoliosOf(tx, "project", project.ID)
+		if err != nil {
+			return err
+		}
+		if err := updateColumnsVersioned(tx, &models.Project{}, project.ID, project.Version, map[string]interface{}{}); err != nil {
+			return err
+		}
+		if _, err := place(tx, projectOrder, project.ID, from, to, at); err != nil {
+			return err
+		}
+
+		if err := tx.Where("id = ?", project.ID).First(project).Error; err != nil {
+			return err
+		}
+		categoryIDs, err := categoryIDsOf(tx, project.ID)
+		if err != nil {
+			return err
+		}
+		project.CategoryIDs = categoryIDs
+		// The portfolio of the category left behind is told as well
+		return recordChangeFrom(tx, previous, "project", "updated", project.ID, project)
+	})
+}
+
+// relinkProject moves the project's link from one category to another, through
+// projects.category_id when it is the primary one so the trigger keeps them in
+// step
+func relinkProject(tx *gorm.DB, id, from, to uint) error {
+	var linked int64
+	if err := tx.Model(&models.ProjectCategory{}).
+		Where("project_id = ? AND category_id = ?", id, to).
+		Count(&linked).Error; err != nil {
+		return err
+	}
+	if linked > 0 {
+		return ErrAlreadyInCategory
+	}
+
+	result := tx.Model(&models.Project{}).
+		Where("id = ? AND category_id = ?", id, from).
+		UpdateColumn("category_id", to)
+	if result.Error != nil || result.RowsAffected > 0 {
+		return result.Error
+	}
+
+	result = tx.Model(&models.ProjectCategory{}).
+		Where("project_id = ? AND category_id = ?", id, from).
+		Update("category_id", to)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// setCategoryPosition writes the position of the project's link to a category,
+// and projects.position when it is the primary category
+func setCategoryPosition(tx *gorm.DB, id uint, categoryID uint, position uint) error {
+	result := tx.Model(&models.ProjectCategory{}).
+		Where("project_id = ? AND category_id = ?", id, categoryID).
+		Update("position", position)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return tx.Model(&models.Project{}).
+		Where("id = ? AND category_id = ?", id, categoryID).
+		UpdateColumn("position", position).Error
+}
+
+// categoryIDsOf returns the live categories a project is in, the primary first
+func categoryIDsOf(db *gorm.DB, id uint) ([]uint, error) {
+	categoryIDs, err := categoryIDsOfAll(db, []uint{id})
+	return categoryIDs[id], err
+}
+
+// categoryIDsOfAll returns the live categories each of the projects is in, the
+// primary first
+func categoryIDsOfAll(db *gorm.DB, ids []uint) (map[uint][]uint, error) {
+	categoryIDs := make(map[uint][]uint, len(ids))
+	if len(ids) == 0 {
+		return categoryIDs, nil
+	}
+	var links []models.ProjectCategory
+	err := db.Model(&models.ProjectCategory{}).
+		Select("project_categories.project_id, project_categories.category_id").
+		Joins("JOIN projects ON projects.id = project_categories.project_id").
+		Joins("JOIN categories ON categories.id = project_categories.category_id AND categories.deleted_at IS NULL").
+		Where("project_categories.project_id IN ?", ids).
+		Order("project_categories.category_id = projects.category_id DESC, project_categories.created_at ASC, project_categories.category_id ASC").
+		Find(&links).Error
+	for _, link := range links {
+		categoryIDs[link.ProjectID] = append(categoryIDs[link.ProjectID], link.CategoryID)
+	}
+	return categoryIDs, err
+}
+
+// portfolioProjectIDs selects the ids of the projects in any live category of
+// the portfolio
+func portfolioProjectIDs(db *gorm.DB, portfolioID uint) *gorm.DB {
+	return db.Model(&models.ProjectCategory{}).
+		Select("project_categories.project_id").
+		Joins("JOIN categories ON categories.id = project_categories.category_id AND categories.deleted_at IS NULL").
+		Where("categories.portfolio_id = ?", portfolioID)
+}
+
+// unlinkCategories takes the projects out of categories being deleted. Projects
+// whose primary category is one of them move to the category they were added
+// to next, if they have one outside the deleted ones; the others stay with
+// their deleted category, as before projects could be in several.
+func unlinkCategories(tx *gorm.DB, categoryIDs []uint) error {
+	if err := tx.Exec(`
+		UPDATE projects
+		SET category_id = next.category_id, version = version + 1, updated_at = NOW()
+		FROM (
+			SELECT DISTINCT ON (project_id) project_id, category_id
+			FROM project_categories
+			WHERE category_id NOT IN ?
+			ORDER BY project_id, created_at ASC, category_id ASC
+		) AS next
+		WHERE next.project_id = projects.id
+		AND projects.category_id IN ?
+		AND projects.deleted_at IS NULL
+	`, categoryIDs, categoryIDs).Error; err != nil {
+		return err
+	}
+
+	return tx.Where("category_id IN ? AND project_id IN (?)", categoryIDs,
+		tx.Model(&models.Project{}).Select("id").Where("category_id NOT IN ?", categoryIDs)).
+		Delete(&models.ProjectCategory{}).Error
+}
@@ -0,0 +1,84 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type projectSourceRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectSourceRepository(db *gorm.DB) ProjectSourceRepository {
+	return &projectSourceRepository{
+		db: db,
+	}
+}
+
+// GetByRepoID finds the owner's project imported from the repository. Sources
+// of deleted projects are ignored.
+func (r *projectSourceRepository) GetByRepoID(ownerID string, provider string, repoID int64) (*models.ProjectSource, error) {
+	var source models.ProjectSource
+	err := r.live().
+		Where("project_sources.owner_id = ? AND project_sources.provider = ? AND project_sources.repo_id = ?", ownerID, provider, repoID).
+		First(&source).Error
+	if err != nil {
+		return nil, err
+	}
+	return &source, nil
+}
+
+// GetByOwnerID lists the owner's projects imported from the provider
+func (r *projectSourceRepository) GetByOwnerID(ownerID string, provider string) ([]models.ProjectSource, error) {
+	var sources []models.ProjectSource
+	err := r.live().
+		Where("project_sources.owner_id = ? AND project_sources.provider = ?", ownerID, provider).
+		Find(&sources).Error
+	return sources, err
+}
+
+// Save stores where the project was imported from, replacing what was stored
+// for it before and any source of a deleted project tracking the same repository
+func (r *projectSourceRepository) Save(source *models.ProjectSource) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("owner_id = ? AND provider = ? AND repo_id = ? AND project_id <> ?",
+			source.OwnerID, source.Provider, source.RepoID, source.ProjectID).
+			Delete(&models.ProjectSource{}).Error; err != nil {
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "project_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"owner_id", "provider", "repo_id", "full_name", "digest", "sync",
+				"synced_at", "sync_error", "failed_at", "updated_at"}),
+		}).Create(source).Error
+	})
+}
+
+// GetDue lists up to limit sources to resync: those with sync on whose last
+// sync, and last failed attempt if any, happened before before
+func (r *projectSourceRepository) GetDue(before time.Time, limit int) ([]models.ProjectSource, error) {
+	var sources []models.ProjectSource
+	err := r.live().
+		Where("project_sources.sync = ? AND project_sources.synced_at < ?", true, before).
+		Where("project_sources.failed_at IS NULL OR project_sources.failed_at < ?", before).
+		Order("project_sources.synced_at ASC").
+		Limit(limit).
+		Find(&sources).Error
+	return sources, err
+}
+
+// MarkFailed records a failed resync; the next one is tried after the next interval
+func (r *projectSourceRepository) MarkFailed(projectID uint, message string, at time.Time) error {
+	return r.db.Model(&models.ProjectSource{}).
+		Where("project_id = ?", projectID).
+		Updates(map[string]interface{}{"sync_error": message, "failed_at": at}).Error
+}
+
+// live selects the sources whose project still exists
+func (r *projectSourceRepository) live() *gorm.DB {
+	return r.db.Model(&models.ProjectSource{}).
+		Joins("JOIN projects ON projects.id = project_sources.project_id AND projects.deleted_at IS NULL")
+}
@@ -0,0 +1,122 @@
+package repo
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// sortColumns maps the sort fields of a query.Spec to SQL columns; only these
+// ever reach ORDER BY or the keyset condition. Projects without a start date
+// are placed on the timeline by when they were created.
+var sortColumns = map[string]string{
+	query.SortTitle:     "title",
+	query.SortPosition:  "position",
+	query.SortCreatedAt: "created_at",
+	query.SortUpdatedAt: "updated_at",
+	query.SortStartDate: "COALESCE(start_date, created_at)",
+}
+
+// applySpec adds the filters, ordering, keyset condition and limit of spec to
+// db. The limit is one over the page size so listPage can tell whether a next
+// page exists.
+func applySpec(db *gorm.DB, spec query.Spec) *gorm.DB {
+	for _, skill := range spec.Skills {
+		db = db.Where(skillCondition(skill))
+	}
+	if spec.Client != "" {
+		db = db.Where("LOWER(client) = LOWER(?)", spec.Client)
+	}
+	if spec.Type != "" {
+		db = db.Where("type = ?", spec.Type)
+	}
+	if spec.CreatedAfter != nil {
+		db = db.Where("created_at >= ?", *spec.CreatedAfter)
+	}
+	if spec.CreatedBefore != nil {
+		db = db.Where("created_at < ?", *spec.CreatedBefore)
+	}
+	if len(spec.Status) > 0 {
+		db = db.Where("status IN ?", spec.Status)
+	}
+	if spec.Featured != nil {
+		db = db.Where("featured = ?", *spec.Featured)
+	}
+	if spec.Role != "" {
+		db = db.Where("LOWER(role) = LOWER(?)", spec.Role)
+	}
+	if spec.Ongoing != nil {
+		db = db.Where("ongoing = ?", *spec.Ongoing)
+	}
+	if spec.StartedAfter != nil {
+		db = db.Where("start_date >= ?", *spec.StartedAfter)
+	}
+	if spec.StartedBefore != nil {
+		db = db.Where("start_date < ?", *spec.StartedBefore)
+	}
+
+	column := sortColumn(spec)
+	direction, compare := "ASC", ">"
+	if spec.Desc {
+		direction, compare = "DESC", "<"
+	}
+
+	if spec.After != nil {
+		db = db.Where("("+column+", id) "+compare+" (?, ?)", spec.After.Value, spec.After.ID)
+	}
+	db = db.Order(column + " " + direction).Order("id " + direction)
+
+	if spec.Limit > 0 {
+		db = db.Limit(spec.Limit + 1)
+	}
+	return db
+}
+
+// skillCondition matches projects listing skill verbatim or linked to a skill
+// of the taxonomy whose slug or an alias matches it ("golang" finds "Go")
+func skillCondition(skill string) clause.Expr {
+	slugs := []string{models.SkillSlug(skill)}
+	if known, ok := models.KnownSkill(skill); ok {
+		slugs = append(append(slugs, known.Slug), known.Aliases...)
+	}
+	return gorm.Expr(`(projects.skills @> ? OR projects.id IN (SELECT project_skills.project_id FROM project_skills
+		JOIN skills ON skills.id = project_skills.skill_id
+		WHERE skills.slug IN ? OR skills.aliases && ?))`,
+		pq.StringArray{skill}, slugs, pq.StringArray(slugs))
+}
+
+// sortColumn returns the SQL column spec is sorted by
+func sortColumn(spec query.Spec) string {
+	if column, ok := sortColumns[spec.Sort]; ok {
+		return column
+	}
+	return "position"
+}
+
+// listPage trims the extra row fetched by applySpec and returns the cursor of
+// the next page, or "" on the last one. key returns the sort value and ID of
+// an item.
+func listPage[T any](items []T, spec query.Spec, key func(item T) (interface{}, uint)) ([]T, string) {
+	if spec.Limit == 0 || len(items) <= spec.Limit {
+		return items, ""
+	}
+	items = items[:spec.Limit]
+	value, id := key(items[len(items)-1])
+	return items, spec.Next(query.FormatValue(value), id)
+}
+
+// sortValue picks the value of the sort column out of a row's fields
+func sortValue(spec query.Spec, title string, position uint, createdAt, updatedAt interface{}) interface{} {
+	switch spec.Sort {
+	case query.SortTitle:
+		return title
+	case query.SortCreatedAt:
+		return createdAt
+	case query.SortUpdatedAt:
+		return updatedAt
+	default:
+		return position
+	}
+}
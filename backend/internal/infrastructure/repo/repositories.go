@@ -0,0 +1,74 @@
+package repo
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Repositories holds one of each repository, all bound to the same database
+// handle
+type Repositories struct {
+	Analytics      AnalyticsRepository
+	Category       CategoryRepository
+	Contact        ContactRepository
+	Education      EducationRepository
+	Experience     ExperienceRepository
+	IdempotencyKey IdempotencyKeyRepository
+	Job            JobRepository
+	LinkCheck      LinkCheckRepository
+	Portfolio      PortfolioRepository
+	PortfolioEvent PortfolioEventRepository
+	Project        ProjectRepository
+	ProjectSource  ProjectSourceRepository
+	Section        SectionRepository
+	SectionContent SectionContentRepository
+	Skill          SkillRepository
+	Template       TemplateRepository
+	Testimonial    TestimonialRepository
+	Translation    TranslationRepository
+	UserStatus     UserStatusRepository
+	Webhook        WebhookRepository
+}
+
+func NewRepositories(db *gorm.DB) *Repositories {
+	return &Repositories{
+		Analytics:      NewAnalyticsRepository(db),
+		Category:       NewCategoryRepository(db),
+		Contact:        NewContactRepository(db),
+		Education:      NewEducationRepository(db),
+		Experience:     NewExperienceRepository(db),
+		IdempotencyKey: NewIdempotencyKeyRepository(db),
+		Job:            NewJobRepository(db),
+		LinkCheck:      NewLinkCheckRepository(db),
+		Portfolio:      NewPortfolioRepository(db),
+		PortfolioEvent: NewPortfolioEventRepository(db),
+		Project:        NewProjectRepository(db),
+		ProjectSource:  NewProjectSourceRepository(db),
+		Section:        NewSectionRepository(db),
+		SectionContent: NewSectionContentRepository(db),
+		Skill:          NewSkillRepository(db),
+		Template:       NewTemplateRepository(db),
+		Testimonial:    NewTestimonialRepository(db),
+		Translation:    NewTranslationRepository(db),
+		UserStatus:     NewUserStatusRepository(db),
+		Webhook:        NewWebhookRepository(db),
+	}
+}
+
+type repositoriesKey struct{}
+
+// WithRepositories returns a copy of ctx carrying repos, which For prefers
+// over its own. A batch binds repositories to its transaction this way for
+// the operations it runs.
+func WithRepositories(ctx context.Context, repos *Repositories) context.Context {
+	return context.WithValue(ctx, repositoriesKey{}, repos)
+}
+
+// For returns the repositories carried by ctx, or r when it carries none
+func (r *Repositories) For(ctx context.Context) *Repositories {
+	if repos, ok := ctx.Value(repositoriesKey{}).(*Repositories); ok {
+		return repos
+	}
+	return r
+}
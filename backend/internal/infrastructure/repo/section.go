@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/ordering"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -19,7 +21,24 @@ func NewSectionRepository(db *gorm.DB) SectionRepository {
 }
 
 func (r *sectionRepository) Create(section *models.Section) error {
-	return r.db.Create(section).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// The trigger appends the section while the portfolio is locked
+		if err := lockParents(tx, sectionOrder, section.PortfolioID); err != nil {
+			return err
+		}
+		requested := section.Position
+		if err := tx.Create(section).Error; err != nil {
+			return err
+		}
+		if requested != 0 {
+			position, err := place(tx, sectionOrder, section.ID, section.PortfolioID, section.PortfolioID, ordering.Placement{Position: requested})
+			if err != nil {
+				return err
+			}
+			section.Position = position
+		}
+		return recordChange(tx, "section", "created", section.ID, section)
+	})
 }
 
 // GetByOwnerID For list views - only basic section info for a specific owner
@@ -35,7 +54,7 @@ func (r *sectionRepository) GetByOwnerID(ownerID string, limit, offset int) ([]m
 	}
 
 	// Get paginated results
-	err := r.db.Select("id, title, description, type, position, portfolio_id, owner_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, type, position, portfolio_id, owner_id, version, created_at, updated_at").
 		Where("owner_id = ?", ownerID).
 		Order("position ASC, created_at ASC").
 		Limit(limit).Offset(offset).
@@ -47,7 +66,16 @@ func (r *sectionRepository) GetByOwnerID(ownerID string, limit, offset int) ([]m
 // GetByID For detail views - basic section info
 func (r *sectionRepository) GetByID(id uint) (*models.Section, error) {
 	var section models.Section
-	err := r.db.Select("id, title, position, owner_id, portfolio_id, created_at, updated_at").
+	err := r.db.Select(sectionColumns).
+		Where("id = ?", id).
+		First(&section).Error
+	return &section, err
+}
+
+// GetByIDSelected For detail views - only the columns and relations picked by sel
+func (r *sectionRepository) GetByIDSelected(id uint, sel query.Selection) (*models.Section, error) {
+	var section models.Section
+	err := preloadIncluded(r.db.Select(selectedColumns(sel, sectionColumns)), sel, sectionRelations).
 		Where("id = ?", id).
 		First(&section).Error
 	return &section, err
@@ -71,7 +99,7 @@ func (r *sectionRepository) GetByPortfolioID(portfolioID string) ([]models.Secti
 	}).Debug("Repository: GetByPortfolioID called")
 
 	var sections []models.Section
-	err := r.db.Select("id, title, position, owner_id, created_at, updated_at").
+	err := r.db.Select(sectionColumns).
 		Where("portfolio_id = ?", portfolioID).
 		Order("position ASC, created_at ASC").
 		Find(&sections).Error
@@ -93,10 +121,26 @@ func (r *sectionRepository) GetByPortfolioID(portfolioID string) ([]models.Secti
 	return sections, err
 }
 
+// ListByPortfolioID For list views - sections of a portfolio, filtered, sorted
+// and paginated by spec. Returns the cursor of the next page, or "" on the last one.
+func (r *sectionRepository) ListByPortfolioID(portfolioID string, spec query.Spec) ([]models.Section, string, error) {
+	var sections []models.Section
+	err := applySpec(preloadIncluded(r.db.Select(selectedColumns(spec.Selection, sectionColumns, sortColumn(spec))), spec.Selection, sectionRelations).
+		Where("portfolio_id = ?", portfolioID), spec).
+		Find(&sections).Error
+	if err != nil {
+		return nil, "", err
+	}
+	sections, next := listPage(sections, spec, func(s models.Section) (interface{}, uint) {
+		return sortValue(spec, s.Title, s.Position, s.CreatedAt, s.UpdatedAt), s.ID
+	})
+	return sections, next, nil
+}
+
 // GetByPortfolioIDWithRelations For detail views - with contents preloaded
 func (r *sectionRepository) GetByPortfolioIDWithRelations(portfolioID string) ([]models.Section, error) {
 	var sections []models.Section
-	err := r.db.Select("id, title, description, type, position, portfolio_id, owner_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, type, position, portfolio_id, owner_id, version, created_at, updated_at").
 		Preload("Contents", func(db *gorm.DB) *gorm.DB {
 			return db.Order("section_contents.order ASC, section_contents.created_at ASC")
 		}).
@@ -108,19 +152,58 @@ func (r *sectionRepository) GetByPortfolioIDWithRelations(portfolioID string) ([
 
 func (r *sectionRepository) GetByType(sectionType string) ([]models.Section, error) {
 	var sections []models.Section
-	err := r.db.Select("id, title, description, type, position, portfolio_id, owner_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, type, position, portfolio_id, owner_id, version, created_at, updated_at").
 		Where("type = ?", sectionType).
 		Find(&sections).Error
 	return sections, err
 }
 
+// Update writes the section if section.Version still matches the stored row,
+// returning ErrVersionConflict otherwise
 func (r *sectionRepository) Update(section *models.Section) error {
-	return r.db.Model(section).Where("id = ?", section.ID).Updates(section).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		before, err := lockSlot(tx, sectionOrder, section.ID)
+		if err != nil {
+			return err
+		}
+		if err := updateVersioned(tx, section, section.ID, &section.Version); err != nil {
+			return err
+		}
+		if section.Position, err = settle(tx, sectionOrder, section.ID, before); err != nil {
+			return err
+		}
+		return recordChange(tx, "section", "updated", section.ID, section)
+	})
+}
+
+// Patch writes every editable field, empty values included, if section.Version
+// still matches the stored row, returning ErrVersionConflict otherwise
+func (r *sectionRepository) Patch(section *models.Section) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateVersioned(tx, section, section.ID, &section.Version, "title", "description", "type"); err != nil {
+			return err
+		}
+		return recordChange(tx, "section", "updated", section.ID, section)
+	})
+}
+
+// UpdatePosition moves the section within its portfolio if version still
+// matches the stored row, returning the position it ends up at
+func (r *sectionRepository) UpdatePosition(id uint, at ordering.Placement, version uint) (uint, error) {
+	return r.MoveToPortfolio(id, 0, at, version)
 }
 
-// UpdatePosition updates only the position field of a section
-func (r *sectionRepository) UpdatePosition(id uint, position uint) error {
-	return r.db.Model(&models.Section{}).Where("id = ?", id).Update("position", position).Error
+// MoveToPortfolio moves the section to another portfolio, or within its own
+// when portfolioID is zero, if version still matches the stored row. Both
+// portfolios are renumbered; the position the section ends up at is returned.
+func (r *sectionRepository) MoveToPortfolio(id uint, portfolioID uint, at ordering.Placement, version uint) (uint, error) {
+	var position uint
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		position, err = reposition(tx, sectionOrder, id, version, portfolioID, at)
+		return err
+	})
+	return position, err
 }
 
 // GetByIDs fetches multiple sections by their IDs
@@ -132,30 +215,33 @@ func (r *sectionRepository) GetByIDs(ids []uint) ([]*models.Section, error) {
 	return sections, nil
 }
 
-// BulkUpdatePositions updates positions for multiple sections in a transaction
-func (r *sectionRepository) BulkUpdatePositions(items []struct {
-	ID       uint `json:"id" binding:"required"`
-	Position uint `json:"position" binding:"required,min=1"`
-}) error {
+// BulkUpdatePositions puts several sections of a portfolio at the given
+// positions in a transaction; the others keep their relative order
+func (r *sectionRepository) BulkUpdatePositions(portfolioID uint, items []ordering.Item) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		for _, item := range items {
-			if err := tx.Model(&models.Section{}).
-				Where("id = ?", item.ID).
-				Update("position", item.Position).Error; err != nil {
-				return err
-			}
-		}
-		return nil
+		return arrange(tx, sectionOrder, portfolioID, items)
 	})
 }
 
-func (r *sectionRepository) Delete(id uint) error {
-	return r.db.Delete(&models.Section{}, id).Error
+func (r *sectionRepository) Delete(id uint, version uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		before, err := lockSlot(tx, sectionOrder, id)
+		if err != nil {
+			return err
+		}
+		if err := deleteVersioned(tx, &models.Section{}, id, version); err != nil {
+			return err
+		}
+		if err := renumber(tx, sectionOrder, before.Parent); err != nil {
+			return err
+		}
+		return recordChange(tx, "section", "deleted", id, map[string]interface{}{"id": id})
+	})
 }
 
 func (r *sectionRepository) List(limit, offset int) ([]models.Section, error) {
 	var sections []models.Section
-	err := r.db.Select("id, title, description, type, position, portfolio_id, owner_id, created_at, updated_at").
+	err := r.db.Select("id, title, description, type, position, portfolio_id, owner_id, version, created_at, updated_at").
 		Limit(limit).Offset(offset).
 		Find(&sections).Error
 	return sections, err
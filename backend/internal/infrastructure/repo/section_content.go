@@ -2,6 +2,7 @@ package repo
 
 import (
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/ordering"
 	"gorm.io/gorm"
 )
 
@@ -16,13 +17,30 @@ func NewSectionContentRepository(db *gorm.DB) SectionContentRepository {
 }
 
 func (r *sectionContentRepository) Create(content *models.SectionContent) error {
-	return r.db.Create(content).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// The trigger appends the content block while the section is locked
+		if err := lockParents(tx, contentOrder, content.SectionID); err != nil {
+			return err
+		}
+		requested := content.Order
+		if err := tx.Create(content).Error; err != nil {
+			return err
+		}
+		if requested != 0 {
+			order, err := place(tx, contentOrder, content.ID, content.SectionID, content.SectionID, ordering.Placement{Position: requested})
+			if err != nil {
+				return err
+			}
+			content.Order = order
+		}
+		return recordChange(tx, "section_content", "created", content.ID, content)
+	})
 }
 
 // GetByID retrieves a single content block by ID
 func (r *sectionContentRepository) GetByID(id uint) (*models.SectionContent, error) {
 	var content models.SectionContent
-	err := r.db.Select("id, section_id, type, content, \"order\", metadata, owner_id, created_at, updated_at").
+	err := r.db.Select("id, section_id, type, content, \"order\", metadata, owner_id, version, created_at, updated_at").
 		Where("id = ?", id).
 		First(&content).Error
 	return &content, err
@@ -31,41 +49,88 @@ func (r *sectionContentRepository) GetByID(id uint) (*models.SectionContent, err
 // GetBySectionID retrieves all content blocks for a section, ordered by position
 func (r *sectionContentRepository) GetBySectionID(sectionID uint) ([]models.SectionContent, error) {
 	var contents []models.SectionContent
-	err := r.db.Select("id, section_id, type, content, \"order\", metadata, owner_id, created_at, updated_at").
+	err := r.db.Select("id, section_id, type, content, \"order\", metadata, owner_id, version, created_at, updated_at").
 		Where("section_id = ?", sectionID).
 		Order("\"order\" ASC, created_at ASC").
 		Find(&contents).Error
 	return contents, err
 }
 
+// Update writes the content block if content.Version still matches the stored row,
+// returning ErrVersionConflict otherwise
 func (r *sectionContentRepository) Update(content *models.SectionContent) error {
-	return r.db.Model(content).Where("id = ?", content.ID).Updates(content).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		before, err := lockSlot(tx, contentOrder, content.ID)
+		if err != nil {
+			return err
+		}
+		if err := updateVersioned(tx, content, content.ID, &content.Version); err != nil {
+			return err
+		}
+		if content.Order, err = settle(tx, contentOrder, content.ID, before); err != nil {
+			return err
+		}
+		return recordChange(tx, "section_content", "updated", content.ID, content)
+	})
 }
 
-// UpdateOrder updates only the order field of a content block
-func (r *sectionContentRepository) UpdateOrder(id uint, order uint) error {
-	return r.db.Model(&models.SectionContent{}).Where("id = ?", id).Update("order", order).Error
+// Patch writes every editable field, empty values included, if content.Version
+// still matches the stored row, returning ErrVersionConflict otherwise
+func (r *sectionContentRepository) Patch(content *models.SectionContent) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		before, err := lockSlot(tx, contentOrder, content.ID)
+		if err != nil {
+			return err
+		}
+		if err := updateVersioned(tx, content, content.ID, &content.Version, "type", "content", "order", "metadata"); err != nil {
+			return err
+		}
+		if content.Order, err = settle(tx, contentOrder, content.ID, before); err != nil {
+			return err
+		}
+		return recordChange(tx, "section_content", "updated", content.ID, content)
+	})
 }
 
-func (r *sectionContentRepository) Delete(id uint) error {
-	return r.db.Delete(&models.SectionContent{}, id).Error
+// UpdatePosition moves the content block within its section if version still
+// matches the stored row, returning the order it ends up at
+func (r *sectionContentRepository) UpdatePosition(id uint, at ordering.Placement, version uint) (uint, error) {
+	return r.MoveToSection(id, 0, at, version)
 }
 
-// CheckDuplicateOrder checks if another content block has the same order in the section
-// Useful to prevent order conflicts, though not strictly enforced
-func (r *sectionContentRepository) CheckDuplicateOrder(sectionID uint, order uint, id uint) (bool, error) {
-	var count int64
-	query := r.db.Model(&models.SectionContent{}).
-		Where("section_id = ? AND \"order\" = ?", sectionID, order)
+// MoveToSection moves the content block to another section, or within its own
+// when sectionID is zero, if version still matches the stored row. Both
+// sections are renumbered; the order the block ends up at is returned.
+func (r *sectionContentRepository) MoveToSection(id uint, sectionID uint, at ordering.Placement, version uint) (uint, error) {
+	var order uint
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		order, err = reposition(tx, contentOrder, id, version, sectionID, at)
+		return err
+	})
+	return order, err
+}
 
-	// Exclude the current content when checking for duplicates (for updates)
-	if id != 0 {
-		query = query.Where("id != ?", id)
-	}
+// BulkUpdatePositions puts several content blocks of a section at the given
+// positions in a transaction; the others keep their relative order
+func (r *sectionContentRepository) BulkUpdatePositions(sectionID uint, items []ordering.Item) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return arrange(tx, contentOrder, sectionID, items)
+	})
+}
 
-	err := query.Count(&count).Error
-	if err != nil {
-		return false, err
-	}
-	return count > 0, nil
+func (r *sectionContentRepository) Delete(id uint, version uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		before, err := lockSlot(tx, contentOrder, id)
+		if err != nil {
+			return err
+		}
+		if err := deleteVersioned(tx, &models.SectionContent{}, id, version); err != nil {
+			return err
+		}
+		if err := renumber(tx, contentOrder, before.Parent); err != nil {
+			return err
+		}
+		return recordChange(tx, "section_content", "deleted", id, map[string]interface{}{"id": id})
+	})
 }
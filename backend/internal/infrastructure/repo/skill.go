@@ -0,0 +1,323 @@
+package repo
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// skillUsageColumn counts the live projects linked to each skill
+const skillUsageColumn = `(SELECT COUNT(*) FROM project_skills
+	JOIN projects ON projects.id = project_skills.project_id AND projects.deleted_at IS NULL
+	WHERE project_skills.skill_id = skills.id) AS projects`
+
+type skillRepository struct {
+	db *gorm.DB
+}
+
+func NewSkillRepository(db *gorm.DB) SkillRepository {
+	return &skillRepository{
+		db: db,
+	}
+}
+
+func (r *skillRepository) Create(skill *models.Skill) error {
+	return r.db.Create(skill).Error
+}
+
+func (r *skillRepository) GetByID(id uint) (*models.Skill, error) {
+	var skill models.Skill
+	err := r.db.Where("id = ?", id).First(&skill).Error
+	return &skill, err
+}
+
+// GetByIDs returns the skills of ownerID among ids
+func (r *skillRepository) GetByIDs(ownerID string, ids []uint) ([]models.Skill, error) {
+	var skills []models.Skill
+	err := r.db.Where("owner_id = ? AND id IN ?", ownerID, ids).
+		Order("id ASC").
+		Find(&skills).Error
+	return skills, err
+}
+
+// GetByOwnerID lists the taxonomy of an owner with the usage of each skill,
+// optionally only the skills of one kind
+func (r *skillRepository) GetByOwnerID(ownerID string, kind string) ([]models.SkillUsage, error) {
+	var skills []models.SkillUsage
+	query := r.db.Model(&models.Skill{}).
+		Select("skills.*, "+skillUsageColumn).
+		Where("owner_id = ?", ownerID)
+	if kind != "" {
+		query = query.Where("kind = ?", kind)
+	}
+	err := query.Order("name ASC").Scan(&skills).Error
+	return skills, err
+}
+
+// Search returns the skills of an owner whose slug or an alias starts with
+// prefix, a slug, the most used first
+func (r *skillRepository) Search(ownerID string, prefix string, limit int) ([]models.SkillUsage, error) {
+	pattern := strings.NewReplacer(`\`, `\\`, "%", `\%`).Replace(prefix) + "%"
+
+	var skills []models.SkillUsage
+	err := r.db.Model(&models.Skill{}).
+		Select("skills.*, "+skillUsageColumn).
+		Where("owner_id = ?", ownerID).
+		Where("slug LIKE ? OR EXISTS (SELECT 1 FROM unnest(aliases) AS alias WHERE alias LIKE ?)", pattern, pattern).
+		Order("projects DESC, name ASC").
+		Limit(limit).
+		Scan(&skills).Error
+	return skills, err
+}
+
+// FindConflict returns a skill of the owner, other than id, whose slug or an
+// alias is one of slugs; gorm.ErrRecordNotFound when there is none
+func (r *skillRepository) FindConflict(ownerID string, slugs []string, id uint) (*models.Skill, error) {
+	var skill models.Skill
+	err := r.db.Where("owner_id = ? AND id <> ?", ownerID, id).
+		Where("slug IN ? OR aliases && ?", slugs, pq.StringArray(slugs)).
+		First(&skill).Error
+	return &skill, err
+}
+
+// CountProjects returns how many live projects use the skill
+func (r *skillRepository) CountProjects(id uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.ProjectSkill{}).
+		Joins("JOIN projects ON projects.id = project_skills.project_id AND projects.deleted_at IS NULL").
+		Where("project_skills.skill_id = ?", id).
+		Count(&count).Error
+	return count, err
+}
+
+// Update writes the skill if skill.Version still matches the stored row,
+// returning ErrVersionConflict otherwise. When the name changes the projects
+// that use the skill get the new one.
+func (r *skillRepository) Update(skill *models.Skill, previousName string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateVersioned(tx, skill, skill.ID, &skill.Version, "name", "slug", "kind", "aliases"); err != nil {
+			return err
+		}
+		if skill.Name == previousName {
+			return nil
+		}
+		return renameProjectSkills(tx, []uint{skill.ID}, []string{models.SkillSlug(previousName)}, skill)
+	})
+}
+
+// Delete removes an unused skill, checking the version when it is non-zero.
+// Links left by deleted projects go with it.
+func (r *skillRepository) Delete(id uint, version uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := deleteVersioned(tx, &models.Skill{}, id, version); err != nil {
+			return err
+		}
+		return tx.Where("skill_id = ?", id).Delete(&models.ProjectSkill{}).Error
+	})
+}
+
+// Merge folds sources into target: their projects link to target and show its
+// name, and their names and aliases become aliases of target. The sources are
+// deleted.
+func (r *skillRepository) Merge(target *models.Skill, sources []models.Skill) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		sourceIDs := make([]uint, len(sources))
+		var sourceSlugs []string
+		for i, source := range sources {
+			sourceIDs[i] = source.ID
+			sourceSlugs = append(sourceSlugs, source.Slug)
+			sourceSlugs = append(sourceSlugs, source.Aliases...)
+		}
+
+		if err := renameProjectSkills(tx, sourceIDs, sourceSlugs, target); err != nil {
+			return err
+		}
+
+		if err := tx.Exec(`INSERT INTO project_skills (project_id, skill_id)
+			SELECT DISTINCT project_id, ? FROM project_skills WHERE skill_id IN ?
+			ON CONFLICT DO NOTHING`, target.ID, sourceIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("skill_id IN ?", sourceIDs).Delete(&models.ProjectSkill{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id IN ?", sourceIDs).Delete(&models.Skill{}).Error; err != nil {
+			return err
+		}
+
+		for _, slug := range sourceSlugs {
+			if slug != target.Slug && !slices.Contains(target.Aliases, slug) {
+				target.Aliases = append(target.Aliases, slug)
+			}
+		}
+		return updateVersioned(tx, target, target.ID, &target.Version, "aliases")
+	})
+}
+
+// GetUsageByPortfolioID counts the projects of a portfolio using each skill,
+// optionally only skills of one kind, the most used first. It also returns the
+// number of projects in the portfolio.
+func (r *skillRepository) GetUsageByPortfolioID(portfolioID uint, kind string) ([]models.SkillUsage, int64, error) {
+	var total int64
+	if err := r.db.Model(&models.Project{}).
+		Where("projects.id IN (?)", portfolioProjectIDs(r.db, portfolioID)).
+		Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var skills []models.SkillUsage
+	query := r.db.Model(&models.Skill{}).
+		Select("skills.*, COUNT(DISTINCT projects.id) AS projects").
+		Joins("JOIN project_skills ON project_skills.skill_id = skills.id").
+		Joins("JOIN projects ON projects.id = project_skills.project_id AND projects.deleted_at IS NULL").
+		Where("projects.id IN (?)", portfolioProjectIDs(r.db, portfolioID))
+	if kind != "" {
+		query = query.Where("skills.kind = ?", kind)
+	}
+	err := query.Group("skills.id").
+		Order("projects DESC, skills.name ASC").
+		Scan(&skills).Error
+	return skills, total, err
+}
+
+// DeleteByOwnerID removes the whole taxonomy of an owner with its links
+func (r *skillRepository) DeleteByOwnerID(ownerID string) (int64, error) {
+	var deleted int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("skill_id IN (?)", tx.Model(&models.Skill{}).Select("id").Where("owner_id = ?", ownerID)).
+			Delete(&models.ProjectSkill{}).Error; err != nil {
+			return err
+		}
+		result := tx.Where("owner_id = ?", ownerID).Delete(&models.Skill{})
+		deleted = result.RowsAffected
+		return result.Error
+	})
+	return deleted, err
+}
+
+// LinkProjectSkills resolves the skills of a project in its owner's taxonomy,
+// creating the entries it lacks, links the project to them and stores their
+// canonical names in the project's array. Returns the canonical names.
+func LinkProjectSkills(db *gorm.DB, projectID uint) (models.StringArray, error) {
+	var project models.Project
+	if err := db.Select("id, owner_id, skills").Where("id = ?", projectID).First(&project).Error; err != nil {
+		return nil, err
+	}
+
+	skills, err := resolveSkills(db, project.OwnerID, project.Skills)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Where("project_id = ?", projectID).Delete(&models.ProjectSkill{}).Error; err != nil {
+		return nil, err
+	}
+	names := make(models.StringArray, len(skills))
+	links := make([]models.ProjectSkill, len(skills))
+	for i, skill := range skills {
+		names[i] = skill.Name
+		links[i] = models.ProjectSkill{ProjectID: projectID, SkillID: skill.ID}
+	}
+	if len(links) > 0 {
+		if err := db.Create(&links).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if !slices.Equal(names, project.Skills) {
+		if err := db.Model(&models.Project{}).Where("id = ?", projectID).UpdateColumn("skills", names).Error; err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
+
+// resolveSkills maps free-text skill names to entries of the owner's taxonomy,
+// in order and without duplicates. A name matches a skill by slug or alias;
+// unknown names create a skill, seeded from the built-in list when it has one.
+func resolveSkills(db *gorm.DB, ownerID string, names []string) ([]models.Skill, error) {
+	var skills []models.Skill
+	for _, name := range names {
+		slug := models.SkillSlug(name)
+		if slug == "" {
+			continue
+		}
+
+		candidates := []string{slug}
+		known, isKnown := models.KnownSkill(name)
+		if isKnown {
+			candidates = append(append(candidates, known.Slug), known.Aliases...)
+		}
+
+		skill, err := findSkill(db, ownerID, slug, candidates)
+		if err == gorm.ErrRecordNotFound {
+			skill = &models.Skill{OwnerID: ownerID, Name: strings.TrimSpace(name), Slug: slug}
+			if isKnown {
+				skill = &known
+				skill.OwnerID = ownerID
+			}
+			// Another request may have created it meanwhile; use theirs then
+			if err = db.Clauses(clause.OnConflict{DoNothing: true}).Create(skill).Error; err == nil && skill.ID == 0 {
+				skill, err = findSkill(db, ownerID, skill.Slug, []string{skill.Slug})
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.ContainsFunc(skills, func(s models.Skill) bool { return s.ID == skill.ID }) {
+			skills = append(skills, *skill)
+		}
+	}
+	return skills, nil
+}
+
+// findSkill returns the owner's skill whose slug or an alias is one of
+// candidates, preferring an exact match on slug
+func findSkill(db *gorm.DB, ownerID string, slug string, candidates []string) (*models.Skill, error) {
+	var skill models.Skill
+	err := db.Where("owner_id = ?", ownerID).
+		Where("slug IN ? OR aliases && ?", candidates, pq.StringArray(candidates)).
+		Order(clause.OrderBy{Expression: clause.Expr{SQL: "slug = ? DESC, id ASC", Vars: []interface{}{slug}, WithoutParentheses: true}}).
+		First(&skill).Error
+	return &skill, err
+}
+
+// renameProjectSkills replaces, in the arrays of the projects linked to
+// skillIDs, the names whose slug is one of slugs with skill's name. Each
+// changed project gets a new version and a change event.
+func renameProjectSkills(tx *gorm.DB, skillIDs []uint, slugs []string, skill *models.Skill) error {
+	var projects []models.Project
+	if err := tx.Select("id, skills").
+		Where("id IN (?)", tx.Model(&models.ProjectSkill{}).Select("project_id").Where("skill_id IN ?", skillIDs)).
+		Find(&projects).Error; err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		var renamed models.StringArray
+		for _, name := range project.Skills {
+			if slices.Contains(slugs, models.SkillSlug(name)) || models.SkillSlug(name) == skill.Slug {
+				name = skill.Name
+			}
+			if !slices.Contains(renamed, name) {
+				renamed = append(renamed, name)
+			}
+		}
+		if slices.Equal(renamed, project.Skills) {
+			continue
+		}
+
+		if err := updateColumnsVersioned(tx, &models.Project{}, project.ID, 0, map[string]interface{}{"skills": renamed}); err != nil {
+			return err
+		}
+		if err := recordChange(tx, "project", "updated", project.ID, map[string]interface{}{"id": project.ID, "skills": renamed}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+package repo
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// templateSummaryColumns are the columns of template listings; bundles are
+// only read one template at a time
+const templateSummaryColumns = "id, key, owner_id, name, description, visibility, version, created_at, updated_at"
+
+type templateRepository struct {
+	db *gorm.DB
+}
+
+func NewTemplateRepository(db *gorm.DB) TemplateRepository {
+	return &templateRepository{
+		db: db,
+	}
+}
+
+func (r *templateRepository) Create(template *models.PortfolioTemplate) error {
+	return r.db.Create(template).Error
+}
+
+func (r *templateRepository) GetByID(id uint) (*models.PortfolioTemplate, error) {
+	var template models.PortfolioTemplate
+	err := r.db.Where("id = ?", id).First(&template).Error
+	return &template, err
+}
+
+// GetAvailable lists the templates every user can use: the built-in ones
+// first, then the shared ones by name
+func (r *templateRepository) GetAvailable() ([]models.PortfolioTemplate, error) {
+	var templates []models.PortfolioTemplate
+	err := r.db.Select(templateSummaryColumns).
+		Where("visibility IN ?", []string{models.TemplateBuiltin, models.TemplateShared}).
+		Order("visibility = 'builtin' DESC, name ASC, id ASC").
+		Find(&templates).Error
+	return templates, err
+}
+
+// GetByOwnerID lists the templates saved by an owner, by name
+func (r *templateRepository) GetByOwnerID(ownerID string) ([]models.PortfolioTemplate, error) {
+	var templates []models.PortfolioTemplate
+	err := r.db.Select(templateSummaryColumns).
+		Where("owner_id = ?", ownerID).
+		Order("name ASC, id ASC").
+		Find(&templates).Error
+	return templates, err
+}
+
+// CheckDuplicate reports whether the owner has another template, other than
+// id, with this name
+func (r *templateRepository) CheckDuplicate(name string, ownerID string, id uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.PortfolioTemplate{}).
+		Where("owner_id = ? AND LOWER(name) = LOWER(?) AND id <> ?", ownerID, name, id).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Update writes the name, description and visibility of the template if
+// template.Version still matches the stored row, returning ErrVersionConflict
+// otherwise. The bundle is kept.
+func (r *templateRepository) Update(template *models.PortfolioTemplate) error {
+	return updateVersioned(r.db, template, template.ID, &template.Version, "name", "description", "visibility")
+}
+
+// Delete removes a template, checking the version when it is non-zero.
+// Portfolios created from it are left as they are.
+func (r *templateRepository) Delete(id uint, version uint) error {
+	return deleteVersioned(r.db, &models.PortfolioTemplate{}, id, version)
+}
+
+// DeleteByOwnerID removes every template saved by an owner
+func (r *templateRepository) DeleteByOwnerID(ownerID string) (int64, error) {
+	result := r.db.Where("owner_id = ?", ownerID).Delete(&models.PortfolioTemplate{})
+	return result.RowsAffected, result.Error
+}
+
+// BundleOf captures the sections with their content blocks and the categories
+// with their projects of a portfolio, in order. A project is captured in its
+// primary category only.
+func (r *templateRepository) BundleOf(portfolioID uint) (models.TemplateBundle, error) {
+	bundle := models.TemplateBundle{
+		Sections:   []models.TemplateSection{},
+		Categories: []models.TemplateCategory{},
+	}
+
+	var sections []models.Section
+	if err := r.db.Where("portfolio_id = ?", portfolioID).
+		Preload("Contents", func(db *gorm.DB) *gorm.DB {
+			return db.Order("\"order\" ASC, created_at ASC, id ASC")
+		}).
+		Order("position ASC, created_at ASC, id ASC").
+		Find(&sections).Error; err != nil {
+		return bundle, err
+	}
+	for _, section := range sections {
+		captured := models.TemplateSection{
+			Title:       section.Title,
+			Description: section.Description,
+			Type:        section.Type,
+			Contents:    make([]models.TemplateContent, len(section.Contents)),
+		}
+		for i, content := range section.Contents {
+			captured.Contents[i] = models.TemplateContent{
+				Type:     content.Type,
+				Content:  content.Content,
+				Metadata: content.Metadata,
+			}
+		}
+		bundle.Sections = append(bundle.Sections, captured)
+	}
+
+	var categories []models.Category
+	if err := r.db.Where("portfolio_id = ?", portfolioID).
+		Preload("Projects", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC, created_at ASC, id ASC")
+		}).
+		Order("position ASC, created_at ASC, id ASC").
+		Find(&categories).Error; err != nil {
+		return bundle, err
+	}
+	for _, category := range categories {
+		captured := models.TemplateCategory{
+			Title:       category.Title,
+			Description: category.Description,
+			Projects:    make([]models.TemplateProject, len(category.Projects)),
+		}
+		for i, project := range category.Projects {
+			captured.Projects[i] = models.TemplateProject{
+				Title:       project.Title,
+				Description: project.Description,
+				Skills:      project.Skills,
+				Role:        project.Role,
+			}
+		}
+		bundle.Categories = append(bundle.Categories, captured)
+	}
+
+	return bundle, nil
+}
+
+// SeedTemplates creates the built-in templates, or refreshes them from the
+// bundles shipped with this version, matching them by key
+func SeedTemplates(db *gorm.DB, templates []models.PortfolioTemplate) error {
+	if len(templates) == 0 {
+		return nil
+	}
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "description", "visibility", "bundle", "updated_at"}),
+	}).Create(&templates).Error
+}
+
+// instantiateTemplate adds the sections, content blocks, categories and
+// placeholder projects of bundle to a portfolio created on the same
+// transaction, in the bundle's order. portfolio.Sections and
+// portfolio.Categories are set to what was created.
+func instantiateTemplate(tx *gorm.DB, portfolio *models.Portfolio, bundle models.TemplateBundle) error {
+	portfolio.Sections = make([]models.Section, len(bundle.Sections))
+	for i, s := range bundle.Sections {
+		section := models.Section{
+			Title:       s.Title,
+			Description: s.Description,
+			Type:        s.Type,
+			Position:    uint(i + 1),
+			OwnerID:     portfolio.OwnerID,
+			PortfolioID: portfolio.ID,
+		}
+		if err := tx.Omit(clause.Associations).Create(&section).Error; err != nil {
+			return err
+		}
+
+		for j, c := range s.Contents {
+			content := models.SectionContent{
+				SectionID: section.ID,
+				Type:      c.Type,
+				Content:   c.Content,
+				Order:     uint(j + 1),
+				Metadata:  c.Metadata,
+				OwnerID:   portfolio.OwnerID,
+			}
+			if err := tx.Omit(clause.Associations).Create(&content).Error; err != nil {
+				return err
+			}
+			section.Contents = append(section.Contents, content)
+		}
+		portfolio.Sections[i] = section
+	}
+
+	portfolio.Categories = make([]models.Category, len(bundle.Categories))
+	for i, c := range bundle.Categories {
+		category := models.Category{
+			Title:       c.Title,
+			Description: c.Description,
+			Position:    uint(i + 1),
+			OwnerID:     portfolio.OwnerID,
+			PortfolioID: portfolio.ID,
+		}
+		if err := tx.Omit(clause.Associations).Create(&category).Error; err != nil {
+			return err
+		}
+
+		for j, p := range c.Projects {
+			project := models.Project{
+				Title:       p.Title,
+				Description: p.Description,
+				Skills:      p.Skills,
+				Role:        p.Role,
+				Position:    uint(j + 1),
+				OwnerID:     portfolio.OwnerID,
+				CategoryID:  category.ID,
+			}
+			// The trigger links the project to its category at this position
+			if err := tx.Create(&project).Error; err != nil {
+				return err
+			}
+			skills, err := LinkProjectSkills(tx, project.ID)
+			if err != nil {
+				return err
+			}
+			project.Skills = skills
+			project.CategoryIDs = []uint{category.ID}
+			category.Projects = append(category.Projects, project)
+		}
+		portfolio.Categories[i] = category
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package repo
+
+import (
+	"errors"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/ordering"
+	"gorm.io/gorm"
+)
+
+// ErrInviteUnusable is returned when a testimonial invite expired or was used
+// while the submission was on its way
+var ErrInviteUnusable = errors.New("testimonial invite expired or already used")
+
+// liveProject keeps testimonials of deleted projects out of public listings
+const liveProject = `(testimonials.project_id IS NULL OR EXISTS (
+	SELECT 1 FROM projects WHERE projects.id = testimonials.project_id AND projects.deleted_at IS NULL))`
+
+type testimonialRepository struct {
+	db *gorm.DB
+}
+
+func NewTestimonialRepository(db *gorm.DB) TestimonialRepository {
+	return &testimonialRepository{
+		db: db,
+	}
+}
+
+// Create appends the testimonial to the ones of its portfolio
+func (r *testimonialRepository) Create(testimonial *models.Testimonial) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return createTestimonial(tx, testimonial)
+	})
+}
+
+// Submit stores a testimonial sent through invite and uses the invite up, all
+// or nothing. It returns ErrInviteUnusable when the invite can no longer be used.
+func (r *testimonialRepository) Submit(testimonial *models.Testimonial, invite *models.TestimonialInvite) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&models.TestimonialInvite{}).
+			Where("id = ? AND used_at IS NULL AND expires_at > ?", invite.ID, now).
+			UpdateColumn("used_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrInviteUnusable
+		}
+		invite.UsedAt = &now
+
+		testimonial.InviteID = &invite.ID
+		return createTestimonial(tx, testimonial)
+	})
+}
+
+// createTestimonial puts the testimonial last while the portfolio is locked
+func createTestimonial(tx *gorm.DB, testimonial *models.Testimonial) error {
+	if err := lockParents(tx, testimonialOrder, testimonial.PortfolioID); err != nil {
+		return err
+	}
+	var count int64
+	if err := tx.Model(&models.Testimonial{}).
+		Where("portfolio_id = ?", testimonial.PortfolioID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	testimonial.Position = uint(count) + 1
+	if err := tx.Create(testimonial).Error; err != nil {
+		return err
+	}
+	return recordChange(tx, "testimonial", "created", testimonial.ID, testimonial)
+}
+
+func (r *testimonialRepository) GetByID(id uint) (*models.Testimonial, error) {
+	var testimonial models.Testimonial
+	err := r.db.First(&testimonial, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &testimonial, nil
+}
+
+// GetByIDs fetches multiple testimonials by their IDs
+func (r *testimonialRepository) GetByIDs(ids []uint) ([]*models.Testimonial, error) {
+	var testimonials []*models.Testimonial
+	if err := r.db.Where("id IN ?", ids).Find(&testimonials).Error; err != nil {
+		return nil, err
+	}
+	return testimonials, nil
+}
+
+// GetByPortfolioID lists the testimonials of a portfolio in order for its
+// owner, optionally only those of one project or in one state
+func (r *testimonialRepository) GetByPortfolioID(portfolioID uint, projectID uint, status string) ([]models.Testimonial, error) {
+	var testimonials []models.Testimonial
+	query := r.db.Where("portfolio_id = ?", portfolioID)
+	if projectID != 0 {
+		query = query.Where("project_id = ?", projectID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("position ASC, created_at ASC").Find(&testimonials).Error
+	return testimonials, err
+}
+
+// GetApprovedByPortfolioID lists the approved testimonials of a portfolio in
+// order, optionally only those of one project
+func (r *testimonialRepository) GetApprovedByPortfolioID(portfolioID uint, projectID uint) ([]models.Testimonial, error) {
+	var testimonials []models.Testimonial
+	query := r.db.Where("portfolio_id = ? AND status = ?", portfolioID, models.TestimonialApproved).
+		Where(liveProject)
+	if projectID != 0 {
+		query = query.Where("project_id = ?", projectID)
+	}
+	err := query.Order("position ASC, created_at ASC").Find(&testimonials).Error
+	return testimonials, err
+}
+
+// GetApprovedByProjectID lists the approved testimonials of a project, in the
+// order of each portfolio the project is shown in
+func (r *testimonialRepository) GetApprovedByProjectID(projectID uint) ([]models.Testimonial, error) {
+	var testimonials []models.Testimonial
+	err := r.db.Where("project_id = ? AND status = ?", projectID, models.TestimonialApproved).
+		Order("portfolio_id ASC, position ASC, created_at ASC").
+		Find(&testimonials).Error
+	return testimonials, err
+}
+
+// Update writes the content of the testimonial if testimonial.Version still
+// matches the stored row, returning ErrVersionConflict otherwise
+func (r *testimonialRepository) Update(testimonial *models.Testimonial) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateVersioned(tx, testimonial, testimonial.ID, &testimonial.Version,
+			"project_id", "author_name", "author_role", "company", "quote", "avatar_url", "rating"); err != nil {
+			return err
+		}
+		return recordChange(tx, "testimonial", "updated", testimonial.ID, testimonial)
+	})
+}
+
+// UpdateStatus moves the testimonial to testimonial.Status if
+// testimonial.Version still matches the stored row, returning
+// ErrVersionConflict otherwise
+func (r *testimonialRepository) UpdateStatus(testimonial *models.Testimonial) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateVersioned(tx, testimonial, testimonial.ID, &testimonial.Version, "status"); err != nil {
+			return err
+		}
+		return recordChange(tx, "testimonial", "updated", testimonial.ID, testimonial)
+	})
+}
+
+// UpdatePosition moves the testimonial within its portfolio if version still
+// matches the stored row, returning the position it ends up at
+func (r *testimonialRepository) UpdatePosition(id uint, at ordering.Placement, version uint) (uint, error) {
+	var position uint
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		position, err = reposition(tx, testimonialOrder, id, version, 0, at)
+		return err
+	})
+	return position, err
+}
+
+// BulkUpdatePositions puts several testimonials of a portfolio at the given
+// positions in a transaction; the others keep their relative order
+func (r *testimonialRepository) BulkUpdatePositions(portfolioID uint, items []ordering.Item) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return arrange(tx, testimonialOrder, portfolioID, items)
+	})
+}
+
+func (r *testimonialRepository) Delete(id uint, version uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		before, err := lockSlot(tx, testimonialOrder, id)
+		if err != nil {
+			return err
+		}
+		if err := deleteVersioned(tx, &models.Testimonial{}, id, version); err != nil {
+			return err
+		}
+		if err := renumber(tx, testimonialOrder, before.Parent); err != nil {
+			return err
+		}
+		return recordChange(tx, "testimonial", "deleted", id, map[string]interface{}{"id": id})
+	})
+}
+
+// ProjectInPortfolio reports whether the project is shown in one of the
+// categories of the portfolio
+func (r *testimonialRepository) ProjectInPortfolio(projectID uint, portfolioID uint) (bool, error) {
+	var count int64
+	err := r.db.Table("project_categories").
+		Joins("JOIN categories ON categories.id = project_categories.category_id AND categories.deleted_at IS NULL").
+		Joins("JOIN projects ON projects.id = project_categories.project_id AND projects.deleted_at IS NULL").
+		Where("project_categories.project_id = ? AND categories.portfolio_id = ?", projectID, portfolioID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *testimonialRepository) CreateInvite(invite *models.TestimonialInvite) error {
+	return r.db.Create(invite).Error
+}
+
+// GetInvitesByPortfolioID lists the invites of a portfolio, newest first
+func (r *testimonialRepository) GetInvitesByPortfolioID(portfolioID uint) ([]models.TestimonialInvite, error) {
+	var invites []models.TestimonialInvite
+	err := r.db.Where("portfolio_id = ?", portfolioID).
+		Order("id DESC").
+		Find(&invites).Error
+	return invites, err
+}
+
+func (r *testimonialRepository) GetInviteByID(id uint) (*models.TestimonialInvite, error) {
+	var invite models.TestimonialInvite
+	err := r.db.First(&invite, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+func (r *testimonialRepository) GetInviteByToken(token string) (*models.TestimonialInvite, error) {
+	var invite models.TestimonialInvite
+	err := r.db.Where("token = ?", token).First(&invite).Error
+	if err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+// DeleteInvite revokes the invite; testimonials already submitted through it stay
+func (r *testimonialRepository) DeleteInvite(id uint) error {
+	return r.db.Delete(&models.TestimonialInvite{}, id).Error
+}
+
+// deletePortfolioTestimonials deletes the testimonials and invites of a portfolio
+func deletePortfolioTestimonials(tx *gorm.DB, portfolioID uint) error {
+	if err := tx.Where("portfolio_id = ?", portfolioID).Delete(&models.Testimonial{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("portfolio_id = ?", portfolioID).Delete(&models.TestimonialInvite{}).Error
+}
@@ -0,0 +1,133 @@
+package repo
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type translationRepository struct {
+	db *gorm.DB
+}
+
+func NewTranslationRepository(db *gorm.DB) TranslationRepository {
+	return &translationRepository{
+		db: db,
+	}
+}
+
+// GetByPortfolioID returns the translations of a portfolio in locale, or in
+// every locale when locale is empty
+func (r *translationRepository) GetByPortfolioID(portfolioID uint, locale string) ([]models.Translation, error) {
+	var translations []models.Translation
+	query := r.db.Where("portfolio_id = ?", portfolioID)
+	if locale != "" {
+		query = query.Where("locale = ?", locale)
+	}
+	err := query.Order("locale ASC, resource_type ASC, resource_id ASC, field ASC").
+		Find(&translations).Error
+	return translations, err
+}
+
+// Save upserts the translations with a value and deletes those without one,
+// all in one transaction
+func (r *translationRepository) Save(translations []models.Translation) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i := range translations {
+			t := &translations[i]
+			if t.Value == "" {
+				if err := tx.Where("locale = ? AND resource_type = ? AND resource_id = ? AND field = ?",
+					t.Locale, t.ResourceType, t.ResourceID, t.Field).
+					Delete(&models.Translation{}).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "locale"}, {Name: "resource_type"}, {Name: "resource_id"}, {Name: "field"}},
+				DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+			}).Create(t).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteLocale removes every translation of a portfolio in locale
+func (r *translationRepository) DeleteLocale(portfolioID uint, locale string) (int64, error) {
+	result := r.db.Where("portfolio_id = ? AND locale = ?", portfolioID, locale).
+		Delete(&models.Translation{})
+	return result.RowsAffected, result.Error
+}
+
+// GetPortfolioOf returns the locale settings of the portfolio a resource
+// belongs to; resourceType is one of the Translation* constants
+func (r *translationRepository) GetPortfolioOf(resourceType string, id uint) (*models.Portfolio, error) {
+	var portfolio models.Portfolio
+	err := r.db.Select("id, owner_id, default_locale, locales").
+		Where("id = (?)", gorm.Expr(portfolioLookups[resourceType], id)).
+		First(&portfolio).Error
+	return &portfolio, err
+}
+
+// GetSources returns the stored text of every translatable field of the
+// portfolio that isn't empty. Image blocks are left out: their content is a URL.
+func (r *translationRepository) GetSources(portfolioID uint) ([]models.TranslationSource, error) {
+	type row struct {
+		ResourceType string
+		ResourceID   uint
+		Field        string
+		Value        string
+	}
+
+	var rows []row
+	err := r.db.Raw(`
+		SELECT 'portfolio' AS resource_type, id AS resource_id, 'title' AS field, title AS value
+			FROM portfolios WHERE id = @id AND deleted_at IS NULL
+		UNION ALL SELECT 'portfolio', id, 'description', COALESCE(description, '')
+			FROM portfolios WHERE id = @id AND deleted_at IS NULL
+		UNION ALL SELECT 'category', id, 'title', title
+			FROM categories WHERE portfolio_id = @id AND deleted_at IS NULL
+		UNION ALL SELECT 'category', id, 'description', COALESCE(description, '')
+			FROM categories WHERE portfolio_id = @id AND deleted_at IS NULL
+		UNION ALL SELECT 'project', projects.id, 'title', projects.title
+			FROM projects WHERE projects.deleted_at IS NULL AND projects.id IN (
+				SELECT project_categories.project_id FROM project_categories
+				JOIN categories ON categories.id = project_categories.category_id
+				WHERE categories.portfolio_id = @id AND categories.deleted_at IS NULL)
+		UNION ALL SELECT 'project', projects.id, 'description', projects.description
+			FROM projects WHERE projects.deleted_at IS NULL AND projects.id IN (
+				SELECT project_categories.project_id FROM project_categories
+				JOIN categories ON categories.id = project_categories.category_id
+				WHERE categories.portfolio_id = @id AND categories.deleted_at IS NULL)
+		UNION ALL SELECT 'section', id, 'title', title
+			FROM sections WHERE portfolio_id = @id AND deleted_at IS NULL
+		UNION ALL SELECT 'section', id, 'description', COALESCE(description, '')
+			FROM sections WHERE portfolio_id = @id AND deleted_at IS NULL
+		UNION ALL SELECT 'section_content', section_contents.id, 'content', section_contents.content
+			FROM section_contents JOIN sections ON sections.id = section_contents.section_id
+			WHERE sections.portfolio_id = @id AND section_contents.type = 'text'
+				AND section_contents.deleted_at IS NULL AND sections.deleted_at IS NULL
+		ORDER BY 1, 2, 3`, map[string]interface{}{"id": portfolioID}).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]models.TranslationSource, 0, len(rows))
+	for _, row := range rows {
+		if row.Value == "" {
+			continue
+		}
+		sources = append(sources, models.TranslationSource{
+			TranslationKey: models.TranslationKey{
+				ResourceType: row.ResourceType,
+				ResourceID:   row.ResourceID,
+				Field:        row.Field,
+			},
+			Value: row.Value,
+		})
+	}
+	return sources, nil
+}
@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"errors"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type userStatusRepository struct {
+	db *gorm.DB
+}
+
+func NewUserStatusRepository(db *gorm.DB) UserStatusRepository {
+	return &userStatusRepository{
+		db: db,
+	}
+}
+
+// GetByOwnerID returns the stored status, or an active status when the owner
+// has none
+func (r *userStatusRepository) GetByOwnerID(ownerID string) (*models.UserStatus, error) {
+	var status models.UserStatus
+	err := r.db.Where("owner_id = ?", ownerID).First(&status).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.UserStatus{OwnerID: ownerID, Status: models.UserStatusActive}, nil
+	}
+	return &status, err
+}
+
+// IsHidden reports whether the owner is suspended or deleted
+func (r *userStatusRepository) IsHidden(ownerID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.UserStatus{}).
+		Where("owner_id = ? AND status IN ?", ownerID, []string{models.UserStatusSuspended, models.UserStatusDeleted}).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Save upserts the owner's status and records the event in one transaction
+func (r *userStatusRepository) Save(status *models.UserStatus, event *models.UserLifecycleEvent) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "owner_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"status", "username", "updated_at"}),
+		}).Create(status).Error; err != nil {
+			return err
+		}
+		return tx.Create(event).Error
+	})
+}
+
+// GetEvents returns the recorded lifecycle events of an owner, oldest first
+func (r *userStatusRepository) GetEvents(ownerID string) ([]models.UserLifecycleEvent, error) {
+	var events []models.UserLifecycleEvent
+	err := r.db.Where("owner_id = ?", ownerID).
+		Order("created_at ASC, id ASC").
+		Find(&events).Error
+	return events, err
+}
@@ -0,0 +1,72 @@
+package repo
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// ErrVersionConflict is returned when a write expects a version the row no longer has
+var ErrVersionConflict = errors.New("version conflict: resource was modified by another request")
+
+// updateVersioned writes the non-zero fields of model only while the stored row
+// still has the expected version, bumping the version in the same statement.
+// version points at the model's Version field and holds the expected value;
+// on success it holds the new one. When columns are given only those are
+// written, zero values included, which is how PATCH clears fields.
+func updateVersioned(db *gorm.DB, model interface{}, id uint, version *uint, columns ...string) error {
+	expected := *version
+	*version = expected + 1
+
+	if len(columns) > 0 {
+		db = db.Select(append(columns, "version"))
+	}
+
+	result := db.Model(model).Where("id = ? AND version = ?", id, expected).Updates(model)
+	if result.Error != nil {
+		*version = expected
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		*version = expected
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// updateColumnsVersioned updates the given columns and bumps the version.
+// A zero version skips the check (internal callers that already hold the row).
+func updateColumnsVersioned(db *gorm.DB, model interface{}, id uint, version uint, columns map[string]interface{}) error {
+	columns["version"] = gorm.Expr("version + 1")
+
+	query := db.Model(model).Where("id = ?", id)
+	if version != 0 {
+		query = query.Where("version = ?", version)
+	}
+
+	result := query.Updates(columns)
+	if result.Error != nil {
+		return result.Error
+	}
+	if version != 0 && result.RowsAffected == 0 {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// deleteVersioned soft deletes the row, checking the version when it is non-zero
+func deleteVersioned(db *gorm.DB, model interface{}, id uint, version uint) error {
+	query := db.Where("id = ?", id)
+	if version != 0 {
+		query = query.Where("version = ?", version)
+	}
+
+	result := query.Delete(model)
+	if result.Error != nil {
+		return result.Error
+	}
+	if version != 0 && result.RowsAffected == 0 {
+		return ErrVersionConflict
+	}
+	return nil
+}
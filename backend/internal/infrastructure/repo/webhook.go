@@ -0,0 +1,148 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &webhookRepository{
+		db: db,
+	}
+}
+
+func (r *webhookRepository) Create(webhook *models.Webhook) error {
+	return r.db.Create(webhook).Error
+}
+
+func (r *webhookRepository) GetByID(id uint) (*models.Webhook, error) {
+	var webhook models.Webhook
+	err := r.db.First(&webhook, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// GetByOwnerID lists the user's webhooks, optionally only those of one portfolio
+func (r *webhookRepository) GetByOwnerID(ownerID string, portfolioID uint, limit, offset int) ([]models.Webhook, int64, error) {
+	var webhooks []models.Webhook
+	var total int64
+
+	query := r.db.Model(&models.Webhook{}).Where("owner_id = ?", ownerID)
+	if portfolioID != 0 {
+		query = query.Where("portfolio_id = ?", portfolioID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("id").Limit(limit).Offset(offset).Find(&webhooks).Error
+	return webhooks, total, err
+}
+
+// Update writes the URL, event filters and active flag if webhook.Version still
+// matches the stored row, returning ErrVersionConflict otherwise
+func (r *webhookRepository) Update(webhook *models.Webhook) error {
+	return updateVersioned(r.db, webhook, webhook.ID, &webhook.Version, "url", "events", "active")
+}
+
+// Delete soft deletes the webhook and gives up on its pending deliveries
+func (r *webhookRepository) Delete(id uint, version uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := deleteVersioned(tx, &models.Webhook{}, id, version); err != nil {
+			return err
+		}
+		return tx.Model(&models.WebhookDelivery{}).
+			Where("webhook_id = ? AND status = ?", id, models.WebhookDeliveryPending).
+			Updates(map[string]interface{}{
+				"status":     models.WebhookDeliveryFailed,
+				"last_error": "webhook deleted",
+			}).Error
+	})
+}
+
+// GetDeliveries returns the delivery log of a webhook, newest first
+func (r *webhookRepository) GetDeliveries(webhookID uint, limit, offset int) ([]models.WebhookDelivery, int64, error) {
+	var deliveries []models.WebhookDelivery
+	var total int64
+
+	query := r.db.Model(&models.WebhookDelivery{}).Where("webhook_id = ?", webhookID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("id DESC").Limit(limit).Offset(offset).Find(&deliveries).Error
+	return deliveries, total, err
+}
+
+// ClaimDueDeliveries picks up to limit pending deliveries whose next attempt is
+// due and pushes their next attempt out by lease, so other dispatchers skip
+// them while this one sends. The webhook is loaded even if it was deleted with
+// its portfolio, so the final portfolio.deleted event still goes out.
+func (r *webhookRepository) ClaimDueDeliveries(now time.Time, limit int, lease time.Duration) ([]models.WebhookDelivery, error) {
+	var ids []uint
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.WebhookDelivery{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, now).
+			Order("next_attempt_at").
+			Limit(limit).
+			Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		return tx.Model(&models.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	var deliveries []models.WebhookDelivery
+	err = r.db.Preload("Webhook", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Where("id IN ?", ids).
+		Order("id").
+		Find(&deliveries).Error
+	return deliveries, err
+}
+
+// MarkDelivered records a successful attempt
+func (r *webhookRepository) MarkDelivered(id uint, statusCode int) error {
+	return r.db.Model(&models.WebhookDelivery{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":           models.WebhookDeliveryDelivered,
+		"attempts":         gorm.Expr("attempts + 1"),
+		"last_status_code": statusCode,
+		"last_error":       "",
+		"delivered_at":     time.Now(),
+	}).Error
+}
+
+// MarkAttemptFailed records a failed attempt. The delivery is retried at
+// nextAttempt, or marked failed for good when nextAttempt is nil.
+func (r *webhookRepository) MarkAttemptFailed(id uint, statusCode int, message string, nextAttempt *time.Time) error {
+	columns := map[string]interface{}{
+		"attempts":         gorm.Expr("attempts + 1"),
+		"last_status_code": statusCode,
+		"last_error":       message,
+	}
+	if nextAttempt != nil {
+		columns["next_attempt_at"] = *nextAttempt
+	} else {
+		columns["status"] = models.WebhookDeliveryFailed
+	}
+	return r.db.Model(&models.WebhookDelivery{}).Where("id = ?", id).Updates(columns).Error
+}
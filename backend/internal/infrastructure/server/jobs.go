@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/analytics"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/contactmail"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/gitimport"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/jobs"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/linkcheck"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/metrics"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/notify"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/stream"
+	"gorm.io/gorm"
+)
+
+// Background job types. Each is scheduled under the same name.
+const (
+	jobPurgeIdempotencyKeys = "idempotency_keys.purge"
+	jobPurgePortfolioEvents = "portfolio_events.purge"
+	jobPurgeJobs            = "jobs.purge"
+	jobAnalyticsRollup      = "analytics.rollup"
+	jobSyncImportedProjects = "projects.sync_imported"
+	jobCheckLinks           = "links.check"
+)
+
+// newJobRunner registers the background jobs and schedules their periodic runs
+func newJobRunner(db *gorm.DB, collector *metrics.Collector) *jobs.Runner {
+	runner := jobs.NewRunner(repo.NewJobRepository(db), collector)
+
+	// Remove idempotency keys past their TTL
+	keys := repo.NewIdempotencyKeyRepository(db)
+	jobs.Register(runner, jobPurgeIdempotencyKeys, func(ctx context.Context, _ struct{}) error {
+		_, err := keys.DeleteExpired(time.Now())
+		return err
+	})
+	runner.Schedule(jobPurgeIdempotencyKeys, jobs.Every(time.Hour), jobPurgeIdempotencyKeys)
+
+	// Drop change stream events too old to resume from
+	events := repo.NewPortfolioEventRepository(db)
+	jobs.Register(runner, jobPurgePortfolioEvents, func(ctx context.Context, _ struct{}) error {
+		_, err := events.DeleteBefore(time.Now().Add(-stream.Retention))
+		return err
+	})
+	runner.Schedule(jobPurgePortfolioEvents, jobs.Every(time.Hour), jobPurgePortfolioEvents)
+
+	// Remove succeeded jobs past their retention; dead jobs stay until handled
+	queue := repo.NewJobRepository(db)
+	retention := jobRetention()
+	jobs.Register(runner, jobPurgeJobs, func(ctx context.Context, _ struct{}) error {
+		_, err := queue.DeleteSucceededBefore(time.Now().Add(-retention))
+		return err
+	})
+	runner.Schedule(jobPurgeJobs, jobs.Every(time.Hour), jobPurgeJobs)
+
+	// Roll page views up into daily counts
+	aggregator := analytics.NewAggregator(repo.NewAnalyticsRepository(db))
+	jobs.Register(runner, jobAnalyticsRollup, func(ctx context.Context, _ struct{}) error {
+		return aggregator.Rollup()
+	})
+	runner.Schedule(jobAnalyticsRollup, jobs.Every(analyticsRollupInterval()), jobAnalyticsRollup)
+
+	// Refresh imported projects that track their repository. A project is due
+	// when it wasn't synced, nor failed to, within the interval.
+	importer := gitimport.NewImporter(gitimport.NewProviders(), repo.NewProjectRepository(db), repo.NewProjectSourceRepository(db))
+	syncInterval := gitImportSyncInterval()
+	jobs.Register(runner, jobSyncImportedProjects, func(ctx context.Context, _ struct{}) error {
+		_, err := importer.SyncDue(ctx, time.Now().Add(-syncInterval))
+		return err
+	}, jobs.WithTimeout(30*time.Minute))
+	runner.Schedule(jobSyncImportedProjects, jobs.Every(syncInterval), jobSyncImportedProjects)
+
+	// Check the URLs stored in projects and image contents. A URL is due when
+	// it wasn't checked within the interval.
+	checker := linkcheck.NewChecker(repo.NewLinkCheckRepository(db), collector)
+	checkInterval := linkCheckInterval()
+	jobs.Register(runner, jobCheckLinks, func(ctx context.Context, _ struct{}) error {
+		_, err := checker.CheckDue(ctx, time.Now().Add(-checkInterval))
+		return err
+	}, jobs.WithTimeout(time.Hour))
+	runner.Schedule(jobCheckLinks, jobs.Every(checkInterval), jobCheckLinks)
+
+	// Send the mails of contact messages, queued as they arrive
+	sender := contactmail.NewSender(repo.NewContactRepository(db), repo.NewPortfolioRepository(db), notify.NewNotifier())
+	jobs.Register(runner, contactmail.JobNotifyOwner, sender.NotifyOwner, jobs.WithTimeout(contactmail.Timeout))
+	jobs.Register(runner, contactmail.JobAutoReply, sender.SendAutoReply, jobs.WithTimeout(contactmail.Timeout))
+
+	return runner
+}
@@ -9,7 +9,11 @@ import (
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/router"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/db"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/jobs"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/metrics"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/stream"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/webhook"
 	middleware2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/middleware"
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -25,17 +29,35 @@ type Server struct {
 	metrics *metrics.Collector
 	logger  *logrus.Logger
 	router  *router.Router
+	hub     *stream.Hub
+	dsn     string
+	jobs    *jobs.Runner
+
+	// Background work runs until stop is called on shutdown; jobsDone is
+	// closed once the job runner returned
+	background context.Context
+	stop       context.CancelFunc
+	jobsDone   chan struct{}
 }
 
 func NewServer(port string, database *db.Database, logger *logrus.Logger) *Server {
 	metricsCollector := metrics.NewCollector()
+	hub := stream.NewHub(repo.NewPortfolioEventRepository(database.DB))
+	runner := newJobRunner(database.DB, metricsCollector)
+	background, stop := context.WithCancel(context.Background())
 
 	return &Server{
-		port:    port,
-		db:      database.DB,
-		logger:  logger,
-		metrics: metricsCollector,
-		router:  router.NewRouter(database.DB, metricsCollector),
+		port:       port,
+		db:         database.DB,
+		logger:     logger,
+		metrics:    metricsCollector,
+		router:     router.NewRouter(database.DB, metricsCollector, hub, runner),
+		hub:        hub,
+		dsn:        database.DSN(),
+		jobs:       runner,
+		background: background,
+		stop:       stop,
+		jobsDone:   make(chan struct{}),
 	}
 }
 
@@ -45,7 +67,23 @@ func (s *Server) Start() error {
 	s.setupRoutes()
 
 	// Start background metrics collection (runs every 30 seconds)
-	s.metrics.StartMetricsCollection(s.db)
+	s.metrics.StartMetricsCollection(s.background, s.db)
+
+	// Forget idle visitors of the rate limiters
+	middleware2.StartRateLimitCleanup(s.background)
+
+	// Run background jobs: purges, rollups, resyncs and link checks are
+	// scheduled in newJobRunner
+	go func() {
+		defer close(s.jobsDone)
+		s.jobs.Run(s.background)
+	}()
+
+	// Deliver queued webhook events in the background
+	go webhook.NewDispatcher(repo.NewWebhookRepository(s.db)).Run(s.background, webhookDispatchInterval())
+
+	// Feed portfolio change streams from PostgreSQL NOTIFY
+	go s.hub.Listen(s.background, s.dsn)
 
 	s.server = &http.Server{
 		Addr:         ":" + s.port,
@@ -59,9 +97,24 @@ func (s *Server) Start() error {
 	return s.server.ListenAndServe()
 }
 
+// Shutdown stops accepting requests and waits for the ones in progress, then
+// stops the background work and waits, as long as ctx allows, for the jobs in
+// progress to finish. Jobs still running when ctx ends are claimed again by
+// another worker once their visibility timeout passes.
 func (s *Server) Shutdown(ctx context.Context) error {
 	s.logger.Info("Server shutting down")
-	return s.server.Shutdown(ctx)
+	var err error
+	if s.server != nil {
+		err = s.server.Shutdown(ctx)
+	}
+
+	s.stop()
+	select {
+	case <-s.jobsDone:
+	case <-ctx.Done():
+		s.logger.Warn("Background jobs still running at shutdown")
+	}
+	return err
 }
 
 func (s *Server) setupEngine() {
@@ -74,6 +127,7 @@ func (s *Server) setupEngine() {
 func (s *Server) setupMiddleware() {
 	// Security middleware (applied first)
 	s.engine.Use(middleware2.RequestID())        // Add request ID for tracing
+	s.engine.Use(middleware2.Locale())           // Language of error messages
 	s.engine.Use(middleware2.PanicRecovery())    // Enhanced panic recovery
 	s.engine.Use(middleware2.ErrorLogging())     // Error logging (4xx/5xx)
 	s.engine.Use(middleware2.SecurityHeaders())  // Security headers
@@ -126,12 +180,10 @@ func (s *Server) setupRoutes() {
 
 	// API group
 	api := s.engine.Group("/api")
-	s.router.RegisterPortfolioRoutes(api)
-	s.router.RegisterCategoryRoutes(api)
-	s.router.RegisterProjectRoutes(api)
-	s.router.RegisterSectionRoutes(api)
-	s.router.RegisterSectionContentRoutes(api)
-	s.router.RegisterUserRoutes(api)
+	s.router.RegisterRoutes(api)
+
+	// OpenAPI document and UI, generated from the routes registered above
+	s.router.RegisterDocsRoutes(api, s.engine.Routes)
 }
 
 func (s *Server) healthHandler(c *gin.Context) {
@@ -176,6 +228,57 @@ func (s *Server) readinessHandler(c *gin.Context) {
 	})
 }
 
+// webhookDispatchInterval reads WEBHOOK_DISPATCH_INTERVAL (e.g. "5s"), defaulting to 5 seconds
+func webhookDispatchInterval() time.Duration {
+	if value := os.Getenv("WEBHOOK_DISPATCH_INTERVAL"); value != "" {
+		if interval, err := time.ParseDuration(value); err == nil && interval > 0 {
+			return interval
+		}
+	}
+	return 5 * time.Second
+}
+
+// analyticsRollupInterval reads ANALYTICS_ROLLUP_INTERVAL (e.g. "5m"), defaulting to 5 minutes
+func analyticsRollupInterval() time.Duration {
+	if value := os.Getenv("ANALYTICS_ROLLUP_INTERVAL"); value != "" {
+		if interval, err := time.ParseDuration(value); err == nil && interval > 0 {
+			return interval
+		}
+	}
+	return 5 * time.Minute
+}
+
+// gitImportSyncInterval reads GIT_IMPORT_SYNC_INTERVAL (e.g. "6h"), defaulting to 24 hours
+func gitImportSyncInterval() time.Duration {
+	if value := os.Getenv("GIT_IMPORT_SYNC_INTERVAL"); value != "" {
+		if interval, err := time.ParseDuration(value); err == nil && interval > 0 {
+			return interval
+		}
+	}
+	return 24 * time.Hour
+}
+
+// linkCheckInterval reads LINK_CHECK_INTERVAL (e.g. "12h"), defaulting to 24 hours
+func linkCheckInterval() time.Duration {
+	if value := os.Getenv("LINK_CHECK_INTERVAL"); value != "" {
+		if interval, err := time.ParseDuration(value); err == nil && interval > 0 {
+			return interval
+		}
+	}
+	return 24 * time.Hour
+}
+
+// jobRetention reads JOB_RETENTION (e.g. "72h"), how long succeeded jobs are
+// kept, defaulting to 7 days
+func jobRetention() time.Duration {
+	if value := os.Getenv("JOB_RETENTION"); value != "" {
+		if retention, err := time.ParseDuration(value); err == nil && retention > 0 {
+			return retention
+		}
+	}
+	return 7 * 24 * time.Hour
+}
+
 func (s *Server) loggingMiddleware() gin.HandlerFunc {
 	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
 		// Don't log successful requests to audit.log - only log errors
@@ -236,8 +339,8 @@ func (s *Server) corsMiddleware() gin.HandlerFunc {
 		}
 
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID")
-		c.Header("Access-Control-Expose-Headers", "X-Request-ID")
+		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID, If-Match, Idempotency-Key")
+		c.Header("Access-Control-Expose-Headers", "X-Request-ID, ETag, Idempotent-Replayed")
 		c.Header("Access-Control-Max-Age", "86400") // 24 hours
 
 		if c.Request.Method == "OPTIONS" {
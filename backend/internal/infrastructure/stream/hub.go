@@ -0,0 +1,191 @@
+package stream
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Retention is how long events are kept for clients resuming with Last-Event-ID
+	Retention = 24 * time.Hour
+
+	// subscriberBuffer is how many events a subscriber may fall behind before
+	// it is dropped; the client then reconnects and catches up from the database
+	subscriberBuffer = 64
+)
+
+// Subscription receives the events of one portfolio. Events is closed when the
+// subscriber is dropped, either because it fell behind or the listener lost
+// its connection and events may have been missed.
+type Subscription struct {
+	PortfolioID uint
+	Events      chan models.PortfolioEvent
+}
+
+// Hub fans out portfolio events announced through PostgreSQL NOTIFY to the
+// subscribers connected to this replica. Every replica runs its own listener,
+// so a change made through any of them reaches every client.
+type Hub struct {
+	events      repo.PortfolioEventRepository
+	mu          sync.Mutex
+	subscribers map[uint]map[*Subscription]struct{}
+}
+
+func NewHub(events repo.PortfolioEventRepository) *Hub {
+	return &Hub{
+		events:      events,
+		subscribers: make(map[uint]map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe registers a subscriber for the portfolio's events
+func (h *Hub) Subscribe(portfolioID uint) *Subscription {
+	sub := &Subscription{
+		PortfolioID: portfolioID,
+		Events:      make(chan models.PortfolioEvent, subscriberBuffer),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subscribers[portfolioID] == nil {
+		h.subscribers[portfolioID] = make(map[*Subscription]struct{})
+	}
+	h.subscribers[portfolioID][sub] = struct{}{}
+	return sub
+}
+
+// Unsubscribe removes the subscriber; it is safe to call more than once
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(sub)
+}
+
+// Broadcast sends the event to the portfolio's subscribers, dropping any that
+// can't keep up
+func (h *Hub) Broadcast(event models.PortfolioEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[event.PortfolioID] {
+		select {
+		case sub.Events <- event:
+		default:
+			h.remove(sub)
+		}
+	}
+}
+
+// Listen receives notifications on repo.PortfolioEventChannel until ctx is
+// cancelled, broadcasting each announced event
+func (h *Hub) Listen(ctx context.Context, dsn string) {
+	listener := pq.NewListener(dsn, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation": "EVENT_STREAM_LISTENER_ERROR",
+				"where":     "backend/internal/infrastructure/stream/hub.go",
+				"function":  "Listen",
+				"event":     event,
+				"error":     err.Error(),
+			}).Error("Portfolio event listener connection problem")
+		}
+	})
+	defer listener.Close()
+
+	if err := listener.Listen(repo.PortfolioEventChannel); err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "EVENT_STREAM_LISTEN_ERROR",
+			"where":     "backend/internal/infrastructure/stream/hub.go",
+			"function":  "Listen",
+			"error":     err.Error(),
+		}).Error("Failed to listen for portfolio events")
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case notification := <-listener.Notify:
+			if notification == nil {
+				// The connection was re-established and notifications sent in
+				// between are lost; make every client catch up from the database
+				h.dropAll()
+				continue
+			}
+			h.handle(notification.Extra)
+		case <-time.After(90 * time.Second):
+			go func() { _ = listener.Ping() }()
+		}
+	}
+}
+
+// handle loads the event named by a "<portfolio id>:<event id>" payload and
+// broadcasts it, skipping the lookup when nobody here is watching the portfolio
+func (h *Hub) handle(payload string) {
+	portfolioPart, eventPart, ok := strings.Cut(payload, ":")
+	portfolioID, err := strconv.ParseUint(portfolioPart, 10, 32)
+	if !ok || err != nil {
+		return
+	}
+	eventID, err := strconv.ParseUint(eventPart, 10, 32)
+	if err != nil {
+		return
+	}
+
+	h.mu.Lock()
+	watched := len(h.subscribers[uint(portfolioID)]) > 0
+	h.mu.Unlock()
+	if !watched {
+		return
+	}
+
+	event, err := h.events.GetByID(uint(eventID))
+	if err != nil {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation":   "EVENT_STREAM_LOAD_ERROR",
+			"where":       "backend/internal/infrastructure/stream/hub.go",
+			"function":    "handle",
+			"portfolioID": portfolioID,
+			"eventID":     eventID,
+			"error":       err.Error(),
+		}).Error("Failed to load portfolio event")
+		return
+	}
+	h.Broadcast(*event)
+}
+
+func (h *Hub) dropAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, subs := range h.subscribers {
+		for sub := range subs {
+			h.remove(sub)
+		}
+	}
+}
+
+// remove must be called with mu held
+func (h *Hub) remove(sub *Subscription) {
+	subs, ok := h.subscribers[sub.PortfolioID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	close(sub.Events)
+	if len(subs) == 0 {
+		delete(h.subscribers, sub.PortfolioID)
+	}
+}
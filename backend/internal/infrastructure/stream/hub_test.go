@@ -0,0 +1,101 @@
+package stream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEvents serves events from memory and counts lookups
+type fakeEvents struct {
+	events  map[uint]models.PortfolioEvent
+	lookups int
+}
+
+func (f *fakeEvents) GetByID(id uint) (*models.PortfolioEvent, error) {
+	f.lookups++
+	event, ok := f.events[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &event, nil
+}
+
+func (f *fakeEvents) GetSince(portfolioID uint, afterID uint, limit int) ([]models.PortfolioEvent, error) {
+	return nil, nil
+}
+
+func (f *fakeEvents) DeleteBefore(cutoff time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestBroadcast_RoutesByPortfolio(t *testing.T) {
+	hub := NewHub(&fakeEvents{})
+	first := hub.Subscribe(1)
+	second := hub.Subscribe(2)
+
+	hub.Broadcast(models.PortfolioEvent{ID: 10, PortfolioID: 1, Event: "section.created"})
+
+	require.Len(t, first.Events, 1)
+	assert.Equal(t, uint(10), (<-first.Events).ID)
+	assert.Len(t, second.Events, 0)
+}
+
+func TestBroadcast_DropsSlowSubscriber(t *testing.T) {
+	hub := NewHub(&fakeEvents{})
+	sub := hub.Subscribe(1)
+
+	for i := 0; i <= subscriberBuffer; i++ {
+		hub.Broadcast(models.PortfolioEvent{ID: uint(i + 1), PortfolioID: 1})
+	}
+
+	received := 0
+	for range sub.Events {
+		received++
+	}
+	assert.Equal(t, subscriberBuffer, received, "channel is closed once the buffer overflows")
+
+	// Unsubscribing a dropped subscriber is a no-op
+	hub.Unsubscribe(sub)
+}
+
+func TestHandle(t *testing.T) {
+	events := &fakeEvents{events: map[uint]models.PortfolioEvent{
+		7: {ID: 7, PortfolioID: 3, Event: "project.updated"},
+	}}
+	hub := NewHub(events)
+
+	// Nobody is watching portfolio 3 yet, so the event isn't loaded
+	hub.handle("3:7")
+	assert.Equal(t, 0, events.lookups)
+
+	sub := hub.Subscribe(3)
+	for _, payload := range []string{"", "3", "x:7", "3:y"} {
+		hub.handle(payload)
+	}
+	assert.Equal(t, 0, events.lookups)
+
+	hub.handle("3:7")
+	require.Len(t, sub.Events, 1)
+	assert.Equal(t, "project.updated", (<-sub.Events).Event)
+
+	hub.handle("3:8")
+	assert.Len(t, sub.Events, 0)
+}
+
+func TestDropAll(t *testing.T) {
+	hub := NewHub(&fakeEvents{})
+	first := hub.Subscribe(1)
+	second := hub.Subscribe(2)
+
+	hub.dropAll()
+
+	_, open := <-first.Events
+	assert.False(t, open)
+	_, open = <-second.Events
+	assert.False(t, open)
+}
@@ -0,0 +1,208 @@
+// Package webhook sends the deliveries queued in the webhook outbox
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/netguard"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultMaxAttempts = 8
+	baseBackoff        = 30 * time.Second
+	maxBackoff         = 6 * time.Hour
+	requestTimeout     = 10 * time.Second
+	maxErrorLength     = 500
+	// claimLease must outlast one request plus recording its outcome
+	claimLease = 2 * requestTimeout
+)
+
+// Dispatcher sends pending outbox deliveries and retries failures with
+// exponential backoff until maxAttempts is reached
+type Dispatcher struct {
+	repo        repo.WebhookRepository
+	client      *http.Client
+	maxAttempts int
+	now         func() time.Time
+}
+
+// NewDispatcher creates a dispatcher configured from the environment:
+// WEBHOOK_MAX_ATTEMPTS (default 8) and WEBHOOK_ALLOW_PRIVATE_TARGETS, which
+// allows loopback and private network targets (off by default)
+func NewDispatcher(webhooks repo.WebhookRepository) *Dispatcher {
+	maxAttempts := defaultMaxAttempts
+	if value, err := strconv.Atoi(os.Getenv("WEBHOOK_MAX_ATTEMPTS")); err == nil && value > 0 {
+		maxAttempts = value
+	}
+
+	return &Dispatcher{
+		repo:        webhooks,
+		client:      newClient(os.Getenv("WEBHOOK_ALLOW_PRIVATE_TARGETS") == "true"),
+		maxAttempts: maxAttempts,
+		now:         time.Now,
+	}
+}
+
+// Run dispatches due deliveries every interval until ctx is cancelled
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := d.DispatchDue(ctx); err != nil {
+				audit.GetErrorLogger().WithFields(logrus.Fields{
+					"operation": "WEBHOOK_DISPATCH_ERROR",
+					"where":     "backend/internal/infrastructure/webhook/dispatcher.go",
+					"function":  "Run",
+					"error":     err.Error(),
+				}).Error("Failed to dispatch webhook deliveries")
+			}
+		}
+	}
+}
+
+// DispatchDue sends every delivery that is currently due and returns how many
+// were attempted. Deliveries are claimed one at a time right before sending, so
+// a lease never has to cover the time spent waiting behind slow endpoints and
+// other dispatchers can't pick up a delivery this one is still going to send.
+func (d *Dispatcher) DispatchDue(ctx context.Context) (int, error) {
+	attempted := 0
+	for ctx.Err() == nil {
+		deliveries, err := d.repo.ClaimDueDeliveries(d.now(), 1, claimLease)
+		if err != nil {
+			return attempted, err
+		}
+		if len(deliveries) == 0 {
+			break
+		}
+
+		d.deliver(ctx, &deliveries[0])
+		attempted++
+	}
+	return attempted, nil
+}
+
+// deliver sends one delivery and records the outcome
+func (d *Dispatcher) deliver(ctx context.Context, delivery *models.WebhookDelivery) {
+	if delivery.Webhook == nil {
+		d.record(delivery, 0, errors.New("webhook no longer exists"), false)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		d.record(delivery, 0, err, false)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "portfolio-manager-webhooks/1.0")
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, strconv.FormatUint(uint64(delivery.ID), 10))
+	req.Header.Set(SignatureHeader, Sign(delivery.Webhook.Secret, d.now().Unix(), delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		d.record(delivery, 0, err, !errors.Is(err, netguard.ErrPrivateTarget))
+		return
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if err := d.repo.MarkDelivered(delivery.ID, resp.StatusCode); err != nil {
+			logRecordError(delivery, err)
+		}
+		return
+	}
+	d.record(delivery, resp.StatusCode, fmt.Errorf("endpoint responded with %d", resp.StatusCode), true)
+}
+
+// record stores a failed attempt, scheduling a retry when one is allowed
+func (d *Dispatcher) record(delivery *models.WebhookDelivery, statusCode int, cause error, retry bool) {
+	message := cause.Error()
+	if len(message) > maxErrorLength {
+		message = message[:maxErrorLength]
+	}
+
+	var next *time.Time
+	attempt := delivery.Attempts + 1
+	if retry && attempt < d.maxAttempts {
+		at := d.now().Add(Backoff(attempt))
+		next = &at
+	}
+
+	audit.GetErrorLogger().WithFields(logrus.Fields{
+		"operation":  "WEBHOOK_DELIVERY_FAILED",
+		"where":      "backend/internal/infrastructure/webhook/dispatcher.go",
+		"function":   "record",
+		"deliveryID": delivery.ID,
+		"webhookID":  delivery.WebhookID,
+		"event":      delivery.Event,
+		"attempt":    attempt,
+		"statusCode": statusCode,
+		"willRetry":  next != nil,
+		"error":      message,
+	}).Warn("Webhook delivery failed")
+
+	if err := d.repo.MarkAttemptFailed(delivery.ID, statusCode, message, next); err != nil {
+		logRecordError(delivery, err)
+	}
+}
+
+func logRecordError(delivery *models.WebhookDelivery, err error) {
+	audit.GetErrorLogger().WithFields(logrus.Fields{
+		"operation":  "WEBHOOK_DELIVERY_RECORD_ERROR",
+		"where":      "backend/internal/infrastructure/webhook/dispatcher.go",
+		"function":   "logRecordError",
+		"deliveryID": delivery.ID,
+		"error":      err.Error(),
+	}).Error("Failed to record webhook delivery outcome")
+}
+
+// Backoff returns the wait before retrying after the given attempt number:
+// 30s, 1m, 2m, 4m... capped at 6h
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	wait := baseBackoff
+	for i := 1; i < attempt; i++ {
+		wait *= 2
+		if wait >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return wait
+}
+
+// newClient builds the HTTP client used for deliveries, refusing private
+// targets unless allowPrivate is set (see netguard.Transport)
+func newClient(allowPrivate bool) *http.Client {
+	return &http.Client{
+		Transport: netguard.Transport(allowPrivate),
+		Timeout:   requestTimeout,
+		// Don't follow redirects: the signed request is meant for the registered URL only
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
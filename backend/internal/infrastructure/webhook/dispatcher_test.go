@@ -0,0 +1,312 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRepository keeps deliveries in memory; only the dispatcher methods are implemented
+type fakeRepository struct {
+	mu         sync.Mutex
+	deliveries map[uint]*models.WebhookDelivery
+}
+
+func newFakeRepository(deliveries ...*models.WebhookDelivery) *fakeRepository {
+	repo := &fakeRepository{deliveries: make(map[uint]*models.WebhookDelivery)}
+	for _, delivery := range deliveries {
+		repo.deliveries[delivery.ID] = delivery
+	}
+	return repo
+}
+
+func (r *fakeRepository) Create(*models.Webhook) error          { return nil }
+func (r *fakeRepository) GetByID(uint) (*models.Webhook, error) { return nil, nil }
+func (r *fakeRepository) Update(*models.Webhook) error          { return nil }
+func (r *fakeRepository) Delete(uint, uint) error               { return nil }
+func (r *fakeRepository) GetByOwnerID(string, uint, int, int) ([]models.Webhook, int64, error) {
+	return nil, 0, nil
+}
+func (r *fakeRepository) GetDeliveries(uint, int, int) ([]models.WebhookDelivery, int64, error) {
+	return nil, 0, nil
+}
+
+func (r *fakeRepository) ClaimDueDeliveries(now time.Time, limit int, lease time.Duration) ([]models.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []models.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status == models.WebhookDeliveryPending && !delivery.NextAttemptAt.After(now) && len(due) < limit {
+			delivery.NextAttemptAt = now.Add(lease)
+			due = append(due, *delivery)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeRepository) MarkDelivered(id uint, statusCode int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delivery := r.deliveries[id]
+	delivery.Status = models.WebhookDeliveryDelivered
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	return nil
+}
+
+func (r *fakeRepository) MarkAttemptFailed(id uint, statusCode int, message string, nextAttempt *time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delivery := r.deliveries[id]
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	delivery.LastError = message
+	if nextAttempt != nil {
+		delivery.NextAttemptAt = *nextAttempt
+	} else {
+		delivery.Status = models.WebhookDeliveryFailed
+	}
+	return nil
+}
+
+func newDelivery(id uint, url string) *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		ID:            id,
+		WebhookID:     1,
+		Webhook:       &models.Webhook{URL: url, Secret: "top-secret"},
+		Event:         "project.created",
+		Payload:       []byte(`{"event":"project.created"}`),
+		Status:        models.WebhookDeliveryPending,
+		NextAttemptAt: time.Now().Add(-time.Second),
+	}
+}
+
+func newTestDispatcher(repo *fakeRepository, allowPrivate bool) *Dispatcher {
+	return &Dispatcher{
+		repo:        repo,
+		client:      newClient(allowPrivate),
+		maxAttempts: 3,
+		now:         time.Now,
+	}
+}
+
+func TestDispatchDue_SignedDelivery(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	delivery := newDelivery(1, server.URL)
+	repo := newFakeRepository(delivery)
+
+	attempted, err := newTestDispatcher(repo, true).DispatchDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempted)
+
+	require.NotNil(t, received)
+	assert.Equal(t, "project.created", received.Header.Get(EventHeader))
+	assert.Equal(t, "1", received.Header.Get(DeliveryHeader))
+	assert.True(t, Verify("top-secret", received.Header.Get(SignatureHeader), body))
+	assert.False(t, Verify("wrong-secret", received.Header.Get(SignatureHeader), body))
+
+	assert.Equal(t, models.WebhookDeliveryDelivered, delivery.Status)
+	assert.Equal(t, http.StatusNoContent, delivery.LastStatusCode)
+}
+
+func TestDispatchDue_RetriesWithBackoff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	delivery := newDelivery(1, server.URL)
+	repo := newFakeRepository(delivery)
+	dispatcher := newTestDispatcher(repo, true)
+
+	_, err := dispatcher.DispatchDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusServiceUnavailable, delivery.LastStatusCode)
+	assert.WithinDuration(t, time.Now().Add(Backoff(1)), delivery.NextAttemptAt, 5*time.Second)
+
+	// Not due yet, so nothing is sent
+	attempted, err := dispatcher.DispatchDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, attempted)
+
+	// Exhaust the remaining attempts
+	for delivery.Status == models.WebhookDeliveryPending {
+		delivery.NextAttemptAt = time.Now().Add(-time.Second)
+		_, err := dispatcher.DispatchDue(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, models.WebhookDeliveryFailed, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+}
+
+func TestDispatchDue_RefusesPrivateTargets(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+
+	delivery := newDelivery(1, server.URL)
+	repo := newFakeRepository(delivery)
+
+	_, err := newTestDispatcher(repo, false).DispatchDue(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, models.WebhookDeliveryFailed, delivery.Status)
+	assert.Contains(t, delivery.LastError, "private address")
+}
+
+func TestDispatchDue_SecondDispatcherDoesNotResend(t *testing.T) {
+	// Every request of the first dispatcher takes a full requestTimeout on the
+	// shared clock; the clock stands still while the second one runs so the
+	// in-flight request stays within its real timeout
+	var clockMu sync.Mutex
+	clock := time.Now()
+	secondRunning := false
+	now := func() time.Time {
+		clockMu.Lock()
+		defer clockMu.Unlock()
+		return clock
+	}
+
+	repo := newFakeRepository()
+	var second *Dispatcher
+	var secondRun sync.Once
+	var sentMu sync.Mutex
+	sent := make(map[string]int)
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clockMu.Lock()
+		if !secondRunning {
+			clock = clock.Add(requestTimeout)
+		}
+		clockMu.Unlock()
+
+		sentMu.Lock()
+		sent[r.Header.Get(DeliveryHeader)]++
+		requests++
+		third := requests == 3
+		sentMu.Unlock()
+
+		// Well past the lease of anything claimed when the first pass started,
+		// another replica's ticker fires
+		if third {
+			secondRun.Do(func() {
+				clockMu.Lock()
+				secondRunning = true
+				clockMu.Unlock()
+
+				_, err := second.DispatchDue(context.Background())
+				assert.NoError(t, err)
+			})
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	const total = 10
+	for id := uint(1); id <= total; id++ {
+		delivery := newDelivery(id, server.URL)
+		delivery.NextAttemptAt = now().Add(-time.Second)
+		repo.deliveries[id] = delivery
+	}
+
+	first := newTestDispatcher(repo, true)
+	first.now = now
+	second = newTestDispatcher(repo, true)
+	second.now = now
+
+	attempted, err := first.DispatchDue(context.Background())
+	require.NoError(t, err)
+	assert.Less(t, attempted, total)
+
+	assert.Len(t, sent, total)
+	for id, count := range sent {
+		assert.Equal(t, 1, count, "delivery %s was sent %d times", id, count)
+	}
+	for _, delivery := range repo.deliveries {
+		assert.Equal(t, models.WebhookDeliveryDelivered, delivery.Status)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{5, 8 * time.Minute},
+		{20, 6 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Backoff(tt.attempt), "attempt %d", tt.attempt)
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"event":"portfolio.updated"}`)
+	header := Sign("secret", 1700000000, body)
+
+	assert.Equal(t, "t=1700000000,v1=", header[:16])
+	assert.True(t, Verify("secret", header, body))
+	assert.False(t, Verify("secret", header, []byte(`{"event":"tampered"}`)))
+	assert.False(t, Verify("secret", "v1=abc", body))
+}
+
+func TestVerifyAt(t *testing.T) {
+	body := []byte(`{"event":"user.deleted","user_id":"abc"}`)
+	signedAt := time.Unix(1700000000, 0)
+	header := Sign("secret", signedAt.Unix(), body)
+
+	tests := []struct {
+		name   string
+		secret string
+		header string
+		body   []byte
+		now    time.Time
+		want   bool
+	}{
+		{"valid", "secret", header, body, signedAt.Add(time.Minute), true},
+		{"clock behind", "secret", header, body, signedAt.Add(-time.Minute), true},
+		{"replayed later", "secret", header, body, signedAt.Add(Tolerance + time.Second), false},
+		{"from the future", "secret", header, body, signedAt.Add(-Tolerance - time.Second), false},
+		{"wrong secret", "other", header, body, signedAt, false},
+		{"tampered body", "secret", header, []byte(`{"event":"user.renamed"}`), signedAt, false},
+		{"timestamp changed", "secret", strings.Replace(header, "t=1700000000", "t=1700000060", 1), body, signedAt, false},
+		{"without timestamp", "secret", header[strings.Index(header, "v1="):], body, signedAt, false},
+		{"empty secret", "", Sign("", signedAt.Unix(), body), body, signedAt, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, VerifyAt(tt.secret, tt.header, tt.body, tt.now))
+		})
+	}
+}
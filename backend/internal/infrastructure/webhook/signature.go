@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader carries "t=<unix seconds>,v1=<hex hmac>"
+	SignatureHeader = "X-Webhook-Signature"
+	// EventHeader names the event, e.g. "project.created"
+	EventHeader = "X-Webhook-Event"
+	// DeliveryHeader carries the delivery ID, stable across retries
+	DeliveryHeader = "X-Webhook-Delivery"
+)
+
+// Sign returns the signature header value for body. The HMAC-SHA256 is computed
+// over "<timestamp>.<body>" with the webhook secret, so receivers can reject
+// replays by checking the timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, hex.EncodeToString(computeMAC(secret, timestamp, body)))
+}
+
+// Tolerance is how far the timestamp of a signature checked by VerifyAt may
+// be from the receiver's clock
+const Tolerance = 5 * time.Minute
+
+// Verify checks a signature header produced by Sign
+func Verify(secret, header string, body []byte) bool {
+	timestamp, signature, ok := parseSignature(header)
+	return ok && hmac.Equal(signature, computeMAC(secret, timestamp, body))
+}
+
+// VerifyAt checks a signature header produced by Sign whose timestamp is
+// within Tolerance of now, so a captured request can't be replayed later
+func VerifyAt(secret, header string, body []byte, now time.Time) bool {
+	timestamp, signature, ok := parseSignature(header)
+	if !ok || secret == "" {
+		return false
+	}
+	if age := now.Sub(time.Unix(timestamp, 0)); age > Tolerance || age < -Tolerance {
+		return false
+	}
+	return hmac.Equal(signature, computeMAC(secret, timestamp, body))
+}
+
+// parseSignature reads the timestamp and MAC of a "t=<unix seconds>,v1=<hex>"
+// header
+func parseSignature(header string) (int64, []byte, bool) {
+	var timestamp int64
+	var signature []byte
+
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return 0, nil, false
+			}
+			timestamp = parsed
+		case "v1":
+			decoded, err := hex.DecodeString(value)
+			if err != nil {
+				return 0, nil, false
+			}
+			signature = decoded
+		}
+	}
+	return timestamp, signature, timestamp != 0 && signature != nil
+}
+
+func computeMAC(secret string, timestamp int64, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,149 @@
+// Package batch resolves references between the operations of a batch request.
+// A reference such as "$ops[0].id" or "$ops[2].category.id" points into the
+// "data" payload returned by an earlier operation.
+package batch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+)
+
+// ErrReference is returned when a reference can't be resolved
+var ErrReference = errors.New("invalid operation reference")
+
+var (
+	referencePattern = regexp.MustCompile(`\$ops\[(\d+)\]((?:\.[A-Za-z0-9_]+)+)`)
+	wholeReference   = regexp.MustCompile(`^\$ops\[(\d+)\]((?:\.[A-Za-z0-9_]+)+)$`)
+)
+
+// ResolvePath replaces every reference in an operation path with the
+// referenced value, e.g. "/sections/portfolio/$ops[0].id"
+func ResolvePath(path string, results []interface{}) (string, error) {
+	var resolveErr error
+	resolved := referencePattern.ReplaceAllStringFunc(path, func(ref string) string {
+		value, err := lookup(ref, results)
+		if err != nil {
+			resolveErr = err
+			return ref
+		}
+		return scalarString(value)
+	})
+	return resolved, resolveErr
+}
+
+// ResolveBody replaces references inside an operation body. A string that is
+// exactly one reference takes the referenced value with its JSON type, so
+// "$ops[0].id" becomes the number 12; references embedded in longer strings
+// are interpolated as text.
+func ResolveBody(body json.RawMessage, results []interface{}) (json.RawMessage, error) {
+	if len(bytes.TrimSpace(body)) == 0 || !bytes.Contains(body, []byte("$ops[")) {
+		return body, nil
+	}
+
+	var document interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return nil, i18n.NewError(err, i18n.MsgBatchBodyInvalid, nil)
+	}
+
+	resolved, err := resolveValue(document, results)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(resolved)
+}
+
+func resolveValue(value interface{}, results []interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		if wholeReference.MatchString(v) {
+			return lookup(v, results)
+		}
+		return ResolvePath(v, results)
+	case map[string]interface{}:
+		for key, item := range v {
+			resolved, err := resolveValue(item, results)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = resolved
+		}
+		return v, nil
+	case []interface{}:
+		for i, item := range v {
+			resolved, err := resolveValue(item, results)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = resolved
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
+// lookup resolves a single "$ops[N].field.field" reference
+func lookup(ref string, results []interface{}) (interface{}, error) {
+	match := wholeReference.FindStringSubmatch(ref)
+	if match == nil {
+		return nil, invalid(i18n.MsgBatchReferenceInvalid, i18n.Params{"ref": ref})
+	}
+
+	index, err := strconv.Atoi(match[1])
+	if err != nil || index >= len(results) {
+		return nil, invalid(i18n.MsgBatchReferenceNotRun, i18n.Params{"ref": ref})
+	}
+
+	current := results[index]
+	for _, field := range strings.Split(strings.TrimPrefix(match[2], "."), ".") {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[field]
+			if !ok {
+				return nil, invalid(i18n.MsgBatchReferenceNoField, i18n.Params{"ref": ref, "field": field})
+			}
+			current = value
+		case []interface{}:
+			i, err := strconv.Atoi(field)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, invalid(i18n.MsgBatchReferenceNoElement, i18n.Params{"ref": ref, "field": field})
+			}
+			current = node[i]
+		default:
+			return nil, invalid(i18n.MsgBatchReferenceNoField, i18n.Params{"ref": ref, "field": field})
+		}
+	}
+	return current, nil
+}
+
+// scalarString formats a referenced value for use inside a string
+func scalarString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		encoded, _ := json.Marshal(v)
+		return string(encoded)
+	}
+}
+
+// invalid returns an ErrReference carrying the message key, so the batch
+// handler can answer in the client's language
+func invalid(key string, params i18n.Params) error {
+	return i18n.NewError(fmt.Errorf("%w: %s", ErrReference, i18n.T(i18n.DefaultLocale, key, params)), key, params)
+}
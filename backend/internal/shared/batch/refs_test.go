@@ -0,0 +1,75 @@
+package batch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testResults(t *testing.T) []interface{} {
+	var first, second interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{"id": 12, "title": "Portfolio"}`), &first))
+	require.NoError(t, json.Unmarshal([]byte(`{"id": 30, "category": {"id": 7}, "skills": ["Go"]}`), &second))
+	return []interface{}{first, second}
+}
+
+func TestResolvePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{"no reference", "/portfolios/own", "/portfolios/own", false},
+		{"id segment", "/portfolios/own/$ops[0].id", "/portfolios/own/12", false},
+		{"nested field", "/categories/own/$ops[1].category.id", "/categories/own/7", false},
+		{"future operation", "/portfolios/own/$ops[2].id", "", true},
+		{"missing field", "/portfolios/own/$ops[0].slug", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolvePath(tt.path, testResults(t))
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrReference)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolveBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    string
+		wantErr bool
+	}{
+		{"no reference", `{"title":"Plain"}`, `{"title":"Plain"}`, false},
+		{"whole reference keeps type", `{"portfolio_id":"$ops[0].id"}`, `{"portfolio_id":12}`, false},
+		{"embedded reference", `{"title":"Copy of $ops[0].title"}`, `{"title":"Copy of Portfolio"}`, false},
+		{"array values", `{"skills":["$ops[1].skills.0","Rust"]}`, `{"skills":["Go","Rust"]}`, false},
+		{"array element out of range", `{"skill":"$ops[1].skills.3"}`, "", true},
+		{"empty body", ``, ``, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveBody(json.RawMessage(tt.body), testResults(t))
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrReference)
+				return
+			}
+			require.NoError(t, err)
+			if tt.want == "" {
+				assert.Empty(t, got)
+				return
+			}
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
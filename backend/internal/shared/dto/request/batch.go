@@ -0,0 +1,18 @@
+package request
+
+import "encoding/json"
+
+// BatchOperation is a single API call inside a batch request. Path is relative
+// to /api and, like string values in Body, may reference earlier results with
+// "$ops[N].field".
+type BatchOperation struct {
+	Method  string            `json:"method" binding:"required,oneof=GET POST PUT PATCH DELETE"`
+	Path    string            `json:"path" binding:"required,min=1"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"` // only If-Match and Content-Type are forwarded
+}
+
+// BatchRequest represents the request body for running several operations atomically
+type BatchRequest struct {
+	Operations []BatchOperation `json:"operations" binding:"required,min=1,max=50,dive"`
+}
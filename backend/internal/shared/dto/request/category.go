@@ -13,3 +13,12 @@ type UpdateCategoryRequest struct {
 	Title       string  `json:"title" binding:"omitempty,min=1,max=255"`
 	Description *string `json:"description,omitempty" binding:"omitempty,max=1000"`
 }
+
+// PatchCategoryRequest is the document PATCH requests are applied to. It is
+// built from the stored category and written back in full, so a field the
+// patch removes or sets to null is cleared.
+// Note: PortfolioID cannot be changed after category creation
+type PatchCategoryRequest struct {
+	Title       string  `json:"title" binding:"required,min=1,max=255"`
+	Description *string `json:"description" binding:"omitempty,max=1000"`
+}
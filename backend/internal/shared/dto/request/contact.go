@@ -0,0 +1,27 @@
+package request
+
+// ContactRequest represents a message sent through a portfolio's contact form.
+// Website is a honeypot: forms hide it from people, so only bots fill it in.
+type ContactRequest struct {
+	Name    string `json:"name" binding:"required,max=100"`
+	Email   string `json:"email" binding:"required,email,max=255"`
+	Subject string `json:"subject" binding:"max=200"`
+	Message string `json:"message" binding:"required,max=5000"`
+	Website string `json:"website"`
+}
+
+// UpdateContactMessageRequest represents the request body for moving a message
+// between the inbox states
+type UpdateContactMessageRequest struct {
+	Status string `json:"status" binding:"required,oneof=unread read archived"`
+}
+
+// ContactSettingsRequest represents the request body for configuring a
+// portfolio's contact form. Enabled defaults to true.
+type ContactSettingsRequest struct {
+	Enabled          *bool  `json:"enabled,omitempty"`
+	NotifyEmail      string `json:"notify_email" binding:"omitempty,email,max=255"`
+	AutoReply        bool   `json:"auto_reply"`
+	AutoReplySubject string `json:"auto_reply_subject" binding:"max=200"`
+	AutoReplyBody    string `json:"auto_reply_body" binding:"max=5000"`
+}
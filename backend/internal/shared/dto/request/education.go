@@ -0,0 +1,23 @@
+package request
+
+import "time"
+
+// EducationRequest represents the request body for editing an education entry
+type EducationRequest struct {
+	Institution string     `json:"institution" binding:"required,max=150"`
+	Degree      string     `json:"degree" binding:"required,max=150"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	EndDate     *time.Time `json:"end_date,omitempty"`
+}
+
+// CreateEducationRequest represents the request body for adding an education
+// entry to a portfolio
+type CreateEducationRequest struct {
+	EducationRequest
+	PortfolioID uint `json:"portfolio_id" binding:"required,min=1"`
+}
+
+// ReorderEducationRequest reorders several education entries of one portfolio
+type ReorderEducationRequest struct {
+	Items []ReorderItem `json:"items" binding:"required,min=1"`
+}
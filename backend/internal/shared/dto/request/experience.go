@@ -0,0 +1,28 @@
+package request
+
+import "time"
+
+// ExperienceRequest represents the request body for editing an experience.
+// ProjectIDs replaces the linked projects, which must be shown in the portfolio.
+type ExperienceRequest struct {
+	Company    string     `json:"company" binding:"required,max=100"`
+	Title      string     `json:"title" binding:"required,max=100"`
+	Location   string     `json:"location" binding:"max=100"`
+	StartDate  time.Time  `json:"start_date"`
+	EndDate    *time.Time `json:"end_date,omitempty"`
+	Current    bool       `json:"current,omitempty"`
+	Highlights []string   `json:"highlights,omitempty" binding:"omitempty,max=20,dive,max=300"`
+	ProjectIDs []uint     `json:"project_ids,omitempty" binding:"omitempty,max=50,dive,min=1"`
+}
+
+// CreateExperienceRequest represents the request body for adding an experience
+// to a portfolio
+type CreateExperienceRequest struct {
+	ExperienceRequest
+	PortfolioID uint `json:"portfolio_id" binding:"required,min=1"`
+}
+
+// ReorderExperiencesRequest reorders several experiences of one portfolio
+type ReorderExperiencesRequest struct {
+	Items []ReorderItem `json:"items" binding:"required,min=1"`
+}
@@ -0,0 +1,15 @@
+package request
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EnqueueJobRequest represents the request body for enqueueing a background
+// job by hand. The payload must match what the job type expects; RunAt
+// defaults to now.
+type EnqueueJobRequest struct {
+	Type    string          `json:"type" binding:"required,max=100"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+	RunAt   *time.Time      `json:"run_at,omitempty"`
+}
@@ -0,0 +1,16 @@
+package request
+
+// PositionRequest places an item among its siblings: at a position, or right
+// before or after another sibling. At most one of them may be given; none puts
+// the item last.
+type PositionRequest struct {
+	Position uint `json:"position,omitempty" binding:"omitempty,min=1"`
+	Before   uint `json:"before,omitempty"`
+	After    uint `json:"after,omitempty"`
+}
+
+// ReorderItem asks for one item of a bulk reorder to be at a position
+type ReorderItem struct {
+	ID       uint `json:"id" binding:"required"`
+	Position uint `json:"position" binding:"required,min=1"`
+}
@@ -4,6 +4,9 @@ package request
 type CreatePortfolioRequest struct {
 	Title       string  `json:"title" binding:"required,min=1,max=255"`
 	Description *string `json:"description,omitempty" binding:"omitempty,max=1000"`
+	// TemplateID creates the portfolio with the sections, content blocks,
+	// categories and placeholder projects of a template
+	TemplateID uint `json:"template_id,omitempty"`
 }
 
 // UpdatePortfolioRequest represents the request body for updating a portfolio
@@ -11,3 +14,11 @@ type UpdatePortfolioRequest struct {
 	Title       string  `json:"title" binding:"omitempty,min=1,max=255"`
 	Description *string `json:"description,omitempty" binding:"omitempty,max=1000"`
 }
+
+// PatchPortfolioRequest is the document PATCH requests are applied to. It is
+// built from the stored portfolio and written back in full, so a field the
+// patch removes or sets to null is cleared.
+type PatchPortfolioRequest struct {
+	Title       string  `json:"title" binding:"required,min=1,max=255"`
+	Description *string `json:"description" binding:"omitempty,max=1000"`
+}
@@ -1,23 +1,79 @@
 package request
 
+import "time"
+
+// ProjectLink is one typed link of a project: repo, demo, case_study or app_store
+type ProjectLink struct {
+	Type  string `json:"type" binding:"required,oneof=repo demo case_study app_store"`
+	URL   string `json:"url" binding:"required,url"`
+	Label string `json:"label,omitempty" binding:"omitempty,max=100"`
+}
+
 // CreateProjectRequest represents the request body for creating a project
 // Note: Images are now managed separately via the image endpoints
 type CreateProjectRequest struct {
-	Title       string   `json:"title" binding:"required,min=1,max=255"`
-	Description string   `json:"description" binding:"required,min=1"`
-	Skills      []string `json:"skills,omitempty"`
-	Client      string   `json:"client" binding:"omitempty,max=255"`
-	Link        string   `json:"link" binding:"omitempty,url"`
-	CategoryID  uint     `json:"category_id" binding:"required,min=1"`
+	Title       string        `json:"title" binding:"required,min=1,max=255"`
+	Description string        `json:"description" binding:"required,min=1"`
+	Skills      []string      `json:"skills,omitempty"`
+	Client      string        `json:"client" binding:"omitempty,max=255"`
+	Link        string        `json:"link" binding:"omitempty,url"`
+	Links       []ProjectLink `json:"links,omitempty" binding:"omitempty,max=20,dive"`
+	StartDate   *time.Time    `json:"start_date,omitempty"`
+	EndDate     *time.Time    `json:"end_date,omitempty"`
+	Ongoing     bool          `json:"ongoing,omitempty"`
+	Role        string        `json:"role,omitempty" binding:"omitempty,max=100"`
+	TeamSize    uint          `json:"team_size,omitempty"`
+	Status      string        `json:"status,omitempty" binding:"omitempty,oneof=shipped in_progress archived"`
+	Featured    bool          `json:"featured,omitempty"`
+	CategoryID  uint          `json:"category_id" binding:"required,min=1"`
 }
 
 // UpdateProjectRequest represents the request body for updating a project
 // Note: Images are now managed separately via the image endpoints
 type UpdateProjectRequest struct {
-	Title       string   `json:"title" binding:"omitempty,min=1,max=255"`
-	Description string   `json:"description" binding:"omitempty,min=1"`
-	Skills      []string `json:"skills,omitempty"`
-	Client      string   `json:"client" binding:"omitempty,max=255"`
-	Link        string   `json:"link" binding:"omitempty,url"`
-	CategoryID  uint     `json:"category_id" binding:"omitempty,min=1"`
+	Title       string        `json:"title" binding:"omitempty,min=1,max=255"`
+	Description string        `json:"description" binding:"omitempty,min=1"`
+	Skills      []string      `json:"skills,omitempty"`
+	Client      string        `json:"client" binding:"omitempty,max=255"`
+	Link        string        `json:"link" binding:"omitempty,url"`
+	Links       []ProjectLink `json:"links,omitempty" binding:"omitempty,max=20,dive"`
+	StartDate   *time.Time    `json:"start_date,omitempty"`
+	EndDate     *time.Time    `json:"end_date,omitempty"`
+	Ongoing     bool          `json:"ongoing,omitempty"`
+	Role        string        `json:"role,omitempty" binding:"omitempty,max=100"`
+	TeamSize    uint          `json:"team_size,omitempty"`
+	Status      string        `json:"status,omitempty" binding:"omitempty,oneof=shipped in_progress archived"`
+	Featured    bool          `json:"featured,omitempty"`
+	CategoryID  uint          `json:"category_id" binding:"omitempty,min=1"`
+}
+
+// PatchProjectRequest is the document PATCH requests are applied to. It is
+// built from the stored project and written back in full, so a field the
+// patch removes or sets to null is cleared. Skills and links support JSON
+// Patch array operations such as {"op":"add","path":"/skills/-","value":"Go"}.
+type PatchProjectRequest struct {
+	Title       string        `json:"title" binding:"required,min=1,max=255"`
+	Description string        `json:"description" binding:"required,min=1"`
+	Skills      []string      `json:"skills"`
+	Client      string        `json:"client" binding:"omitempty,max=255"`
+	Link        string        `json:"link" binding:"omitempty,url"`
+	Links       []ProjectLink `json:"links" binding:"omitempty,max=20,dive"`
+	StartDate   *time.Time    `json:"start_date"`
+	EndDate     *time.Time    `json:"end_date"`
+	Ongoing     bool          `json:"ongoing"`
+	Role        string        `json:"role" binding:"omitempty,max=100"`
+	TeamSize    uint          `json:"team_size"`
+	Status      string        `json:"status" binding:"required,oneof=shipped in_progress archived"`
+	Featured    bool          `json:"featured"`
+	CategoryID  uint          `json:"category_id" binding:"required,min=1"`
+}
+
+// ImportProjectsRequest represents the request body for importing public
+// repositories of a Git hosting provider into a category. Sync turns the
+// scheduled resync from upstream on or off for every one of them.
+type ImportProjectsRequest struct {
+	Provider      string  `json:"provider" binding:"required,oneof=github gitea"`
+	CategoryID    uint    `json:"category_id" binding:"required,min=1"`
+	RepositoryIDs []int64 `json:"repository_ids" binding:"required,min=1,max=50,dive,min=1"`
+	Sync          bool    `json:"sync"`
 }
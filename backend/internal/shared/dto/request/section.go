@@ -15,3 +15,12 @@ type UpdateSectionRequest struct {
 	Type        string  `json:"type" binding:"omitempty,min=1,max=100"`
 	PortfolioID uint    `json:"portfolio_id" binding:"omitempty,min=1"`
 }
+
+// PatchSectionRequest is the document PATCH requests are applied to. It is
+// built from the stored section and written back in full, so a field the
+// patch removes or sets to null is cleared.
+type PatchSectionRequest struct {
+	Title       string  `json:"title" binding:"required,min=1,max=255"`
+	Description *string `json:"description" binding:"omitempty,max=1000"`
+	Type        string  `json:"type" binding:"required,min=1,max=100"`
+}
@@ -21,3 +21,25 @@ type UpdateSectionContentRequest struct {
 type UpdateSectionContentOrderRequest struct {
 	Order uint `json:"order" binding:"required"`
 }
+
+// PatchSectionContentRequest is the document PATCH requests are applied to. It
+// is built from the stored content block and written back in full, so a field
+// the patch removes or sets to null is cleared.
+type PatchSectionContentRequest struct {
+	Type     string  `json:"type" binding:"required,oneof=text image"`
+	Content  string  `json:"content" binding:"required,min=1,max=5000"`
+	Order    uint    `json:"order"`
+	Metadata *string `json:"metadata"`
+}
+
+// MoveSectionContentRequest moves a content block to another section
+type MoveSectionContentRequest struct {
+	PositionRequest
+	SectionID uint `json:"section_id" binding:"required,min=1"`
+}
+
+// ReorderSectionContentsRequest reorders several content blocks of one section
+type ReorderSectionContentsRequest struct {
+	SectionID uint          `json:"section_id" binding:"required,min=1"`
+	Items     []ReorderItem `json:"items" binding:"required,min=1"`
+}
@@ -0,0 +1,22 @@
+package request
+
+// CreateSkillRequest represents the request body for adding a skill to the taxonomy
+type CreateSkillRequest struct {
+	Name    string   `json:"name" binding:"required,max=100"`
+	Kind    string   `json:"kind" binding:"omitempty,oneof=language framework tool"`
+	Aliases []string `json:"aliases" binding:"omitempty,max=20,dive,max=100"`
+}
+
+// UpdateSkillRequest represents the request body for updating a skill. The
+// aliases replace the stored ones.
+type UpdateSkillRequest struct {
+	Name    string   `json:"name" binding:"required,max=100"`
+	Kind    string   `json:"kind" binding:"omitempty,oneof=language framework tool"`
+	Aliases []string `json:"aliases" binding:"omitempty,max=20,dive,max=100"`
+}
+
+// MergeSkillsRequest represents the request body for merging duplicate skills
+// into the one in the path
+type MergeSkillsRequest struct {
+	SourceIDs []uint `json:"source_ids" binding:"required,min=1,max=50"`
+}
@@ -0,0 +1,18 @@
+package request
+
+// CreateTemplateRequest represents the request body for saving a portfolio as
+// a template. Visibility defaults to private.
+type CreateTemplateRequest struct {
+	PortfolioID uint   `json:"portfolio_id" binding:"required"`
+	Name        string `json:"name" binding:"required,min=1,max=100"`
+	Description string `json:"description" binding:"omitempty,max=1000"`
+	Visibility  string `json:"visibility" binding:"omitempty,oneof=private shared"`
+}
+
+// UpdateTemplateRequest represents the request body for renaming a template or
+// sharing it; the captured content doesn't change
+type UpdateTemplateRequest struct {
+	Name        string `json:"name" binding:"required,min=1,max=100"`
+	Description string `json:"description" binding:"omitempty,max=1000"`
+	Visibility  string `json:"visibility" binding:"required,oneof=private shared"`
+}
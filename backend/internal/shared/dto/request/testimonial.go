@@ -0,0 +1,45 @@
+package request
+
+// SubmitTestimonialRequest represents a testimonial a client sends through an
+// invite link; the invite decides the portfolio and project
+type SubmitTestimonialRequest struct {
+	AuthorName string `json:"author_name" binding:"required,max=100"`
+	AuthorRole string `json:"author_role" binding:"max=100"`
+	Company    string `json:"company" binding:"max=100"`
+	Quote      string `json:"quote" binding:"required,max=2000"`
+	AvatarURL  string `json:"avatar_url" binding:"max=2048"`
+	Rating     *uint  `json:"rating,omitempty" binding:"omitempty,min=1,max=5"`
+}
+
+// TestimonialRequest represents the request body for editing a testimonial
+type TestimonialRequest struct {
+	SubmitTestimonialRequest
+	ProjectID *uint `json:"project_id,omitempty" binding:"omitempty,min=1"`
+}
+
+// CreateTestimonialRequest represents the request body for adding a
+// testimonial to a portfolio; the ones the owner adds are approved
+type CreateTestimonialRequest struct {
+	TestimonialRequest
+	PortfolioID uint `json:"portfolio_id" binding:"required,min=1"`
+}
+
+// UpdateTestimonialStatusRequest represents the request body for approving or
+// rejecting a testimonial
+type UpdateTestimonialStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=pending approved rejected"`
+}
+
+// ReorderTestimonialsRequest reorders several testimonials of one portfolio
+type ReorderTestimonialsRequest struct {
+	Items []ReorderItem `json:"items" binding:"required,min=1"`
+}
+
+// CreateTestimonialInviteRequest represents the request body for inviting a
+// client to submit a testimonial about the portfolio, or one of its projects.
+// ExpiresInDays defaults to 30.
+type CreateTestimonialInviteRequest struct {
+	ProjectID     *uint  `json:"project_id,omitempty" binding:"omitempty,min=1"`
+	Note          string `json:"note" binding:"max=255"`
+	ExpiresInDays uint   `json:"expires_in_days,omitempty" binding:"omitempty,min=1,max=90"`
+}
@@ -0,0 +1,21 @@
+package request
+
+// SetLocalesRequest represents the request body for setting the locales of a portfolio
+type SetLocalesRequest struct {
+	DefaultLocale string   `json:"default_locale" binding:"required,max=35"`
+	Locales       []string `json:"locales" binding:"omitempty,max=20,dive,max=35"`
+}
+
+// TranslationItem is the text of one field in the locale of the request. An
+// empty value removes the translation.
+type TranslationItem struct {
+	ResourceType string `json:"resource_type" binding:"required"`
+	ResourceID   uint   `json:"resource_id" binding:"required"`
+	Field        string `json:"field" binding:"required"`
+	Value        string `json:"value"`
+}
+
+// SaveTranslationsRequest represents the request body for saving translations of one locale
+type SaveTranslationsRequest struct {
+	Translations []TranslationItem `json:"translations" binding:"required,min=1,max=500,dive"`
+}
@@ -0,0 +1,11 @@
+package request
+
+// AuthentikUserEvent is the body Authentik sends to the user lifecycle webhook.
+// It is produced by a webhook mapping on the Authentik side; UserID must be
+// the same subject the OIDC provider puts in access tokens.
+type AuthentikUserEvent struct {
+	Event            string `json:"event" binding:"required,oneof=user.deleted user.deactivated user.reactivated user.renamed"`
+	UserID           string `json:"user_id" binding:"required,max=255"`
+	Username         string `json:"username" binding:"max=255"`
+	PreviousUsername string `json:"previous_username" binding:"max=255"`
+}
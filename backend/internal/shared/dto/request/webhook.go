@@ -0,0 +1,19 @@
+package request
+
+// CreateWebhookRequest represents the request body for registering a webhook.
+// When Secret is omitted one is generated and returned once in the response.
+type CreateWebhookRequest struct {
+	PortfolioID uint     `json:"portfolio_id" binding:"required,min=1"`
+	URL         string   `json:"url" binding:"required,url,max=2048"`
+	Events      []string `json:"events" binding:"required,min=1"`
+	Secret      string   `json:"secret,omitempty" binding:"omitempty,min=16,max=255"`
+	Active      *bool    `json:"active,omitempty"`
+}
+
+// UpdateWebhookRequest represents the request body for updating a webhook
+// Note: PortfolioID and Secret cannot be changed after creation
+type UpdateWebhookRequest struct {
+	URL    string   `json:"url" binding:"required,url,max=2048"`
+	Events []string `json:"events" binding:"required,min=1"`
+	Active bool     `json:"active"`
+}
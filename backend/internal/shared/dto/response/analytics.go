@@ -0,0 +1,85 @@
+package response
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+// AnalyticsResponse reports the views of a portfolio's public pages over a
+// period. Visitors are unique per day only, since visitor hashes change daily.
+type AnalyticsResponse struct {
+	PortfolioID   uint                   `json:"portfolio_id"`
+	From          string                 `json:"from"`
+	To            string                 `json:"to"`
+	Views         int64                  `json:"views"`
+	Visitors      int64                  `json:"visitors"`
+	Daily         []DailyViewsResponse   `json:"daily"`
+	TopProjects   []models.ResourceViews `json:"top_projects"`
+	TopCategories []models.ResourceViews `json:"top_categories"`
+	Referrers     []ReferrerResponse     `json:"referrers"`
+	Countries     []CountryResponse      `json:"countries"`
+}
+
+// DailyViewsResponse counts the views of one day
+type DailyViewsResponse struct {
+	Date     string `json:"date"`
+	Views    int64  `json:"views"`
+	Visitors int64  `json:"visitors"`
+}
+
+// ReferrerResponse counts the views coming from one domain
+type ReferrerResponse struct {
+	Domain   string `json:"domain"`
+	Views    int64  `json:"views"`
+	Visitors int64  `json:"visitors"`
+}
+
+// CountryResponse counts the views coming from one country
+type CountryResponse struct {
+	Country  string `json:"country"`
+	Views    int64  `json:"views"`
+	Visitors int64  `json:"visitors"`
+}
+
+// ToAnalyticsResponse builds the report of a portfolio from its daily views,
+// listing every day of the period, those without views too
+func ToAnalyticsResponse(portfolioID uint, from, to time.Time, days []models.DailyView, projects, categories []models.ResourceViews, referrers, countries []models.SourceViews) AnalyticsResponse {
+	report := AnalyticsResponse{
+		PortfolioID:   portfolioID,
+		From:          from.Format(time.DateOnly),
+		To:            to.Format(time.DateOnly),
+		Daily:         []DailyViewsResponse{},
+		TopProjects:   projects,
+		TopCategories: categories,
+		Referrers:     make([]ReferrerResponse, len(referrers)),
+		Countries:     make([]CountryResponse, len(countries)),
+	}
+	if report.TopProjects == nil {
+		report.TopProjects = []models.ResourceViews{}
+	}
+	if report.TopCategories == nil {
+		report.TopCategories = []models.ResourceViews{}
+	}
+
+	byDate := make(map[string]models.DailyView, len(days))
+	for _, day := range days {
+		byDate[day.Day.Format(time.DateOnly)] = day
+	}
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		date := day.Format(time.DateOnly)
+		views := byDate[date]
+		report.Daily = append(report.Daily, DailyViewsResponse{Date: date, Views: views.Views, Visitors: views.Visitors})
+		report.Views += views.Views
+		report.Visitors += views.Visitors
+	}
+
+	for i, referrer := range referrers {
+		report.Referrers[i] = ReferrerResponse{Domain: referrer.Value, Views: referrer.Views, Visitors: referrer.Visitors}
+	}
+	for i, country := range countries {
+		report.Countries[i] = CountryResponse{Country: country.Value, Views: country.Views, Visitors: country.Visitors}
+	}
+
+	return report
+}
@@ -0,0 +1,17 @@
+package response
+
+import "encoding/json"
+
+// BatchResult is the outcome of one operation in a batch
+type BatchResult struct {
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// BatchResponse lists the result of every operation that ran. When the batch
+// fails, FailedOperation is the index of the operation that stopped it and
+// nothing was saved.
+type BatchResponse struct {
+	Results         []BatchResult `json:"results"`
+	FailedOperation *int          `json:"failed_operation,omitempty"`
+}
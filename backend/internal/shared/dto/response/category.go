@@ -11,7 +11,9 @@ type CategoryResponse struct {
 	ID          uint       `json:"id"`
 	Title       string     `json:"title"`
 	Description *string    `json:"description,omitempty"`
+	Position    uint       `json:"position"`
 	OwnerID     string     `json:"owner_id,omitempty"`
+	Version     uint       `json:"version"`
 	PortfolioID uint       `json:"portfolio_id"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
@@ -23,7 +25,9 @@ type CategoryDetailResponse struct {
 	ID          uint              `json:"id"`
 	Title       string            `json:"title"`
 	Description *string           `json:"description,omitempty"`
+	Position    uint              `json:"position"`
 	OwnerID     string            `json:"owner_id,omitempty"`
+	Version     uint              `json:"version"`
 	PortfolioID uint              `json:"portfolio_id"`
 	Projects    []ProjectResponse `json:"projects,omitempty"`
 	CreatedAt   time.Time         `json:"created_at"`
@@ -37,7 +41,9 @@ func ToCategoryResponse(category *models.Category) CategoryResponse {
 		ID:          category.ID,
 		Title:       category.Title,
 		Description: category.Description,
+		Position:    category.Position,
 		OwnerID:     category.OwnerID,
+		Version:     category.Version,
 		PortfolioID: category.PortfolioID,
 		CreatedAt:   category.CreatedAt,
 		UpdatedAt:   category.UpdatedAt,
@@ -56,7 +62,9 @@ func ToCategoryDetailResponse(category *models.Category) CategoryDetailResponse
 		ID:          category.ID,
 		Title:       category.Title,
 		Description: category.Description,
+		Position:    category.Position,
 		OwnerID:     category.OwnerID,
+		Version:     category.Version,
 		PortfolioID: category.PortfolioID,
 		Projects:    projects,
 		CreatedAt:   category.CreatedAt,
@@ -0,0 +1,72 @@
+package response
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+// ContactMessageResponse represents a message of the owner's inbox
+type ContactMessageResponse struct {
+	ID          uint       `json:"id"`
+	PortfolioID uint       `json:"portfolio_id"`
+	Name        string     `json:"name"`
+	Email       string     `json:"email"`
+	Subject     string     `json:"subject"`
+	Message     string     `json:"message"`
+	Status      string     `json:"status"`
+	ReadAt      *time.Time `json:"read_at,omitempty"`
+	Version     uint       `json:"version"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ContactSettingsResponse represents the contact form settings of a portfolio
+type ContactSettingsResponse struct {
+	PortfolioID      uint   `json:"portfolio_id"`
+	Enabled          bool   `json:"enabled"`
+	NotifyEmail      string `json:"notify_email"`
+	AutoReply        bool   `json:"auto_reply"`
+	AutoReplySubject string `json:"auto_reply_subject"`
+	AutoReplyBody    string `json:"auto_reply_body"`
+	Version          uint   `json:"version"`
+}
+
+// ToContactMessageResponse converts a model to a response DTO
+func ToContactMessageResponse(message *models.ContactMessage) ContactMessageResponse {
+	return ContactMessageResponse{
+		ID:          message.ID,
+		PortfolioID: message.PortfolioID,
+		Name:        message.Name,
+		Email:       message.Email,
+		Subject:     message.Subject,
+		Message:     message.Body,
+		Status:      message.Status,
+		ReadAt:      message.ReadAt,
+		Version:     message.Version,
+		CreatedAt:   message.CreatedAt,
+		UpdatedAt:   message.UpdatedAt,
+	}
+}
+
+// ToContactMessageListResponse converts a slice of models to response DTOs
+func ToContactMessageListResponse(messages []models.ContactMessage) []ContactMessageResponse {
+	result := make([]ContactMessageResponse, len(messages))
+	for i := range messages {
+		result[i] = ToContactMessageResponse(&messages[i])
+	}
+	return result
+}
+
+// ToContactSettingsResponse converts a model to a response DTO
+func ToContactSettingsResponse(settings *models.ContactSettings) ContactSettingsResponse {
+	return ContactSettingsResponse{
+		PortfolioID:      settings.PortfolioID,
+		Enabled:          settings.Enabled,
+		NotifyEmail:      settings.NotifyEmail,
+		AutoReply:        settings.AutoReply,
+		AutoReplySubject: settings.AutoReplySubject,
+		AutoReplyBody:    settings.AutoReplyBody,
+		Version:          settings.Version,
+	}
+}
@@ -0,0 +1,48 @@
+package response
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+// EducationResponse represents a degree or course in a portfolio
+type EducationResponse struct {
+	ID          uint       `json:"id"`
+	PortfolioID uint       `json:"portfolio_id"`
+	Institution string     `json:"institution"`
+	Degree      string     `json:"degree"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	EndDate     *time.Time `json:"end_date,omitempty"`
+	Position    uint       `json:"position"`
+	OwnerID     string     `json:"owner_id,omitempty"`
+	Version     uint       `json:"version"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ToEducationResponse converts a model to a response DTO
+func ToEducationResponse(education *models.Education) EducationResponse {
+	return EducationResponse{
+		ID:          education.ID,
+		PortfolioID: education.PortfolioID,
+		Institution: education.Institution,
+		Degree:      education.Degree,
+		StartDate:   education.StartDate,
+		EndDate:     education.EndDate,
+		Position:    education.Position,
+		OwnerID:     education.OwnerID,
+		Version:     education.Version,
+		CreatedAt:   education.CreatedAt,
+		UpdatedAt:   education.UpdatedAt,
+	}
+}
+
+// ToEducationListResponse converts a slice of models to response DTOs
+func ToEducationListResponse(education []models.Education) []EducationResponse {
+	result := make([]EducationResponse, len(education))
+	for i := range education {
+		result[i] = ToEducationResponse(&education[i])
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package response
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+// ExperienceResponse represents a position in the work history of a portfolio
+type ExperienceResponse struct {
+	ID          uint       `json:"id"`
+	PortfolioID uint       `json:"portfolio_id"`
+	Company     string     `json:"company"`
+	Title       string     `json:"title"`
+	Location    string     `json:"location,omitempty"`
+	StartDate   time.Time  `json:"start_date"`
+	EndDate     *time.Time `json:"end_date,omitempty"`
+	Current     bool       `json:"current"`
+	Highlights  []string   `json:"highlights"`
+	ProjectIDs  []uint     `json:"project_ids"`
+	Position    uint       `json:"position"`
+	OwnerID     string     `json:"owner_id,omitempty"`
+	Version     uint       `json:"version"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ToExperienceResponse converts a model to a response DTO
+func ToExperienceResponse(experience *models.Experience) ExperienceResponse {
+	highlights := []string(experience.Highlights)
+	if highlights == nil {
+		highlights = []string{}
+	}
+	projectIDs := experience.ProjectIDs
+	if projectIDs == nil {
+		projectIDs = []uint{}
+	}
+	return ExperienceResponse{
+		ID:          experience.ID,
+		PortfolioID: experience.PortfolioID,
+		Company:     experience.Company,
+		Title:       experience.Title,
+		Location:    experience.Location,
+		StartDate:   experience.StartDate,
+		EndDate:     experience.EndDate,
+		Current:     experience.Current,
+		Highlights:  highlights,
+		ProjectIDs:  projectIDs,
+		Position:    experience.Position,
+		OwnerID:     experience.OwnerID,
+		Version:     experience.Version,
+		CreatedAt:   experience.CreatedAt,
+		UpdatedAt:   experience.UpdatedAt,
+	}
+}
+
+// ToExperienceListResponse converts a slice of models to response DTOs
+func ToExperienceListResponse(experiences []models.Experience) []ExperienceResponse {
+	result := make([]ExperienceResponse, len(experiences))
+	for i := range experiences {
+		result[i] = ToExperienceResponse(&experiences[i])
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+// JobResponse represents a background job in responses
+type JobResponse struct {
+	ID          uint            `json:"id"`
+	Type        string          `json:"type"`
+	Status      string          `json:"status"`
+	Payload     json.RawMessage `json:"payload"`
+	RunAt       time.Time       `json:"run_at"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"max_attempts"`
+	Timeout     int             `json:"timeout"`
+	Schedule    string          `json:"schedule,omitempty"`
+	LockedBy    string          `json:"locked_by,omitempty"`
+	LockedUntil *time.Time      `json:"locked_until,omitempty"`
+	LastError   string          `json:"last_error,omitempty"`
+	FinishedAt  *time.Time      `json:"finished_at,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// JobStatsResponse summarizes the queue: the registered job types, how many
+// jobs of each type are in each status, and the schedules with their runs
+type JobStatsResponse struct {
+	Types     []string             `json:"types"`
+	Counts    []models.JobCount    `json:"counts"`
+	Schedules []models.JobSchedule `json:"schedules"`
+}
+
+// ToJobResponse converts a model to a response DTO
+func ToJobResponse(job *models.Job) JobResponse {
+	return JobResponse{
+		ID:          job.ID,
+		Type:        job.Type,
+		Status:      job.Status,
+		Payload:     json.RawMessage(job.Payload),
+		RunAt:       job.RunAt,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		Timeout:     job.Timeout,
+		Schedule:    job.Schedule,
+		LockedBy:    job.LockedBy,
+		LockedUntil: job.LockedUntil,
+		LastError:   job.LastError,
+		FinishedAt:  job.FinishedAt,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}
+}
+
+// ToJobListResponse converts a slice of models to response DTOs
+func ToJobListResponse(jobs []models.Job) []JobResponse {
+	result := make([]JobResponse, len(jobs))
+	for i := range jobs {
+		result[i] = ToJobResponse(&jobs[i])
+	}
+	return result
+}
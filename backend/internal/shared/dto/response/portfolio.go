@@ -8,38 +8,49 @@ import (
 
 // PortfolioResponse represents a basic portfolio in responses
 type PortfolioResponse struct {
-	ID          uint       `json:"id"`
-	Title       string     `json:"title"`
-	Description *string    `json:"description,omitempty"`
-	OwnerID     string     `json:"owner_id,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	ID            uint       `json:"id"`
+	Title         string     `json:"title"`
+	Description   *string    `json:"description,omitempty"`
+	OwnerID       string     `json:"owner_id,omitempty"`
+	Version       uint       `json:"version"`
+	DefaultLocale string     `json:"default_locale,omitempty"`
+	Locales       []string   `json:"locales,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
 }
 
 // PortfolioDetailResponse represents a detailed portfolio with relationships
 type PortfolioDetailResponse struct {
-	ID          uint               `json:"id"`
-	Title       string             `json:"title"`
-	Description *string            `json:"description,omitempty"`
-	OwnerID     string             `json:"owner_id,omitempty"`
-	Sections    []SectionResponse  `json:"sections,omitempty"`
-	Categories  []CategoryResponse `json:"categories,omitempty"`
-	CreatedAt   time.Time          `json:"created_at"`
-	UpdatedAt   time.Time          `json:"updated_at"`
-	DeletedAt   *time.Time         `json:"deleted_at,omitempty"`
+	ID            uint                 `json:"id"`
+	Title         string               `json:"title"`
+	Description   *string              `json:"description,omitempty"`
+	OwnerID       string               `json:"owner_id,omitempty"`
+	Version       uint                 `json:"version"`
+	DefaultLocale string               `json:"default_locale,omitempty"`
+	Locales       []string             `json:"locales,omitempty"`
+	Sections      []SectionResponse    `json:"sections,omitempty"`
+	Categories    []CategoryResponse   `json:"categories,omitempty"`
+	Experiences   []ExperienceResponse `json:"experiences,omitempty"`
+	Education     []EducationResponse  `json:"education,omitempty"`
+	CreatedAt     time.Time            `json:"created_at"`
+	UpdatedAt     time.Time            `json:"updated_at"`
+	DeletedAt     *time.Time           `json:"deleted_at,omitempty"`
 }
 
 // ToPortfolioResponse converts a model to a basic response DTO
 func ToPortfolioResponse(portfolio *models.Portfolio) PortfolioResponse {
 	return PortfolioResponse{
-		ID:          portfolio.ID,
-		Title:       portfolio.Title,
-		Description: portfolio.Description,
-		OwnerID:     portfolio.OwnerID,
-		CreatedAt:   portfolio.CreatedAt,
-		UpdatedAt:   portfolio.UpdatedAt,
-		DeletedAt:   nil,
+		ID:            portfolio.ID,
+		Title:         portfolio.Title,
+		Description:   portfolio.Description,
+		OwnerID:       portfolio.OwnerID,
+		Version:       portfolio.Version,
+		DefaultLocale: portfolio.DefaultLocale,
+		Locales:       portfolioLocales(portfolio),
+		CreatedAt:     portfolio.CreatedAt,
+		UpdatedAt:     portfolio.UpdatedAt,
+		DeletedAt:     nil,
 	}
 }
 
@@ -56,15 +67,20 @@ func ToPortfolioDetailResponse(portfolio *models.Portfolio) PortfolioDetailRespo
 	}
 
 	return PortfolioDetailResponse{
-		ID:          portfolio.ID,
-		Title:       portfolio.Title,
-		Description: portfolio.Description,
-		OwnerID:     portfolio.OwnerID,
-		Sections:    sections,
-		Categories:  categories,
-		CreatedAt:   portfolio.CreatedAt,
-		UpdatedAt:   portfolio.UpdatedAt,
-		DeletedAt:   nil,
+		ID:            portfolio.ID,
+		Title:         portfolio.Title,
+		Description:   portfolio.Description,
+		OwnerID:       portfolio.OwnerID,
+		Version:       portfolio.Version,
+		DefaultLocale: portfolio.DefaultLocale,
+		Locales:       portfolioLocales(portfolio),
+		Sections:      sections,
+		Categories:    categories,
+		Experiences:   ToExperienceListResponse(portfolio.Experiences),
+		Education:     ToEducationListResponse(portfolio.Education),
+		CreatedAt:     portfolio.CreatedAt,
+		UpdatedAt:     portfolio.UpdatedAt,
+		DeletedAt:     nil,
 	}
 }
 
@@ -76,3 +92,11 @@ func ToPortfolioListResponse(portfolios []models.Portfolio) []PortfolioResponse
 	}
 	return responses
 }
+
+// portfolioLocales lists the enabled locales, or none when they weren't loaded
+func portfolioLocales(portfolio *models.Portfolio) []string {
+	if portfolio.DefaultLocale == "" {
+		return nil
+	}
+	return portfolio.ContentLocales()
+}
@@ -0,0 +1,32 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+// PortfolioEventResponse is the data of one change stream message
+type PortfolioEventResponse struct {
+	ID          uint            `json:"id"`
+	Event       string          `json:"event"`
+	PortfolioID uint            `json:"portfolio_id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Data        json.RawMessage `json:"data"`
+}
+
+// ToPortfolioEventResponse converts a model to a response DTO
+func ToPortfolioEventResponse(event *models.PortfolioEvent) PortfolioEventResponse {
+	data := json.RawMessage(event.Data)
+	if len(data) == 0 {
+		data = json.RawMessage("null")
+	}
+	return PortfolioEventResponse{
+		ID:          event.ID,
+		Event:       event.Event,
+		PortfolioID: event.PortfolioID,
+		OccurredAt:  event.CreatedAt,
+		Data:        data,
+	}
+}
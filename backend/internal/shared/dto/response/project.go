@@ -8,17 +8,27 @@ import (
 
 // ProjectResponse represents a project in responses
 type ProjectResponse struct {
-	ID          uint       `json:"id"`
-	Title       string     `json:"title"`
-	Description string     `json:"description"`
-	Skills      []string   `json:"skills,omitempty"`
-	Client      string     `json:"client,omitempty"`
-	Link        string     `json:"link,omitempty"`
-	OwnerID     string     `json:"owner_id,omitempty"`
-	CategoryID  uint       `json:"category_id"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	ID          uint                 `json:"id"`
+	Title       string               `json:"title"`
+	Description string               `json:"description"`
+	Skills      []string             `json:"skills,omitempty"`
+	Client      string               `json:"client,omitempty"`
+	Link        string               `json:"link,omitempty"`
+	Links       []models.ProjectLink `json:"links"`
+	StartDate   *time.Time           `json:"start_date"`
+	EndDate     *time.Time           `json:"end_date"`
+	Ongoing     bool                 `json:"ongoing"`
+	Role        string               `json:"role,omitempty"`
+	TeamSize    uint                 `json:"team_size,omitempty"`
+	Status      string               `json:"status"`
+	Featured    bool                 `json:"featured"`
+	Position    uint                 `json:"position"`
+	OwnerID     string               `json:"owner_id,omitempty"`
+	Version     uint                 `json:"version"`
+	CategoryID  uint                 `json:"category_id"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+	DeletedAt   *time.Time           `json:"deleted_at,omitempty"`
 }
 
 // ToProjectResponse converts a model to a response DTO
@@ -30,7 +40,17 @@ func ToProjectResponse(project *models.Project) ProjectResponse {
 		Skills:      project.Skills,
 		Client:      project.Client,
 		Link:        project.Link,
+		Links:       project.Links,
+		StartDate:   project.StartDate,
+		EndDate:     project.EndDate,
+		Ongoing:     project.Ongoing,
+		Role:        project.Role,
+		TeamSize:    project.TeamSize,
+		Status:      project.Status,
+		Featured:    project.Featured,
+		Position:    project.Position,
 		OwnerID:     project.OwnerID,
+		Version:     project.Version,
 		CategoryID:  project.CategoryID,
 		CreatedAt:   project.CreatedAt,
 		UpdatedAt:   project.UpdatedAt,
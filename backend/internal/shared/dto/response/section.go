@@ -12,7 +12,9 @@ type SectionResponse struct {
 	Title       string                   `json:"title"`
 	Description *string                  `json:"description,omitempty"`
 	Type        string                   `json:"type"`
+	Position    uint                     `json:"position"`
 	OwnerID     string                   `json:"owner_id,omitempty"`
+	Version     uint                     `json:"version"`
 	PortfolioID uint                     `json:"portfolio_id"`
 	Contents    []SectionContentResponse `json:"contents,omitempty"`
 	CreatedAt   time.Time                `json:"created_at"`
@@ -32,7 +34,9 @@ func ToSectionResponse(section *models.Section) SectionResponse {
 		Title:       section.Title,
 		Description: section.Description,
 		Type:        section.Type,
+		Position:    section.Position,
 		OwnerID:     section.OwnerID,
+		Version:     section.Version,
 		PortfolioID: section.PortfolioID,
 		Contents:    contents,
 		CreatedAt:   section.CreatedAt,
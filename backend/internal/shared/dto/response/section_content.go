@@ -13,6 +13,7 @@ type SectionContentResponse struct {
 	Type      string     `json:"type"`
 	Content   string     `json:"content"`
 	Order     uint       `json:"order"`
+	Version   uint       `json:"version"`
 	Metadata  *string    `json:"metadata,omitempty"`
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at"`
@@ -27,6 +28,7 @@ func ToSectionContentResponse(content *models.SectionContent) SectionContentResp
 		Type:      content.Type,
 		Content:   content.Content,
 		Order:     content.Order,
+		Version:   content.Version,
 		Metadata:  content.Metadata,
 		CreatedAt: content.CreatedAt,
 		UpdatedAt: content.UpdatedAt,
@@ -0,0 +1,94 @@
+package response
+
+import (
+	"math"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+// SkillResponse represents an entry of the skills taxonomy in responses.
+// Projects is the number of projects using it; suggestions from the built-in
+// list have no ID.
+type SkillResponse struct {
+	ID       uint     `json:"id,omitempty"`
+	Name     string   `json:"name"`
+	Slug     string   `json:"slug"`
+	Kind     string   `json:"kind,omitempty"`
+	Aliases  []string `json:"aliases"`
+	Projects int64    `json:"projects"`
+	Version  uint     `json:"version,omitempty"`
+}
+
+// SkillStatResponse is the usage of one skill across a portfolio
+type SkillStatResponse struct {
+	ID       uint    `json:"id"`
+	Name     string  `json:"name"`
+	Kind     string  `json:"kind,omitempty"`
+	Projects int64   `json:"projects"`
+	Percent  float64 `json:"percent"`
+}
+
+// SkillStatsResponse reports which skills the projects of a portfolio use
+type SkillStatsResponse struct {
+	PortfolioID   uint                `json:"portfolio_id"`
+	TotalProjects int64               `json:"total_projects"`
+	Skills        []SkillStatResponse `json:"skills"`
+	ByKind        map[string]int64    `json:"by_kind"`
+}
+
+// ToSkillResponse converts a model to a response DTO
+func ToSkillResponse(skill *models.Skill, projects int64) SkillResponse {
+	aliases := []string(skill.Aliases)
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return SkillResponse{
+		ID:       skill.ID,
+		Name:     skill.Name,
+		Slug:     skill.Slug,
+		Kind:     skill.Kind,
+		Aliases:  aliases,
+		Projects: projects,
+		Version:  skill.Version,
+	}
+}
+
+// ToSkillListResponse converts a slice of models to response DTOs
+func ToSkillListResponse(skills []models.SkillUsage) []SkillResponse {
+	responses := make([]SkillResponse, len(skills))
+	for i := range skills {
+		responses[i] = ToSkillResponse(&skills[i].Skill, skills[i].Projects)
+	}
+	return responses
+}
+
+// ToSkillStatsResponse builds the usage report of a portfolio; ByKind counts
+// the skill links of each kind, "other" for skills without one
+func ToSkillStatsResponse(portfolioID uint, skills []models.SkillUsage, totalProjects int64) SkillStatsResponse {
+	stats := SkillStatsResponse{
+		PortfolioID:   portfolioID,
+		TotalProjects: totalProjects,
+		Skills:        make([]SkillStatResponse, len(skills)),
+		ByKind:        make(map[string]int64),
+	}
+	for i, skill := range skills {
+		var percent float64
+		if totalProjects > 0 {
+			percent = math.Round(float64(skill.Projects)*1000/float64(totalProjects)) / 10
+		}
+		stats.Skills[i] = SkillStatResponse{
+			ID:       skill.ID,
+			Name:     skill.Name,
+			Kind:     skill.Kind,
+			Projects: skill.Projects,
+			Percent:  percent,
+		}
+
+		kind := skill.Kind
+		if kind == "" {
+			kind = "other"
+		}
+		stats.ByKind[kind] += skill.Projects
+	}
+	return stats
+}
@@ -0,0 +1,97 @@
+package response
+
+import (
+	"encoding/json"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
+)
+
+// ToPortfolioSparse renders the selected fields of a portfolio; sections,
+// categories, experiences and education are relations
+func ToPortfolioSparse(portfolio *models.Portfolio, sel query.Selection) map[string]interface{} {
+	return sparse(ToPortfolioDetailResponse(portfolio), sel, "sections", "categories", "experiences", "education")
+}
+
+// ToCategorySparse renders the selected fields of a category; projects is a relation
+func ToCategorySparse(category *models.Category, sel query.Selection) map[string]interface{} {
+	return sparse(ToCategoryDetailResponse(category), sel, "projects")
+}
+
+// ToCategoryListSparse renders the selected fields of each category
+func ToCategoryListSparse(categories []models.Category, sel query.Selection) []map[string]interface{} {
+	responses := make([]map[string]interface{}, len(categories))
+	for i := range categories {
+		responses[i] = ToCategorySparse(&categories[i], sel)
+	}
+	return responses
+}
+
+// ToProjectSparse renders the selected fields of a project
+func ToProjectSparse(project *models.Project, sel query.Selection) map[string]interface{} {
+	return sparse(ToProjectResponse(project), sel)
+}
+
+// ToProjectListSparse renders the selected fields of each project
+func ToProjectListSparse(projects []models.Project, sel query.Selection) []map[string]interface{} {
+	responses := make([]map[string]interface{}, len(projects))
+	for i := range projects {
+		responses[i] = ToProjectSparse(&projects[i], sel)
+	}
+	return responses
+}
+
+// ToSectionSparse renders the selected fields of a section; contents is a relation
+func ToSectionSparse(section *models.Section, sel query.Selection) map[string]interface{} {
+	return sparse(ToSectionResponse(section), sel, "contents")
+}
+
+// ToSectionListSparse renders the selected fields of each section
+func ToSectionListSparse(sections []models.Section, sel query.Selection) []map[string]interface{} {
+	responses := make([]map[string]interface{}, len(sections))
+	for i := range sections {
+		responses[i] = ToSectionSparse(&sections[i], sel)
+	}
+	return responses
+}
+
+// sparse keeps the id, the selected fields and the included relations of a
+// response DTO. Selected fields the DTO omits as empty come back as null and
+// included relations as an empty list, so the shape only depends on the query.
+func sparse(dto interface{}, sel query.Selection, relations ...string) map[string]interface{} {
+	var all map[string]interface{}
+	data, _ := json.Marshal(dto)
+	_ = json.Unmarshal(data, &all)
+
+	out := map[string]interface{}{"id": all["id"]}
+	for _, field := range sel.Fields {
+		out[field] = all[field]
+	}
+	if len(sel.Fields) == 0 {
+		for key, value := range all {
+			if !isRelation(key, relations) {
+				out[key] = value
+			}
+		}
+	}
+	for _, relation := range relations {
+		if !sel.Includes(relation) {
+			continue
+		}
+		if value, ok := all[relation]; ok {
+			out[relation] = value
+		} else {
+			out[relation] = []interface{}{}
+		}
+	}
+	return out
+}
+
+func isRelation(key string, relations []string) bool {
+	for _, relation := range relations {
+		if key == relation {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package response
+
+import (
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToCategorySparse(t *testing.T) {
+	category := &models.Category{
+		Title:       "Backend",
+		Position:    2,
+		PortfolioID: 9,
+		Projects:    []models.Project{{Title: "API"}},
+	}
+	category.ID = 4
+
+	tests := []struct {
+		name     string
+		sel      query.Selection
+		expected []string
+	}{
+		{
+			name:     "Only the picked fields and the id",
+			sel:      query.Selection{Fields: []string{"title", "position"}},
+			expected: []string{"id", "title", "position"},
+		},
+		{
+			name:     "Picked fields with a relation",
+			sel:      query.Selection{Fields: []string{"title"}, Include: []string{"projects"}},
+			expected: []string{"id", "title", "projects"},
+		},
+		{
+			name:     "Omitted empty fields come back as null",
+			sel:      query.Selection{Fields: []string{"description"}},
+			expected: []string{"id", "description"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ToCategorySparse(category, tt.sel)
+
+			keys := make([]string, 0, len(result))
+			for key := range result {
+				keys = append(keys, key)
+			}
+			assert.ElementsMatch(t, tt.expected, keys)
+			assert.EqualValues(t, 4, result["id"])
+		})
+	}
+}
+
+func TestToCategorySparse_IncludeWithoutFields(t *testing.T) {
+	category := &models.Category{Title: "Empty"}
+	category.ID = 1
+
+	result := ToCategorySparse(category, query.Selection{Include: []string{"projects"}})
+
+	assert.Equal(t, "Empty", result["title"], "every field is kept when fields= is absent")
+	assert.Equal(t, []interface{}{}, result["projects"], "an included relation is never missing")
+}
@@ -0,0 +1,55 @@
+package response
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+// TemplateResponse represents a portfolio template in responses. Built-in
+// templates have a key; listings leave the bundle out.
+type TemplateResponse struct {
+	ID          uint                   `json:"id"`
+	Key         string                 `json:"key,omitempty"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Visibility  string                 `json:"visibility"`
+	Bundle      *models.TemplateBundle `json:"bundle,omitempty"`
+	Version     uint                   `json:"version"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
+
+// ToTemplateResponse converts a model to a response DTO, with its bundle
+func ToTemplateResponse(template *models.PortfolioTemplate) TemplateResponse {
+	resp := toTemplateSummary(template)
+	resp.Bundle = &template.Bundle
+	return resp
+}
+
+// ToTemplateListResponse converts a slice of models to response DTOs without
+// their bundles
+func ToTemplateListResponse(templates []models.PortfolioTemplate) []TemplateResponse {
+	responses := make([]TemplateResponse, len(templates))
+	for i := range templates {
+		responses[i] = toTemplateSummary(&templates[i])
+	}
+	return responses
+}
+
+func toTemplateSummary(template *models.PortfolioTemplate) TemplateResponse {
+	var key string
+	if template.Key != nil {
+		key = *template.Key
+	}
+	return TemplateResponse{
+		ID:          template.ID,
+		Key:         key,
+		Name:        template.Name,
+		Description: template.Description,
+		Visibility:  template.Visibility,
+		Version:     template.Version,
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+}
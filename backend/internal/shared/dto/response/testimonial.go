@@ -0,0 +1,99 @@
+package response
+
+import (
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+// TestimonialResponse represents a testimonial of a portfolio or project
+type TestimonialResponse struct {
+	ID          uint      `json:"id"`
+	PortfolioID uint      `json:"portfolio_id"`
+	ProjectID   *uint     `json:"project_id,omitempty"`
+	AuthorName  string    `json:"author_name"`
+	AuthorRole  string    `json:"author_role,omitempty"`
+	Company     string    `json:"company,omitempty"`
+	Quote       string    `json:"quote"`
+	AvatarURL   string    `json:"avatar_url,omitempty"`
+	Rating      *uint     `json:"rating,omitempty"`
+	Status      string    `json:"status"`
+	Position    uint      `json:"position"`
+	Version     uint      `json:"version"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TestimonialInviteResponse represents an invite link as its owner sees it
+type TestimonialInviteResponse struct {
+	ID          uint       `json:"id"`
+	PortfolioID uint       `json:"portfolio_id"`
+	ProjectID   *uint      `json:"project_id,omitempty"`
+	Token       string     `json:"token"`
+	Note        string     `json:"note,omitempty"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	UsedAt      *time.Time `json:"used_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// TestimonialInviteInfoResponse is what the submission form of an invite link
+// shows the client: what the testimonial will be about
+type TestimonialInviteInfoResponse struct {
+	PortfolioID    uint      `json:"portfolio_id"`
+	PortfolioTitle string    `json:"portfolio_title"`
+	ProjectID      *uint     `json:"project_id,omitempty"`
+	ProjectTitle   string    `json:"project_title,omitempty"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// ToTestimonialResponse converts a model to a response DTO
+func ToTestimonialResponse(testimonial *models.Testimonial) TestimonialResponse {
+	return TestimonialResponse{
+		ID:          testimonial.ID,
+		PortfolioID: testimonial.PortfolioID,
+		ProjectID:   testimonial.ProjectID,
+		AuthorName:  testimonial.AuthorName,
+		AuthorRole:  testimonial.AuthorRole,
+		Company:     testimonial.Company,
+		Quote:       testimonial.Quote,
+		AvatarURL:   testimonial.AvatarURL,
+		Rating:      testimonial.Rating,
+		Status:      testimonial.Status,
+		Position:    testimonial.Position,
+		Version:     testimonial.Version,
+		CreatedAt:   testimonial.CreatedAt,
+		UpdatedAt:   testimonial.UpdatedAt,
+	}
+}
+
+// ToTestimonialListResponse converts a slice of models to response DTOs
+func ToTestimonialListResponse(testimonials []models.Testimonial) []TestimonialResponse {
+	result := make([]TestimonialResponse, len(testimonials))
+	for i := range testimonials {
+		result[i] = ToTestimonialResponse(&testimonials[i])
+	}
+	return result
+}
+
+// ToTestimonialInviteResponse converts a model to a response DTO
+func ToTestimonialInviteResponse(invite *models.TestimonialInvite) TestimonialInviteResponse {
+	return TestimonialInviteResponse{
+		ID:          invite.ID,
+		PortfolioID: invite.PortfolioID,
+		ProjectID:   invite.ProjectID,
+		Token:       invite.Token,
+		Note:        invite.Note,
+		ExpiresAt:   invite.ExpiresAt,
+		UsedAt:      invite.UsedAt,
+		CreatedAt:   invite.CreatedAt,
+	}
+}
+
+// ToTestimonialInviteListResponse converts a slice of models to response DTOs
+func ToTestimonialInviteListResponse(invites []models.TestimonialInvite) []TestimonialInviteResponse {
+	result := make([]TestimonialInviteResponse, len(invites))
+	for i := range invites {
+		result[i] = ToTestimonialInviteResponse(&invites[i])
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+package response
+
+import (
+	"math"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+// TranslationResponse represents a translated field in responses
+type TranslationResponse struct {
+	Locale       string    `json:"locale"`
+	ResourceType string    `json:"resource_type"`
+	ResourceID   uint      `json:"resource_id"`
+	Field        string    `json:"field"`
+	Value        string    `json:"value"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// LocaleCompleteness reports how much of a portfolio is translated into a locale.
+// Missing lists the fields with stored text and no translation.
+type LocaleCompleteness struct {
+	Locale     string                  `json:"locale"`
+	Total      int                     `json:"total"`
+	Translated int                     `json:"translated"`
+	Percent    float64                 `json:"percent"`
+	Missing    []models.TranslationKey `json:"missing"`
+}
+
+// CompletenessResponse reports the translation progress of every enabled locale
+// other than the default
+type CompletenessResponse struct {
+	PortfolioID   uint                 `json:"portfolio_id"`
+	DefaultLocale string               `json:"default_locale"`
+	Locales       []LocaleCompleteness `json:"locales"`
+}
+
+// ToTranslationResponse converts a model to a response DTO
+func ToTranslationResponse(translation *models.Translation) TranslationResponse {
+	return TranslationResponse{
+		Locale:       translation.Locale,
+		ResourceType: translation.ResourceType,
+		ResourceID:   translation.ResourceID,
+		Field:        translation.Field,
+		Value:        translation.Value,
+		UpdatedAt:    translation.UpdatedAt,
+	}
+}
+
+// ToTranslationListResponse converts a slice of models to response DTOs
+func ToTranslationListResponse(translations []models.Translation) []TranslationResponse {
+	responses := make([]TranslationResponse, len(translations))
+	for i := range translations {
+		responses[i] = ToTranslationResponse(&translations[i])
+	}
+	return responses
+}
+
+// ToCompletenessResponse compares the translations with the translatable
+// fields of the portfolio, locale by locale. Translations of fields that no
+// longer exist or have no text don't count.
+func ToCompletenessResponse(portfolio *models.Portfolio, sources []models.TranslationSource, translations []models.Translation) CompletenessResponse {
+	translated := make(map[string]map[models.TranslationKey]bool)
+	for _, t := range translations {
+		if translated[t.Locale] == nil {
+			translated[t.Locale] = make(map[models.TranslationKey]bool)
+		}
+		translated[t.Locale][models.TranslationKey{ResourceType: t.ResourceType, ResourceID: t.ResourceID, Field: t.Field}] = true
+	}
+
+	locales := portfolio.ContentLocales()
+	report := CompletenessResponse{
+		PortfolioID:   portfolio.ID,
+		DefaultLocale: locales[0],
+		Locales:       make([]LocaleCompleteness, 0, len(locales)-1),
+	}
+	for _, locale := range locales[1:] {
+		entry := LocaleCompleteness{
+			Locale:  locale,
+			Total:   len(sources),
+			Missing: []models.TranslationKey{},
+			Percent: 100,
+		}
+		for _, source := range sources {
+			if translated[locale][source.TranslationKey] {
+				entry.Translated++
+			} else {
+				entry.Missing = append(entry.Missing, source.TranslationKey)
+			}
+		}
+		if entry.Total > 0 {
+			entry.Percent = math.Round(float64(entry.Translated)*1000/float64(entry.Total)) / 10
+		}
+		report.Locales = append(report.Locales, entry)
+	}
+	return report
+}
@@ -0,0 +1,88 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+// WebhookResponse represents a webhook in responses. Secret is only filled in
+// when the webhook is created.
+type WebhookResponse struct {
+	ID          uint      `json:"id"`
+	PortfolioID uint      `json:"portfolio_id"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events"`
+	Active      bool      `json:"active"`
+	Secret      string    `json:"secret,omitempty"`
+	Version     uint      `json:"version"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// WebhookDeliveryResponse represents one entry of a webhook's delivery log
+type WebhookDeliveryResponse struct {
+	ID             uint            `json:"id"`
+	WebhookID      uint            `json:"webhook_id"`
+	Event          string          `json:"event"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	LastStatusCode int             `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	Payload        json.RawMessage `json:"payload"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// ToWebhookResponse converts a model to a response DTO
+func ToWebhookResponse(webhook *models.Webhook) WebhookResponse {
+	events := []string(webhook.Events)
+	if events == nil {
+		events = []string{}
+	}
+	return WebhookResponse{
+		ID:          webhook.ID,
+		PortfolioID: webhook.PortfolioID,
+		URL:         webhook.URL,
+		Events:      events,
+		Active:      webhook.Active,
+		Version:     webhook.Version,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+}
+
+// ToWebhookListResponse converts a slice of models to response DTOs
+func ToWebhookListResponse(webhooks []models.Webhook) []WebhookResponse {
+	result := make([]WebhookResponse, len(webhooks))
+	for i := range webhooks {
+		result[i] = ToWebhookResponse(&webhooks[i])
+	}
+	return result
+}
+
+// ToWebhookDeliveryListResponse converts delivery log entries to response DTOs
+func ToWebhookDeliveryListResponse(deliveries []models.WebhookDelivery) []WebhookDeliveryResponse {
+	result := make([]WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		result[i] = WebhookDeliveryResponse{
+			ID:             delivery.ID,
+			WebhookID:      delivery.WebhookID,
+			Event:          delivery.Event,
+			Status:         delivery.Status,
+			Attempts:       delivery.Attempts,
+			LastStatusCode: delivery.LastStatusCode,
+			LastError:      delivery.LastError,
+			Payload:        json.RawMessage(delivery.Payload),
+			DeliveredAt:    delivery.DeliveredAt,
+			CreatedAt:      delivery.CreatedAt,
+		}
+		if delivery.Status == models.WebhookDeliveryPending {
+			next := delivery.NextAttemptAt
+			result[i].NextAttemptAt = &next
+		}
+	}
+	return result
+}
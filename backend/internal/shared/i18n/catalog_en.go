@@ -0,0 +1,383 @@
+package i18n
+
+// catalogEN is the English catalog; it is the fallback of the others and must
+// hold every key
+var catalogEN = map[string]string{
+	// General
+	"access_denied":           "Access denied",
+	"invalid_request":         "Invalid request data",
+	"read_body_failed":        "Failed to read request body",
+	"internal_error":          "An internal server error occurred",
+	"rate_limited":            "Rate limit exceeded. Please try again later.",
+	"request_too_large":       "Request body too large. Maximum size is {max} bytes",
+	"resource_modified":       "{resource} has been modified by another request, reload and try again",
+	"update_positions_failed": "Failed to update positions",
+	"duplicate_position":      "Duplicate position: {position}",
+	"user_id_required":        "User ID is required",
+	"portfolio_id_required":   "Portfolio ID is required",
+	"position_ambiguous":      "Give only one of position, before and after",
+	"position_anchor_invalid": "The item to place next to is not in the same list",
+	"reorder_not_siblings":    "All reordered items must be in the same list",
+	"reorder_duplicate_item":  "An item is listed more than once",
+
+	// Authentication
+	"auth.header_required": "Authorization header required",
+	"auth.invalid_format":  "Invalid authorization format",
+	"auth.invalid_token":   "Invalid token",
+	"auth.invalid_claims":  "Invalid token claims",
+	"auth.unavailable":     "Authentication service unavailable",
+	"account.suspended":    "Account is suspended",
+
+	// Idempotency-Key and If-Match
+	"idempotency.key_too_long":       "Idempotency-Key must be at most 255 characters",
+	"idempotency.failed":             "Failed to process Idempotency-Key",
+	"idempotency.key_reused":         "Idempotency-Key was already used with a different request",
+	"idempotency.in_progress":        "A request with this Idempotency-Key is still being processed",
+	"precondition.if_match_required": "If-Match header required",
+	"precondition.if_match_invalid":  "Invalid If-Match header",
+
+	// Portfolios
+	"portfolio.not_found":              "Portfolio not found",
+	"portfolio.invalid_id":             "Invalid portfolio ID",
+	"portfolio.access_denied":          "Access denied: portfolio belongs to another user",
+	"portfolio.title_taken":            "Portfolio with this title already exists",
+	"portfolio.duplicate_check_failed": "Failed to check for duplicate portfolio",
+	"portfolio.create_failed":          "Failed to create portfolio",
+	"portfolio.update_failed":          "Failed to update portfolio",
+	"portfolio.delete_failed":          "Failed to delete portfolio",
+	"portfolio.list_failed":            "Failed to retrieve portfolios",
+	"portfolio.locales_failed":         "Failed to update portfolio locales",
+
+	// Categories
+	"category.not_found":       "Category not found",
+	"category.invalid_id":      "Invalid category ID",
+	"category.access_denied":   "Access denied: category belongs to another user's portfolio",
+	"category.some_not_found":  "Some categories not found",
+	"category.create_failed":   "Failed to create category",
+	"category.update_failed":   "Failed to update category",
+	"category.delete_failed":   "Failed to delete category",
+	"category.list_failed":     "Failed to retrieve categories",
+	"category.position_failed": "Failed to update category position",
+
+	// Projects
+	"project.not_found":              "Project not found",
+	"project.invalid_id":             "Invalid project ID",
+	"project.title_taken":            "Project with this title already exists in this category",
+	"project.duplicate_check_failed": "Failed to check for duplicate project",
+	"project.create_failed":          "Failed to create project",
+	"project.update_failed":          "Failed to update project",
+	"project.delete_failed":          "Failed to delete project",
+	"project.list_failed":            "Failed to retrieve projects",
+	"project.position_failed":        "Failed to update project position",
+	"project.skills_required":        "At least one skill is required",
+	"project.client_required":        "Client name is required",
+	"project.not_in_category":        "Project is not in this category",
+	"project.last_category":          "A project must stay in at least one category",
+	"project.some_not_in_category":   "Some projects were not found in this category",
+	"project.categories_failed":      "Failed to update the project's categories",
+	"project.already_in_category":    "Project is already in this category",
+	"project.move_failed":            "Failed to move project",
+
+	// Sections
+	"section.not_found":              "Section not found",
+	"section.invalid_id":             "Invalid section ID",
+	"section.access_denied":          "Access denied: section belongs to another user's portfolio",
+	"section.some_not_found":         "Some sections not found",
+	"section.title_taken":            "Section with this title already exists in this portfolio",
+	"section.duplicate_check_failed": "Failed to check for duplicate section",
+	"section.create_failed":          "Failed to create section",
+	"section.update_failed":          "Failed to update section",
+	"section.delete_failed":          "Failed to delete section",
+	"section.list_failed":            "Failed to retrieve sections",
+	"section.position_failed":        "Failed to update section position",
+	"section.type_required":          "Section type is required",
+	"section.move_failed":            "Failed to move section",
+
+	// Section contents
+	"content.not_found":      "Content not found",
+	"content.invalid_id":     "Invalid content ID",
+	"content.create_failed":  "Failed to create content",
+	"content.update_failed":  "Failed to update content",
+	"content.delete_failed":  "Failed to delete content",
+	"content.list_failed":    "Failed to retrieve contents",
+	"content.order_failed":   "Failed to update content order",
+	"content.some_not_found": "Some contents were not found in this section",
+	"content.move_failed":    "Failed to move content",
+
+	// Webhooks
+	"webhook.not_found":                "Webhook not found",
+	"webhook.invalid_id":               "Invalid webhook ID",
+	"webhook.create_failed":            "Failed to create webhook",
+	"webhook.update_failed":            "Failed to update webhook",
+	"webhook.delete_failed":            "Failed to delete webhook",
+	"webhook.list_failed":              "Failed to retrieve webhooks",
+	"webhook.deliveries_failed":        "Failed to retrieve webhook deliveries",
+	"webhook.authentik_not_configured": "Authentik webhook is not configured",
+	"webhook.invalid_signature":        "Invalid signature",
+	"webhook.process_failed":           "Failed to process event",
+
+	// Users
+	"user.load_failed":   "Failed to retrieve user data",
+	"user.delete_failed": "Failed to delete user data",
+
+	// Query parameters
+	"query.sort":              "sort must be one of {fields}",
+	"query.limit":             "limit must be between 1 and {max}",
+	"query.cursor_malformed":  "Malformed cursor",
+	"query.cursor_sort":       "The cursor belongs to a different sort",
+	"query.filter":            "{param} can't be filtered here",
+	"query.bool":              "{param} must be true or false",
+	"query.date":              "\"{value}\" is not a date (use YYYY-MM-DD or RFC 3339)",
+	"query.period_date":       "{param} \"{value}\" is not a date (use YYYY-MM-DD)",
+	"query.period_order":      "from must not be after to",
+	"query.period_length":     "The period can't be longer than {max} days",
+	"query.param_unsupported": "{param} isn't supported here",
+	"query.param_values":      "{param} must be among {allowed}",
+
+	// PATCH, event streams and batches
+	"patch.content_type":           "Content-Type must be {merge} or {json}",
+	"patch.test_failed":            "Patch test operation failed",
+	"patch.not_object":             "A merge patch must be a JSON object",
+	"patch.malformed":              "The patch is not valid JSON",
+	"patch.not_applicable":         "The patch refers to a path the document doesn't have",
+	"patch.unknown_field":          "{field} can't be changed with a patch",
+	"patch.wrong_type":             "{field} has the wrong type",
+	"patch.invalid":                "The patched document is not valid",
+	"stream.accept_required":       "Event streams require Accept: text/event-stream",
+	"stream.invalid_last_event_id": "Invalid Last-Event-ID",
+	"batch.commit_failed":          "Failed to commit batch",
+	"batch.operation_failed":       "Operation {index} failed, no changes were saved",
+	"batch.path_invalid":           "Operation path must start with /",
+	"batch.nested":                 "Batch requests cannot be nested",
+	"batch.operation_invalid":      "Operation method or path is invalid",
+	"batch.body_invalid":           "Operation body is not valid JSON",
+	"batch.reference_invalid":      "{ref} is not a valid reference",
+	"batch.reference_not_run":      "{ref} refers to an operation that has not run yet",
+	"batch.reference_no_field":     "{ref} has no field \"{field}\"",
+	"batch.reference_no_element":   "{ref} has no element \"{field}\"",
+
+	// Translations
+	"translation.locale_not_enabled": "{locale} is not enabled for this portfolio",
+	"translation.default_locale":     "{locale} is the default locale, edit the content itself",
+	"translation.resource_not_found": "{resource} {id} is not part of this portfolio",
+	"translation.list_failed":        "Failed to retrieve translations",
+	"translation.save_failed":        "Failed to save translations",
+	"translation.delete_failed":      "Failed to delete translations",
+	"translation.report_failed":      "Failed to build the completeness report",
+
+	// Skills
+	"skill.not_found":      "Skill not found",
+	"skill.invalid_id":     "Invalid skill ID",
+	"skill.name_taken":     "{name} is already a skill or an alias of one",
+	"skill.in_use":         "The skill is used by {count} projects, merge it into another skill instead",
+	"skill.merge_self":     "A skill cannot be merged into itself",
+	"skill.some_not_found": "Some skills were not found",
+	"skill.list_failed":    "Failed to retrieve skills",
+	"skill.create_failed":  "Failed to create skill",
+	"skill.update_failed":  "Failed to update skill",
+	"skill.delete_failed":  "Failed to delete skill",
+	"skill.merge_failed":   "Failed to merge skills",
+	"skill.stats_failed":   "Failed to compute skill statistics",
+
+	// Templates
+	"template.not_found":     "Template not found",
+	"template.invalid_id":    "Invalid template ID",
+	"template.name_taken":    "You already have a template named {name}",
+	"template.list_failed":   "Failed to retrieve templates",
+	"template.create_failed": "Failed to create template",
+	"template.update_failed": "Failed to update template",
+	"template.delete_failed": "Failed to delete template",
+
+	// Analytics
+	"analytics.report_failed": "Failed to retrieve analytics",
+
+	// Link checks
+	"link.report_failed": "Failed to retrieve broken links",
+
+	// Administration
+	"admin.required": "Administrator access required",
+
+	// Background jobs
+	"job.not_found":       "Job not found",
+	"job.invalid_id":      "Invalid job ID",
+	"job.invalid_status":  "Invalid job status",
+	"job.unknown_type":    "Unknown job type",
+	"job.invalid_payload": "Invalid job payload",
+	"job.not_dead":        "Only dead jobs can be retried",
+	"job.running":         "Running jobs cannot be deleted",
+	"job.list_failed":     "Failed to retrieve jobs",
+	"job.stats_failed":    "Failed to retrieve job statistics",
+	"job.create_failed":   "Failed to enqueue job",
+	"job.retry_failed":    "Failed to retry job",
+	"job.delete_failed":   "Failed to delete job",
+
+	// Contact form and inbox
+	"contact.not_found":            "Message not found",
+	"contact.invalid_id":           "Invalid message ID",
+	"contact.invalid_status":       "status must be one of unread, read, archived",
+	"contact.disabled":             "This portfolio doesn't accept messages",
+	"contact.send_failed":          "Failed to send message",
+	"contact.list_failed":          "Failed to retrieve messages",
+	"contact.update_failed":        "Failed to update message",
+	"contact.delete_failed":        "Failed to delete message",
+	"contact.settings_failed":      "Failed to retrieve contact settings",
+	"contact.settings_save_failed": "Failed to save contact settings",
+
+	// Testimonials and invites
+	"testimonial.not_found":                "Testimonial not found",
+	"testimonial.invalid_id":               "Invalid testimonial ID",
+	"testimonial.invalid_status":           "status must be one of pending, approved, rejected",
+	"testimonial.some_not_found":           "One or more testimonials not found",
+	"testimonial.project_not_in_portfolio": "The project isn't shown in this portfolio",
+	"testimonial.list_failed":              "Failed to retrieve testimonials",
+	"testimonial.create_failed":            "Failed to create testimonial",
+	"testimonial.update_failed":            "Failed to update testimonial",
+	"testimonial.delete_failed":            "Failed to delete testimonial",
+	"testimonial.position_failed":          "Failed to update testimonial position",
+	"testimonial.submit_failed":            "Failed to submit testimonial",
+	"testimonial.invite_not_found":         "Invite not found",
+	"testimonial.invite_invalid_id":        "Invalid invite ID",
+	"testimonial.invite_unusable":          "This invite link has expired or was already used",
+	"testimonial.invite_create_failed":     "Failed to create invite",
+	"testimonial.invite_list_failed":       "Failed to retrieve invites",
+	"testimonial.invite_delete_failed":     "Failed to delete invite",
+
+	// Work experience and education
+	"experience.not_found":                 "Experience not found",
+	"experience.invalid_id":                "Invalid experience ID",
+	"experience.some_not_found":            "One or more experiences not found",
+	"experience.projects_not_in_portfolio": "Linked projects must be shown in this portfolio",
+	"experience.list_failed":               "Failed to retrieve experiences",
+	"experience.create_failed":             "Failed to create experience",
+	"experience.update_failed":             "Failed to update experience",
+	"experience.delete_failed":             "Failed to delete experience",
+	"experience.position_failed":           "Failed to update experience position",
+	"education.not_found":                  "Education entry not found",
+	"education.invalid_id":                 "Invalid education ID",
+	"education.some_not_found":             "One or more education entries not found",
+	"education.list_failed":                "Failed to retrieve education",
+	"education.create_failed":              "Failed to create education entry",
+	"education.update_failed":              "Failed to update education entry",
+	"education.delete_failed":              "Failed to delete education entry",
+	"education.position_failed":            "Failed to update education position",
+
+	// Project import
+	"import.unknown_provider":  "Git provider not available",
+	"import.account_required":  "An account name is required",
+	"import.account_not_found": "Account not found on the Git provider",
+	"import.provider_failed":   "The Git provider could not be reached, try again later",
+	"import.list_failed":       "Failed to list repositories",
+	"import.failed":            "Failed to import repositories",
+
+	// Validation; {field} is the label of the field
+	"validation.required":           "{field} is required",
+	"validation.min":                "{field} must be at least {min} characters",
+	"validation.max":                "{field} must be less than {max} characters",
+	"validation.min_value":          "{field} must be at least {min}",
+	"validation.max_value":          "{field} must be at most {max}",
+	"validation.url":                "{field} must be a valid URL",
+	"validation.url_scheme_missing": "{field} must include a scheme (http:// or https://)",
+	"validation.url_scheme":         "{field} must use http:// or https:// scheme",
+	"validation.oneof":              "{field} must be one of: {values}",
+	"validation.email":              "{field} must be a valid email address",
+	"validation.type":               "{field} must be a {type}",
+	"validation.failed":             "{field} failed the {tag} check",
+	"validation.events_required":    "At least one event is required",
+	"validation.unknown_event":      "Unknown event \"{value}\"",
+	"validation.locale":             "{field} must be a language tag such as en or pt-BR",
+	"validation.not_translatable":   "{value} cannot be translated",
+	"validation.max_items":          "{field} can have at most {max} items",
+	"validation.end_before_start":   "{field} must not be before the start date",
+	"validation.ongoing_end_date":   "{field} must be empty for an ongoing project",
+	"validation.current_end_date":   "{field} must be empty for a current position",
+	"validation.placeholder":        "{field} can't use {value}; only {portfolio} is filled in",
+
+	// Field labels
+	"field.title":              "Title",
+	"field.description":        "Description",
+	"field.category_id":        "Category ID",
+	"field.portfolio_id":       "Portfolio ID",
+	"field.section_id":         "Section ID",
+	"field.type":               "Type",
+	"field.link":               "Link",
+	"field.url":                "URL",
+	"field.content":            "Content",
+	"field.metadata":           "Metadata",
+	"field.events":             "Events",
+	"field.skills":             "Skills",
+	"field.client":             "Client",
+	"field.position":           "Position",
+	"field.order":              "Order",
+	"field.name":               "Name",
+	"field.email":              "Email",
+	"field.default_locale":     "Default locale",
+	"field.locales":            "Locales",
+	"field.locale":             "Locale",
+	"field.resource_type":      "Resource type",
+	"field.resource_id":        "Resource ID",
+	"field.field":              "Field",
+	"field.value":              "Value",
+	"field.kind":               "Kind",
+	"field.aliases":            "Aliases",
+	"field.source_ids":         "Source IDs",
+	"field.links":              "Links",
+	"field.start_date":         "Start date",
+	"field.end_date":           "End date",
+	"field.role":               "Role",
+	"field.team_size":          "Team size",
+	"field.status":             "Status",
+	"field.visibility":         "Visibility",
+	"field.subject":            "Subject",
+	"field.message":            "Message",
+	"field.notify_email":       "Notification email",
+	"field.auto_reply_subject": "Auto-reply subject",
+	"field.auto_reply_body":    "Auto-reply body",
+	"field.author_name":        "Author name",
+	"field.author_role":        "Author role",
+	"field.company":            "Company",
+	"field.quote":              "Quote",
+	"field.avatar_url":         "Avatar URL",
+	"field.rating":             "Rating",
+	"field.note":               "Note",
+	"field.expires_in_days":    "Expires in (days)",
+	"field.project_id":         "Project ID",
+	"field.location":           "Location",
+	"field.highlights":         "Highlights",
+	"field.project_ids":        "Linked projects",
+	"field.institution":        "Institution",
+	"field.degree":             "Degree",
+	"field.provider":           "Provider",
+	"field.repository_ids":     "Repositories",
+
+	// Resource names
+	"resource.portfolio":       "Portfolio",
+	"resource.category":        "Category",
+	"resource.project":         "Project",
+	"resource.section":         "Section",
+	"resource.content":         "Content",
+	"resource.webhook":         "Webhook",
+	"resource.section_content": "Section content",
+	"resource.skill":           "Skill",
+	"resource.template":        "Template",
+	"resource.contactmessage":  "Message",
+	"resource.contactsettings": "Contact settings",
+	"resource.testimonial":     "Testimonial",
+	"resource.experience":      "Experience",
+	"resource.education":       "Education entry",
+
+	// HTTP status titles of problem responses
+	"status.400": "Bad Request",
+	"status.401": "Unauthorized",
+	"status.403": "Forbidden",
+	"status.404": "Not Found",
+	"status.406": "Not Acceptable",
+	"status.409": "Conflict",
+	"status.412": "Precondition Failed",
+	"status.413": "Request Entity Too Large",
+	"status.415": "Unsupported Media Type",
+	"status.422": "Unprocessable Entity",
+	"status.428": "Precondition Required",
+	"status.429": "Too Many Requests",
+	"status.500": "Internal Server Error",
+	"status.503": "Service Unavailable",
+}
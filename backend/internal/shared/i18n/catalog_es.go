@@ -0,0 +1,382 @@
+package i18n
+
+// catalogES is the Spanish catalog
+var catalogES = map[string]string{
+	// General
+	"access_denied":           "Acceso denegado",
+	"invalid_request":         "Datos de la solicitud no válidos",
+	"read_body_failed":        "Error al leer el cuerpo de la solicitud",
+	"internal_error":          "Se produjo un error interno del servidor",
+	"rate_limited":            "Límite de solicitudes superado. Inténtalo de nuevo más tarde.",
+	"request_too_large":       "Cuerpo de la solicitud demasiado grande. El tamaño máximo es {max} bytes",
+	"resource_modified":       "{resource}: otra solicitud hizo cambios, recarga e inténtalo de nuevo",
+	"update_positions_failed": "Error al actualizar las posiciones",
+	"duplicate_position":      "Posición duplicada: {position}",
+	"user_id_required":        "El ID de usuario es obligatorio",
+	"portfolio_id_required":   "El ID del portafolio es obligatorio",
+	"position_ambiguous":      "Indique solo uno de position, before y after",
+	"position_anchor_invalid": "El elemento junto al que colocar no está en la misma lista",
+	"reorder_not_siblings":    "Todos los elementos reordenados deben estar en la misma lista",
+	"reorder_duplicate_item":  "Un elemento aparece más de una vez",
+
+	// Authentication
+	"auth.header_required": "Se requiere la cabecera Authorization",
+	"auth.invalid_format":  "Formato de autorización no válido",
+	"auth.invalid_token":   "Token no válido",
+	"auth.invalid_claims":  "Claims del token no válidos",
+	"auth.unavailable":     "Servicio de autenticación no disponible",
+	"account.suspended":    "La cuenta está suspendida",
+
+	// Idempotency-Key and If-Match
+	"idempotency.key_too_long":       "La Idempotency-Key debe tener como máximo 255 caracteres",
+	"idempotency.failed":             "Error al procesar la Idempotency-Key",
+	"idempotency.key_reused":         "La Idempotency-Key ya se usó con otra solicitud",
+	"idempotency.in_progress":        "Una solicitud con esta Idempotency-Key aún se está procesando",
+	"precondition.if_match_required": "Se requiere la cabecera If-Match",
+	"precondition.if_match_invalid":  "Cabecera If-Match no válida",
+
+	// Portfolios
+	"portfolio.not_found":              "Portafolio no encontrado",
+	"portfolio.invalid_id":             "ID de portafolio no válido",
+	"portfolio.access_denied":          "Acceso denegado: el portafolio pertenece a otro usuario",
+	"portfolio.title_taken":            "Ya existe un portafolio con este título",
+	"portfolio.duplicate_check_failed": "Error al comprobar si el portafolio está duplicado",
+	"portfolio.create_failed":          "Error al crear el portafolio",
+	"portfolio.update_failed":          "Error al actualizar el portafolio",
+	"portfolio.delete_failed":          "Error al eliminar el portafolio",
+	"portfolio.list_failed":            "Error al obtener los portafolios",
+	"portfolio.locales_failed":         "Error al actualizar los idiomas del portafolio",
+
+	// Categories
+	"category.not_found":       "Categoría no encontrada",
+	"category.invalid_id":      "ID de categoría no válido",
+	"category.access_denied":   "Acceso denegado: la categoría pertenece al portafolio de otro usuario",
+	"category.some_not_found":  "No se encontraron algunas categorías",
+	"category.create_failed":   "Error al crear la categoría",
+	"category.update_failed":   "Error al actualizar la categoría",
+	"category.delete_failed":   "Error al eliminar la categoría",
+	"category.list_failed":     "Error al obtener las categorías",
+	"category.position_failed": "Error al actualizar la posición de la categoría",
+
+	// Projects
+	"project.not_found":              "Proyecto no encontrado",
+	"project.invalid_id":             "ID de proyecto no válido",
+	"project.title_taken":            "Ya existe un proyecto con este título en esta categoría",
+	"project.duplicate_check_failed": "Error al comprobar si el proyecto está duplicado",
+	"project.create_failed":          "Error al crear el proyecto",
+	"project.update_failed":          "Error al actualizar el proyecto",
+	"project.delete_failed":          "Error al eliminar el proyecto",
+	"project.list_failed":            "Error al obtener los proyectos",
+	"project.position_failed":        "Error al actualizar la posición del proyecto",
+	"project.skills_required":        "Se requiere al menos una habilidad",
+	"project.client_required":        "El nombre del cliente es obligatorio",
+	"project.not_in_category":        "El proyecto no está en esta categoría",
+	"project.last_category":          "Un proyecto debe permanecer en al menos una categoría",
+	"project.some_not_in_category":   "Algunos proyectos no se encontraron en esta categoría",
+	"project.categories_failed":      "Error al actualizar las categorías del proyecto",
+	"project.already_in_category":    "El proyecto ya está en esta categoría",
+	"project.move_failed":            "Error al mover el proyecto",
+
+	// Sections
+	"section.not_found":              "Sección no encontrada",
+	"section.invalid_id":             "ID de sección no válido",
+	"section.access_denied":          "Acceso denegado: la sección pertenece al portafolio de otro usuario",
+	"section.some_not_found":         "No se encontraron algunas secciones",
+	"section.title_taken":            "Ya existe una sección con este título en este portafolio",
+	"section.duplicate_check_failed": "Error al comprobar si la sección está duplicada",
+	"section.create_failed":          "Error al crear la sección",
+	"section.update_failed":          "Error al actualizar la sección",
+	"section.delete_failed":          "Error al eliminar la sección",
+	"section.list_failed":            "Error al obtener las secciones",
+	"section.position_failed":        "Error al actualizar la posición de la sección",
+	"section.type_required":          "El tipo de sección es obligatorio",
+	"section.move_failed":            "Error al mover la sección",
+
+	// Section contents
+	"content.not_found":      "Contenido no encontrado",
+	"content.invalid_id":     "ID de contenido no válido",
+	"content.create_failed":  "Error al crear el contenido",
+	"content.update_failed":  "Error al actualizar el contenido",
+	"content.delete_failed":  "Error al eliminar el contenido",
+	"content.list_failed":    "Error al obtener los contenidos",
+	"content.order_failed":   "Error al actualizar el orden del contenido",
+	"content.some_not_found": "Algunos contenidos no se encontraron en esta sección",
+	"content.move_failed":    "Error al mover el contenido",
+
+	// Webhooks
+	"webhook.not_found":                "Webhook no encontrado",
+	"webhook.invalid_id":               "ID de webhook no válido",
+	"webhook.create_failed":            "Error al crear el webhook",
+	"webhook.update_failed":            "Error al actualizar el webhook",
+	"webhook.delete_failed":            "Error al eliminar el webhook",
+	"webhook.list_failed":              "Error al obtener los webhooks",
+	"webhook.deliveries_failed":        "Error al obtener las entregas del webhook",
+	"webhook.authentik_not_configured": "El webhook de Authentik no está configurado",
+	"webhook.invalid_signature":        "Firma no válida",
+	"webhook.process_failed":           "Error al procesar el evento",
+
+	// Users
+	"user.load_failed":   "Error al obtener los datos del usuario",
+	"user.delete_failed": "Error al eliminar los datos del usuario",
+
+	// Query parameters
+	"query.sort":              "sort debe ser uno de {fields}",
+	"query.limit":             "limit debe estar entre 1 y {max}",
+	"query.cursor_malformed":  "Cursor mal formado",
+	"query.cursor_sort":       "El cursor pertenece a otro orden",
+	"query.filter":            "{param} no se puede filtrar aquí",
+	"query.bool":              "{param} debe ser true o false",
+	"query.date":              "\"{value}\" no es una fecha (usa AAAA-MM-DD o RFC 3339)",
+	"query.period_date":       "{param} \"{value}\" no es una fecha (usa AAAA-MM-DD)",
+	"query.period_order":      "from no puede ser posterior a to",
+	"query.period_length":     "El periodo no puede superar los {max} días",
+	"query.param_unsupported": "{param} no se admite aquí",
+	"query.param_values":      "{param} debe estar entre {allowed}",
+
+	// PATCH, event streams and batches
+	"patch.content_type":           "El Content-Type debe ser {merge} o {json}",
+	"patch.test_failed":            "La operación test del patch falló",
+	"patch.not_object":             "Un merge patch debe ser un objeto JSON",
+	"patch.malformed":              "El patch no es JSON válido",
+	"patch.not_applicable":         "El patch hace referencia a una ruta que el documento no tiene",
+	"patch.unknown_field":          "{field} no se puede cambiar con un patch",
+	"patch.wrong_type":             "{field} tiene un tipo incorrecto",
+	"patch.invalid":                "El documento resultante del patch no es válido",
+	"stream.accept_required":       "Los flujos de eventos requieren Accept: text/event-stream",
+	"stream.invalid_last_event_id": "Last-Event-ID no válido",
+	"batch.commit_failed":          "Error al confirmar el lote",
+	"batch.operation_failed":       "La operación {index} falló, no se guardó ningún cambio",
+	"batch.path_invalid":           "La ruta de la operación debe empezar por /",
+	"batch.nested":                 "Las solicitudes por lotes no se pueden anidar",
+	"batch.operation_invalid":      "El método o la ruta de la operación no son válidos",
+	"batch.body_invalid":           "El cuerpo de la operación no es JSON válido",
+	"batch.reference_invalid":      "{ref} no es una referencia válida",
+	"batch.reference_not_run":      "{ref} hace referencia a una operación que aún no se ha ejecutado",
+	"batch.reference_no_field":     "{ref} no tiene el campo \"{field}\"",
+	"batch.reference_no_element":   "{ref} no tiene el elemento \"{field}\"",
+
+	// Translations
+	"translation.locale_not_enabled": "{locale} no está habilitado en este portafolio",
+	"translation.default_locale":     "{locale} es el idioma predeterminado, edita el contenido directamente",
+	"translation.resource_not_found": "{resource} {id} no forma parte de este portafolio",
+	"translation.list_failed":        "Error al obtener las traducciones",
+	"translation.save_failed":        "Error al guardar las traducciones",
+	"translation.delete_failed":      "Error al eliminar las traducciones",
+	"translation.report_failed":      "Error al generar el informe de completitud",
+
+	// Skills
+	"skill.not_found":      "Habilidad no encontrada",
+	"skill.invalid_id":     "ID de habilidad no válido",
+	"skill.name_taken":     "{name} ya es una habilidad o un alias de una",
+	"skill.in_use":         "La habilidad se usa en {count} proyectos, combínala con otra habilidad",
+	"skill.merge_self":     "Una habilidad no se puede combinar consigo misma",
+	"skill.some_not_found": "Algunas habilidades no se encontraron",
+	"skill.list_failed":    "Error al obtener las habilidades",
+	"skill.create_failed":  "Error al crear la habilidad",
+	"skill.update_failed":  "Error al actualizar la habilidad",
+	"skill.delete_failed":  "Error al eliminar la habilidad",
+	"skill.merge_failed":   "Error al combinar las habilidades",
+	"skill.stats_failed":   "Error al calcular las estadísticas de habilidades",
+
+	// Templates
+	"template.not_found":     "Plantilla no encontrada",
+	"template.invalid_id":    "ID de plantilla no válido",
+	"template.name_taken":    "Ya tienes una plantilla llamada {name}",
+	"template.list_failed":   "Error al obtener las plantillas",
+	"template.create_failed": "Error al crear la plantilla",
+	"template.update_failed": "Error al actualizar la plantilla",
+	"template.delete_failed": "Error al eliminar la plantilla",
+
+	// Analytics
+	"analytics.report_failed": "Error al obtener las estadísticas de visitas",
+
+	// Link checks
+	"link.report_failed": "Error al obtener los enlaces rotos",
+
+	// Administration
+	"admin.required": "Se requiere acceso de administrador",
+
+	// Background jobs
+	"job.not_found":       "Tarea no encontrada",
+	"job.invalid_id":      "ID de tarea no válido",
+	"job.invalid_status":  "Estado de tarea no válido",
+	"job.unknown_type":    "Tipo de tarea desconocido",
+	"job.invalid_payload": "Datos de la tarea no válidos",
+	"job.not_dead":        "Solo se pueden reintentar las tareas muertas",
+	"job.running":         "Las tareas en ejecución no se pueden eliminar",
+	"job.list_failed":     "Error al obtener las tareas",
+	"job.stats_failed":    "Error al obtener las estadísticas de las tareas",
+	"job.create_failed":   "Error al encolar la tarea",
+	"job.retry_failed":    "Error al reintentar la tarea",
+	"job.delete_failed":   "Error al eliminar la tarea",
+
+	// Contact form and inbox
+	"contact.not_found":            "Mensaje no encontrado",
+	"contact.invalid_id":           "ID de mensaje no válido",
+	"contact.invalid_status":       "status debe ser uno de unread, read, archived",
+	"contact.disabled":             "Este portafolio no acepta mensajes",
+	"contact.send_failed":          "Error al enviar el mensaje",
+	"contact.list_failed":          "Error al obtener los mensajes",
+	"contact.update_failed":        "Error al actualizar el mensaje",
+	"contact.delete_failed":        "Error al eliminar el mensaje",
+	"contact.settings_failed":      "Error al obtener la configuración de contacto",
+	"contact.settings_save_failed": "Error al guardar la configuración de contacto",
+
+	// Testimonials and invites
+	"testimonial.not_found":                "Testimonio no encontrado",
+	"testimonial.invalid_id":               "ID de testimonio no válido",
+	"testimonial.invalid_status":           "status debe ser uno de pending, approved, rejected",
+	"testimonial.some_not_found":           "Uno o más testimonios no encontrados",
+	"testimonial.project_not_in_portfolio": "El proyecto no aparece en este portafolio",
+	"testimonial.list_failed":              "Error al obtener los testimonios",
+	"testimonial.create_failed":            "Error al crear el testimonio",
+	"testimonial.update_failed":            "Error al actualizar el testimonio",
+	"testimonial.delete_failed":            "Error al eliminar el testimonio",
+	"testimonial.position_failed":          "Error al actualizar la posición del testimonio",
+	"testimonial.submit_failed":            "Error al enviar el testimonio",
+	"testimonial.invite_not_found":         "Invitación no encontrada",
+	"testimonial.invite_invalid_id":        "ID de invitación no válido",
+	"testimonial.invite_unusable":          "Este enlace de invitación caducó o ya se usó",
+	"testimonial.invite_create_failed":     "Error al crear la invitación",
+	"testimonial.invite_list_failed":       "Error al obtener las invitaciones",
+	"testimonial.invite_delete_failed":     "Error al eliminar la invitación",
+
+	// Work experience and education
+	"experience.not_found":                 "Experiencia no encontrada",
+	"experience.invalid_id":                "ID de experiencia no válido",
+	"experience.some_not_found":            "Una o más experiencias no se encontraron",
+	"experience.projects_not_in_portfolio": "Los proyectos vinculados deben aparecer en este portafolio",
+	"experience.list_failed":               "Error al obtener las experiencias",
+	"experience.create_failed":             "Error al crear la experiencia",
+	"experience.update_failed":             "Error al actualizar la experiencia",
+	"experience.delete_failed":             "Error al eliminar la experiencia",
+	"experience.position_failed":           "Error al actualizar la posición de la experiencia",
+	"education.not_found":                  "Formación no encontrada",
+	"education.invalid_id":                 "ID de formación no válido",
+	"education.some_not_found":             "Una o más formaciones no se encontraron",
+	"education.list_failed":                "Error al obtener la formación",
+	"education.create_failed":              "Error al crear la formación",
+	"education.update_failed":              "Error al actualizar la formación",
+	"education.delete_failed":              "Error al eliminar la formación",
+	"education.position_failed":            "Error al actualizar la posición de la formación",
+
+	// Project import
+	"import.unknown_provider":  "Proveedor Git no disponible",
+	"import.account_required":  "El nombre de la cuenta es obligatorio",
+	"import.account_not_found": "Cuenta no encontrada en el proveedor Git",
+	"import.provider_failed":   "No se pudo acceder al proveedor Git, inténtalo de nuevo más tarde",
+	"import.list_failed":       "Error al listar los repositorios",
+	"import.failed":            "Error al importar los repositorios",
+
+	// Validation; {field} is the label of the field
+	"validation.required":           "El campo {field} es obligatorio",
+	"validation.min":                "El campo {field} debe tener al menos {min} caracteres",
+	"validation.max":                "El campo {field} debe tener menos de {max} caracteres",
+	"validation.min_value":          "El campo {field} debe ser como mínimo {min}",
+	"validation.max_value":          "El campo {field} debe ser como máximo {max}",
+	"validation.url":                "El campo {field} debe ser una URL válida",
+	"validation.url_scheme_missing": "El campo {field} debe incluir el esquema (http:// o https://)",
+	"validation.url_scheme":         "El campo {field} debe usar el esquema http:// o https://",
+	"validation.oneof":              "El campo {field} debe ser uno de: {values}",
+	"validation.email":              "El campo {field} debe ser un correo electrónico válido",
+	"validation.type":               "El campo {field} debe ser de tipo {type}",
+	"validation.failed":             "El campo {field} no superó la validación {tag}",
+	"validation.events_required":    "Se requiere al menos un evento",
+	"validation.unknown_event":      "Evento desconocido \"{value}\"",
+	"validation.locale":             "El campo {field} debe ser una etiqueta de idioma como en o pt-BR",
+	"validation.not_translatable":   "{value} no se puede traducir",
+	"validation.max_items":          "{field} puede tener como máximo {max} elementos",
+	"validation.end_before_start":   "El campo {field} no puede ser anterior a la fecha de inicio",
+	"validation.ongoing_end_date":   "El campo {field} debe quedar vacío en un proyecto en curso",
+	"validation.current_end_date":   "{field} debe quedar vacío para un puesto actual",
+	"validation.placeholder":        "El campo {field} no puede usar {value}; solo se completa {portfolio}",
+
+	// Field labels
+	"field.title":              "Título",
+	"field.description":        "Descripción",
+	"field.category_id":        "ID de la categoría",
+	"field.portfolio_id":       "ID del portafolio",
+	"field.section_id":         "ID de la sección",
+	"field.type":               "Tipo",
+	"field.link":               "Enlace",
+	"field.url":                "URL",
+	"field.content":            "Contenido",
+	"field.metadata":           "Metadatos",
+	"field.events":             "Eventos",
+	"field.skills":             "Habilidades",
+	"field.client":             "Cliente",
+	"field.position":           "Posición",
+	"field.order":              "Orden",
+	"field.name":               "Nombre",
+	"field.email":              "Correo electrónico",
+	"field.default_locale":     "Idioma predeterminado",
+	"field.locales":            "Idiomas",
+	"field.locale":             "Idioma",
+	"field.resource_type":      "Tipo de recurso",
+	"field.resource_id":        "ID del recurso",
+	"field.field":              "Campo",
+	"field.value":              "Valor",
+	"field.kind":               "Tipo de habilidad",
+	"field.aliases":            "Alias",
+	"field.source_ids":         "IDs de origen",
+	"field.links":              "Enlaces",
+	"field.start_date":         "Fecha de inicio",
+	"field.end_date":           "Fecha de finalización",
+	"field.role":               "Rol",
+	"field.team_size":          "Tamaño del equipo",
+	"field.status":             "Estado",
+	"field.visibility":         "Visibilidad",
+	"field.subject":            "Asunto",
+	"field.message":            "Mensaje",
+	"field.notify_email":       "Correo de notificación",
+	"field.auto_reply_subject": "Asunto de la respuesta automática",
+	"field.auto_reply_body":    "Cuerpo de la respuesta automática",
+	"field.author_name":        "Nombre del autor",
+	"field.author_role":        "Cargo del autor",
+	"field.company":            "Empresa",
+	"field.quote":              "Testimonio",
+	"field.avatar_url":         "URL del avatar",
+	"field.rating":             "Valoración",
+	"field.note":               "Nota",
+	"field.expires_in_days":    "Caduca en (días)",
+	"field.project_id":         "ID del proyecto",
+	"field.location":           "Ubicación",
+	"field.highlights":         "Logros",
+	"field.project_ids":        "Proyectos vinculados",
+	"field.institution":        "Institución",
+	"field.degree":             "Título",
+	"field.provider":           "Proveedor",
+	"field.repository_ids":     "Repositorios",
+
+	// Resource names
+	"resource.portfolio":       "Portafolio",
+	"resource.category":        "Categoría",
+	"resource.project":         "Proyecto",
+	"resource.section":         "Sección",
+	"resource.content":         "Contenido",
+	"resource.webhook":         "Webhook",
+	"resource.section_content": "Contenido de la sección",
+	"resource.skill":           "Habilidad",
+	"resource.template":        "Plantilla",
+	"resource.contactmessage":  "Mensaje",
+	"resource.contactsettings": "Configuración de contacto",
+	"resource.testimonial":     "Testimonio",
+	"resource.experience":      "Experiencia",
+	"resource.education":       "Formación",
+
+	// HTTP status titles of problem responses
+	"status.400": "Solicitud incorrecta",
+	"status.401": "No autorizado",
+	"status.403": "Prohibido",
+	"status.404": "No encontrado",
+	"status.406": "No aceptable",
+	"status.409": "Conflicto",
+	"status.412": "Precondición fallida",
+	"status.413": "Solicitud demasiado grande",
+	"status.415": "Tipo de medio no soportado",
+	"status.422": "Entidad no procesable",
+	"status.428": "Precondición requerida",
+	"status.429": "Demasiadas solicitudes",
+	"status.500": "Error interno del servidor",
+	"status.503": "Servicio no disponible",
+}
@@ -0,0 +1,382 @@
+package i18n
+
+// catalogPTBR is the Brazilian Portuguese catalog
+var catalogPTBR = map[string]string{
+	// General
+	"access_denied":           "Acesso negado",
+	"invalid_request":         "Dados da requisição inválidos",
+	"read_body_failed":        "Falha ao ler o corpo da requisição",
+	"internal_error":          "Ocorreu um erro interno no servidor",
+	"rate_limited":            "Limite de requisições excedido. Tente novamente mais tarde.",
+	"request_too_large":       "Corpo da requisição muito grande. O tamanho máximo é {max} bytes",
+	"resource_modified":       "{resource}: outra requisição fez alterações, recarregue e tente novamente",
+	"update_positions_failed": "Falha ao atualizar as posições",
+	"duplicate_position":      "Posição duplicada: {position}",
+	"user_id_required":        "O ID do usuário é obrigatório",
+	"portfolio_id_required":   "O ID do portfólio é obrigatório",
+	"position_ambiguous":      "Informe apenas um entre position, before e after",
+	"position_anchor_invalid": "O item ao lado do qual posicionar não está na mesma lista",
+	"reorder_not_siblings":    "Todos os itens reordenados devem estar na mesma lista",
+	"reorder_duplicate_item":  "Um item aparece mais de uma vez",
+
+	// Authentication
+	"auth.header_required": "O cabeçalho Authorization é obrigatório",
+	"auth.invalid_format":  "Formato de autorização inválido",
+	"auth.invalid_token":   "Token inválido",
+	"auth.invalid_claims":  "Claims do token inválidas",
+	"auth.unavailable":     "Serviço de autenticação indisponível",
+	"account.suspended":    "A conta está suspensa",
+
+	// Idempotency-Key and If-Match
+	"idempotency.key_too_long":       "O Idempotency-Key deve ter no máximo 255 caracteres",
+	"idempotency.failed":             "Falha ao processar o Idempotency-Key",
+	"idempotency.key_reused":         "O Idempotency-Key já foi usado com outra requisição",
+	"idempotency.in_progress":        "Uma requisição com este Idempotency-Key ainda está sendo processada",
+	"precondition.if_match_required": "O cabeçalho If-Match é obrigatório",
+	"precondition.if_match_invalid":  "Cabeçalho If-Match inválido",
+
+	// Portfolios
+	"portfolio.not_found":              "Portfólio não encontrado",
+	"portfolio.invalid_id":             "ID de portfólio inválido",
+	"portfolio.access_denied":          "Acesso negado: o portfólio pertence a outro usuário",
+	"portfolio.title_taken":            "Já existe um portfólio com este título",
+	"portfolio.duplicate_check_failed": "Falha ao verificar portfólio duplicado",
+	"portfolio.create_failed":          "Falha ao criar o portfólio",
+	"portfolio.update_failed":          "Falha ao atualizar o portfólio",
+	"portfolio.delete_failed":          "Falha ao excluir o portfólio",
+	"portfolio.list_failed":            "Falha ao carregar os portfólios",
+	"portfolio.locales_failed":         "Falha ao atualizar os idiomas do portfólio",
+
+	// Categories
+	"category.not_found":       "Categoria não encontrada",
+	"category.invalid_id":      "ID de categoria inválido",
+	"category.access_denied":   "Acesso negado: a categoria pertence ao portfólio de outro usuário",
+	"category.some_not_found":  "Algumas categorias não foram encontradas",
+	"category.create_failed":   "Falha ao criar a categoria",
+	"category.update_failed":   "Falha ao atualizar a categoria",
+	"category.delete_failed":   "Falha ao excluir a categoria",
+	"category.list_failed":     "Falha ao carregar as categorias",
+	"category.position_failed": "Falha ao atualizar a posição da categoria",
+
+	// Projects
+	"project.not_found":              "Projeto não encontrado",
+	"project.invalid_id":             "ID de projeto inválido",
+	"project.title_taken":            "Já existe um projeto com este título nesta categoria",
+	"project.duplicate_check_failed": "Falha ao verificar projeto duplicado",
+	"project.create_failed":          "Falha ao criar o projeto",
+	"project.update_failed":          "Falha ao atualizar o projeto",
+	"project.delete_failed":          "Falha ao excluir o projeto",
+	"project.list_failed":            "Falha ao carregar os projetos",
+	"project.position_failed":        "Falha ao atualizar a posição do projeto",
+	"project.skills_required":        "Informe pelo menos uma habilidade",
+	"project.client_required":        "O nome do cliente é obrigatório",
+	"project.not_in_category":        "O projeto não está nesta categoria",
+	"project.last_category":          "Um projeto deve permanecer em pelo menos uma categoria",
+	"project.some_not_in_category":   "Alguns projetos não foram encontrados nesta categoria",
+	"project.categories_failed":      "Falha ao atualizar as categorias do projeto",
+	"project.already_in_category":    "O projeto já está nesta categoria",
+	"project.move_failed":            "Falha ao mover o projeto",
+
+	// Sections
+	"section.not_found":              "Seção não encontrada",
+	"section.invalid_id":             "ID de seção inválido",
+	"section.access_denied":          "Acesso negado: a seção pertence ao portfólio de outro usuário",
+	"section.some_not_found":         "Algumas seções não foram encontradas",
+	"section.title_taken":            "Já existe uma seção com este título neste portfólio",
+	"section.duplicate_check_failed": "Falha ao verificar seção duplicada",
+	"section.create_failed":          "Falha ao criar a seção",
+	"section.update_failed":          "Falha ao atualizar a seção",
+	"section.delete_failed":          "Falha ao excluir a seção",
+	"section.list_failed":            "Falha ao carregar as seções",
+	"section.position_failed":        "Falha ao atualizar a posição da seção",
+	"section.type_required":          "O tipo da seção é obrigatório",
+	"section.move_failed":            "Falha ao mover a seção",
+
+	// Section contents
+	"content.not_found":      "Conteúdo não encontrado",
+	"content.invalid_id":     "ID de conteúdo inválido",
+	"content.create_failed":  "Falha ao criar o conteúdo",
+	"content.update_failed":  "Falha ao atualizar o conteúdo",
+	"content.delete_failed":  "Falha ao excluir o conteúdo",
+	"content.list_failed":    "Falha ao carregar os conteúdos",
+	"content.order_failed":   "Falha ao atualizar a ordem do conteúdo",
+	"content.some_not_found": "Alguns conteúdos não foram encontrados nesta seção",
+	"content.move_failed":    "Falha ao mover o conteúdo",
+
+	// Webhooks
+	"webhook.not_found":                "Webhook não encontrado",
+	"webhook.invalid_id":               "ID de webhook inválido",
+	"webhook.create_failed":            "Falha ao criar o webhook",
+	"webhook.update_failed":            "Falha ao atualizar o webhook",
+	"webhook.delete_failed":            "Falha ao excluir o webhook",
+	"webhook.list_failed":              "Falha ao carregar os webhooks",
+	"webhook.deliveries_failed":        "Falha ao carregar as entregas do webhook",
+	"webhook.authentik_not_configured": "O webhook do Authentik não está configurado",
+	"webhook.invalid_signature":        "Assinatura inválida",
+	"webhook.process_failed":           "Falha ao processar o evento",
+
+	// Users
+	"user.load_failed":   "Falha ao carregar os dados do usuário",
+	"user.delete_failed": "Falha ao excluir os dados do usuário",
+
+	// Query parameters
+	"query.sort":              "sort deve ser um de {fields}",
+	"query.limit":             "limit deve estar entre 1 e {max}",
+	"query.cursor_malformed":  "Cursor malformado",
+	"query.cursor_sort":       "O cursor pertence a outra ordenação",
+	"query.filter":            "{param} não pode ser filtrado aqui",
+	"query.bool":              "{param} deve ser true ou false",
+	"query.date":              "\"{value}\" não é uma data (use AAAA-MM-DD ou RFC 3339)",
+	"query.period_date":       "{param} \"{value}\" não é uma data (use AAAA-MM-DD)",
+	"query.period_order":      "from não pode ser posterior a to",
+	"query.period_length":     "O período não pode passar de {max} dias",
+	"query.param_unsupported": "{param} não é suportado aqui",
+	"query.param_values":      "{param} deve estar entre {allowed}",
+
+	// PATCH, event streams and batches
+	"patch.content_type":           "O Content-Type deve ser {merge} ou {json}",
+	"patch.test_failed":            "A operação test do patch falhou",
+	"patch.not_object":             "Um merge patch deve ser um objeto JSON",
+	"patch.malformed":              "O patch não é um JSON válido",
+	"patch.not_applicable":         "O patch se refere a um caminho que o documento não tem",
+	"patch.unknown_field":          "{field} não pode ser alterado com um patch",
+	"patch.wrong_type":             "{field} tem o tipo errado",
+	"patch.invalid":                "O documento resultante do patch não é válido",
+	"stream.accept_required":       "Fluxos de eventos exigem Accept: text/event-stream",
+	"stream.invalid_last_event_id": "Last-Event-ID inválido",
+	"batch.commit_failed":          "Falha ao confirmar o lote",
+	"batch.operation_failed":       "A operação {index} falhou, nenhuma alteração foi salva",
+	"batch.path_invalid":           "O caminho da operação deve começar com /",
+	"batch.nested":                 "Requisições em lote não podem ser aninhadas",
+	"batch.operation_invalid":      "O método ou o caminho da operação é inválido",
+	"batch.body_invalid":           "O corpo da operação não é um JSON válido",
+	"batch.reference_invalid":      "{ref} não é uma referência válida",
+	"batch.reference_not_run":      "{ref} se refere a uma operação que ainda não foi executada",
+	"batch.reference_no_field":     "{ref} não tem o campo \"{field}\"",
+	"batch.reference_no_element":   "{ref} não tem o elemento \"{field}\"",
+
+	// Translations
+	"translation.locale_not_enabled": "{locale} não está habilitado neste portfólio",
+	"translation.default_locale":     "{locale} é o idioma padrão, edite o próprio conteúdo",
+	"translation.resource_not_found": "{resource} {id} não faz parte deste portfólio",
+	"translation.list_failed":        "Falha ao carregar as traduções",
+	"translation.save_failed":        "Falha ao salvar as traduções",
+	"translation.delete_failed":      "Falha ao excluir as traduções",
+	"translation.report_failed":      "Falha ao gerar o relatório de completude",
+
+	// Skills
+	"skill.not_found":      "Habilidade não encontrada",
+	"skill.invalid_id":     "ID de habilidade inválido",
+	"skill.name_taken":     "{name} já é uma habilidade ou um apelido de uma",
+	"skill.in_use":         "A habilidade é usada por {count} projetos, mescle-a com outra habilidade",
+	"skill.merge_self":     "Uma habilidade não pode ser mesclada consigo mesma",
+	"skill.some_not_found": "Algumas habilidades não foram encontradas",
+	"skill.list_failed":    "Falha ao obter as habilidades",
+	"skill.create_failed":  "Falha ao criar a habilidade",
+	"skill.update_failed":  "Falha ao atualizar a habilidade",
+	"skill.delete_failed":  "Falha ao excluir a habilidade",
+	"skill.merge_failed":   "Falha ao mesclar as habilidades",
+	"skill.stats_failed":   "Falha ao calcular as estatísticas de habilidades",
+
+	// Templates
+	"template.not_found":     "Modelo não encontrado",
+	"template.invalid_id":    "ID de modelo inválido",
+	"template.name_taken":    "Você já tem um modelo chamado {name}",
+	"template.list_failed":   "Falha ao obter os modelos",
+	"template.create_failed": "Falha ao criar o modelo",
+	"template.update_failed": "Falha ao atualizar o modelo",
+	"template.delete_failed": "Falha ao excluir o modelo",
+
+	// Analytics
+	"analytics.report_failed": "Falha ao obter as estatísticas de acesso",
+
+	// Link checks
+	"link.report_failed": "Falha ao obter os links quebrados",
+
+	// Administration
+	"admin.required": "Acesso de administrador necessário",
+
+	// Background jobs
+	"job.not_found":       "Tarefa não encontrada",
+	"job.invalid_id":      "ID de tarefa inválido",
+	"job.invalid_status":  "Status de tarefa inválido",
+	"job.unknown_type":    "Tipo de tarefa desconhecido",
+	"job.invalid_payload": "Dados da tarefa inválidos",
+	"job.not_dead":        "Apenas tarefas mortas podem ser repetidas",
+	"job.running":         "Tarefas em execução não podem ser excluídas",
+	"job.list_failed":     "Falha ao recuperar as tarefas",
+	"job.stats_failed":    "Falha ao recuperar as estatísticas das tarefas",
+	"job.create_failed":   "Falha ao enfileirar a tarefa",
+	"job.retry_failed":    "Falha ao repetir a tarefa",
+	"job.delete_failed":   "Falha ao excluir a tarefa",
+
+	// Contact form and inbox
+	"contact.not_found":            "Mensagem não encontrada",
+	"contact.invalid_id":           "ID de mensagem inválido",
+	"contact.invalid_status":       "status deve ser um de unread, read, archived",
+	"contact.disabled":             "Este portfólio não aceita mensagens",
+	"contact.send_failed":          "Falha ao enviar a mensagem",
+	"contact.list_failed":          "Falha ao obter as mensagens",
+	"contact.update_failed":        "Falha ao atualizar a mensagem",
+	"contact.delete_failed":        "Falha ao excluir a mensagem",
+	"contact.settings_failed":      "Falha ao obter as configurações de contato",
+	"contact.settings_save_failed": "Falha ao salvar as configurações de contato",
+
+	// Testimonials and invites
+	"testimonial.not_found":                "Depoimento não encontrado",
+	"testimonial.invalid_id":               "ID de depoimento inválido",
+	"testimonial.invalid_status":           "status deve ser um de pending, approved, rejected",
+	"testimonial.some_not_found":           "Um ou mais depoimentos não foram encontrados",
+	"testimonial.project_not_in_portfolio": "O projeto não aparece neste portfólio",
+	"testimonial.list_failed":              "Falha ao buscar depoimentos",
+	"testimonial.create_failed":            "Falha ao criar depoimento",
+	"testimonial.update_failed":            "Falha ao atualizar depoimento",
+	"testimonial.delete_failed":            "Falha ao excluir depoimento",
+	"testimonial.position_failed":          "Falha ao atualizar a posição do depoimento",
+	"testimonial.submit_failed":            "Falha ao enviar depoimento",
+	"testimonial.invite_not_found":         "Convite não encontrado",
+	"testimonial.invite_invalid_id":        "ID de convite inválido",
+	"testimonial.invite_unusable":          "Este link de convite expirou ou já foi usado",
+	"testimonial.invite_create_failed":     "Falha ao criar convite",
+	"testimonial.invite_list_failed":       "Falha ao buscar convites",
+	"testimonial.invite_delete_failed":     "Falha ao excluir convite",
+
+	// Work experience and education
+	"experience.not_found":                 "Experiência não encontrada",
+	"experience.invalid_id":                "ID de experiência inválido",
+	"experience.some_not_found":            "Uma ou mais experiências não foram encontradas",
+	"experience.projects_not_in_portfolio": "Os projetos vinculados devem aparecer neste portfólio",
+	"experience.list_failed":               "Falha ao obter as experiências",
+	"experience.create_failed":             "Falha ao criar a experiência",
+	"experience.update_failed":             "Falha ao atualizar a experiência",
+	"experience.delete_failed":             "Falha ao excluir a experiência",
+	"experience.position_failed":           "Falha ao atualizar a posição da experiência",
+	"education.not_found":                  "Formação não encontrada",
+	"education.invalid_id":                 "ID de formação inválido",
+	"education.some_not_found":             "Uma ou mais formações não foram encontradas",
+	"education.list_failed":                "Falha ao obter a formação",
+	"education.create_failed":              "Falha ao criar a formação",
+	"education.update_failed":              "Falha ao atualizar a formação",
+	"education.delete_failed":              "Falha ao excluir a formação",
+	"education.position_failed":            "Falha ao atualizar a posição da formação",
+
+	// Project import
+	"import.unknown_provider":  "Provedor Git indisponível",
+	"import.account_required":  "O nome da conta é obrigatório",
+	"import.account_not_found": "Conta não encontrada no provedor Git",
+	"import.provider_failed":   "Não foi possível acessar o provedor Git, tente novamente mais tarde",
+	"import.list_failed":       "Falha ao listar os repositórios",
+	"import.failed":            "Falha ao importar os repositórios",
+
+	// Validation; {field} is the label of the field
+	"validation.required":           "O campo {field} é obrigatório",
+	"validation.min":                "O campo {field} deve ter pelo menos {min} caracteres",
+	"validation.max":                "O campo {field} deve ter menos de {max} caracteres",
+	"validation.min_value":          "O campo {field} deve ser no mínimo {min}",
+	"validation.max_value":          "O campo {field} deve ser no máximo {max}",
+	"validation.url":                "O campo {field} deve ser uma URL válida",
+	"validation.url_scheme_missing": "O campo {field} deve incluir o esquema (http:// ou https://)",
+	"validation.url_scheme":         "O campo {field} deve usar o esquema http:// ou https://",
+	"validation.oneof":              "O campo {field} deve ser um destes valores: {values}",
+	"validation.email":              "O campo {field} deve ser um e-mail válido",
+	"validation.type":               "O campo {field} deve ser do tipo {type}",
+	"validation.failed":             "O campo {field} não passou na validação {tag}",
+	"validation.events_required":    "Informe pelo menos um evento",
+	"validation.unknown_event":      "Evento desconhecido \"{value}\"",
+	"validation.locale":             "O campo {field} deve ser uma tag de idioma como en ou pt-BR",
+	"validation.not_translatable":   "{value} não pode ser traduzido",
+	"validation.max_items":          "{field} pode ter no máximo {max} itens",
+	"validation.end_before_start":   "O campo {field} não pode ser anterior à data de início",
+	"validation.ongoing_end_date":   "O campo {field} deve ficar vazio em um projeto em andamento",
+	"validation.current_end_date":   "{field} deve ficar vazio para um cargo atual",
+	"validation.placeholder":        "O campo {field} não pode usar {value}; só {portfolio} é preenchido",
+
+	// Field labels
+	"field.title":              "Título",
+	"field.description":        "Descrição",
+	"field.category_id":        "ID da categoria",
+	"field.portfolio_id":       "ID do portfólio",
+	"field.section_id":         "ID da seção",
+	"field.type":               "Tipo",
+	"field.link":               "Link",
+	"field.url":                "URL",
+	"field.content":            "Conteúdo",
+	"field.metadata":           "Metadados",
+	"field.events":             "Eventos",
+	"field.skills":             "Habilidades",
+	"field.client":             "Cliente",
+	"field.position":           "Posição",
+	"field.order":              "Ordem",
+	"field.name":               "Nome",
+	"field.email":              "E-mail",
+	"field.default_locale":     "Idioma padrão",
+	"field.locales":            "Idiomas",
+	"field.locale":             "Idioma",
+	"field.resource_type":      "Tipo de recurso",
+	"field.resource_id":        "ID do recurso",
+	"field.field":              "Campo",
+	"field.value":              "Valor",
+	"field.kind":               "Tipo de habilidade",
+	"field.aliases":            "Apelidos",
+	"field.source_ids":         "IDs de origem",
+	"field.links":              "Links",
+	"field.start_date":         "Data de início",
+	"field.end_date":           "Data de término",
+	"field.role":               "Função",
+	"field.team_size":          "Tamanho da equipe",
+	"field.status":             "Status",
+	"field.visibility":         "Visibilidade",
+	"field.subject":            "Assunto",
+	"field.message":            "Mensagem",
+	"field.notify_email":       "E-mail de notificação",
+	"field.auto_reply_subject": "Assunto da resposta automática",
+	"field.auto_reply_body":    "Corpo da resposta automática",
+	"field.author_name":        "Nome do autor",
+	"field.author_role":        "Cargo do autor",
+	"field.company":            "Empresa",
+	"field.quote":              "Depoimento",
+	"field.avatar_url":         "URL do avatar",
+	"field.rating":             "Avaliação",
+	"field.note":               "Observação",
+	"field.expires_in_days":    "Expira em (dias)",
+	"field.project_id":         "ID do projeto",
+	"field.location":           "Local",
+	"field.highlights":         "Destaques",
+	"field.project_ids":        "Projetos vinculados",
+	"field.institution":        "Instituição",
+	"field.degree":             "Curso",
+	"field.provider":           "Provedor",
+	"field.repository_ids":     "Repositórios",
+
+	// Resource names
+	"resource.portfolio":       "Portfólio",
+	"resource.category":        "Categoria",
+	"resource.project":         "Projeto",
+	"resource.section":         "Seção",
+	"resource.content":         "Conteúdo",
+	"resource.webhook":         "Webhook",
+	"resource.section_content": "Conteúdo da seção",
+	"resource.skill":           "Habilidade",
+	"resource.template":        "Modelo",
+	"resource.contactmessage":  "Mensagem",
+	"resource.contactsettings": "Configurações de contato",
+	"resource.testimonial":     "Depoimento",
+	"resource.experience":      "Experiência",
+	"resource.education":       "Formação",
+
+	// HTTP status titles of problem responses
+	"status.400": "Requisição inválida",
+	"status.401": "Não autorizado",
+	"status.403": "Proibido",
+	"status.404": "Não encontrado",
+	"status.406": "Não aceitável",
+	"status.409": "Conflito",
+	"status.412": "Falha na pré-condição",
+	"status.413": "Requisição muito grande",
+	"status.415": "Tipo de mídia não suportado",
+	"status.422": "Entidade não processável",
+	"status.428": "Pré-condição necessária",
+	"status.429": "Muitas requisições",
+	"status.500": "Erro interno do servidor",
+	"status.503": "Serviço indisponível",
+}
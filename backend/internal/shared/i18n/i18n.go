@@ -0,0 +1,197 @@
+// Package i18n holds the message catalogs of error and validation messages.
+// Messages are looked up by key in the locale negotiated from Accept-Language,
+// falling back to English, and placeholders such as {field} are filled from
+// Params when the message is rendered.
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Supported locales
+const (
+	English          = "en"
+	PortugueseBrazil = "pt-BR"
+	Spanish          = "es"
+
+	// DefaultLocale is used when the client accepts none of the supported locales,
+	// and for keys a catalog is missing
+	DefaultLocale = English
+)
+
+// Supported lists the locales that have a catalog
+var Supported = []string{English, PortugueseBrazil, Spanish}
+
+var catalogs = map[string]map[string]string{
+	English:          catalogEN,
+	PortugueseBrazil: catalogPTBR,
+	Spanish:          catalogES,
+}
+
+// Params fills the {name} placeholders of a message
+type Params map[string]interface{}
+
+// Message is a catalog key with the values of its placeholders. A Message used
+// as a param value is rendered in the same locale, e.g. a resource name.
+type Message struct {
+	Key    string
+	Params Params
+}
+
+// Lookup returns the text of key in locale, falling back to the default locale
+func Lookup(locale, key string) (string, bool) {
+	if text, ok := catalogs[locale][key]; ok {
+		return text, true
+	}
+	text, ok := catalogs[DefaultLocale][key]
+	return text, ok
+}
+
+// T renders key in locale. A key missing from every catalog is returned as is,
+// so free-form text such as a wrapped error still reaches the client.
+func T(locale, key string, params Params) string {
+	text, ok := Lookup(locale, key)
+	if !ok {
+		text = key
+	}
+	return format(locale, text, params)
+}
+
+// Label is the display name of a request field, e.g. category_id → "Category ID",
+// or fallback when the catalog has none
+func Label(locale, field, fallback string) string {
+	if text, ok := Lookup(locale, "field."+field); ok {
+		return text
+	}
+	return fallback
+}
+
+func format(locale, text string, params Params) string {
+	if len(params) == 0 || !strings.Contains(text, "{") {
+		return text
+	}
+	pairs := make([]string, 0, 2*len(params))
+	for name, value := range params {
+		if msg, ok := value.(Message); ok {
+			value = T(locale, msg.Key, msg.Params)
+		}
+		pairs = append(pairs, "{"+name+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(pairs...).Replace(text)
+}
+
+// Negotiate picks the supported locale that best matches an Accept-Language
+// header, or the default locale when none does
+func Negotiate(header string) string {
+	if locale, ok := Match(header, Supported); ok {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// Match picks the locale of available that best matches an Accept-Language
+// header (a single tag such as ?lang=pt works too). Ranges are tried by
+// descending q; each matches a locale exactly (case-insensitive) or by
+// language, so pt and pt-PT get pt-BR and es-MX gets es. "*" matches the
+// first available locale.
+func Match(header string, available []string) (string, bool) {
+	type tag struct {
+		value string
+		q     float64
+	}
+	var tags []tag
+	for _, part := range strings.Split(header, ",") {
+		value, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if value == "" {
+			continue
+		}
+		q := 1.0
+		if qs, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(qs, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > 0 {
+			tags = append(tags, tag{value: value, q: q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	for _, t := range tags {
+		if locale, ok := match(t.value, available); ok {
+			return locale, true
+		}
+	}
+	return "", false
+}
+
+func match(value string, available []string) (string, bool) {
+	if value == "*" && len(available) > 0 {
+		return available[0], true
+	}
+	for _, locale := range available {
+		if strings.EqualFold(value, locale) {
+			return locale, true
+		}
+	}
+	language, _, _ := strings.Cut(value, "-")
+	for _, locale := range available {
+		base, _, _ := strings.Cut(locale, "-")
+		if strings.EqualFold(language, base) {
+			return locale, true
+		}
+	}
+	return "", false
+}
+
+var languageTag = regexp.MustCompile(`^([A-Za-z]{2,3})(?:-([A-Za-z]{4}))?(?:-([A-Za-z]{2}|[0-9]{3}))?$`)
+
+// Canonical formats a BCP 47 language tag of the form language[-Script][-REGION]
+// the usual way (pt-br → pt-BR, zh-hant → zh-Hant), reporting false for anything else
+func Canonical(tag string) (string, bool) {
+	parts := languageTag.FindStringSubmatch(strings.TrimSpace(tag))
+	if parts == nil {
+		return "", false
+	}
+	canonical := strings.ToLower(parts[1])
+	if script := parts[2]; script != "" {
+		canonical += "-" + strings.ToUpper(script[:1]) + strings.ToLower(script[1:])
+	}
+	if region := parts[3]; region != "" {
+		canonical += "-" + strings.ToUpper(region)
+	}
+	return canonical, true
+}
+
+// Error is an error with a catalog message, so handlers can answer it in the
+// client's locale. Err keeps the English text for the logs and the sentinel
+// errors.Is matches.
+type Error struct {
+	Message
+	Err error
+}
+
+// NewError returns err with the message key and params
+func NewError(err error, key string, params Params) *Error {
+	return &Error{Message: Message{Key: key, Params: params}, Err: err}
+}
+
+func (e *Error) Error() string { return e.Err.Error() }
+func (e *Error) Unwrap() error { return e.Err }
+
+// MessageOf returns the catalog message err carries, or its text when it
+// carries none
+func MessageOf(err error) Message {
+	var localized *Error
+	if errors.As(err, &localized) {
+		return localized.Message
+	}
+	return Message{Key: err.Error()}
+}
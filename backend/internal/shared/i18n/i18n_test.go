@@ -0,0 +1,156 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{"Empty", "", English},
+		{"Exact", "pt-BR", PortugueseBrazil},
+		{"CaseInsensitive", "PT-br", PortugueseBrazil},
+		{"LanguageOnly", "pt", PortugueseBrazil},
+		{"OtherRegion", "es-MX", Spanish},
+		{"PortugalFallsBackToBrazil", "pt-PT", PortugueseBrazil},
+		{"HighestQuality", "en;q=0.5, es;q=0.9", Spanish},
+		{"OrderBreaksTies", "es, pt-BR", Spanish},
+		{"SkipsUnsupported", "fr-FR, de;q=0.9, pt;q=0.8", PortugueseBrazil},
+		{"Unsupported", "fr, de", English},
+		{"QualityZeroExcluded", "es;q=0, pt-BR;q=0.1", PortugueseBrazil},
+		{"Wildcard", "fr, *;q=0.5", English},
+		{"MalformedQualitySkipped", "es;q=abc, pt", PortugueseBrazil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Negotiate(tt.header))
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	available := []string{"pt-BR", "en"}
+
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+		ok       bool
+	}{
+		{"SingleTag", "en", "en", true},
+		{"LanguageOnly", "pt", "pt-BR", true},
+		{"Wildcard", "*", "pt-BR", true},
+		{"PrefersQuality", "en;q=0.4, pt-PT;q=0.6", "pt-BR", true},
+		{"NoMatch", "es, fr", "", false},
+		{"Empty", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locale, ok := Match(tt.header, available)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, locale)
+		})
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		tag      string
+		expected string
+		ok       bool
+	}{
+		{"en", "en", true},
+		{"PT-br", "pt-BR", true},
+		{" es-419 ", "es-419", true},
+		{"zh-hant-tw", "zh-Hant-TW", true},
+		{"english", "", false},
+		{"pt_BR", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			canonical, ok := Canonical(tt.tag)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, canonical)
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	tests := []struct {
+		name     string
+		locale   string
+		key      string
+		params   Params
+		expected string
+	}{
+		{"English", English, MsgProjectNotFound, nil, "Project not found"},
+		{"Portuguese", PortugueseBrazil, MsgProjectNotFound, nil, "Projeto não encontrado"},
+		{"Spanish", Spanish, MsgProjectNotFound, nil, "Proyecto no encontrado"},
+		{"UnknownLocaleFallsBack", "fr", MsgProjectNotFound, nil, "Project not found"},
+		{"EmptyLocaleFallsBack", "", MsgProjectNotFound, nil, "Project not found"},
+		{"UnknownKeyReturnedAsIs", Spanish, "query: invalid sort field", nil, "query: invalid sort field"},
+		{"Params", English, MsgDuplicatePosition, Params{"position": 3}, "Duplicate position: 3"},
+		{
+			"NestedMessageParam",
+			PortugueseBrazil,
+			MsgResourceModified,
+			Params{"resource": Message{Key: Resource("Project")}},
+			"Projeto: outra requisição fez alterações, recarregue e tente novamente",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, T(tt.locale, tt.key, tt.params))
+		})
+	}
+}
+
+func TestLabel(t *testing.T) {
+	assert.Equal(t, "Category ID", Label(English, "category_id", "CategoryID"))
+	assert.Equal(t, "ID de la categoría", Label(Spanish, "category_id", "CategoryID"))
+	assert.Equal(t, "TestField", Label(Spanish, "test_field", "TestField"))
+}
+
+func TestMessageOf(t *testing.T) {
+	base := errors.New("bad sort")
+	err := fmt.Errorf("listing: %w", NewError(base, MsgQuerySort, Params{"fields": "title"}))
+
+	assert.Equal(t, Message{Key: MsgQuerySort, Params: Params{"fields": "title"}}, MessageOf(err))
+	assert.ErrorIs(t, err, base)
+	assert.Equal(t, Message{Key: "plain"}, MessageOf(errors.New("plain")))
+}
+
+// TestCatalogs_Complete keeps the translations in step with the English catalog
+func TestCatalogs_Complete(t *testing.T) {
+	placeholder := regexp.MustCompile(`\{\w+\}`)
+	placeholders := func(text string) []string {
+		found := placeholder.FindAllString(text, -1)
+		sort.Strings(found)
+		return found
+	}
+
+	for _, locale := range Supported {
+		catalog := catalogs[locale]
+		assert.Len(t, catalog, len(catalogEN), "%s has keys English lacks", locale)
+		for key, english := range catalogEN {
+			text, ok := catalog[key]
+			if assert.True(t, ok, "%s is missing %q", locale, key) {
+				assert.Equal(t, placeholders(english), placeholders(text), "%s %q placeholders", locale, key)
+			}
+		}
+	}
+}
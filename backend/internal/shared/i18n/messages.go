@@ -0,0 +1,309 @@
+package i18n
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Message keys of the catalogs. Handlers and middleware pass these to the
+// response helpers instead of English text.
+const (
+	// General
+	MsgAccessDenied          = "access_denied"
+	MsgInvalidRequest        = "invalid_request"
+	MsgReadBodyFailed        = "read_body_failed"
+	MsgInternalError         = "internal_error"
+	MsgRateLimited           = "rate_limited"
+	MsgRequestTooLarge       = "request_too_large"
+	MsgResourceModified      = "resource_modified"
+	MsgUpdatePositionsFailed = "update_positions_failed"
+	MsgDuplicatePosition     = "duplicate_position"
+	MsgUserIDRequired        = "user_id_required"
+	MsgPortfolioIDRequired   = "portfolio_id_required"
+	MsgPositionAmbiguous     = "position_ambiguous"
+	MsgPositionAnchorInvalid = "position_anchor_invalid"
+	MsgReorderNotSiblings    = "reorder_not_siblings"
+	MsgReorderDuplicateItem  = "reorder_duplicate_item"
+
+	// Authentication
+	MsgAuthHeaderRequired = "auth.header_required"
+	MsgAuthInvalidFormat  = "auth.invalid_format"
+	MsgAuthInvalidToken   = "auth.invalid_token"
+	MsgAuthInvalidClaims  = "auth.invalid_claims"
+	MsgAuthUnavailable    = "auth.unavailable"
+	MsgAccountSuspended   = "account.suspended"
+
+	// Idempotency-Key and If-Match
+	MsgIdempotencyKeyTooLong = "idempotency.key_too_long"
+	MsgIdempotencyFailed     = "idempotency.failed"
+	MsgIdempotencyKeyReused  = "idempotency.key_reused"
+	MsgIdempotencyInProgress = "idempotency.in_progress"
+	MsgIfMatchRequired       = "precondition.if_match_required"
+	MsgIfMatchInvalid        = "precondition.if_match_invalid"
+
+	// Portfolios
+	MsgPortfolioNotFound             = "portfolio.not_found"
+	MsgPortfolioInvalidID            = "portfolio.invalid_id"
+	MsgPortfolioAccessDenied         = "portfolio.access_denied"
+	MsgPortfolioTitleTaken           = "portfolio.title_taken"
+	MsgPortfolioDuplicateCheckFailed = "portfolio.duplicate_check_failed"
+	MsgPortfolioCreateFailed         = "portfolio.create_failed"
+	MsgPortfolioUpdateFailed         = "portfolio.update_failed"
+	MsgPortfolioDeleteFailed         = "portfolio.delete_failed"
+	MsgPortfolioListFailed           = "portfolio.list_failed"
+	MsgPortfolioLocalesFailed        = "portfolio.locales_failed"
+
+	// Categories
+	MsgCategoryNotFound       = "category.not_found"
+	MsgCategoryInvalidID      = "category.invalid_id"
+	MsgCategoryAccessDenied   = "category.access_denied"
+	MsgCategorySomeNotFound   = "category.some_not_found"
+	MsgCategoryCreateFailed   = "category.create_failed"
+	MsgCategoryUpdateFailed   = "category.update_failed"
+	MsgCategoryDeleteFailed   = "category.delete_failed"
+	MsgCategoryListFailed     = "category.list_failed"
+	MsgCategoryPositionFailed = "category.position_failed"
+
+	// Projects
+	MsgProjectNotFound             = "project.not_found"
+	MsgProjectInvalidID            = "project.invalid_id"
+	MsgProjectTitleTaken           = "project.title_taken"
+	MsgProjectDuplicateCheckFailed = "project.duplicate_check_failed"
+	MsgProjectCreateFailed         = "project.create_failed"
+	MsgProjectUpdateFailed         = "project.update_failed"
+	MsgProjectDeleteFailed         = "project.delete_failed"
+	MsgProjectListFailed           = "project.list_failed"
+	MsgProjectPositionFailed       = "project.position_failed"
+	MsgProjectSkillsRequired       = "project.skills_required"
+	MsgProjectClientRequired       = "project.client_required"
+	MsgProjectNotInCategory        = "project.not_in_category"
+	MsgProjectLastCategory         = "project.last_category"
+	MsgProjectSomeNotInCategory    = "project.some_not_in_category"
+	MsgProjectCategoriesFailed     = "project.categories_failed"
+	MsgProjectAlreadyInCategory    = "project.already_in_category"
+	MsgProjectMoveFailed           = "project.move_failed"
+
+	// Sections
+	MsgSectionNotFound             = "section.not_found"
+	MsgSectionInvalidID            = "section.invalid_id"
+	MsgSectionAccessDenied         = "section.access_denied"
+	MsgSectionSomeNotFound         = "section.some_not_found"
+	MsgSectionTitleTaken           = "section.title_taken"
+	MsgSectionDuplicateCheckFailed = "section.duplicate_check_failed"
+	MsgSectionCreateFailed         = "section.create_failed"
+	MsgSectionUpdateFailed         = "section.update_failed"
+	MsgSectionDeleteFailed         = "section.delete_failed"
+	MsgSectionListFailed           = "section.list_failed"
+	MsgSectionPositionFailed       = "section.position_failed"
+	MsgSectionTypeRequired         = "section.type_required"
+	MsgSectionMoveFailed           = "section.move_failed"
+
+	// Section contents
+	MsgContentNotFound     = "content.not_found"
+	MsgContentInvalidID    = "content.invalid_id"
+	MsgContentCreateFailed = "content.create_failed"
+	MsgContentUpdateFailed = "content.update_failed"
+	MsgContentDeleteFailed = "content.delete_failed"
+	MsgContentListFailed   = "content.list_failed"
+	MsgContentOrderFailed  = "content.order_failed"
+	MsgContentSomeNotFound = "content.some_not_found"
+	MsgContentMoveFailed   = "content.move_failed"
+
+	// Webhooks
+	MsgWebhookNotFound               = "webhook.not_found"
+	MsgWebhookInvalidID              = "webhook.invalid_id"
+	MsgWebhookCreateFailed           = "webhook.create_failed"
+	MsgWebhookUpdateFailed           = "webhook.update_failed"
+	MsgWebhookDeleteFailed           = "webhook.delete_failed"
+	MsgWebhookListFailed             = "webhook.list_failed"
+	MsgWebhookDeliveriesFailed       = "webhook.deliveries_failed"
+	MsgWebhookAuthentikNotConfigured = "webhook.authentik_not_configured"
+	MsgWebhookInvalidSignature       = "webhook.invalid_signature"
+	MsgWebhookProcessFailed          = "webhook.process_failed"
+
+	// Users
+	MsgUserLoadFailed   = "user.load_failed"
+	MsgUserDeleteFailed = "user.delete_failed"
+
+	// Query parameters, see internal/shared/query
+	MsgQuerySort             = "query.sort"
+	MsgQueryLimit            = "query.limit"
+	MsgQueryCursorMalformed  = "query.cursor_malformed"
+	MsgQueryCursorSort       = "query.cursor_sort"
+	MsgQueryFilter           = "query.filter"
+	MsgQueryBool             = "query.bool"
+	MsgQueryDate             = "query.date"
+	MsgQueryPeriodDate       = "query.period_date"
+	MsgQueryPeriodOrder      = "query.period_order"
+	MsgQueryPeriodLength     = "query.period_length"
+	MsgQueryParamUnsupported = "query.param_unsupported"
+	MsgQueryParamValues      = "query.param_values"
+
+	// PATCH, event streams and batches
+	MsgPatchContentType        = "patch.content_type"
+	MsgPatchTestFailed         = "patch.test_failed"
+	MsgPatchNotObject          = "patch.not_object"
+	MsgPatchMalformed          = "patch.malformed"
+	MsgPatchNotApplicable      = "patch.not_applicable"
+	MsgPatchUnknownField       = "patch.unknown_field"
+	MsgPatchWrongType          = "patch.wrong_type"
+	MsgPatchInvalid            = "patch.invalid"
+	MsgStreamAcceptRequired    = "stream.accept_required"
+	MsgStreamInvalidEventID    = "stream.invalid_last_event_id"
+	MsgBatchCommitFailed       = "batch.commit_failed"
+	MsgBatchOperationFailed    = "batch.operation_failed"
+	MsgBatchPathInvalid        = "batch.path_invalid"
+	MsgBatchNested             = "batch.nested"
+	MsgBatchOperationInvalid   = "batch.operation_invalid"
+	MsgBatchBodyInvalid        = "batch.body_invalid"
+	MsgBatchReferenceInvalid   = "batch.reference_invalid"
+	MsgBatchReferenceNotRun    = "batch.reference_not_run"
+	MsgBatchReferenceNoField   = "batch.reference_no_field"
+	MsgBatchReferenceNoElement = "batch.reference_no_element"
+
+	// Translations of portfolio content
+	MsgTranslationLocaleNotEnabled = "translation.locale_not_enabled"
+	MsgTranslationDefaultLocale    = "translation.default_locale"
+	MsgTranslationResourceNotFound = "translation.resource_not_found"
+	MsgTranslationListFailed       = "translation.list_failed"
+	MsgTranslationSaveFailed       = "translation.save_failed"
+	MsgTranslationDeleteFailed     = "translation.delete_failed"
+	MsgTranslationReportFailed     = "translation.report_failed"
+
+	// Skills taxonomy
+	MsgSkillNotFound     = "skill.not_found"
+	MsgSkillInvalidID    = "skill.invalid_id"
+	MsgSkillNameTaken    = "skill.name_taken"
+	MsgSkillInUse        = "skill.in_use"
+	MsgSkillMergeSelf    = "skill.merge_self"
+	MsgSkillSomeNotFound = "skill.some_not_found"
+	MsgSkillListFailed   = "skill.list_failed"
+	MsgSkillCreateFailed = "skill.create_failed"
+	MsgSkillUpdateFailed = "skill.update_failed"
+	MsgSkillDeleteFailed = "skill.delete_failed"
+	MsgSkillMergeFailed  = "skill.merge_failed"
+	MsgSkillStatsFailed  = "skill.stats_failed"
+
+	// Portfolio templates
+	MsgTemplateNotFound     = "template.not_found"
+	MsgTemplateInvalidID    = "template.invalid_id"
+	MsgTemplateNameTaken    = "template.name_taken"
+	MsgTemplateListFailed   = "template.list_failed"
+	MsgTemplateCreateFailed = "template.create_failed"
+	MsgTemplateUpdateFailed = "template.update_failed"
+	MsgTemplateDeleteFailed = "template.delete_failed"
+
+	// View analytics
+	MsgAnalyticsReportFailed = "analytics.report_failed"
+
+	// Link checks
+	MsgLinkReportFailed = "link.report_failed"
+
+	// Administration
+	MsgAdminRequired = "admin.required"
+
+	// Background jobs
+	MsgJobNotFound       = "job.not_found"
+	MsgJobInvalidID      = "job.invalid_id"
+	MsgJobInvalidStatus  = "job.invalid_status"
+	MsgJobUnknownType    = "job.unknown_type"
+	MsgJobInvalidPayload = "job.invalid_payload"
+	MsgJobNotDead        = "job.not_dead"
+	MsgJobRunning        = "job.running"
+	MsgJobListFailed     = "job.list_failed"
+	MsgJobStatsFailed    = "job.stats_failed"
+	MsgJobCreateFailed   = "job.create_failed"
+	MsgJobRetryFailed    = "job.retry_failed"
+	MsgJobDeleteFailed   = "job.delete_failed"
+
+	// Contact form and inbox
+	MsgContactNotFound           = "contact.not_found"
+	MsgContactInvalidID          = "contact.invalid_id"
+	MsgContactInvalidStatus      = "contact.invalid_status"
+	MsgContactDisabled           = "contact.disabled"
+	MsgContactSendFailed         = "contact.send_failed"
+	MsgContactListFailed         = "contact.list_failed"
+	MsgContactUpdateFailed       = "contact.update_failed"
+	MsgContactDeleteFailed       = "contact.delete_failed"
+	MsgContactSettingsFailed     = "contact.settings_failed"
+	MsgContactSettingsSaveFailed = "contact.settings_save_failed"
+
+	// Testimonials and invites
+	MsgTestimonialNotFound              = "testimonial.not_found"
+	MsgTestimonialInvalidID             = "testimonial.invalid_id"
+	MsgTestimonialInvalidStatus         = "testimonial.invalid_status"
+	MsgTestimonialSomeNotFound          = "testimonial.some_not_found"
+	MsgTestimonialProjectNotInPortfolio = "testimonial.project_not_in_portfolio"
+	MsgTestimonialListFailed            = "testimonial.list_failed"
+	MsgTestimonialCreateFailed          = "testimonial.create_failed"
+	MsgTestimonialUpdateFailed          = "testimonial.update_failed"
+	MsgTestimonialDeleteFailed          = "testimonial.delete_failed"
+	MsgTestimonialPositionFailed        = "testimonial.position_failed"
+	MsgTestimonialSubmitFailed          = "testimonial.submit_failed"
+	MsgTestimonialInviteNotFound        = "testimonial.invite_not_found"
+	MsgTestimonialInviteInvalidID       = "testimonial.invite_invalid_id"
+	MsgTestimonialInviteUnusable        = "testimonial.invite_unusable"
+	MsgTestimonialInviteCreateFailed    = "testimonial.invite_create_failed"
+	MsgTestimonialInviteListFailed      = "testimonial.invite_list_failed"
+	MsgTestimonialInviteDeleteFailed    = "testimonial.invite_delete_failed"
+
+	// Work experience and education
+	MsgExperienceNotFound               = "experience.not_found"
+	MsgExperienceInvalidID              = "experience.invalid_id"
+	MsgExperienceSomeNotFound           = "experience.some_not_found"
+	MsgExperienceProjectsNotInPortfolio = "experience.projects_not_in_portfolio"
+	MsgExperienceListFailed             = "experience.list_failed"
+	MsgExperienceCreateFailed           = "experience.create_failed"
+	MsgExperienceUpdateFailed           = "experience.update_failed"
+	MsgExperienceDeleteFailed           = "experience.delete_failed"
+	MsgExperiencePositionFailed         = "experience.position_failed"
+	MsgEducationNotFound                = "education.not_found"
+	MsgEducationInvalidID               = "education.invalid_id"
+	MsgEducationSomeNotFound            = "education.some_not_found"
+	MsgEducationListFailed              = "education.list_failed"
+	MsgEducationCreateFailed            = "education.create_failed"
+	MsgEducationUpdateFailed            = "education.update_failed"
+	MsgEducationDeleteFailed            = "education.delete_failed"
+	MsgEducationPositionFailed          = "education.position_failed"
+
+	// Project import
+	MsgImportUnknownProvider = "import.unknown_provider"
+	MsgImportAccountRequired = "import.account_required"
+	MsgImportAccountNotFound = "import.account_not_found"
+	MsgImportProviderFailed  = "import.provider_failed"
+	MsgImportListFailed      = "import.list_failed"
+	MsgImportFailed          = "import.failed"
+
+	// Validation, see internal/shared/validator
+	MsgValidationRequired         = "validation.required"
+	MsgValidationMin              = "validation.min"
+	MsgValidationMax              = "validation.max"
+	MsgValidationMinValue         = "validation.min_value"
+	MsgValidationMaxValue         = "validation.max_value"
+	MsgValidationURL              = "validation.url"
+	MsgValidationURLSchemeMissing = "validation.url_scheme_missing"
+	MsgValidationURLScheme        = "validation.url_scheme"
+	MsgValidationOneOf            = "validation.oneof"
+	MsgValidationEmail            = "validation.email"
+	MsgValidationType             = "validation.type"
+	MsgValidationFailed           = "validation.failed"
+	MsgValidationEventsRequired   = "validation.events_required"
+	MsgValidationUnknownEvent     = "validation.unknown_event"
+	MsgValidationLocale           = "validation.locale"
+	MsgValidationNotTranslatable  = "validation.not_translatable"
+	MsgValidationMaxItems         = "validation.max_items"
+	MsgValidationEndBeforeStart   = "validation.end_before_start"
+	MsgValidationOngoingEndDate   = "validation.ongoing_end_date"
+	MsgValidationCurrentEndDate   = "validation.current_end_date"
+	MsgValidationPlaceholder      = "validation.placeholder"
+)
+
+// Status is the key of the title of an HTTP status, e.g. "status.404"
+func Status(code int) string {
+	return "status." + strconv.Itoa(code)
+}
+
+// Resource is the key of a resource name, e.g. Resource("Project") → "resource.project"
+func Resource(name string) string {
+	return "resource." + strings.ToLower(name)
+}
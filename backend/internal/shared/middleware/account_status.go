@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// ActiveAccount freezes the data of owners that Authentik reported as
+// deactivated or deleted: reads still work, writes get 403. A token issued
+// before the account was deactivated stays valid until it expires, so this is
+// what actually stops further changes. Must run after AuthMiddleware.
+func ActiveAccount(statuses repo.UserStatusRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		userID := c.GetString("userID")
+		hidden, err := statuses.IsHidden(userID)
+		if err != nil {
+			// Fail open: a status lookup error shouldn't lock every user out
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation": "ACCOUNT_STATUS_LOOKUP_ERROR",
+				"where":     "backend/internal/shared/middleware/account_status.go",
+				"function":  "ActiveAccount",
+				"userID":    userID,
+				"error":     err.Error(),
+			}).Error("Failed to check account status")
+			c.Next()
+			return
+		}
+
+		if hidden {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation": "ACCOUNT_SUSPENDED_WRITE",
+				"where":     "backend/internal/shared/middleware/account_status.go",
+				"function":  "ActiveAccount",
+				"userID":    userID,
+				"method":    c.Request.Method,
+				"path":      c.Request.URL.Path,
+			}).Warn("Write rejected for suspended account")
+			response.Abort(c, http.StatusForbidden, response.CodeAccountSuspended, i18n.MsgAccountSuspended)
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// AdminOnly lets through the users listed in ADMIN_USER_IDS, a comma
+// separated list of Authentik subjects; everyone else gets 403. With the
+// variable unset nobody is an administrator. Must run after AuthMiddleware.
+func AdminOnly() gin.HandlerFunc {
+	admins := make(map[string]bool)
+	for _, id := range strings.Split(os.Getenv("ADMIN_USER_IDS"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			admins[id] = true
+		}
+	}
+
+	return func(c *gin.Context) {
+		userID := c.GetString("userID")
+		if !admins[userID] {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation": "ADMIN_ACCESS_DENIED",
+				"where":     "backend/internal/shared/middleware/admin.go",
+				"function":  "AdminOnly",
+				"userID":    userID,
+				"method":    c.Request.Method,
+				"path":      c.Request.URL.Path,
+			}).Warn("Administrator access denied")
+			response.Abort(c, http.StatusForbidden, response.CodeForbidden, i18n.MsgAdminRequired)
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
@@ -115,8 +117,7 @@ func AuthMiddleware() gin.HandlerFunc {
 				"ip":        c.ClientIP(),
 				"path":      c.Request.URL.Path,
 			}).Warn("Authorization header required")
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header required"})
-			c.Abort()
+			response.Abort(c, http.StatusUnauthorized, "", i18n.MsgAuthHeaderRequired)
 			return
 		}
 
@@ -130,8 +131,7 @@ func AuthMiddleware() gin.HandlerFunc {
 				"ip":        c.ClientIP(),
 				"path":      c.Request.URL.Path,
 			}).Warn("Invalid authorization format")
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization format"})
-			c.Abort()
+			response.Abort(c, http.StatusUnauthorized, "", i18n.MsgAuthInvalidFormat)
 			return
 		}
 
@@ -158,8 +158,7 @@ func AuthMiddleware() gin.HandlerFunc {
 				"path":      c.Request.URL.Path,
 				"error":     err.Error(),
 			}).Error("OIDC not initialized")
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Authentication service unavailable"})
-			c.Abort()
+			response.Abort(c, http.StatusInternalServerError, response.CodeServiceUnavailable, i18n.MsgAuthUnavailable)
 			return
 		}
 
@@ -180,8 +179,7 @@ func AuthMiddleware() gin.HandlerFunc {
 				"token_prefix": accessToken[:smaller(20, len(accessToken))],
 				"error":        err.Error(),
 			}).Warn("Token verification failed")
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
-			c.Abort()
+			response.Abort(c, http.StatusUnauthorized, "", i18n.MsgAuthInvalidToken)
 			return
 		}
 
@@ -197,8 +195,7 @@ func AuthMiddleware() gin.HandlerFunc {
 				"path":      c.Request.URL.Path,
 				"error":     err.Error(),
 			}).Error("Failed to extract claims from token")
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
-			c.Abort()
+			response.Abort(c, http.StatusUnauthorized, "", i18n.MsgAuthInvalidClaims)
 			return
 		}
 
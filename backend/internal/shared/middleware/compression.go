@@ -79,6 +79,11 @@ func shouldCompress(req *http.Request) bool {
 		return false
 	}
 
+	// Don't compress event streams, gzip would hold events back until it flushes
+	if strings.Contains(req.Header.Get("Accept"), "text/event-stream") {
+		return false
+	}
+
 	// Don't compress websocket connections
 	if strings.ToLower(req.Header.Get("Upgrade")) == "websocket" {
 		return false
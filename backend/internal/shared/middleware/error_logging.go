@@ -3,6 +3,7 @@ package middleware
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"regexp"
 	"runtime/debug"
 	"strings"
@@ -10,6 +11,8 @@ import (
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/errorlog"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
@@ -29,9 +32,7 @@ func ErrorLogging() gin.HandlerFunc {
 				logServerError(c, 500, fmt.Sprintf("Panic: %v", err),
 					file, line, function, stackTrace, time.Since(start))
 
-				c.AbortWithStatusJSON(500, gin.H{
-					"error": "An internal server error occurred",
-				})
+				response.Abort(c, http.StatusInternalServerError, "", i18n.MsgInternalError)
 			}
 		}()
 
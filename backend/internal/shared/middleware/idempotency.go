@@ -0,0 +1,190 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/repo"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying the client-chosen key
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayHeader marks a response that was replayed from storage
+	IdempotentReplayHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	defaultIdempotencyTTL   = 24 * time.Hour
+)
+
+// IdempotencyTTL returns how long stored keys are kept, from IDEMPOTENCY_KEY_TTL
+// (a Go duration such as "24h" or "30m")
+func IdempotencyTTL() time.Duration {
+	if value := os.Getenv("IDEMPOTENCY_KEY_TTL"); value != "" {
+		if ttl, err := time.ParseDuration(value); err == nil && ttl > 0 {
+			return ttl
+		}
+	}
+	return defaultIdempotencyTTL
+}
+
+// idempotencyWriter captures the response body so it can be stored for replays
+type idempotencyWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *idempotencyWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *idempotencyWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Idempotency makes POST requests carrying an Idempotency-Key header safe to
+// retry. The first request reserves the key for the authenticated user and its
+// response is stored; an identical retry gets the stored response back, a
+// retry with a different body gets 422, and a retry while the first request is
+// still running gets 409. Server errors release the key so the client can retry.
+// Must run after AuthMiddleware, since keys are scoped per user.
+func Idempotency(keys repo.IdempotencyKeyRepository) gin.HandlerFunc {
+	ttl := IdempotencyTTL()
+
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if c.Request.Method != http.MethodPost || key == "" {
+			c.Next()
+			return
+		}
+
+		userID := c.GetString("userID")
+		if len(key) > maxIdempotencyKeyLength {
+			response.Abort(c, http.StatusBadRequest, "", i18n.MsgIdempotencyKeyTooLong)
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			response.Abort(c, http.StatusBadRequest, "", i18n.MsgReadBodyFailed)
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		record := &models.IdempotencyKey{
+			Key:         key,
+			OwnerID:     userID,
+			Method:      c.Request.Method,
+			Path:        c.Request.URL.Path,
+			RequestHash: hashRequest(c.Request.Method, c.Request.URL.Path, body),
+			ExpiresAt:   time.Now().Add(ttl),
+		}
+
+		stored, created, err := keys.Reserve(record)
+		if err != nil {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation": "IDEMPOTENCY_RESERVE_ERROR",
+				"where":     "backend/internal/shared/middleware/idempotency.go",
+				"function":  "Idempotency",
+				"userID":    userID,
+				"path":      c.Request.URL.Path,
+				"error":     err.Error(),
+			}).Error("Failed to reserve idempotency key")
+			response.Abort(c, http.StatusInternalServerError, "", i18n.MsgIdempotencyFailed)
+			return
+		}
+
+		if !created {
+			replayIdempotent(c, stored, record.RequestHash)
+			return
+		}
+
+		writer := &idempotencyWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+
+		// Release the key if the handler panics or fails, so a retry can run again
+		completed := false
+		defer func() {
+			if completed {
+				return
+			}
+			if err := keys.Release(record.ID); err != nil {
+				audit.GetErrorLogger().WithFields(logrus.Fields{
+					"operation": "IDEMPOTENCY_RELEASE_ERROR",
+					"where":     "backend/internal/shared/middleware/idempotency.go",
+					"function":  "Idempotency",
+					"userID":    userID,
+					"error":     err.Error(),
+				}).Error("Failed to release idempotency key")
+			}
+		}()
+
+		c.Next()
+
+		status := c.Writer.Status()
+		if status >= http.StatusInternalServerError {
+			return
+		}
+
+		if err := keys.Complete(record.ID, status, c.Writer.Header().Get("Content-Type"), writer.body.Bytes()); err != nil {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation": "IDEMPOTENCY_COMPLETE_ERROR",
+				"where":     "backend/internal/shared/middleware/idempotency.go",
+				"function":  "Idempotency",
+				"userID":    userID,
+				"error":     err.Error(),
+			}).Error("Failed to store idempotent response")
+			return
+		}
+		completed = true
+	}
+}
+
+// replayIdempotent answers a request whose key was already used
+func replayIdempotent(c *gin.Context, stored *models.IdempotencyKey, requestHash string) {
+	if stored.RequestHash != requestHash {
+		audit.GetErrorLogger().WithFields(logrus.Fields{
+			"operation": "IDEMPOTENCY_KEY_REUSED",
+			"where":     "backend/internal/shared/middleware/idempotency.go",
+			"function":  "replayIdempotent",
+			"userID":    stored.OwnerID,
+			"path":      c.Request.URL.Path,
+		}).Warn("Idempotency-Key reused with a different request")
+		response.Abort(c, http.StatusUnprocessableEntity, response.CodeIdempotencyMismatch, i18n.MsgIdempotencyKeyReused)
+		return
+	}
+
+	if stored.StatusCode == 0 {
+		response.Abort(c, http.StatusConflict, response.CodeIdempotencyConflict, i18n.MsgIdempotencyInProgress)
+		return
+	}
+
+	contentType := stored.ContentType
+	if contentType == "" {
+		contentType = "application/json; charset=utf-8"
+	}
+	c.Header(IdempotentReplayHeader, "true")
+	c.Data(stored.StatusCode, contentType, stored.ResponseBody)
+	c.Abort()
+}
+
+// hashRequest fingerprints the parts of a request that must match on retry
+func hashRequest(method, path string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method + " " + path + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
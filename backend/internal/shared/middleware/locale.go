@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+// Locale negotiates the language of error and validation messages from the
+// Accept-Language header and stores it as "locale" for the response helpers.
+// Clients without a supported language get English.
+func Locale() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("locale", i18n.Negotiate(c.GetHeader("Accept-Language")))
+		c.Next()
+	}
+}
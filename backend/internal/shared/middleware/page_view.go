@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/analytics"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// PageView records a view of the page named by :id once it was served
+// successfully. resourceType is the kind of page, such as models.ViewProject.
+// Failing to record never fails the request.
+func PageView(recorder *analytics.Recorder, resourceType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if c.Writer.Status() != http.StatusOK {
+			return
+		}
+		id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+		if err != nil {
+			return
+		}
+
+		err = recorder.Record(c.Request, c.ClientIP(), resourceType, uint(id))
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			audit.GetErrorLogger().WithFields(logrus.Fields{
+				"operation":    "RECORD_PAGE_VIEW_ERROR",
+				"where":        "backend/internal/shared/middleware/page_view.go",
+				"function":     "PageView",
+				"resourceType": resourceType,
+				"resourceID":   id,
+				"error":        err.Error(),
+			}).Error("Failed to record page view")
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// ifMatchVersionKey is the context key holding the version parsed from If-Match
+const ifMatchVersionKey = "ifMatchVersion"
+
+// FormatETag renders a row version as a strong ETag
+func FormatETag(version uint) string {
+	return `"` + strconv.FormatUint(uint64(version), 10) + `"`
+}
+
+// ParseETag extracts the row version from an ETag, accepting the weak form too
+func ParseETag(etag string) (uint, bool) {
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	if len(etag) < 2 || etag[0] != '"' || etag[len(etag)-1] != '"' {
+		return 0, false
+	}
+	version, err := strconv.ParseUint(etag[1:len(etag)-1], 10, 64)
+	if err != nil || version == 0 {
+		return 0, false
+	}
+	return uint(version), true
+}
+
+// IfMatchVersion returns the version the client expects the resource to have.
+// It is only set when the request carried a concrete If-Match ETag.
+func IfMatchVersion(c *gin.Context) (uint, bool) {
+	value, exists := c.Get(ifMatchVersionKey)
+	if !exists {
+		return 0, false
+	}
+	version, ok := value.(uint)
+	return version, ok
+}
+
+// IfMatch reads the If-Match header on PUT, PATCH and DELETE requests that
+// target a single resource (routes with an :id parameter). The handler
+// compares the parsed version with the stored one and the repository repeats
+// the check atomically when writing.
+// When REQUIRE_IF_MATCH=true, requests without the header are rejected with 428.
+func IfMatch() gin.HandlerFunc {
+	strict := os.Getenv("REQUIRE_IF_MATCH") == "true"
+
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			c.Next()
+			return
+		}
+
+		if c.Param("id") == "" {
+			c.Next()
+			return
+		}
+
+		header := strings.TrimSpace(c.GetHeader("If-Match"))
+		if header == "" {
+			if strict {
+				audit.GetErrorLogger().WithFields(logrus.Fields{
+					"operation": "IF_MATCH_REQUIRED",
+					"where":     "backend/internal/shared/middleware/precondition.go",
+					"function":  "IfMatch",
+					"method":    c.Request.Method,
+					"path":      c.Request.URL.Path,
+				}).Warn("If-Match header required")
+				response.Abort(c, http.StatusPreconditionRequired, "", i18n.MsgIfMatchRequired)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		// "*" matches any current representation, so there's nothing to compare
+		if header == "*" {
+			c.Next()
+			return
+		}
+
+		version, ok := ParseETag(header)
+		if !ok {
+			// A malformed ETag can never match the stored version
+			response.Abort(c, http.StatusPreconditionFailed, "", i18n.MsgIfMatchInvalid)
+			return
+		}
+
+		c.Set(ifMatchVersionKey, version)
+		c.Next()
+	}
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"os"
 	"strconv"
@@ -8,6 +9,8 @@ import (
 	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 )
@@ -49,17 +52,67 @@ func initRateLimiter() *RateLimiter {
 			}
 		}
 
-		rateLimiter = &RateLimiter{
-			visitors: make(map[string]*Visitor),
-			rate:     rate,
-			window:   window,
+		rateLimiter = newRateLimiter(rate, window)
+	})
+
+	return rateLimiter
+}
+
+var contactRateLimiter *RateLimiter
+var contactRateLimiterOnce sync.Once
+
+// initContactRateLimiter initializes the rate limiter of contact form submissions
+func initContactRateLimiter() *RateLimiter {
+	contactRateLimiterOnce.Do(func() {
+		// Get configuration from environment or use defaults
+		rate := 5           // default: 5 messages per window
+		window := time.Hour // default: 1 hour
+
+		if rateStr := os.Getenv("CONTACT_RATE_LIMIT_REQUESTS"); rateStr != "" {
+			if r, err := strconv.Atoi(rateStr); err == nil {
+				rate = r
+			}
+		}
+
+		if windowStr := os.Getenv("CONTACT_RATE_LIMIT_WINDOW"); windowStr != "" {
+			if w, err := strconv.Atoi(windowStr); err == nil {
+				window = time.Duration(w) * time.Second
+			}
 		}
 
-		// Start cleanup goroutine to remove old visitors
-		go rateLimiter.cleanupVisitors()
+		contactRateLimiter = newRateLimiter(rate, window)
 	})
 
-	return rateLimiter
+	return contactRateLimiter
+}
+
+// newRateLimiter creates a rate limiter allowing rate requests per window
+func newRateLimiter(rate int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		visitors: make(map[string]*Visitor),
+		rate:     rate,
+		window:   window,
+	}
+}
+
+// StartRateLimitCleanup removes old visitors from the rate limiters every 5
+// minutes, until ctx is cancelled
+func StartRateLimitCleanup(ctx context.Context) {
+	limiters := []*RateLimiter{initRateLimiter(), initContactRateLimiter()}
+	ticker := time.NewTicker(5 * time.Minute)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				for _, limiter := range limiters {
+					limiter.cleanupVisitors(now)
+				}
+			}
+		}
+	}()
 }
 
 // getVisitor retrieves or creates a visitor for an IP
@@ -104,22 +157,18 @@ func (rl *RateLimiter) isAllowed(ip string) bool {
 	return true
 }
 
-// cleanupVisitors periodically removes old visitors to prevent memory leak
-func (rl *RateLimiter) cleanupVisitors() {
-	ticker := time.NewTicker(5 * time.Minute)
-	defer ticker.Stop()
-
-	for range ticker.C {
-		rl.mu.Lock()
-		now := time.Now()
-		for ip, visitor := range rl.visitors {
-			visitor.mu.Lock()
-			if now.Sub(visitor.lastSeen) > 10*time.Minute {
-				delete(rl.visitors, ip)
-			}
-			visitor.mu.Unlock()
+// cleanupVisitors removes old visitors to prevent memory leak
+func (rl *RateLimiter) cleanupVisitors(now time.Time) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	for ip, visitor := range rl.visitors {
+		visitor.mu.Lock()
+		// Keep visitors for a whole window at least, so long windows aren't cut short
+		if now.Sub(visitor.lastSeen) > max(rl.window, 10*time.Minute) {
+			delete(rl.visitors, ip)
 		}
-		rl.mu.Unlock()
+		visitor.mu.Unlock()
 	}
 }
 
@@ -132,8 +181,18 @@ func RateLimit() gin.HandlerFunc {
 		}
 	}
 
-	limiter := initRateLimiter()
+	return limit(initRateLimiter(), "RateLimit")
+}
+
+// ContactRateLimit limits how many contact messages an IP can send, 5 per hour
+// unless CONTACT_RATE_LIMIT_REQUESTS and CONTACT_RATE_LIMIT_WINDOW (seconds)
+// say otherwise. Unlike RateLimit it stays on in test mode, where it is tested.
+func ContactRateLimit() gin.HandlerFunc {
+	return limit(initContactRateLimiter(), "ContactRateLimit")
+}
 
+// limit rejects requests from IPs that went over the limiter's rate
+func limit(limiter *RateLimiter, function string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Get client IP
 		ip := c.ClientIP()
@@ -143,14 +202,12 @@ func RateLimit() gin.HandlerFunc {
 			audit.GetErrorLogger().WithFields(logrus.Fields{
 				"operation": "RATE_LIMIT_EXCEEDED",
 				"where":     "backend/internal/shared/middleware/rate_limit.go",
-				"function":  "RateLimit",
+				"function":  function,
 				"ip":        ip,
 				"path":      c.Request.URL.Path,
 				"method":    c.Request.Method,
 			}).Warn("Rate limit exceeded")
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
-				"error": "Rate limit exceeded. Please try again later.",
-			})
+			response.Abort(c, http.StatusTooManyRequests, "", i18n.MsgRateLimited)
 			return
 		}
 
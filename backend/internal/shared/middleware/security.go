@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/audit"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 )
@@ -78,9 +80,8 @@ func RequestSizeLimit() gin.HandlerFunc {
 				"method":    c.Request.Method,
 				"max_size":  maxSize,
 			}).Warn("Request body too large")
-			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{
-				"error": fmt.Sprintf("Request body too large. Maximum size is %d bytes", maxSize),
-			})
+			response.ErrorWithParams(c, http.StatusRequestEntityTooLarge, "", i18n.MsgRequestTooLarge, i18n.Params{"max": maxSize})
+			c.Abort()
 		}
 	}
 }
@@ -116,9 +117,7 @@ func PanicRecovery() gin.HandlerFunc {
 		defer func() {
 			if err := recover(); err != nil {
 				// Return safe error to client (don't expose panic details)
-				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
-					"error": "An internal server error occurred",
-				})
+				response.Abort(c, http.StatusInternalServerError, "", i18n.MsgInternalError)
 			}
 		}()
 
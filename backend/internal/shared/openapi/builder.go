@@ -0,0 +1,306 @@
+package openapi
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/response"
+	"github.com/gin-gonic/gin"
+)
+
+// Envelope describes how the handler wraps the response payload
+type Envelope int
+
+const (
+	// EnvelopeData wraps the payload as {"data": ..., "message": ...}
+	EnvelopeData Envelope = iota
+	// EnvelopePaginated wraps a list as {"data": [...], "page", "limit", "total", "message"}
+	EnvelopePaginated
+	// EnvelopeNone writes the payload as-is
+	EnvelopeNone
+	// EnvelopeCursor wraps a list as {"data": [...], "next_cursor", "message"}
+	EnvelopeCursor
+)
+
+// Route documents a single operation registered on the Gin engine
+type Route struct {
+	Method      string
+	Path        string // Gin-style path relative to the API base, e.g. "/projects/own/:id"
+	Summary     string
+	Description string
+	Tag         string
+	Auth        bool
+	Query       []Parameter
+	Request     interface{} // zero value of the request body type, nil when there is no body
+	Response    interface{} // zero value of the response payload type, nil for an untyped payload
+	Status      int         // success status code, defaults to 200
+	Envelope    Envelope
+	Patch       bool // Request is a patch document accepting merge patch and JSON Patch bodies
+}
+
+// Key identifies a route by method and Gin path
+func (r Route) Key(basePath string) string {
+	return r.Method + " " + basePath + r.Path
+}
+
+const bearerScheme = "bearerAuth"
+
+// Build generates an OpenAPI document for the given routes mounted under basePath
+func Build(info Info, basePath string, tags []Tag, routes []Route) *Document {
+	registry := NewSchemaRegistry()
+	errorSchema := registry.SchemaFor(response.Problem{})
+
+	doc := &Document{
+		OpenAPI: "3.1.0",
+		Info:    info,
+		Servers: []Server{{URL: basePath}},
+		Paths:   make(map[string]*PathItem),
+		Tags:    tags,
+	}
+
+	for _, route := range routes {
+		path, params := convertPath(route.Path)
+
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+
+		op := &Operation{
+			Summary:     route.Summary,
+			Description: route.Description,
+			OperationID: operationID(route.Method, route.Path),
+			Parameters:  append(params, route.Query...),
+			Responses:   make(map[string]*Response),
+		}
+		if route.Tag != "" {
+			op.Tags = []string{route.Tag}
+		}
+
+		if route.Request != nil {
+			op.RequestBody = &RequestBody{
+				Required: true,
+				Content:  map[string]*MediaType{"application/json": {Schema: registry.SchemaFor(route.Request)}},
+			}
+			if route.Patch {
+				op.RequestBody.Content = patchContent(registry.SchemaFor(route.Request))
+			}
+		}
+
+		status := route.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		op.Responses[strconv.Itoa(status)] = &Response{
+			Description: http.StatusText(status),
+			Content:     map[string]*MediaType{"application/json": {Schema: envelope(registry, route)}},
+		}
+
+		errorContent := map[string]*MediaType{response.ProblemContentType: {Schema: errorSchema}}
+		if route.Auth {
+			op.Security = []map[string][]string{{bearerScheme: {}}}
+			op.Responses["401"] = &Response{Description: "Missing or invalid bearer token", Content: errorContent}
+
+			if versioned(route) {
+				op.Parameters = append(op.Parameters, Parameter{
+					Name:        "If-Match",
+					In:          "header",
+					Description: "ETag returned by the last read; required when REQUIRE_IF_MATCH=true",
+					Schema:      &Schema{Type: "string"},
+				})
+				op.Responses["412"] = &Response{Description: "The resource was modified since the given ETag", Content: errorContent}
+				op.Responses["428"] = &Response{Description: "If-Match header required", Content: errorContent}
+			}
+			if route.Method == http.MethodPost {
+				op.Parameters = append(op.Parameters, Parameter{
+					Name:        "Idempotency-Key",
+					In:          "header",
+					Description: "Client-chosen key that makes retries of this request safe",
+					Schema:      &Schema{Type: "string", MaxLength: intPtr(255)},
+				})
+				op.Responses["409"] = &Response{Description: "A request with this Idempotency-Key is still being processed", Content: errorContent}
+				op.Responses["422"] = &Response{Description: "Idempotency-Key was already used with a different request", Content: errorContent}
+			}
+			if route.Method == http.MethodGet || versioned(route) {
+				op.Responses[strconv.Itoa(status)].Headers = map[string]*Header{
+					"ETag": {Description: "Current resource version, for use in If-Match", Schema: &Schema{Type: "string"}},
+				}
+			}
+		}
+		if route.Patch {
+			op.Responses["409"] = &Response{Description: "A JSON Patch test operation failed", Content: errorContent}
+			op.Responses["415"] = &Response{Description: "Content-Type is not a supported patch format", Content: errorContent}
+		}
+		op.Responses["default"] = &Response{Description: "Error", Content: errorContent}
+
+		setOperation(item, route.Method, op)
+	}
+
+	doc.Components = Components{
+		Schemas: registry.Schemas(),
+		SecuritySchemes: map[string]*SecurityScheme{
+			bearerScheme: {
+				Type:         "http",
+				Scheme:       "bearer",
+				BearerFormat: "JWT",
+				Description:  "Authentik OIDC access token",
+			},
+		},
+	}
+
+	return doc
+}
+
+// Undocumented returns the registered routes under basePath that have no entry in routes
+func Undocumented(registered gin.RoutesInfo, basePath string, routes []Route) []string {
+	documented := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		documented[route.Key(basePath)] = true
+	}
+
+	var missing []string
+	for _, info := range registered {
+		if !strings.HasPrefix(info.Path, basePath+"/") {
+			continue
+		}
+		key := info.Method + " " + info.Path
+		if !documented[key] {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+func envelope(registry *SchemaRegistry, route Route) *Schema {
+	payload := registry.SchemaFor(route.Response)
+
+	switch route.Envelope {
+	case EnvelopeNone:
+		return payload
+	case EnvelopePaginated:
+		if payload.Type != "array" {
+			payload = &Schema{Type: "array", Items: payload}
+		}
+		return &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"data":    payload,
+				"page":    {Type: "integer"},
+				"limit":   {Type: "integer"},
+				"total":   {Type: "integer"},
+				"message": {Type: "string"},
+			},
+			Required: []string{"data", "page", "limit", "total", "message"},
+		}
+	case EnvelopeCursor:
+		if payload.Type != "array" {
+			payload = &Schema{Type: "array", Items: payload}
+		}
+		return &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"data":        payload,
+				"next_cursor": {Type: []string{"string", "null"}, Description: "Pass as ?cursor= for the next page; null on the last page"},
+				"message":     {Type: "string"},
+			},
+			Required: []string{"data", "next_cursor", "message"},
+		}
+	default:
+		return &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"data":    payload,
+				"message": {Type: "string"},
+			},
+			Required: []string{"message"},
+		}
+	}
+}
+
+// patchContent documents the two accepted patch formats for a patch document schema
+func patchContent(document *Schema) map[string]*MediaType {
+	operation := &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"op":    {Type: "string", Enum: []interface{}{"add", "remove", "replace", "move", "copy", "test"}},
+			"path":  {Type: "string", Description: "JSON Pointer into the patch document"},
+			"from":  {Type: "string"},
+			"value": {},
+		},
+		Required: []string{"op", "path"},
+	}
+
+	return map[string]*MediaType{
+		"application/merge-patch+json": {Schema: document},
+		"application/json-patch+json":  {Schema: &Schema{Type: "array", Items: operation}},
+	}
+}
+
+// versioned reports whether the route writes a single resource guarded by If-Match
+func versioned(route Route) bool {
+	switch route.Method {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return strings.Contains(route.Path, "/:id")
+	}
+	return false
+}
+
+// convertPath turns "/projects/own/:id" into "/projects/own/{id}" and returns the path parameters
+func convertPath(path string) (string, []Parameter) {
+	segments := strings.Split(path, "/")
+	var params []Parameter
+
+	for i, segment := range segments {
+		if len(segment) < 2 || (segment[0] != ':' && segment[0] != '*') {
+			continue
+		}
+		name := segment[1:]
+		segments[i] = "{" + name + "}"
+
+		schema := &Schema{Type: "string"}
+		if name == "id" || strings.HasSuffix(name, "Id") || strings.HasSuffix(name, "ID") {
+			schema = &Schema{Type: "integer", Minimum: float64Ptr(1)}
+		}
+		params = append(params, Parameter{Name: name, In: "path", Required: true, Schema: schema})
+	}
+
+	return strings.Join(segments, "/"), params
+}
+
+// operationID builds a unique identifier such as "putProjectsOwnId"
+func operationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, segment := range strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/' || r == '-' || r == ':' || r == '*'
+	}) {
+		b.WriteString(strings.ToUpper(segment[:1]) + segment[1:])
+	}
+	return b.String()
+}
+
+func setOperation(item *PathItem, method string, op *Operation) {
+	switch method {
+	case http.MethodGet:
+		item.Get = op
+	case http.MethodPut:
+		item.Put = op
+	case http.MethodPost:
+		item.Post = op
+	case http.MethodDelete:
+		item.Delete = op
+	case http.MethodPatch:
+		item.Patch = op
+	case http.MethodHead:
+		item.Head = op
+	}
+}
+
+// QueryParam documents an optional query string parameter
+func QueryParam(name, schemaType, description string) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: &Schema{Type: schemaType}}
+}
@@ -0,0 +1,108 @@
+package openapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaFor_BindingTags(t *testing.T) {
+	registry := NewSchemaRegistry()
+
+	ref := registry.SchemaFor(request.CreateSectionContentRequest{})
+	assert.Equal(t, "#/components/schemas/request.CreateSectionContentRequest", ref.Ref)
+
+	schema := registry.Schemas()["request.CreateSectionContentRequest"]
+	require.NotNil(t, schema)
+	assert.ElementsMatch(t, []string{"type", "content", "section_id"}, schema.Required)
+
+	assert.Equal(t, []interface{}{"text", "image"}, schema.Properties["type"].Enum)
+	assert.Equal(t, 1, *schema.Properties["content"].MinLength)
+	assert.Equal(t, 5000, *schema.Properties["content"].MaxLength)
+	assert.Equal(t, float64(1), *schema.Properties["section_id"].Minimum)
+	assert.Equal(t, []string{"integer", "null"}, schema.Properties["order"].Type)
+	assert.Equal(t, []string{"string", "null"}, schema.Properties["metadata"].Type)
+}
+
+func TestSchemaFor_Types(t *testing.T) {
+	tests := []struct {
+		name       string
+		field      string
+		wantType   interface{}
+		wantFormat string
+	}{
+		{name: "URL binding", field: "link", wantType: "string", wantFormat: "uri"},
+		{name: "String slice", field: "skills", wantType: "array"},
+		{name: "Unsigned integer", field: "category_id", wantType: "integer"},
+	}
+
+	registry := NewSchemaRegistry()
+	registry.SchemaFor(request.CreateProjectRequest{})
+	schema := registry.Schemas()["request.CreateProjectRequest"]
+	require.NotNil(t, schema)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prop := schema.Properties[tt.field]
+			require.NotNil(t, prop)
+			assert.Equal(t, tt.wantType, prop.Type)
+			assert.Equal(t, tt.wantFormat, prop.Format)
+		})
+	}
+}
+
+func TestBuild_PathsAndSecurity(t *testing.T) {
+	doc := Build(Info{Title: "test", Version: "1"}, "/api", nil, []Route{
+		{Method: http.MethodPut, Path: "/projects/own/:id", Auth: true, Request: request.UpdateProjectRequest{}},
+		{Method: http.MethodGet, Path: "/sections/:sectionId/contents", Envelope: EnvelopeNone},
+	})
+
+	put := doc.Paths["/projects/own/{id}"].Put
+	require.NotNil(t, put)
+	assert.Equal(t, "putProjectsOwnId", put.OperationID)
+	assert.Equal(t, []map[string][]string{{"bearerAuth": {}}}, put.Security)
+	assert.Contains(t, put.Responses, "401")
+	require.Len(t, put.Parameters, 2)
+	assert.Equal(t, "id", put.Parameters[0].Name)
+	assert.Equal(t, "path", put.Parameters[0].In)
+	assert.Equal(t, "If-Match", put.Parameters[1].Name)
+	assert.Equal(t, "header", put.Parameters[1].In)
+	assert.Contains(t, put.Responses, "412")
+	assert.Contains(t, put.Responses["200"].Headers, "ETag")
+
+	get := doc.Paths["/sections/{sectionId}/contents"].Get
+	require.NotNil(t, get)
+	assert.Nil(t, get.Security)
+	assert.Equal(t, "integer", get.Parameters[0].Schema.Type)
+}
+
+func TestUndocumented(t *testing.T) {
+	registered := gin.RoutesInfo{
+		{Method: http.MethodGet, Path: "/api/projects/own"},
+		{Method: http.MethodPost, Path: "/api/projects/own"},
+		{Method: http.MethodGet, Path: "/health"},
+	}
+	routes := []Route{{Method: http.MethodGet, Path: "/projects/own"}}
+
+	assert.Equal(t, []string{"POST /api/projects/own"}, Undocumented(registered, "/api", routes))
+}
+
+func TestUIHandler_SameOrigin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/docs", UIHandler("/api/openapi.json", "/api/docs/"+BundleFile))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `spec-url="/api/openapi.json"`)
+	assert.Contains(t, w.Body.String(), `src="/api/docs/`+BundleFile+`"`)
+	assert.NotContains(t, w.Body.String(), "https://")
+	assert.NotContains(t, w.Header().Get("Content-Security-Policy"), "https:")
+}
@@ -0,0 +1,40 @@
+#!/bin/sh
+
+# Vendors the Redoc bundle embedded by the API docs page into ui/, checking it
+# against the pinned checksum. A bundle already in ui/ is checked too. Run by
+# go generate from this directory; fails on a mismatch so the build stops.
+#
+# To upgrade, bump REDOC_VERSION and pin the sha256 of the new bundle.
+
+set -eu
+
+REDOC_VERSION="v2.1.5"
+REDOC_SHA256=""
+
+bundle="ui/redoc.standalone.js"
+
+if [ -z "$REDOC_SHA256" ]; then
+    echo "redoc.sh: pin REDOC_SHA256 to the sha256 of the $REDOC_VERSION bundle" >&2
+    exit 1
+fi
+
+# check fails unless the file has the pinned checksum
+check() {
+    actual=$(sha256sum "$1" | cut -d ' ' -f 1)
+    if [ "$actual" != "$REDOC_SHA256" ]; then
+        echo "redoc.sh: $1 has sha256 $actual, expected $REDOC_SHA256" >&2
+        return 1
+    fi
+}
+
+if [ -s "$bundle" ]; then
+    check "$bundle"
+    exit
+fi
+
+curl -fsSL -o "$bundle.tmp" "https://cdn.redoc.ly/redoc/$REDOC_VERSION/bundles/redoc.standalone.js"
+if ! check "$bundle.tmp"; then
+    rm -f "$bundle.tmp"
+    exit 1
+fi
+mv "$bundle.tmp" "$bundle"
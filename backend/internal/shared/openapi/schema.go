@@ -0,0 +1,240 @@
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// SchemaRegistry converts Go types into JSON schemas and collects named
+// struct schemas so they can be referenced from components.schemas
+type SchemaRegistry struct {
+	schemas map[string]*Schema
+}
+
+// NewSchemaRegistry creates an empty registry
+func NewSchemaRegistry() *SchemaRegistry {
+	return &SchemaRegistry{schemas: make(map[string]*Schema)}
+}
+
+// Schemas returns every named schema collected so far
+func (r *SchemaRegistry) Schemas() map[string]*Schema {
+	return r.schemas
+}
+
+// SchemaFor returns a schema for the value's type. Named structs are stored in
+// the registry and returned as a $ref.
+func (r *SchemaRegistry) SchemaFor(v interface{}) *Schema {
+	if v == nil {
+		return &Schema{}
+	}
+	return r.schemaForType(reflect.TypeOf(v))
+}
+
+func (r *SchemaRegistry) schemaForType(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	// Types with a custom JSON encoding that reflection can't see through
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.PkgPath() == "gorm.io/gorm" && t.Name() == "DeletedAt":
+		return &Schema{Type: []string{"string", "null"}, Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Minimum: float64Ptr(0)}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: r.schemaForType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaForType(t.Elem())}
+	case reflect.Struct:
+		return r.structSchema(t)
+	default:
+		// interface{} and anything else accepts any JSON value
+		return &Schema{}
+	}
+}
+
+func (r *SchemaRegistry) structSchema(t reflect.Type) *Schema {
+	name := schemaName(t)
+	if name == "" {
+		return r.buildStruct(t)
+	}
+
+	ref := &Schema{Ref: "#/components/schemas/" + name}
+	if _, exists := r.schemas[name]; exists {
+		return ref
+	}
+
+	// Reserve the name first so self-referencing types terminate
+	r.schemas[name] = &Schema{}
+	*r.schemas[name] = *r.buildStruct(t)
+	return ref
+}
+
+func (r *SchemaRegistry) buildStruct(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	r.addFields(schema, t)
+	return schema
+}
+
+func (r *SchemaRegistry) addFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		jsonTag := field.Tag.Get("json")
+		if jsonTag == "-" {
+			continue
+		}
+
+		// Embedded structs without a json name (e.g. gorm.Model) are flattened
+		if field.Anonymous && jsonTag == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				r.addFields(schema, embedded)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if parts := strings.Split(jsonTag, ","); parts[0] != "" {
+			name = parts[0]
+		}
+
+		prop := r.schemaForType(field.Type)
+		if field.Type.Kind() == reflect.Ptr && prop.Ref == "" {
+			prop.Type = nullable(prop.Type)
+		}
+
+		if applyBinding(prop, field.Tag.Get("binding"), field.Type) {
+			schema.Required = append(schema.Required, name)
+		}
+
+		schema.Properties[name] = prop
+	}
+}
+
+// applyBinding maps gin/go-playground binding rules onto schema keywords.
+// It reports whether the field is required.
+func applyBinding(s *Schema, binding string, t reflect.Type) bool {
+	if binding == "" || s.Ref != "" {
+		return binding != "" && strings.Contains(","+binding+",", ",required,")
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	required := false
+	for _, rule := range strings.Split(binding, ",") {
+		key, value, _ := strings.Cut(rule, "=")
+		if key == "dive" {
+			// Remaining rules apply to slice elements, not the field itself
+			break
+		}
+		switch key {
+		case "required":
+			required = true
+		case "min", "gte":
+			setBound(s, t, value, true)
+		case "max", "lte":
+			setBound(s, t, value, false)
+		case "oneof":
+			for _, option := range strings.Fields(value) {
+				s.Enum = append(s.Enum, option)
+			}
+		case "url":
+			s.Format = "uri"
+		case "email":
+			s.Format = "email"
+		}
+	}
+	return required
+}
+
+func setBound(s *Schema, t reflect.Type, value string, lower bool) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		if lower {
+			s.MinLength = &n
+		} else {
+			s.MaxLength = &n
+		}
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if lower {
+			s.MinItems = &n
+		} else {
+			s.MaxItems = &n
+		}
+	default:
+		if lower {
+			s.Minimum = float64Ptr(float64(n))
+		} else {
+			s.Maximum = float64Ptr(float64(n))
+		}
+	}
+}
+
+// schemaName builds a stable component name such as "request.CreateProjectRequest"
+func schemaName(t reflect.Type) string {
+	if t.Name() == "" {
+		return ""
+	}
+	pkg := t.PkgPath()
+	if idx := strings.LastIndex(pkg, "/"); idx >= 0 {
+		pkg = pkg[idx+1:]
+	}
+	return pkg + "." + t.Name()
+}
+
+// nullable turns a JSON schema type into its OpenAPI 3.1 nullable form
+func nullable(schemaType interface{}) interface{} {
+	switch v := schemaType.(type) {
+	case string:
+		return []string{v, "null"}
+	case nil:
+		return nil
+	default:
+		return v
+	}
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,123 @@
+// Package ordering places items among their siblings: the categories and
+// sections of a portfolio, the projects of a category and the contents of a
+// section. An order is the list of sibling IDs; positions are their 1-based
+// indexes, so they never have gaps or duplicates.
+package ordering
+
+import (
+	"errors"
+	"sort"
+)
+
+var (
+	// ErrAnchor is returned when the item to place next to isn't a sibling
+	ErrAnchor = errors.New("anchor is not a sibling")
+	// ErrNotSibling is returned when an item to arrange isn't a sibling
+	ErrNotSibling = errors.New("item is not a sibling")
+	// ErrDuplicate is returned when an item is listed more than once
+	ErrDuplicate = errors.New("item listed more than once")
+)
+
+// Placement says where an item goes: right before or after another sibling,
+// or at a position. Positions past the end, and the zero Placement, put the
+// item last.
+type Placement struct {
+	Position uint
+	Before   uint
+	After    uint
+}
+
+// Item asks for one sibling to be at a position
+type Item struct {
+	ID       uint
+	Position uint
+}
+
+// Move returns the order with id placed as asked. id may come from another
+// parent, in which case it isn't in order yet.
+func Move(order []uint, id uint, at Placement) ([]uint, error) {
+	siblings := without(order, map[uint]bool{id: true})
+
+	index := len(siblings)
+	switch {
+	case at.Before != 0:
+		index = indexOf(siblings, at.Before)
+		if index < 0 {
+			return nil, ErrAnchor
+		}
+	case at.After != 0:
+		index = indexOf(siblings, at.After)
+		if index < 0 {
+			return nil, ErrAnchor
+		}
+		index++
+	case at.Position != 0:
+		index = clamp(at.Position, len(siblings))
+	}
+	return insert(siblings, index, id), nil
+}
+
+// Arrange returns the order with every item at its position. The items are
+// taken out and put back from the lowest position up, so the others keep
+// their relative order around them.
+func Arrange(order []uint, items []Item) ([]uint, error) {
+	listed := make(map[uint]bool, len(items))
+	for _, item := range items {
+		if indexOf(order, item.ID) < 0 {
+			return nil, ErrNotSibling
+		}
+		if listed[item.ID] {
+			return nil, ErrDuplicate
+		}
+		listed[item.ID] = true
+	}
+
+	sorted := make([]Item, len(items))
+	copy(sorted, items)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+
+	arranged := without(order, listed)
+	for _, item := range sorted {
+		arranged = insert(arranged, clamp(item.Position, len(arranged)), item.ID)
+	}
+	return arranged, nil
+}
+
+// Position returns the 1-based position of id in the order, 0 if it isn't in it
+func Position(order []uint, id uint) uint {
+	return uint(indexOf(order, id) + 1)
+}
+
+func without(order []uint, ids map[uint]bool) []uint {
+	kept := make([]uint, 0, len(order))
+	for _, id := range order {
+		if !ids[id] {
+			kept = append(kept, id)
+		}
+	}
+	return kept
+}
+
+func insert(order []uint, index int, id uint) []uint {
+	order = append(order, 0)
+	copy(order[index+1:], order[index:])
+	order[index] = id
+	return order
+}
+
+func indexOf(order []uint, id uint) int {
+	for i, sibling := range order {
+		if sibling == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// clamp turns a 1-based position into an index into n siblings
+func clamp(position uint, n int) int {
+	if position == 0 || int(position) > n {
+		return n
+	}
+	return int(position) - 1
+}
@@ -0,0 +1,77 @@
+package ordering
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMove(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   []uint
+		id      uint
+		at      Placement
+		want    []uint
+		wantErr error
+	}{
+		{"last by default", []uint{1, 2, 3}, 1, Placement{}, []uint{2, 3, 1}, nil},
+		{"to position", []uint{1, 2, 3}, 3, Placement{Position: 1}, []uint{3, 1, 2}, nil},
+		{"position past the end", []uint{1, 2, 3}, 1, Placement{Position: 9}, []uint{2, 3, 1}, nil},
+		{"before", []uint{1, 2, 3}, 3, Placement{Before: 2}, []uint{1, 3, 2}, nil},
+		{"after", []uint{1, 2, 3}, 1, Placement{After: 2}, []uint{2, 1, 3}, nil},
+		{"after the last", []uint{1, 2, 3}, 1, Placement{After: 3}, []uint{2, 3, 1}, nil},
+		{"from another parent", []uint{1, 2}, 7, Placement{Before: 1}, []uint{7, 1, 2}, nil},
+		{"into an empty parent", nil, 7, Placement{Position: 3}, []uint{7}, nil},
+		{"same place", []uint{1, 2, 3}, 2, Placement{Position: 2}, []uint{1, 2, 3}, nil},
+		{"unknown anchor", []uint{1, 2, 3}, 1, Placement{Before: 9}, nil, ErrAnchor},
+		{"anchor is the item", []uint{1, 2, 3}, 2, Placement{After: 2}, nil, ErrAnchor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Move(tt.order, tt.id, tt.at)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestArrange(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   []uint
+		items   []Item
+		want    []uint
+		wantErr error
+	}{
+		{"swap", []uint{1, 2, 3}, []Item{{ID: 1, Position: 2}, {ID: 2, Position: 1}}, []uint{2, 1, 3}, nil},
+		{"reverse", []uint{1, 2, 3}, []Item{{ID: 1, Position: 3}, {ID: 2, Position: 2}, {ID: 3, Position: 1}}, []uint{3, 2, 1}, nil},
+		{"others keep their order", []uint{1, 2, 3, 4}, []Item{{ID: 4, Position: 1}, {ID: 1, Position: 4}}, []uint{4, 2, 3, 1}, nil},
+		{"positions past the end", []uint{1, 2, 3}, []Item{{ID: 1, Position: 10}, {ID: 2, Position: 20}}, []uint{3, 1, 2}, nil},
+		{"not a sibling", []uint{1, 2, 3}, []Item{{ID: 9, Position: 1}}, nil, ErrNotSibling},
+		{"listed twice", []uint{1, 2, 3}, []Item{{ID: 1, Position: 1}, {ID: 1, Position: 2}}, nil, ErrDuplicate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Arrange(tt.order, tt.items)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPosition(t *testing.T) {
+	assert.Equal(t, uint(2), Position([]uint{4, 7, 9}, 7))
+	assert.Equal(t, uint(0), Position([]uint{4, 7, 9}, 5))
+}
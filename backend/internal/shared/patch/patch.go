@@ -0,0 +1,104 @@
+// Package patch applies RFC 7396 JSON Merge Patch and RFC 6902 JSON Patch
+// documents to the editable representation of a resource.
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"reflect"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+)
+
+const (
+	// MergePatchContentType selects RFC 7396 JSON Merge Patch
+	MergePatchContentType = "application/merge-patch+json"
+	// JSONPatchContentType selects RFC 6902 JSON Patch
+	JSONPatchContentType = "application/json-patch+json"
+)
+
+var (
+	// ErrUnsupportedMediaType is returned for a Content-Type that is neither patch format
+	ErrUnsupportedMediaType = errors.New("unsupported patch media type")
+	// ErrTestFailed is returned when a JSON Patch "test" operation does not match
+	ErrTestFailed = errors.New("patch test operation failed")
+	// ErrInvalidPatch is returned when the patch is malformed or can't be applied
+	ErrInvalidPatch = errors.New("invalid patch")
+)
+
+// Apply patches doc, a pointer to the resource's patch document, in place.
+// doc is encoded to JSON, the patch applied according to contentType, and the
+// result decoded back into doc. Fields the document doesn't declare are rejected,
+// so a patch can't touch IDs, owners or timestamps. A plain application/json
+// body is treated as a merge patch.
+func Apply(contentType string, doc interface{}, body []byte) error {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ErrUnsupportedMediaType
+	}
+
+	original, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	var patched []byte
+	switch mediaType {
+	case MergePatchContentType, "application/json":
+		if trimmed := bytes.TrimSpace(body); len(trimmed) == 0 || trimmed[0] != '{' {
+			return invalid(errors.New("merge patch must be a JSON object"), i18n.MsgPatchNotObject, nil)
+		}
+		patched, err = jsonpatch.MergePatch(original, body)
+		if err != nil {
+			return invalid(err, i18n.MsgPatchMalformed, nil)
+		}
+	case JSONPatchContentType:
+		operations, err := jsonpatch.DecodePatch(body)
+		if err != nil {
+			return invalid(err, i18n.MsgPatchMalformed, nil)
+		}
+		patched, err = operations.Apply(original)
+		if err != nil {
+			if errors.Is(err, jsonpatch.ErrTestFailed) {
+				return ErrTestFailed
+			}
+			return invalid(err, i18n.MsgPatchNotApplicable, nil)
+		}
+	default:
+		return ErrUnsupportedMediaType
+	}
+
+	// Start from an empty document so fields the patch removed end up cleared
+	target := reflect.ValueOf(doc).Elem()
+	target.Set(reflect.Zero(target.Type()))
+
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(doc); err != nil {
+		return decodeError(err)
+	}
+	return nil
+}
+
+// invalid returns an ErrInvalidPatch carrying the message key, so handlers
+// can answer in the client's language; cause stays in the text for the logs
+func invalid(cause error, key string, params i18n.Params) error {
+	return i18n.NewError(fmt.Errorf("%w: %v", ErrInvalidPatch, cause), key, params)
+}
+
+// decodeError names the field of the patched document that doesn't fit
+func decodeError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return invalid(err, i18n.MsgPatchWrongType, i18n.Params{"field": typeErr.Field})
+	}
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		return invalid(err, i18n.MsgPatchUnknownField, i18n.Params{"field": strings.Trim(field, `"`)})
+	}
+	return invalid(err, i18n.MsgPatchInvalid, nil)
+}
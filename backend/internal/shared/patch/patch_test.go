@@ -0,0 +1,118 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type document struct {
+	Title       string   `json:"title"`
+	Description *string  `json:"description"`
+	Skills      []string `json:"skills"`
+}
+
+func newDocument() *document {
+	description := "original"
+	return &document{Title: "Title", Description: &description, Skills: []string{"go", "sql"}}
+}
+
+func TestApply_MergePatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		check       func(t *testing.T, doc *document)
+	}{
+		{
+			name:        "replaces field",
+			contentType: MergePatchContentType,
+			body:        `{"title":"New"}`,
+			check: func(t *testing.T, doc *document) {
+				assert.Equal(t, "New", doc.Title)
+				require.NotNil(t, doc.Description)
+				assert.Equal(t, "original", *doc.Description)
+				assert.Equal(t, []string{"go", "sql"}, doc.Skills)
+			},
+		},
+		{
+			name:        "null clears optional field",
+			contentType: MergePatchContentType + "; charset=utf-8",
+			body:        `{"description":null,"skills":null}`,
+			check: func(t *testing.T, doc *document) {
+				assert.Equal(t, "Title", doc.Title)
+				assert.Nil(t, doc.Description)
+				assert.Nil(t, doc.Skills)
+			},
+		},
+		{
+			name:        "plain json is a merge patch",
+			contentType: "application/json",
+			body:        `{"skills":["rust"]}`,
+			check: func(t *testing.T, doc *document) {
+				assert.Equal(t, []string{"rust"}, doc.Skills)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := newDocument()
+			require.NoError(t, Apply(tt.contentType, doc, []byte(tt.body)))
+			tt.check(t, doc)
+		})
+	}
+}
+
+func TestApply_JSONPatch(t *testing.T) {
+	doc := newDocument()
+	body := `[
+		{"op":"test","path":"/title","value":"Title"},
+		{"op":"add","path":"/skills/-","value":"docker"},
+		{"op":"remove","path":"/skills/0"},
+		{"op":"replace","path":"/description","value":null}
+	]`
+
+	require.NoError(t, Apply(JSONPatchContentType, doc, []byte(body)))
+	assert.Equal(t, []string{"sql", "docker"}, doc.Skills)
+	assert.Nil(t, doc.Description)
+}
+
+func TestApply_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        error
+		key         string
+	}{
+		{"unsupported media type", "text/plain", `{}`, ErrUnsupportedMediaType, ""},
+		{"missing media type", "", `{}`, ErrUnsupportedMediaType, ""},
+		{"merge patch not an object", MergePatchContentType, `["title"]`, ErrInvalidPatch, i18n.MsgPatchNotObject},
+		{"unknown field", MergePatchContentType, `{"owner_id":"someone"}`, ErrInvalidPatch, i18n.MsgPatchUnknownField},
+		{"wrong type", MergePatchContentType, `{"title":5}`, ErrInvalidPatch, i18n.MsgPatchWrongType},
+		{"failed test op", JSONPatchContentType, `[{"op":"test","path":"/title","value":"Other"}]`, ErrTestFailed, ""},
+		{"missing path", JSONPatchContentType, `[{"op":"remove","path":"/skills/9"}]`, ErrInvalidPatch, i18n.MsgPatchNotApplicable},
+		{"malformed json patch", JSONPatchContentType, `{"op":"add"}`, ErrInvalidPatch, i18n.MsgPatchMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Apply(tt.contentType, newDocument(), []byte(tt.body))
+			assert.ErrorIs(t, err, tt.want)
+			if tt.key != "" {
+				assert.Equal(t, tt.key, i18n.MessageOf(err).Key)
+			}
+		})
+	}
+}
+
+func TestApply_ErrorNamesField(t *testing.T) {
+	err := Apply(MergePatchContentType, newDocument(), []byte(`{"owner_id":"someone"}`))
+	assert.Equal(t, i18n.Params{"field": "owner_id"}, i18n.MessageOf(err).Params)
+
+	err = Apply(MergePatchContentType, newDocument(), []byte(`{"title":5}`))
+	assert.Equal(t, i18n.Params{"field": "title"}, i18n.MessageOf(err).Params)
+}
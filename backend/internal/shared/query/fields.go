@@ -0,0 +1,70 @@
+package query
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+)
+
+// Selection is a parsed sparse fieldset: fields=title,position picks the
+// columns of the resource and include=projects the relations loaded with it.
+// An empty selection keeps the endpoint's full default shape.
+type Selection struct {
+	Fields  []string
+	Include []string
+}
+
+// SelectionOptions lists the fields and relations an endpoint allows
+type SelectionOptions struct {
+	Fields  []string
+	Include []string
+}
+
+// ParseSelection reads fields= and include= from query parameters. The ID is
+// always returned, so it doesn't need to be listed.
+func ParseSelection(values url.Values, opts SelectionOptions) (Selection, error) {
+	var sel Selection
+	var err error
+	if sel.Fields, err = parseList(values.Get("fields"), "fields", opts.Fields); err != nil {
+		return sel, err
+	}
+	if sel.Include, err = parseList(values.Get("include"), "include", opts.Include); err != nil {
+		return sel, err
+	}
+	return sel, nil
+}
+
+// Empty reports whether the client asked for the default shape
+func (s Selection) Empty() bool {
+	return len(s.Fields) == 0 && len(s.Include) == 0
+}
+
+// Has reports whether field is part of the response; every field is when
+// fields= was not given
+func (s Selection) Has(field string) bool {
+	return len(s.Fields) == 0 || field == "id" || contains(s.Fields, field)
+}
+
+// Includes reports whether relation was asked for with include=
+func (s Selection) Includes(relation string) bool {
+	return contains(s.Include, relation)
+}
+
+func parseList(value, param string, allowed []string) ([]string, error) {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" || item == "id" || contains(list, item) {
+			continue
+		}
+		if !contains(allowed, item) {
+			if len(allowed) == 0 {
+				return nil, invalid(i18n.MsgQueryParamUnsupported, i18n.Params{"param": param})
+			}
+			return nil, invalid(i18n.MsgQueryParamValues, i18n.Params{"param": param, "allowed": strings.Join(allowed, ", ")})
+		}
+		list = append(list, item)
+	}
+	return list, nil
+}
@@ -0,0 +1,49 @@
+package query
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+)
+
+// Period is a range of whole days, From and To included, at midnight UTC
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+// Days returns the number of days in the period
+func (p Period) Days() int {
+	return int(p.To.Sub(p.From).Hours()/24) + 1
+}
+
+// ParsePeriod reads from= and to= as YYYY-MM-DD dates. Without to= the period
+// ends on the day of now; without from= it spans defaultDays. Periods longer
+// than maxDays are rejected.
+func ParsePeriod(values url.Values, now time.Time, defaultDays, maxDays int) (Period, error) {
+	var p Period
+	var err error
+
+	p.To = now.UTC().Truncate(24 * time.Hour)
+	if value := values.Get("to"); value != "" {
+		if p.To, err = time.Parse(time.DateOnly, value); err != nil {
+			return p, invalid(i18n.MsgQueryPeriodDate, i18n.Params{"param": "to", "value": value})
+		}
+	}
+
+	p.From = p.To.AddDate(0, 0, 1-defaultDays)
+	if value := values.Get("from"); value != "" {
+		if p.From, err = time.Parse(time.DateOnly, value); err != nil {
+			return p, invalid(i18n.MsgQueryPeriodDate, i18n.Params{"param": "from", "value": value})
+		}
+	}
+
+	if p.From.After(p.To) {
+		return p, invalid(i18n.MsgQueryPeriodOrder, nil)
+	}
+	if p.Days() > maxDays {
+		return p, invalid(i18n.MsgQueryPeriodLength, i18n.Params{"max": maxDays})
+	}
+	return p, nil
+}
@@ -0,0 +1,261 @@
+// Package query parses the list query spec shared by list endpoints: opaque
+// cursor pagination, sort=[-]field over whitelisted fields, and filters.
+// Values are only ever bound as SQL parameters; sort fields are limited to
+// the names an endpoint allows, so the spec is safe to turn into SQL.
+package query
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+)
+
+const (
+	// MaxLimit caps the page size of cursor pagination
+	MaxLimit = 100
+
+	// Sortable fields
+	SortTitle     = "title"
+	SortPosition  = "position"
+	SortCreatedAt = "created_at"
+	SortUpdatedAt = "updated_at"
+	SortStartDate = "start_date"
+
+	// Filters an endpoint may accept
+	FilterSkills   = "skills"
+	FilterClient   = "client"
+	FilterCreated  = "created"
+	FilterType     = "type"
+	FilterStatus   = "status"
+	FilterFeatured = "featured"
+	FilterRole     = "role"
+	FilterOngoing  = "ongoing"
+	FilterStarted  = "started"
+)
+
+// ErrInvalidQuery wraps every parse error, so handlers can answer 400
+var ErrInvalidQuery = errors.New("invalid query")
+
+// Options lists what an endpoint supports
+type Options struct {
+	SortFields  []string
+	DefaultSort string
+	Filters     []string
+	Selection   SelectionOptions
+}
+
+// Spec is a parsed list query
+type Spec struct {
+	Sort  string
+	Desc  bool
+	Limit int // 0 returns every match
+	After *Cursor
+
+	Skills        []string
+	Client        string
+	Type          string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+
+	Status        []string
+	Featured      *bool
+	Role          string
+	Ongoing       *bool
+	StartedAfter  *time.Time
+	StartedBefore *time.Time
+
+	Selection
+}
+
+// Cursor marks the last row of a page; the next page starts after it
+type Cursor struct {
+	Sort  string `json:"s"`
+	Desc  bool   `json:"d,omitempty"`
+	Value string `json:"v"`
+	ID    uint   `json:"i"`
+}
+
+// Parse reads the spec from query parameters:
+//
+//	sort=title | sort=-created_at
+//	limit=20&cursor=<next_cursor of the previous page>
+//	skills=go,react&client=Acme&type=text
+//	created_after=2024-01-01&created_before=2024-12-31T00:00:00Z
+//	status=shipped,archived&featured=true&role=Lead&ongoing=false
+//	started_after=2023-01-01&started_before=2024-01-01
+//	fields=title,position&include=projects
+//
+// Without limit or cursor every match is returned, as the lists did before.
+func Parse(values url.Values, opts Options) (Spec, error) {
+	spec := Spec{Sort: opts.DefaultSort}
+
+	if sort := values.Get("sort"); sort != "" {
+		field := strings.TrimPrefix(sort, "-")
+		if !contains(opts.SortFields, field) {
+			return spec, invalid(i18n.MsgQuerySort, i18n.Params{"fields": strings.Join(opts.SortFields, ", ")})
+		}
+		spec.Sort = field
+		spec.Desc = strings.HasPrefix(sort, "-")
+	}
+
+	if limit := values.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 || n > MaxLimit {
+			return spec, invalid(i18n.MsgQueryLimit, i18n.Params{"max": MaxLimit})
+		}
+		spec.Limit = n
+	}
+
+	if cursor := values.Get("cursor"); cursor != "" {
+		after, err := DecodeCursor(cursor)
+		if err != nil {
+			return spec, err
+		}
+		if after.Sort != spec.Sort || after.Desc != spec.Desc {
+			return spec, invalid(i18n.MsgQueryCursorSort, nil)
+		}
+		spec.After = &after
+		if spec.Limit == 0 {
+			spec.Limit = MaxLimit
+		}
+	}
+
+	for key := range values {
+		filter := key
+		switch key {
+		case "created_after", "created_before":
+			filter = FilterCreated
+		case "started_after", "started_before":
+			filter = FilterStarted
+		}
+		switch filter {
+		case FilterSkills, FilterClient, FilterType, FilterCreated, FilterStatus, FilterFeatured, FilterRole, FilterOngoing, FilterStarted:
+			if !contains(opts.Filters, filter) {
+				return spec, invalid(i18n.MsgQueryFilter, i18n.Params{"param": key})
+			}
+		}
+	}
+
+	spec.Skills = splitList(values.Get(FilterSkills))
+	spec.Client = strings.TrimSpace(values.Get(FilterClient))
+	spec.Type = strings.TrimSpace(values.Get(FilterType))
+	spec.Status = splitList(values.Get(FilterStatus))
+	spec.Role = strings.TrimSpace(values.Get(FilterRole))
+
+	var err error
+	if spec.CreatedAfter, err = parseTime(values.Get("created_after")); err != nil {
+		return spec, err
+	}
+	if spec.CreatedBefore, err = parseTime(values.Get("created_before")); err != nil {
+		return spec, err
+	}
+	if spec.StartedAfter, err = parseTime(values.Get("started_after")); err != nil {
+		return spec, err
+	}
+	if spec.StartedBefore, err = parseTime(values.Get("started_before")); err != nil {
+		return spec, err
+	}
+	if spec.Featured, err = parseBool(FilterFeatured, values.Get(FilterFeatured)); err != nil {
+		return spec, err
+	}
+	if spec.Ongoing, err = parseBool(FilterOngoing, values.Get(FilterOngoing)); err != nil {
+		return spec, err
+	}
+	if spec.Selection, err = ParseSelection(values, opts.Selection); err != nil {
+		return spec, err
+	}
+
+	return spec, nil
+}
+
+// Next returns the cursor of the page ending with the row (value, id)
+func (s Spec) Next(value string, id uint) string {
+	return EncodeCursor(Cursor{Sort: s.Sort, Desc: s.Desc, Value: value, ID: id})
+}
+
+// EncodeCursor makes an opaque cursor string
+func EncodeCursor(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor reads a cursor made by EncodeCursor
+func DecodeCursor(value string) (Cursor, error) {
+	var cursor Cursor
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err == nil {
+		err = json.Unmarshal(data, &cursor)
+	}
+	if err != nil || cursor.ID == 0 {
+		return cursor, invalid(i18n.MsgQueryCursorMalformed, nil)
+	}
+	return cursor, nil
+}
+
+// FormatValue renders a sort column value for a cursor
+func FormatValue(value interface{}) string {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// splitList splits a comma-separated value, dropping empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseBool accepts true or false; an empty value leaves the filter unset
+func parseBool(name, value string) (*bool, error) {
+	if value == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, invalid(i18n.MsgQueryBool, i18n.Params{"param": name})
+	}
+	return &b, nil
+}
+
+// parseTime accepts RFC 3339 timestamps or plain dates
+func parseTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, invalid(i18n.MsgQueryDate, i18n.Params{"value": value})
+}
+
+// invalid returns an ErrInvalidQuery carrying the message key, so handlers
+// can answer in the client's language
+func invalid(key string, params i18n.Params) error {
+	return i18n.NewError(fmt.Errorf("%w: %s", ErrInvalidQuery, i18n.T(i18n.DefaultLocale, key, params)), key, params)
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
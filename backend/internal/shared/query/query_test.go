@@ -0,0 +1,261 @@
+package query
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testOptions = Options{
+	SortFields:  []string{SortTitle, SortPosition, SortCreatedAt, SortUpdatedAt},
+	DefaultSort: SortPosition,
+	Filters:     []string{FilterSkills, FilterClient, FilterCreated},
+}
+
+var timelineOptions = Options{
+	SortFields:  []string{SortStartDate},
+	DefaultSort: SortStartDate,
+	Filters:     []string{FilterSkills, FilterStatus, FilterFeatured, FilterRole, FilterOngoing, FilterStarted},
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected Spec
+	}{
+		{
+			name:     "Defaults return everything by position",
+			query:    "",
+			expected: Spec{Sort: SortPosition},
+		},
+		{
+			name:     "Descending sort",
+			query:    "sort=-created_at&limit=5",
+			expected: Spec{Sort: SortCreatedAt, Desc: true, Limit: 5},
+		},
+		{
+			name:     "Skills are split and trimmed",
+			query:    "skills=go,%20react,,&client=Acme",
+			expected: Spec{Sort: SortPosition, Skills: []string{"go", "react"}, Client: "Acme"},
+		},
+		{
+			name:  "Created range accepts dates and timestamps",
+			query: "created_after=2024-01-01&created_before=2024-06-01T12:00:00Z",
+			expected: Spec{
+				Sort:          SortPosition,
+				CreatedAfter:  timePtr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				CreatedBefore: timePtr(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			spec, err := Parse(values, testOptions)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, spec)
+		})
+	}
+}
+
+func TestParse_ProjectFilters(t *testing.T) {
+	yes, no := true, false
+
+	tests := []struct {
+		name     string
+		query    string
+		expected Spec
+	}{
+		{
+			name:     "Statuses are split and trimmed",
+			query:    "status=shipped,%20archived,",
+			expected: Spec{Sort: SortStartDate, Status: []string{"shipped", "archived"}},
+		},
+		{
+			name:     "Booleans and role",
+			query:    "featured=true&ongoing=0&role=%20Lead%20",
+			expected: Spec{Sort: SortStartDate, Featured: &yes, Ongoing: &no, Role: "Lead"},
+		},
+		{
+			name:  "Started range",
+			query: "sort=-start_date&started_after=2023-01-01&started_before=2024-01-01",
+			expected: Spec{
+				Sort:          SortStartDate,
+				Desc:          true,
+				StartedAfter:  timePtr(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+				StartedBefore: timePtr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			spec, err := Parse(values, timelineOptions)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, spec)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "Unknown sort field", query: "sort=owner_id"},
+		{name: "Sort field with SQL", query: "sort=title%3BDROP%20TABLE%20projects"},
+		{name: "Limit above maximum", query: "limit=101"},
+		{name: "Limit not a number", query: "limit=ten"},
+		{name: "Zero limit", query: "limit=0"},
+		{name: "Malformed cursor", query: "cursor=not-a-cursor"},
+		{name: "Cursor from another sort", query: "sort=title&cursor=" + EncodeCursor(Cursor{Sort: SortPosition, Value: "1", ID: 1})},
+		{name: "Filter not allowed here", query: "type=text"},
+		{name: "Bad date", query: "created_after=yesterday"},
+		{name: "Project filter not allowed here", query: "status=shipped"},
+		{name: "Started range not allowed here", query: "started_after=2024-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			_, err = Parse(values, testOptions)
+			assert.True(t, errors.Is(err, ErrInvalidQuery), "expected ErrInvalidQuery, got %v", err)
+		})
+	}
+}
+
+func TestParse_InvalidProjectFilters(t *testing.T) {
+	for _, q := range []string{"featured=yes", "ongoing=maybe", "started_before=soon"} {
+		t.Run(q, func(t *testing.T) {
+			values, err := url.ParseQuery(q)
+			require.NoError(t, err)
+
+			_, err = Parse(values, timelineOptions)
+			assert.True(t, errors.Is(err, ErrInvalidQuery), "expected ErrInvalidQuery, got %v", err)
+		})
+	}
+}
+
+func TestParse_CursorRoundTrip(t *testing.T) {
+	spec := Spec{Sort: SortTitle, Desc: true, Limit: 2}
+	next := spec.Next("Beta", 7)
+
+	values := url.Values{"sort": {"-title"}, "cursor": {next}}
+	parsed, err := Parse(values, testOptions)
+	require.NoError(t, err)
+
+	require.NotNil(t, parsed.After)
+	assert.Equal(t, Cursor{Sort: SortTitle, Desc: true, Value: "Beta", ID: 7}, *parsed.After)
+	assert.Equal(t, MaxLimit, parsed.Limit, "a cursor without limit pages by the maximum")
+}
+
+func TestFormatValue(t *testing.T) {
+	at := time.Date(2024, 3, 1, 10, 30, 0, 123456000, time.FixedZone("CET", 3600))
+	assert.Equal(t, "2024-03-01T09:30:00.123456Z", FormatValue(at))
+	assert.Equal(t, "3", FormatValue(uint(3)))
+	assert.Equal(t, "Alpha", FormatValue("Alpha"))
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestParseSelection(t *testing.T) {
+	opts := SelectionOptions{
+		Fields:  []string{"title", "position"},
+		Include: []string{"projects"},
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected Selection
+		wantErr  bool
+	}{
+		{name: "Nothing selected", query: "", expected: Selection{}},
+		{name: "Fields trimmed and deduplicated", query: "fields=title,%20position,title,id", expected: Selection{Fields: []string{"title", "position"}}},
+		{name: "Include only", query: "include=projects", expected: Selection{Include: []string{"projects"}}},
+		{name: "Unknown field", query: "fields=title,owner_secret", wantErr: true},
+		{name: "Column expression", query: "fields=title)%20FROM%20users--", wantErr: true},
+		{name: "Unknown relation", query: "include=sections", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			sel, err := ParseSelection(values, opts)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidQuery), "expected ErrInvalidQuery, got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, sel)
+		})
+	}
+}
+
+func TestSelection_Has(t *testing.T) {
+	assert.True(t, Selection{}.Has("title"), "everything is selected by default")
+	assert.True(t, Selection{Fields: []string{"title"}}.Has("id"), "the id is always selected")
+	assert.False(t, Selection{Fields: []string{"title"}}.Has("position"))
+	assert.True(t, Selection{Include: []string{"projects"}}.Includes("projects"))
+	assert.True(t, Selection{}.Empty())
+}
+
+func TestParsePeriod(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 30, 0, 0, time.UTC)
+	day := func(month time.Month, d int) time.Time { return time.Date(2026, month, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name  string
+		query string
+		want  Period
+		days  int
+	}{
+		{name: "Defaults", query: "", want: Period{From: day(2, 9), To: day(3, 10)}, days: 30},
+		{name: "From only", query: "from=2026-03-01", want: Period{From: day(3, 1), To: day(3, 10)}, days: 10},
+		{name: "To only", query: "to=2026-01-31", want: Period{From: day(1, 2), To: day(1, 31)}, days: 30},
+		{name: "Both", query: "from=2026-01-01&to=2026-01-01", want: Period{From: day(1, 1), To: day(1, 1)}, days: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			period, err := ParsePeriod(values, now, 30, 366)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, period)
+			assert.Equal(t, tt.days, period.Days())
+		})
+	}
+}
+
+func TestParsePeriod_Invalid(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 30, 0, 0, time.UTC)
+
+	for _, q := range []string{"from=yesterday", "to=2026-13-01", "from=2026-03-05&to=2026-03-01", "from=2024-01-01&to=2026-01-01"} {
+		t.Run(q, func(t *testing.T) {
+			values, err := url.ParseQuery(q)
+			require.NoError(t, err)
+
+			_, err = ParsePeriod(values, now, 30, 366)
+			assert.True(t, errors.Is(err, ErrInvalidQuery), "expected ErrInvalidQuery, got %v", err)
+		})
+	}
+}
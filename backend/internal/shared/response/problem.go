@@ -0,0 +1,283 @@
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	playground "github.com/go-playground/validator/v10"
+)
+
+// ProblemContentType is the media type of every error body (RFC 7807)
+const ProblemContentType = "application/problem+json"
+
+// Stable error codes clients can switch on. Errors without a more specific
+// code get the one of their status, see codeForStatus.
+const (
+	CodeBadRequest           = "bad_request"
+	CodeValidationFailed     = "validation_failed"
+	CodeInvalidQuery         = "invalid_query"
+	CodeUnauthorized         = "unauthorized"
+	CodeInvalidSignature     = "invalid_signature"
+	CodeForbidden            = "forbidden"
+	CodeAccountSuspended     = "account_suspended"
+	CodeNotFound             = "not_found"
+	CodeNotAcceptable        = "not_acceptable"
+	CodeConflict             = "conflict"
+	CodeIdempotencyConflict  = "idempotency_in_progress"
+	CodePreconditionFailed   = "precondition_failed"
+	CodeRequestTooLarge      = "request_too_large"
+	CodeUnsupportedMediaType = "unsupported_media_type"
+	CodeIdempotencyMismatch  = "idempotency_key_reused"
+	CodeBatchFailed          = "batch_failed"
+	CodePreconditionRequired = "precondition_required"
+	CodeRateLimited          = "rate_limited"
+	CodeInternal             = "internal_error"
+	CodeUpstreamFailed       = "upstream_failed"
+	CodeServiceUnavailable   = "service_unavailable"
+)
+
+// Problem is an RFC 7807 problem details body
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Code      string       `json:"code"`
+	RequestID string       `json:"request_id,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+	// Data carries the partial results some errors return, e.g. a rolled back batch
+	Data interface{} `json:"data,omitempty"`
+	// Error repeats Detail for clients written against the former {"error": "..."} body
+	Error string `json:"error"`
+}
+
+// FieldError points at the request field that failed validation
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewProblem builds the problem for status, tagged with the request ID set by
+// middleware.RequestID. detail is an i18n message key, or text sent as is.
+func NewProblem(c *gin.Context, status int, code, detail string) Problem {
+	return NewProblemWithParams(c, status, code, detail, nil)
+}
+
+// NewProblemWithParams renders the title and the message key in the locale set
+// by middleware.Locale
+func NewProblemWithParams(c *gin.Context, status int, code, key string, params i18n.Params) Problem {
+	if code == "" {
+		code = codeForStatus(status)
+	}
+	locale := Locale(c)
+	title, ok := i18n.Lookup(locale, i18n.Status(status))
+	if !ok {
+		title = http.StatusText(status)
+	}
+	detail := i18n.T(locale, key, params)
+	return Problem{
+		Type:      "about:blank",
+		Title:     title,
+		Status:    status,
+		Detail:    detail,
+		Code:      code,
+		RequestID: c.GetString("request_id"),
+		Error:     detail,
+	}
+}
+
+// Locale is the language negotiated by middleware.Locale; empty means the default
+func Locale(c *gin.Context) string {
+	return c.GetString("locale")
+}
+
+// WriteProblem sends problem as application/problem+json
+func WriteProblem(c *gin.Context, problem Problem) {
+	locale := Locale(c)
+	if locale == "" {
+		locale = i18n.DefaultLocale
+	}
+	c.Header("Content-Type", ProblemContentType)
+	c.Header("Content-Language", locale)
+	c.Writer.Header().Add("Vary", "Accept-Language")
+	c.JSON(problem.Status, problem)
+}
+
+// ErrorWithCode sends a problem with a specific error code
+func ErrorWithCode(c *gin.Context, statusCode int, code, message string) {
+	WriteProblem(c, NewProblem(c, statusCode, code, message))
+}
+
+// ErrorWithParams sends a problem whose detail is the message key filled with params
+func ErrorWithParams(c *gin.Context, statusCode int, code, key string, params i18n.Params) {
+	WriteProblem(c, NewProblemWithParams(c, statusCode, code, key, params))
+}
+
+// Abort sends a problem and stops the handler chain; used by middleware
+func Abort(c *gin.Context, statusCode int, code, message string) {
+	ErrorWithCode(c, statusCode, code, message)
+	c.Abort()
+}
+
+// ValidationFailed sends a 400 listing every field error found in err, which
+// may come from gin binding tags, internal/shared/validator, or both joined
+func ValidationFailed(c *gin.Context, message string, err error) {
+	problem := NewProblem(c, http.StatusBadRequest, CodeValidationFailed, message)
+	problem.Errors = FieldErrors(Locale(c), err)
+	WriteProblem(c, problem)
+}
+
+// Invalid is ValidationFailed with the first field error as detail, for the
+// errors of internal/shared/validator which stop at the first failed check
+func Invalid(c *gin.Context, err error) {
+	fields := FieldErrors(Locale(c), err)
+	detail := i18n.MsgInvalidRequest
+	if len(fields) > 0 {
+		detail = fields[0].Message
+	}
+	problem := NewProblem(c, http.StatusBadRequest, CodeValidationFailed, detail)
+	problem.Errors = fields
+	WriteProblem(c, problem)
+}
+
+// FieldErrors flattens binding, validator and JSON type errors into field
+// errors with messages in locale
+func FieldErrors(locale string, err error) []FieldError {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var fields []FieldError
+		for _, inner := range joined.Unwrap() {
+			fields = append(fields, FieldErrors(locale, inner)...)
+		}
+		return fields
+	}
+
+	var bindingErrs playground.ValidationErrors
+	if errors.As(err, &bindingErrs) {
+		fields := make([]FieldError, 0, len(bindingErrs))
+		for _, fe := range bindingErrs {
+			field := fieldPath(fe.Namespace())
+			fields = append(fields, FieldError{Field: field, Code: fe.Tag(), Message: bindingMessage(locale, field, fe)})
+		}
+		return fields
+	}
+
+	var validationErr validator.ValidationError
+	if errors.As(err, &validationErr) {
+		return []FieldError{{Field: validationErr.JSONField(), Code: validationErr.Code, Message: validationErr.Localize(locale)}}
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		params := i18n.Params{"field": i18n.Label(locale, typeErr.Field, typeErr.Field), "type": typeErr.Type.String()}
+		return []FieldError{{Field: typeErr.Field, Code: "type", Message: i18n.T(locale, i18n.MsgValidationType, params)}}
+	}
+	return nil
+}
+
+// Binding errors name their fields the way clients spell them, see tagName
+func init() {
+	if engine, ok := binding.Validator.Engine().(*playground.Validate); ok {
+		engine.RegisterTagNameFunc(tagName)
+	}
+}
+
+// tagName names a field by its json tag, or its form tag for multipart
+// requests. Untagged fields keep their Go name.
+func tagName(field reflect.StructField) string {
+	for _, key := range []string{"json", "form"} {
+		name, _, _ := strings.Cut(field.Tag.Get(key), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return ""
+}
+
+// fieldPath turns a validator namespace such as
+// "PatchProjectRequest.skills[0]" into the JSON path "skills[0]". The request
+// type and embedded structs, still spelled in Go, are dropped: JSON flattens
+// embedded structs.
+func fieldPath(namespace string) string {
+	segments := strings.Split(namespace, ".")
+	if len(segments) > 1 {
+		segments = segments[1:]
+	}
+	var parts []string
+	for _, part := range segments {
+		if part != "" && !unicode.IsUpper([]rune(part)[0]) {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// bindingMessage describes a failed binding tag in the validator's wording
+func bindingMessage(locale, field string, fe playground.FieldError) string {
+	params := i18n.Params{"field": i18n.Label(locale, field, field)}
+	switch fe.Tag() {
+	case "required":
+		return i18n.T(locale, i18n.MsgValidationRequired, params)
+	case "min":
+		params["min"] = fe.Param()
+		return i18n.T(locale, i18n.MsgValidationMinValue, params)
+	case "max":
+		params["max"] = fe.Param()
+		return i18n.T(locale, i18n.MsgValidationMaxValue, params)
+	case "oneof":
+		params["values"] = strings.Join(strings.Fields(fe.Param()), ", ")
+		return i18n.T(locale, i18n.MsgValidationOneOf, params)
+	case "url":
+		return i18n.T(locale, i18n.MsgValidationURL, params)
+	case "email":
+		return i18n.T(locale, i18n.MsgValidationEmail, params)
+	default:
+		params["tag"] = fe.Tag()
+		return i18n.T(locale, i18n.MsgValidationFailed, params)
+	}
+}
+
+// codeForStatus is the default error code of a status
+func codeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusNotAcceptable:
+		return CodeNotAcceptable
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusPreconditionFailed:
+		return CodePreconditionFailed
+	case http.StatusRequestEntityTooLarge:
+		return CodeRequestTooLarge
+	case http.StatusUnsupportedMediaType:
+		return CodeUnsupportedMediaType
+	case http.StatusPreconditionRequired:
+		return CodePreconditionRequired
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusServiceUnavailable:
+		return CodeServiceUnavailable
+	}
+	if status >= 500 {
+		return CodeInternal
+	}
+	return strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+}
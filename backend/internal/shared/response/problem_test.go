@@ -0,0 +1,182 @@
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/dto/request"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type bindingRequest struct {
+	Title string   `json:"title" binding:"required"`
+	Type  string   `json:"type" binding:"oneof=text image"`
+	Tags  []string `json:"tags" binding:"dive,max=3"`
+}
+
+func TestFieldErrors(t *testing.T) {
+	bindingErr := binding.Validator.ValidateStruct(&bindingRequest{Type: "video", Tags: []string{"toolong"}})
+	require.Error(t, bindingErr)
+
+	var typeErr error = &json.UnmarshalTypeError{Field: "position", Type: reflect.TypeOf(uint(0))}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected []FieldError
+	}{
+		{
+			name: "Binding tags",
+			err:  bindingErr,
+			expected: []FieldError{
+				{Field: "title", Code: "required", Message: "Title is required"},
+				{Field: "type", Code: "oneof", Message: "Type must be one of: text, image"},
+				{Field: "tags[0]", Code: "max", Message: "tags[0] must be at most 3"},
+			},
+		},
+		{
+			name:     "Shared validator",
+			err:      validator.ValidationError{Field: "CategoryID", Code: validator.CodeRequired, Key: i18n.MsgValidationRequired},
+			expected: []FieldError{{Field: "category_id", Code: "required", Message: "Category ID is required"}},
+		},
+		{
+			name: "Both joined",
+			err: errors.Join(
+				validator.ValidationError{Field: "Link", Code: validator.CodeURL, Key: i18n.MsgValidationURL},
+				typeErr,
+			),
+			expected: []FieldError{
+				{Field: "link", Code: "url", Message: "Link must be a valid URL"},
+				{Field: "position", Code: "type", Message: "Position must be a uint"},
+			},
+		},
+		{
+			name:     "Errors without a field",
+			err:      errors.New("unexpected EOF"),
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FieldErrors(i18n.English, tt.err))
+		})
+	}
+}
+
+func TestValidationFailed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+	c.Set("request_id", "req-42")
+
+	Invalid(c, validator.ValidationError{Field: "Title", Code: validator.CodeRequired, Key: i18n.MsgValidationRequired})
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, ProblemContentType, recorder.Header().Get("Content-Type"))
+
+	var problem Problem
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assert.Equal(t, Problem{
+		Type:      "about:blank",
+		Title:     "Bad Request",
+		Status:    http.StatusBadRequest,
+		Detail:    "Title is required",
+		Code:      CodeValidationFailed,
+		RequestID: "req-42",
+		Errors:    []FieldError{{Field: "title", Code: "required", Message: "Title is required"}},
+		Error:     "Title is required",
+	}, problem)
+}
+
+func TestValidationFailed_JSONFieldNames(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+
+	// Fields of the embedded ExperienceRequest are top-level in JSON
+	err := binding.Validator.ValidateStruct(&request.CreateExperienceRequest{
+		ExperienceRequest: request.ExperienceRequest{Company: "Acme", Title: "Engineer", ProjectIDs: []uint{4, 0}},
+		PortfolioID:       1,
+	})
+	require.Error(t, err)
+	ValidationFailed(c, i18n.MsgInvalidRequest, err)
+
+	var problem Problem
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assert.Equal(t, CodeValidationFailed, problem.Code)
+	assert.Equal(t, []FieldError{{Field: "project_ids[1]", Code: "min", Message: "project_ids[1] must be at least 1"}}, problem.Errors)
+}
+
+func TestInvalid_Localized(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+	c.Set("locale", i18n.PortugueseBrazil)
+
+	Invalid(c, validator.ValidationError{Field: "CategoryID", Code: validator.CodeRequired, Key: i18n.MsgValidationRequired})
+
+	assert.Equal(t, i18n.PortugueseBrazil, recorder.Header().Get("Content-Language"))
+	assert.Equal(t, "Accept-Language", recorder.Header().Get("Vary"))
+
+	var problem Problem
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assert.Equal(t, "Requisição inválida", problem.Title)
+	assert.Equal(t, "O campo ID da categoria é obrigatório", problem.Detail)
+	assert.Equal(t, []FieldError{{Field: "category_id", Code: "required", Message: "O campo ID da categoria é obrigatório"}}, problem.Errors)
+}
+
+func TestErrorWithParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Set("locale", i18n.Spanish)
+
+	ErrorWithParams(c, http.StatusBadRequest, "", i18n.MsgDuplicatePosition, i18n.Params{"position": 2})
+
+	var problem Problem
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assert.Equal(t, "Posición duplicada: 2", problem.Detail)
+	assert.Equal(t, CodeBadRequest, problem.Code)
+}
+
+func TestError_DefaultCodes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		status int
+		code   string
+	}{
+		{http.StatusNotFound, CodeNotFound},
+		{http.StatusPreconditionFailed, CodePreconditionFailed},
+		{http.StatusUnprocessableEntity, "unprocessable_entity"},
+		{http.StatusBadGateway, CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+
+			Error(c, tt.status, "message")
+
+			var problem Problem
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+			assert.Equal(t, tt.status, problem.Status)
+			assert.Equal(t, tt.code, problem.Code)
+			assert.Equal(t, "message", problem.Error, "error keeps the former body readable")
+		})
+	}
+}
@@ -4,15 +4,16 @@ import (
 	"net/http"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/infrastructure/errorlog"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 )
 
-// Error sends a standardized error response
+// Error sends a standardized application/problem+json error response with
+// the default code of statusCode. message is an i18n key, rendered in the
+// request's locale; text that is not a key is sent unchanged.
 func Error(c *gin.Context, statusCode int, message string) {
-	c.JSON(statusCode, gin.H{
-		"error": message,
-	})
+	ErrorWithCode(c, statusCode, "", message)
 }
 
 // Success sends a standardized success response with data
@@ -45,6 +46,19 @@ func SuccessWithPagination(c *gin.Context, statusCode int, key string, data inte
 	})
 }
 
+// SuccessWithCursor sends a cursor-paginated list; next_cursor is null on the last page
+func SuccessWithCursor(c *gin.Context, statusCode int, key string, data interface{}, nextCursor string) {
+	var next interface{}
+	if nextCursor != "" {
+		next = nextCursor
+	}
+	c.JSON(statusCode, gin.H{
+		"data":        data,
+		"next_cursor": next,
+		"message":     "Success",
+	})
+}
+
 // OK is a convenience wrapper for http.StatusOK success responses
 func OK(c *gin.Context, key string, data interface{}, message string) {
 	SuccessWithKey(c, http.StatusOK, key, data, message)
@@ -82,6 +96,22 @@ func InternalErrorWithDetails(c *gin.Context, userMessage string, detailedError
 	Error(c, http.StatusInternalServerError, userMessage)
 }
 
+// PreconditionFailed is a convenience wrapper for http.StatusPreconditionFailed error responses
+// Used when the If-Match version no longer matches the stored resource
+func PreconditionFailed(c *gin.Context, message string) {
+	Error(c, http.StatusPreconditionFailed, message)
+}
+
+// Conflict is a convenience wrapper for http.StatusConflict error responses
+func Conflict(c *gin.Context, message string) {
+	Error(c, http.StatusConflict, message)
+}
+
+// UnsupportedMediaType is a convenience wrapper for http.StatusUnsupportedMediaType error responses
+func UnsupportedMediaType(c *gin.Context, message string) {
+	Error(c, http.StatusUnsupportedMediaType, message)
+}
+
 // Forbidden is a convenience wrapper for http.StatusForbidden error responses
 func Forbidden(c *gin.Context, message string) {
 	Error(c, http.StatusForbidden, message)
@@ -105,7 +135,7 @@ func ForbiddenWithDetails(c *gin.Context, message string, details map[string]int
 		"path":              c.Request.URL.Path,
 		"ip":                c.ClientIP(),
 		"user_agent":        c.Request.UserAgent(),
-		"forbidden_message": message,
+		"forbidden_message": i18n.T(i18n.DefaultLocale, message, nil),
 	}
 
 	// Add all provided details to audit log
@@ -0,0 +1,63 @@
+// Package templates holds the built-in portfolio templates. Each is a JSON
+// bundle in builtin/, embedded in the binary and seeded into the database at
+// startup, so they can be listed and used like the templates users save.
+package templates
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+)
+
+//go:embed builtin/*.json
+var builtinFiles embed.FS
+
+// builtinFile is the layout of a bundle in builtin/
+type builtinFile struct {
+	Key         string                `json:"key"`
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Bundle      models.TemplateBundle `json:"bundle"`
+}
+
+// Builtin returns the built-in templates, sorted by key. The key of each is
+// the name of its file.
+func Builtin() ([]models.PortfolioTemplate, error) {
+	entries, err := builtinFiles.ReadDir("builtin")
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]models.PortfolioTemplate, 0, len(entries))
+	for _, entry := range entries {
+		data, err := builtinFiles.ReadFile(path.Join("builtin", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		var file builtinFile
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&file); err != nil {
+			return nil, fmt.Errorf("template %s: %w", entry.Name(), err)
+		}
+		if key := strings.TrimSuffix(entry.Name(), ".json"); file.Key != key {
+			return nil, fmt.Errorf("template %s: key %q doesn't match the file name", entry.Name(), file.Key)
+		}
+
+		key := file.Key
+		templates = append(templates, models.PortfolioTemplate{
+			Key:         &key,
+			Name:        file.Name,
+			Description: file.Description,
+			Visibility:  models.TemplateBuiltin,
+			Bundle:      file.Bundle,
+		})
+	}
+	return templates, nil
+}
@@ -0,0 +1,63 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltin(t *testing.T) {
+	templates, err := Builtin()
+	require.NoError(t, err)
+
+	var keys []string
+	for _, template := range templates {
+		require.NotNil(t, template.Key)
+		keys = append(keys, *template.Key)
+	}
+	assert.Equal(t, []string{"consultant", "designer", "developer"}, keys)
+
+	for _, template := range templates {
+		t.Run(*template.Key, func(t *testing.T) {
+			assert.Equal(t, models.TemplateBuiltin, template.Visibility)
+			assert.NotEmpty(t, template.Name)
+			assert.NotEmpty(t, template.Description)
+			assert.NotEmpty(t, template.Bundle.Sections)
+			assert.NotEmpty(t, template.Bundle.Categories)
+		})
+	}
+}
+
+// TestBuiltin_Valid checks that everything the built-in templates create
+// passes the validation the API applies to the same content
+func TestBuiltin_Valid(t *testing.T) {
+	templates, err := Builtin()
+	require.NoError(t, err)
+
+	for _, template := range templates {
+		t.Run(*template.Key, func(t *testing.T) {
+			for _, s := range template.Bundle.Sections {
+				section := models.Section{Title: s.Title, Description: s.Description, Type: s.Type, PortfolioID: 1}
+				assert.NoError(t, validator.ValidateSection(&section), s.Title)
+
+				for _, c := range s.Contents {
+					content := models.SectionContent{SectionID: 1, Type: c.Type, Content: c.Content, Metadata: c.Metadata}
+					assert.NoError(t, validator.ValidateSectionContent(&content), c.Content)
+				}
+			}
+
+			for _, c := range template.Bundle.Categories {
+				category := models.Category{Title: c.Title, Description: c.Description, PortfolioID: 1}
+				assert.NoError(t, validator.ValidateCategory(&category), c.Title)
+
+				for _, p := range c.Projects {
+					project := models.Project{Title: p.Title, Description: p.Description, Skills: p.Skills, Role: p.Role, CategoryID: 1}
+					assert.NoError(t, validator.ValidateProject(&project), p.Title)
+				}
+			}
+		})
+	}
+}
@@ -3,19 +3,76 @@ package validator
 import (
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
+	"unicode"
 
 	models2 "github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
 )
 
-// ValidationError represents a validation error
+// Codes of validation failures; they match the binding tags of gin request
+// structs so clients see the same code whichever check failed
+const (
+	CodeRequired    = "required"
+	CodeMin         = "min"
+	CodeMax         = "max"
+	CodeURL         = "url"
+	CodeOneOf       = "oneof"
+	CodeLocale      = "locale"
+	CodeGteField    = "gtefield"
+	CodeExcluded    = "excluded_with"
+	CodePlaceholder = "placeholder"
+)
+
+// maxProjectLinks caps how many typed links a project can have
+const maxProjectLinks = 20
+
+// ValidationError represents a validation error. It carries a code and the
+// catalog message with its parameters; the text is rendered per locale.
 type ValidationError struct {
-	Field   string
-	Message string
+	Field string
+	Code  string
+	// Key is the i18n message; Params fills its placeholders besides {field}
+	Key    string
+	Params i18n.Params
 }
 
+// Error renders the message in the default locale
 func (e ValidationError) Error() string {
-	return e.Message
+	return e.Localize(i18n.DefaultLocale)
+}
+
+// Localize renders the message in locale, naming the field by its label
+func (e ValidationError) Localize(locale string) string {
+	params := i18n.Params{"field": i18n.Label(locale, e.JSONField(), e.Field)}
+	for name, value := range e.Params {
+		params[name] = value
+	}
+	return i18n.T(locale, e.Key, params)
+}
+
+// JSONField returns Field the way clients spell it, e.g. CategoryID → category_id
+func (e ValidationError) JSONField() string {
+	return JSONName(e.Field)
+}
+
+// JSONName converts a Go field name to its snake_case JSON name
+func JSONName(field string) string {
+	runes := []rune(field)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Start a new word at a lower→upper step, or at the last capital of an
+			// acronym followed by lowercase (URLPath → url_path)
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
 }
 
 // ValidateStringLength validates that a string is within the specified length range
@@ -24,19 +81,24 @@ func ValidateStringLength(value, fieldName string, min, max int) error {
 	if length < min {
 		if min == 1 {
 			return ValidationError{
-				Field:   fieldName,
-				Message: fmt.Sprintf("%s is required", fieldName),
+				Field: fieldName,
+				Code:  CodeRequired,
+				Key:   i18n.MsgValidationRequired,
 			}
 		}
 		return ValidationError{
-			Field:   fieldName,
-			Message: fmt.Sprintf("%s must be at least %d characters", fieldName, min),
+			Field:  fieldName,
+			Code:   CodeMin,
+			Key:    i18n.MsgValidationMin,
+			Params: i18n.Params{"min": min},
 		}
 	}
 	if max > 0 && length > max {
 		return ValidationError{
-			Field:   fieldName,
-			Message: fmt.Sprintf("%s must be less than %d characters", fieldName, max),
+			Field:  fieldName,
+			Code:   CodeMax,
+			Key:    i18n.MsgValidationMax,
+			Params: i18n.Params{"max": max},
 		}
 	}
 	return nil
@@ -53,16 +115,18 @@ func ValidateURL(urlStr, fieldName string) error {
 	parsedURL, err := url.Parse(urlStr)
 	if err != nil {
 		return ValidationError{
-			Field:   fieldName,
-			Message: fmt.Sprintf("%s must be a valid URL", fieldName),
+			Field: fieldName,
+			Code:  CodeURL,
+			Key:   i18n.MsgValidationURL,
 		}
 	}
 
 	// Ensure URL has a scheme
 	if parsedURL.Scheme == "" {
 		return ValidationError{
-			Field:   fieldName,
-			Message: fmt.Sprintf("%s must include a scheme (http:// or https://)", fieldName),
+			Field: fieldName,
+			Code:  CodeURL,
+			Key:   i18n.MsgValidationURLSchemeMissing,
 		}
 	}
 
@@ -70,8 +134,9 @@ func ValidateURL(urlStr, fieldName string) error {
 	scheme := strings.ToLower(parsedURL.Scheme)
 	if scheme != "http" && scheme != "https" {
 		return ValidationError{
-			Field:   fieldName,
-			Message: fmt.Sprintf("%s must use http:// or https:// scheme", fieldName),
+			Field: fieldName,
+			Code:  CodeURL,
+			Key:   i18n.MsgValidationURLScheme,
 		}
 	}
 
@@ -93,8 +158,9 @@ func ValidateProject(project *models2.Project) error {
 	// Validate category_id is provided
 	if project.CategoryID == 0 {
 		return ValidationError{
-			Field:   "CategoryID",
-			Message: "Category ID is required",
+			Field: "CategoryID",
+			Code:  CodeRequired,
+			Key:   i18n.MsgValidationRequired,
 		}
 	}
 
@@ -103,12 +169,79 @@ func ValidateProject(project *models2.Project) error {
 		return err
 	}
 
-	// Add more validations as needed
-	// For example: client name, etc.
+	// Validate typed links; each needs a known type and a safe URL
+	if err := validateProjectLinks(project.Links); err != nil {
+		return err
+	}
+
+	// An end date can't precede the start date, nor be set on an ongoing project
+	if project.EndDate != nil {
+		if project.Ongoing {
+			return ValidationError{
+				Field: "EndDate",
+				Code:  CodeExcluded,
+				Key:   i18n.MsgValidationOngoingEndDate,
+			}
+		}
+		if project.StartDate != nil && project.EndDate.Before(*project.StartDate) {
+			return ValidationError{
+				Field: "EndDate",
+				Code:  CodeGteField,
+				Key:   i18n.MsgValidationEndBeforeStart,
+			}
+		}
+	}
+
+	if err := ValidateStringLength(project.Role, "Role", 0, 100); err != nil {
+		return err
+	}
+
+	// Status is optional; the database defaults it to shipped
+	if project.Status != "" && !slices.Contains(models2.ProjectStatuses, project.Status) {
+		return ValidationError{
+			Field:  "Status",
+			Code:   CodeOneOf,
+			Key:    i18n.MsgValidationOneOf,
+			Params: i18n.Params{"values": strings.Join(models2.ProjectStatuses, ", ")},
+		}
+	}
 
 	return nil
 }
 
+// validateProjectLinks checks every typed link of a project
+func validateProjectLinks(links models2.ProjectLinks) error {
+	if len(links) > maxProjectLinks {
+		return ValidationError{
+			Field:  "Links",
+			Code:   CodeMax,
+			Key:    i18n.MsgValidationMaxItems,
+			Params: i18n.Params{"max": maxProjectLinks},
+		}
+	}
+	for i, link := range links {
+		field := fmt.Sprintf("Links[%d]", i)
+		if !slices.Contains(models2.ProjectLinkTypes, link.Type) {
+			return ValidationError{
+				Field:  field + ".Type",
+				Code:   CodeOneOf,
+				Key:    i18n.MsgValidationOneOf,
+				Params: i18n.Params{"values": strings.Join(models2.ProjectLinkTypes, ", ")},
+			}
+		}
+		if err := ValidateStringLength(link.URL, field+".URL", 1, 2048); err != nil {
+			return err
+		}
+		if err := ValidateURL(link.URL, field+".URL"); err != nil {
+			return err
+		}
+		if err := ValidateStringLength(link.Label, field+".Label", 0, 100); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ValidateCategory validates all category fields
 func ValidateCategory(category *models2.Category) error {
 	// Validate title
@@ -119,8 +252,9 @@ func ValidateCategory(category *models2.Category) error {
 	// Validate portfolio_id is provided
 	if category.PortfolioID == 0 {
 		return ValidationError{
-			Field:   "PortfolioID",
-			Message: "Portfolio ID is required",
+			Field: "PortfolioID",
+			Code:  CodeRequired,
+			Key:   i18n.MsgValidationRequired,
 		}
 	}
 
@@ -149,8 +283,9 @@ func ValidateSection(section *models2.Section) error {
 	// Validate portfolio_id is provided
 	if section.PortfolioID == 0 {
 		return ValidationError{
-			Field:   "PortfolioID",
-			Message: "Portfolio ID is required",
+			Field: "PortfolioID",
+			Code:  CodeRequired,
+			Key:   i18n.MsgValidationRequired,
 		}
 	}
 
@@ -186,16 +321,19 @@ func ValidateSectionContent(content *models2.SectionContent) error {
 	// Validate section_id is provided
 	if content.SectionID == 0 {
 		return ValidationError{
-			Field:   "SectionID",
-			Message: "Section ID is required",
+			Field: "SectionID",
+			Code:  CodeRequired,
+			Key:   i18n.MsgValidationRequired,
 		}
 	}
 
 	// Validate type
 	if content.Type != "text" && content.Type != "image" {
 		return ValidationError{
-			Field:   "Type",
-			Message: "Type must be either 'text' or 'image'",
+			Field:  "Type",
+			Code:   CodeOneOf,
+			Key:    i18n.MsgValidationOneOf,
+			Params: i18n.Params{"values": "text, image"},
 		}
 	}
 
@@ -209,11 +347,375 @@ func ValidateSectionContent(content *models2.SectionContent) error {
 		// Basic validation - check if not too long
 		if len(*content.Metadata) > 10000 {
 			return ValidationError{
-				Field:   "Metadata",
-				Message: "Metadata must be less than 10000 characters",
+				Field:  "Metadata",
+				Code:   CodeMax,
+				Key:    i18n.MsgValidationMax,
+				Params: i18n.Params{"max": 10000},
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateWebhook validates all webhook fields
+func ValidateWebhook(webhook *models2.Webhook) error {
+	// Validate portfolio_id is provided
+	if webhook.PortfolioID == 0 {
+		return ValidationError{
+			Field: "PortfolioID",
+			Code:  CodeRequired,
+			Key:   i18n.MsgValidationRequired,
+		}
+	}
+
+	// Validate URL (required, http or https only)
+	if err := ValidateStringLength(webhook.URL, "URL", 1, 2048); err != nil {
+		return err
+	}
+	if err := ValidateURL(webhook.URL, "URL"); err != nil {
+		return err
+	}
+
+	// Validate event filters
+	if len(webhook.Events) == 0 {
+		return ValidationError{
+			Field: "Events",
+			Code:  CodeRequired,
+			Key:   i18n.MsgValidationEventsRequired,
+		}
+	}
+	for _, filter := range webhook.Events {
+		if !validWebhookFilter(filter) {
+			return ValidationError{
+				Field:  "Events",
+				Code:   CodeOneOf,
+				Key:    i18n.MsgValidationUnknownEvent,
+				Params: i18n.Params{"value": filter},
+			}
+		}
+	}
+
+	return nil
+}
+
+// validWebhookFilter accepts "*", a known event, or "<resource>.*" for a known resource
+func validWebhookFilter(filter string) bool {
+	for _, event := range models2.WebhookEvents {
+		if filter == "*" || filter == event {
+			return true
+		}
+		if resource, ok := strings.CutSuffix(filter, ".*"); ok && strings.HasPrefix(event, resource+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateLocales checks the locale settings of a portfolio and returns them in
+// canonical form: the default locale first, always enabled, and no locale twice
+func ValidateLocales(defaultLocale string, locales []string) (string, []string, error) {
+	canonicalDefault, ok := i18n.Canonical(defaultLocale)
+	if !ok {
+		return "", nil, ValidationError{
+			Field: "DefaultLocale",
+			Code:  CodeLocale,
+			Key:   i18n.MsgValidationLocale,
+		}
+	}
+
+	enabled := []string{canonicalDefault}
+	for _, locale := range locales {
+		canonical, ok := i18n.Canonical(locale)
+		if !ok {
+			return "", nil, ValidationError{
+				Field: "Locales",
+				Code:  CodeLocale,
+				Key:   i18n.MsgValidationLocale,
+			}
+		}
+		if !slices.Contains(enabled, canonical) {
+			enabled = append(enabled, canonical)
+		}
+	}
+	return canonicalDefault, enabled, nil
+}
+
+// translationMaxLength mirrors the limits the stored fields are validated with
+var translationMaxLength = map[string]int{
+	"title":       100,
+	"description": 500,
+	"content":     5000,
+}
+
+// ValidateTranslation validates one translated field; an empty value is allowed
+// and removes the translation
+func ValidateTranslation(translation *models2.Translation) error {
+	if _, ok := models2.TranslatableFields[translation.ResourceType]; !ok {
+		return ValidationError{
+			Field:  "ResourceType",
+			Code:   CodeOneOf,
+			Key:    i18n.MsgValidationOneOf,
+			Params: i18n.Params{"values": "portfolio, category, project, section, section_content"},
+		}
+	}
+
+	if translation.ResourceID == 0 {
+		return ValidationError{
+			Field: "ResourceID",
+			Code:  CodeRequired,
+			Key:   i18n.MsgValidationRequired,
+		}
+	}
+
+	if !models2.IsTranslatable(translation.ResourceType, translation.Field) {
+		return ValidationError{
+			Field:  "Field",
+			Code:   CodeOneOf,
+			Key:    i18n.MsgValidationNotTranslatable,
+			Params: i18n.Params{"value": translation.ResourceType + "." + translation.Field},
+		}
+	}
+
+	// The project description has no limit of its own
+	max := translationMaxLength[translation.Field]
+	if translation.ResourceType == models2.TranslationProject && translation.Field == "description" {
+		max = 0
+	}
+	return ValidateStringLength(translation.Value, "Value", 0, max)
+}
+
+// maxSkillAliases bounds how many aliases one skill can have
+const maxSkillAliases = 20
+
+// ValidateSkill validates an entry of the skills taxonomy; Slug and Aliases
+// are expected to be derived already (see models.Skill.SetName)
+func ValidateSkill(skill *models2.Skill) error {
+	if err := ValidateStringLength(strings.TrimSpace(skill.Name), "Name", 1, 100); err != nil {
+		return err
+	}
+	if skill.Slug == "" {
+		return ValidationError{
+			Field: "Name",
+			Code:  CodeRequired,
+			Key:   i18n.MsgValidationRequired,
+		}
+	}
+
+	switch skill.Kind {
+	case "", models2.SkillKindLanguage, models2.SkillKindFramework, models2.SkillKindTool:
+	default:
+		return ValidationError{
+			Field:  "Kind",
+			Code:   CodeOneOf,
+			Key:    i18n.MsgValidationOneOf,
+			Params: i18n.Params{"values": "language, framework, tool"},
+		}
+	}
+
+	if len(skill.Aliases) > maxSkillAliases {
+		return ValidationError{
+			Field:  "Aliases",
+			Code:   CodeMax,
+			Key:    i18n.MsgValidationMaxItems,
+			Params: i18n.Params{"max": maxSkillAliases},
+		}
+	}
+	for _, alias := range skill.Aliases {
+		if err := ValidateStringLength(alias, "Aliases", 1, 100); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateContactMessage validates a message sent through a contact form,
+// which must say something besides whitespace
+func ValidateContactMessage(message *models2.ContactMessage) error {
+	if err := ValidateStringLength(strings.TrimSpace(message.Name), "Name", 1, 100); err != nil {
+		return err
+	}
+	if err := ValidateStringLength(message.Subject, "Subject", 0, 200); err != nil {
+		return err
+	}
+	return ValidateStringLength(strings.TrimSpace(message.Body), "Message", 1, 5000)
+}
+
+// ValidateContactSettings validates the contact form settings of a portfolio;
+// an auto-reply needs a subject and a body, and can't quote the visitor
+func ValidateContactSettings(settings *models2.ContactSettings) error {
+	if !settings.AutoReply {
+		return nil
+	}
+	if err := ValidateStringLength(strings.TrimSpace(settings.AutoReplySubject), "AutoReplySubject", 1, 200); err != nil {
+		return err
+	}
+	if err := ValidateStringLength(strings.TrimSpace(settings.AutoReplyBody), "AutoReplyBody", 1, 5000); err != nil {
+		return err
+	}
+	templates := []struct{ field, text string }{
+		{"AutoReplySubject", settings.AutoReplySubject},
+		{"AutoReplyBody", settings.AutoReplyBody},
+	}
+	for _, template := range templates {
+		for _, placeholder := range models2.VisitorPlaceholders {
+			if strings.Contains(template.text, placeholder) {
+				return ValidationError{
+					Field:  template.field,
+					Code:   CodePlaceholder,
+					Key:    i18n.MsgValidationPlaceholder,
+					Params: i18n.Params{"value": placeholder, "portfolio": "{portfolio}"},
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateTestimonial validates a testimonial; the rating is optional and
+// goes from 1 to 5
+func ValidateTestimonial(testimonial *models2.Testimonial) error {
+	if testimonial.PortfolioID == 0 {
+		return ValidationError{
+			Field: "PortfolioID",
+			Code:  CodeRequired,
+			Key:   i18n.MsgValidationRequired,
+		}
+	}
+	if err := ValidateStringLength(strings.TrimSpace(testimonial.AuthorName), "AuthorName", 1, 100); err != nil {
+		return err
+	}
+	if err := ValidateStringLength(testimonial.AuthorRole, "AuthorRole", 0, 100); err != nil {
+		return err
+	}
+	if err := ValidateStringLength(testimonial.Company, "Company", 0, 100); err != nil {
+		return err
+	}
+	if err := ValidateStringLength(strings.TrimSpace(testimonial.Quote), "Quote", 1, 2000); err != nil {
+		return err
+	}
+	if err := ValidateStringLength(testimonial.AvatarURL, "AvatarURL", 0, 2048); err != nil {
+		return err
+	}
+	if err := ValidateURL(testimonial.AvatarURL, "AvatarURL"); err != nil {
+		return err
+	}
+
+	if testimonial.Rating != nil && *testimonial.Rating < 1 {
+		return ValidationError{
+			Field:  "Rating",
+			Code:   CodeMin,
+			Key:    i18n.MsgValidationMinValue,
+			Params: i18n.Params{"min": 1},
+		}
+	}
+	if testimonial.Rating != nil && *testimonial.Rating > 5 {
+		return ValidationError{
+			Field:  "Rating",
+			Code:   CodeMax,
+			Key:    i18n.MsgValidationMaxValue,
+			Params: i18n.Params{"max": 5},
+		}
+	}
+
+	if !slices.Contains(models2.TestimonialStatuses, testimonial.Status) {
+		return ValidationError{
+			Field:  "Status",
+			Code:   CodeOneOf,
+			Key:    i18n.MsgValidationOneOf,
+			Params: i18n.Params{"values": strings.Join(models2.TestimonialStatuses, ", ")},
+		}
+	}
+
+	return nil
+}
+
+// maxHighlights is how many highlights an experience can list
+const maxHighlights = 20
+
+// ValidateExperience validates an experience; a current position has no end date
+func ValidateExperience(experience *models2.Experience) error {
+	if experience.PortfolioID == 0 {
+		return ValidationError{
+			Field: "PortfolioID",
+			Code:  CodeRequired,
+			Key:   i18n.MsgValidationRequired,
+		}
+	}
+	if err := ValidateStringLength(strings.TrimSpace(experience.Company), "Company", 1, 100); err != nil {
+		return err
+	}
+	if err := ValidateStringLength(strings.TrimSpace(experience.Title), "Title", 1, 100); err != nil {
+		return err
+	}
+	if err := ValidateStringLength(experience.Location, "Location", 0, 100); err != nil {
+		return err
+	}
+
+	if experience.StartDate.IsZero() {
+		return ValidationError{
+			Field: "StartDate",
+			Code:  CodeRequired,
+			Key:   i18n.MsgValidationRequired,
+		}
+	}
+	if experience.EndDate != nil {
+		if experience.Current {
+			return ValidationError{
+				Field: "EndDate",
+				Code:  CodeExcluded,
+				Key:   i18n.MsgValidationCurrentEndDate,
+			}
+		}
+		if experience.EndDate.Before(experience.StartDate) {
+			return ValidationError{
+				Field: "EndDate",
+				Code:  CodeGteField,
+				Key:   i18n.MsgValidationEndBeforeStart,
 			}
 		}
 	}
 
+	if len(experience.Highlights) > maxHighlights {
+		return ValidationError{
+			Field:  "Highlights",
+			Code:   CodeMax,
+			Key:    i18n.MsgValidationMaxItems,
+			Params: i18n.Params{"max": maxHighlights},
+		}
+	}
+	for i, highlight := range experience.Highlights {
+		if err := ValidateStringLength(strings.TrimSpace(highlight), fmt.Sprintf("Highlights[%d]", i), 1, 300); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateEducation validates an education entry; both dates are optional
+func ValidateEducation(education *models2.Education) error {
+	if education.PortfolioID == 0 {
+		return ValidationError{
+			Field: "PortfolioID",
+			Code:  CodeRequired,
+			Key:   i18n.MsgValidationRequired,
+		}
+	}
+	if err := ValidateStringLength(strings.TrimSpace(education.Institution), "Institution", 1, 150); err != nil {
+		return err
+	}
+	if err := ValidateStringLength(strings.TrimSpace(education.Degree), "Degree", 1, 150); err != nil {
+		return err
+	}
+
+	if education.StartDate != nil && education.EndDate != nil && education.EndDate.Before(*education.StartDate) {
+		return ValidationError{
+			Field: "EndDate",
+			Code:  CodeGteField,
+			Key:   i18n.MsgValidationEndBeforeStart,
+		}
+	}
+
 	return nil
 }
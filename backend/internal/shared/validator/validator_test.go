@@ -1,9 +1,12 @@
 package validator
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/application/models"
+	"github.com/JorgeSaicoski/portfolio-manager/backend/internal/shared/i18n"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -185,6 +188,9 @@ func TestValidateURL(t *testing.T) {
 }
 
 func TestValidateProject(t *testing.T) {
+	start := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name    string
 		project *models.Project
@@ -303,6 +309,107 @@ func TestValidateProject(t *testing.T) {
 			wantErr: true,
 			errMsg:  "Category ID is required",
 		},
+		{
+			name: "Valid project with typed links, dates and status",
+			project: &models.Project{
+				Title:       "Test Project",
+				Description: "A test project description",
+				CategoryID:  1,
+				Links: models.ProjectLinks{
+					{Type: models.LinkRepo, URL: "https://github.com/example/app"},
+					{Type: models.LinkAppStore, URL: "https://apps.apple.com/app/id1", Label: "iOS"},
+				},
+				StartDate: &start,
+				EndDate:   &end,
+				Role:      "Lead developer",
+				TeamSize:  4,
+				Status:    models.ProjectArchived,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Valid ongoing project without end date",
+			project: &models.Project{
+				Title:       "Test Project",
+				Description: "A test project description",
+				CategoryID:  1,
+				StartDate:   &start,
+				Ongoing:     true,
+				Status:      models.ProjectInProgress,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Unknown link type",
+			project: &models.Project{
+				Title:       "Test Project",
+				Description: "A test project description",
+				CategoryID:  1,
+				Links:       models.ProjectLinks{{Type: "blog", URL: "https://example.com"}},
+			},
+			wantErr: true,
+			errMsg:  "Links[0].Type must be one of: repo, demo, case_study, app_store",
+		},
+		{
+			name: "Link with unsafe scheme",
+			project: &models.Project{
+				Title:       "Test Project",
+				Description: "A test project description",
+				CategoryID:  1,
+				Links: models.ProjectLinks{
+					{Type: models.LinkDemo, URL: "https://example.com"},
+					{Type: models.LinkDemo, URL: "javascript:alert('XSS')"},
+				},
+			},
+			wantErr: true,
+			errMsg:  "Links[1].URL must use http:// or https:// scheme",
+		},
+		{
+			name: "Link without URL",
+			project: &models.Project{
+				Title:       "Test Project",
+				Description: "A test project description",
+				CategoryID:  1,
+				Links:       models.ProjectLinks{{Type: models.LinkCaseStudy}},
+			},
+			wantErr: true,
+			errMsg:  "Links[0].URL is required",
+		},
+		{
+			name: "End date before start date",
+			project: &models.Project{
+				Title:       "Test Project",
+				Description: "A test project description",
+				CategoryID:  1,
+				StartDate:   &end,
+				EndDate:     &start,
+			},
+			wantErr: true,
+			errMsg:  "End date must not be before the start date",
+		},
+		{
+			name: "Ongoing project with end date",
+			project: &models.Project{
+				Title:       "Test Project",
+				Description: "A test project description",
+				CategoryID:  1,
+				EndDate:     &end,
+				Ongoing:     true,
+			},
+			wantErr: true,
+			errMsg:  "End date must be empty for an ongoing project",
+		},
+		{
+			name: "Unknown status",
+			project: &models.Project{
+				Title:       "Test Project",
+				Description: "A test project description",
+				CategoryID:  1,
+				Status:      "done",
+			},
+			wantErr: true,
+			errMsg:  "Status must be one of: shipped, in_progress, archived",
+		},
 	}
 
 	for _, tt := range tests {
@@ -581,11 +688,542 @@ func TestValidateSectionContent(t *testing.T) {
 	}
 }
 
+func TestValidateWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		webhook *models.Webhook
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "Valid webhook",
+			webhook: &models.Webhook{
+				PortfolioID: 1,
+				URL:         "https://example.com/hooks",
+				Events:      []string{"project.created", "section.*"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Wildcard for all events",
+			webhook: &models.Webhook{
+				PortfolioID: 1,
+				URL:         "https://example.com/hooks",
+				Events:      []string{"*"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Missing URL",
+			webhook: &models.Webhook{
+				PortfolioID: 1,
+				Events:      []string{"*"},
+			},
+			wantErr: true,
+			errMsg:  "URL is required",
+		},
+		{
+			name: "Non-HTTP URL",
+			webhook: &models.Webhook{
+				PortfolioID: 1,
+				URL:         "ftp://example.com",
+				Events:      []string{"*"},
+			},
+			wantErr: true,
+			errMsg:  "must use http:// or https:// scheme",
+		},
+		{
+			name: "No events",
+			webhook: &models.Webhook{
+				PortfolioID: 1,
+				URL:         "https://example.com/hooks",
+			},
+			wantErr: true,
+			errMsg:  "At least one event is required",
+		},
+		{
+			name: "Unknown event",
+			webhook: &models.Webhook{
+				PortfolioID: 1,
+				URL:         "https://example.com/hooks",
+				Events:      []string{"image.*"},
+			},
+			wantErr: true,
+			errMsg:  "Unknown event",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateWebhook(tt.webhook)
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errMsg != "" {
+					assert.Contains(t, err.Error(), tt.errMsg)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateLocales(t *testing.T) {
+	tests := []struct {
+		name            string
+		defaultLocale   string
+		locales         []string
+		expectedDefault string
+		expected        []string
+		errMsg          string
+	}{
+		{
+			name:            "Canonical form with the default first",
+			defaultLocale:   "PT-br",
+			locales:         []string{"en", "es-mx"},
+			expectedDefault: "pt-BR",
+			expected:        []string{"pt-BR", "en", "es-MX"},
+		},
+		{
+			name:            "Duplicates dropped",
+			defaultLocale:   "en",
+			locales:         []string{"es", "EN", "es"},
+			expectedDefault: "en",
+			expected:        []string{"en", "es"},
+		},
+		{
+			name:            "Default only",
+			defaultLocale:   "es",
+			expectedDefault: "es",
+			expected:        []string{"es"},
+		},
+		{
+			name:          "Invalid default",
+			defaultLocale: "english",
+			errMsg:        "Default locale must be a language tag",
+		},
+		{
+			name:          "Invalid locale",
+			defaultLocale: "en",
+			locales:       []string{"pt_BR"},
+			errMsg:        "Locales must be a language tag",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defaultLocale, locales, err := ValidateLocales(tt.defaultLocale, tt.locales)
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedDefault, defaultLocale)
+			assert.Equal(t, tt.expected, locales)
+		})
+	}
+}
+
+func TestValidateTranslation(t *testing.T) {
+	tests := []struct {
+		name        string
+		translation *models.Translation
+		errMsg      string
+	}{
+		{
+			name:        "Valid title",
+			translation: &models.Translation{ResourceType: models.TranslationProject, ResourceID: 1, Field: "title", Value: "Projeto"},
+		},
+		{
+			name:        "Empty value removes",
+			translation: &models.Translation{ResourceType: models.TranslationSection, ResourceID: 1, Field: "description"},
+		},
+		{
+			name:        "Long project description",
+			translation: &models.Translation{ResourceType: models.TranslationProject, ResourceID: 1, Field: "description", Value: strings.Repeat("a", 2000)},
+		},
+		{
+			name:        "Unknown resource type",
+			translation: &models.Translation{ResourceType: "webhook", ResourceID: 1, Field: "url", Value: "x"},
+			errMsg:      "must be one of",
+		},
+		{
+			name:        "Missing resource ID",
+			translation: &models.Translation{ResourceType: models.TranslationCategory, Field: "title", Value: "x"},
+			errMsg:      "Resource ID is required",
+		},
+		{
+			name:        "Field not translatable",
+			translation: &models.Translation{ResourceType: models.TranslationSectionContent, ResourceID: 1, Field: "title", Value: "x"},
+			errMsg:      "section_content.title cannot be translated",
+		},
+		{
+			name:        "Title too long",
+			translation: &models.Translation{ResourceType: models.TranslationPortfolio, ResourceID: 1, Field: "title", Value: strings.Repeat("a", 101)},
+			errMsg:      "must be less than 100 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTranslation(tt.translation)
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateSkill(t *testing.T) {
+	skill := func(name, kind string, aliases ...string) *models.Skill {
+		s := &models.Skill{Kind: kind}
+		s.SetName(name, aliases)
+		return s
+	}
+	manyAliases := make([]string, 21)
+	for i := range manyAliases {
+		manyAliases[i] = strings.Repeat("a", i+1)
+	}
+
+	tests := []struct {
+		name   string
+		skill  *models.Skill
+		errMsg string
+	}{
+		{
+			name:  "Valid skill",
+			skill: skill("Go", models.SkillKindLanguage, "golang"),
+		},
+		{
+			name:  "Kind is optional",
+			skill: skill("Scrum", ""),
+		},
+		{
+			name:   "Missing name",
+			skill:  skill("  ", ""),
+			errMsg: "Name is required",
+		},
+		{
+			name:   "Name without letters",
+			skill:  skill("--", ""),
+			errMsg: "Name is required",
+		},
+		{
+			name:   "Name too long",
+			skill:  skill(strings.Repeat("a", 101), ""),
+			errMsg: "must be less than 100 characters",
+		},
+		{
+			name:   "Unknown kind",
+			skill:  skill("Figma", "design"),
+			errMsg: "must be one of: language, framework, tool",
+		},
+		{
+			name:   "Too many aliases",
+			skill:  skill("Go", "", manyAliases...),
+			errMsg: "can have at most 20 items",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSkill(tt.skill)
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateContactMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		message *models.ContactMessage
+		errMsg  string
+	}{
+		{
+			name:    "Valid message",
+			message: &models.ContactMessage{Name: "Ana", Subject: "Hello", Body: "Let's work together"},
+		},
+		{
+			name:    "Subject is optional",
+			message: &models.ContactMessage{Name: "Ana", Body: "Hi"},
+		},
+		{
+			name:    "Blank name",
+			message: &models.ContactMessage{Name: "   ", Body: "Hi"},
+			errMsg:  "Name is required",
+		},
+		{
+			name:    "Blank message",
+			message: &models.ContactMessage{Name: "Ana", Body: " \n "},
+			errMsg:  "Message is required",
+		},
+		{
+			name:    "Subject too long",
+			message: &models.ContactMessage{Name: "Ana", Subject: strings.Repeat("a", 201), Body: "Hi"},
+			errMsg:  "must be less than 200 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateContactMessage(tt.message)
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateContactSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *models.ContactSettings
+		errMsg   string
+	}{
+		{
+			name:     "Without auto-reply",
+			settings: &models.ContactSettings{Enabled: true},
+		},
+		{
+			name:     "Valid auto-reply",
+			settings: &models.ContactSettings{AutoReply: true, AutoReplySubject: "Thanks for writing to {portfolio}", AutoReplyBody: "I'll get back to you soon."},
+		},
+		{
+			name:     "Auto-reply quoting the visitor",
+			settings: &models.ContactSettings{AutoReply: true, AutoReplySubject: "Thanks", AutoReplyBody: "Got your message about {subject}"},
+			errMsg:   "Auto-reply body can't use {subject}; only {portfolio} is filled in",
+		},
+		{
+			name:     "Auto-reply without subject",
+			settings: &models.ContactSettings{AutoReply: true, AutoReplyBody: "Thanks"},
+			errMsg:   "Auto-reply subject is required",
+		},
+		{
+			name:     "Auto-reply without body",
+			settings: &models.ContactSettings{AutoReply: true, AutoReplySubject: "Thanks", AutoReplyBody: "  "},
+			errMsg:   "Auto-reply body is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateContactSettings(tt.settings)
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateTestimonial(t *testing.T) {
+	rating := func(value uint) *uint { return &value }
+	valid := func() *models.Testimonial {
+		return &models.Testimonial{PortfolioID: 1, AuthorName: "Ana", Quote: "Great work", Status: models.TestimonialPending}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*models.Testimonial)
+		errMsg string
+	}{
+		{name: "Valid testimonial", modify: func(*models.Testimonial) {}},
+		{
+			name: "All fields",
+			modify: func(tm *models.Testimonial) {
+				tm.AuthorRole = "CTO"
+				tm.Company = "Acme"
+				tm.AvatarURL = "https://example.com/ana.png"
+				tm.Rating = rating(5)
+				tm.Status = models.TestimonialApproved
+			},
+		},
+		{name: "Missing portfolio", modify: func(tm *models.Testimonial) { tm.PortfolioID = 0 }, errMsg: "is required"},
+		{name: "Blank author", modify: func(tm *models.Testimonial) { tm.AuthorName = "  " }, errMsg: "Author name is required"},
+		{name: "Blank quote", modify: func(tm *models.Testimonial) { tm.Quote = "\n" }, errMsg: "Quote is required"},
+		{name: "Quote too long", modify: func(tm *models.Testimonial) { tm.Quote = strings.Repeat("a", 2001) }, errMsg: "must be less than 2000 characters"},
+		{name: "Avatar not http", modify: func(tm *models.Testimonial) { tm.AvatarURL = "javascript:alert(1)" }, errMsg: "Avatar URL"},
+		{name: "Rating zero", modify: func(tm *models.Testimonial) { tm.Rating = rating(0) }, errMsg: "Rating must be at least 1"},
+		{name: "Rating above five", modify: func(tm *models.Testimonial) { tm.Rating = rating(6) }, errMsg: "Rating must be at most 5"},
+		{name: "Unknown status", modify: func(tm *models.Testimonial) { tm.Status = "published" }, errMsg: "Status must be one of"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testimonial := valid()
+			tt.modify(testimonial)
+			err := ValidateTestimonial(testimonial)
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateExperience(t *testing.T) {
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	date := func(t time.Time) *time.Time { return &t }
+	valid := func() *models.Experience {
+		return &models.Experience{PortfolioID: 1, Company: "Acme", Title: "Backend Engineer", StartDate: start}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*models.Experience)
+		errMsg string
+	}{
+		{name: "Valid experience", modify: func(*models.Experience) {}},
+		{
+			name: "All fields",
+			modify: func(e *models.Experience) {
+				e.Location = "Lisbon"
+				e.EndDate = date(start.AddDate(2, 0, 0))
+				e.Highlights = models.StringArray{"Cut p99 latency in half", "Led the billing rewrite"}
+			},
+		},
+		{name: "Current position", modify: func(e *models.Experience) { e.Current = true }},
+		{name: "Missing portfolio", modify: func(e *models.Experience) { e.PortfolioID = 0 }, errMsg: "is required"},
+		{name: "Blank company", modify: func(e *models.Experience) { e.Company = " " }, errMsg: "Company is required"},
+		{name: "Blank title", modify: func(e *models.Experience) { e.Title = "" }, errMsg: "Title is required"},
+		{name: "Location too long", modify: func(e *models.Experience) { e.Location = strings.Repeat("a", 101) }, errMsg: "must be less than 100 characters"},
+		{name: "Missing start date", modify: func(e *models.Experience) { e.StartDate = time.Time{} }, errMsg: "Start date is required"},
+		{name: "End before start", modify: func(e *models.Experience) { e.EndDate = date(start.AddDate(0, -1, 0)) }, errMsg: "must not be before the start date"},
+		{
+			name: "End date on current position",
+			modify: func(e *models.Experience) {
+				e.Current = true
+				e.EndDate = date(start.AddDate(1, 0, 0))
+			},
+			errMsg: "must be empty for a current position",
+		},
+		{name: "Too many highlights", modify: func(e *models.Experience) { e.Highlights = make(models.StringArray, 21) }, errMsg: "at most 20 items"},
+		{name: "Blank highlight", modify: func(e *models.Experience) { e.Highlights = models.StringArray{"Shipped", "  "} }, errMsg: "is required"},
+		{name: "Highlight too long", modify: func(e *models.Experience) { e.Highlights = models.StringArray{strings.Repeat("a", 301)} }, errMsg: "must be less than 300 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			experience := valid()
+			tt.modify(experience)
+			err := ValidateExperience(experience)
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateEducation(t *testing.T) {
+	start := time.Date(2015, 9, 1, 0, 0, 0, 0, time.UTC)
+	date := func(t time.Time) *time.Time { return &t }
+	valid := func() *models.Education {
+		return &models.Education{PortfolioID: 1, Institution: "University of Lisbon", Degree: "BSc Computer Science"}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*models.Education)
+		errMsg string
+	}{
+		{name: "Valid without dates", modify: func(*models.Education) {}},
+		{
+			name: "With dates",
+			modify: func(e *models.Education) {
+				e.StartDate = date(start)
+				e.EndDate = date(start.AddDate(4, 0, 0))
+			},
+		},
+		{name: "Only end date", modify: func(e *models.Education) { e.EndDate = date(start) }},
+		{name: "Missing portfolio", modify: func(e *models.Education) { e.PortfolioID = 0 }, errMsg: "is required"},
+		{name: "Blank institution", modify: func(e *models.Education) { e.Institution = "  " }, errMsg: "Institution is required"},
+		{name: "Blank degree", modify: func(e *models.Education) { e.Degree = "" }, errMsg: "Degree is required"},
+		{name: "Degree too long", modify: func(e *models.Education) { e.Degree = strings.Repeat("a", 151) }, errMsg: "must be less than 150 characters"},
+		{
+			name: "End before start",
+			modify: func(e *models.Education) {
+				e.StartDate = date(start)
+				e.EndDate = date(start.AddDate(-1, 0, 0))
+			},
+			errMsg: "must not be before the start date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			education := valid()
+			tt.modify(education)
+			err := ValidateEducation(education)
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestValidationError_Error(t *testing.T) {
 	err := ValidationError{
-		Field:   "TestField",
-		Message: "Test error message",
+		Field: "TestField",
+		Code:  CodeRequired,
+		Key:   i18n.MsgValidationRequired,
+	}
+
+	assert.Equal(t, "TestField is required", err.Error())
+}
+
+func TestValidationError_Localize(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		locale   string
+		expected string
+	}{
+		{"RequiredEnglish", ValidateStringLength("", "Title", 1, 100), i18n.English, "Title is required"},
+		{"RequiredPortuguese", ValidateStringLength("", "Title", 1, 100), i18n.PortugueseBrazil, "O campo Título é obrigatório"},
+		{"MaxSpanish", ValidateStringLength("long", "Description", 0, 2), i18n.Spanish, "El campo Descripción debe tener menos de 2 caracteres"},
+		{"LabelledField", ValidateCategory(&models.Category{Title: "Ok"}), i18n.PortugueseBrazil, "O campo ID do portfólio é obrigatório"},
+		{"UnknownEvent", ValidateWebhook(&models.Webhook{PortfolioID: 1, URL: "https://example.com", Events: []string{"nope"}}), i18n.Spanish, `Evento desconocido "nope"`},
 	}
 
-	assert.Equal(t, "Test error message", err.Error())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var validationErr ValidationError
+			if assert.ErrorAs(t, tt.err, &validationErr) {
+				assert.Equal(t, tt.expected, validationErr.Localize(tt.locale))
+			}
+		})
+	}
+}
+
+func TestJSONName(t *testing.T) {
+	tests := []struct {
+		field    string
+		expected string
+	}{
+		{"Title", "title"},
+		{"CategoryID", "category_id"},
+		{"URL", "url"},
+		{"URLPath", "url_path"},
+		{"SectionID", "section_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			assert.Equal(t, tt.expected, JSONName(tt.field))
+		})
+	}
 }